@@ -121,6 +121,12 @@ const (
 	// funds towards the total capacity of the channel. The channel may be
 	// funded symmetrically or asymmetrically.
 	DualFunder = 1
+
+	// SingleFunderExternal represents a single funder channel we initiated
+	// with a funding transaction constructed and signed outside of the
+	// wallet, such as from a PSBT. Whoever signed the transaction is in
+	// charge of publishing it, so we never broadcast it ourselves.
+	SingleFunderExternal = 2
 )
 
 // ChannelConstraints represents a set of constraints meant to allow a node to
@@ -451,7 +457,7 @@ type OpenChannel struct {
 	// is found to be pending.
 	//
 	// NOTE: This value will only be populated for single-funder channels
-	// for which we are the initiator.
+	// for which we are the initiator, and for dual-funder channels.
 	FundingTxn *wire.MsgTx
 
 	// TODO(roasbeef): eww
@@ -472,6 +478,21 @@ func (c *OpenChannel) FullSync() error {
 	return c.Db.Update(c.fullSync)
 }
 
+// hasFundingTxn returns true if the funding transaction of the channel is
+// stored along with it. This is the case for single funder channels that we
+// initiated, and for all dual funder channels as either side may need to
+// rebroadcast the funding transaction.
+func (c *OpenChannel) hasFundingTxn() bool {
+	switch c.ChanType {
+	case SingleFunder:
+		return c.IsInitiator
+	case DualFunder, SingleFunderExternal:
+		return true
+	default:
+		return false
+	}
+}
+
 // ShortChanID returns the current ShortChannelID of this channel.
 func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	c.RLock()
@@ -1911,6 +1932,13 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 			return err
 		}
 
+		// The override of the channel's commitment fee policy is no
+		// longer needed either.
+		err = deleteCommitFeePolicyOverride(tx, chanPointBuf.Bytes())
+		if err != nil {
+			return err
+		}
+
 		// Finally, create a summary of this channel in the closed
 		// channel bucket for this node.
 		return putChannelCloseSummary(
@@ -2230,8 +2258,9 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For single funder channels that we initiated, as well as all dual
+	// funder channels, write the funding txn.
+	if channel.hasFundingTxn() {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2340,8 +2369,9 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For single funder channels that we initiated, as well as all dual
+	// funder channels, read the funding txn.
+	if channel.hasFundingTxn() {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
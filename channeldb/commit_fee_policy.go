@@ -0,0 +1,224 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// commitFeePolicyBucket is the name of the bucket within the database
+	// that stores the overrides of the commitment fee policies of our
+	// channels, keyed by their channel point.
+	commitFeePolicyBucket = []byte("commit-fee-policies")
+)
+
+// CommitFeePolicyOverride overrides fields of the commitment fee policy
+// configured for a channel. Nil fields leave the configured ones in place,
+// while fields set to zero reset them to their default.
+type CommitFeePolicyOverride struct {
+	// ConfTarget overrides the confirmation target, in blocks, the fee
+	// estimator is queried with.
+	ConfTarget *uint32
+
+	// MinFeePerKw overrides the lowest fee rate, in sat/kw, we propose.
+	MinFeePerKw *uint64
+
+	// MaxFeePerKw overrides the highest fee rate, in sat/kw, we propose.
+	MaxFeePerKw *uint64
+
+	// Hysteresis overrides the percentage by which the target fee rate
+	// must deviate from the current commitment fee rate before we propose
+	// it.
+	Hysteresis *uint32
+}
+
+// Update sets the fields of the override to the fields set in the passed
+// override.
+func (o *CommitFeePolicyOverride) Update(newOverride *CommitFeePolicyOverride) {
+	if newOverride.ConfTarget != nil {
+		o.ConfTarget = newOverride.ConfTarget
+	}
+	if newOverride.MinFeePerKw != nil {
+		o.MinFeePerKw = newOverride.MinFeePerKw
+	}
+	if newOverride.MaxFeePerKw != nil {
+		o.MaxFeePerKw = newOverride.MaxFeePerKw
+	}
+	if newOverride.Hysteresis != nil {
+		o.Hysteresis = newOverride.Hysteresis
+	}
+}
+
+// UpdateCommitFeePolicyOverride updates the persisted override of the
+// commitment fee policy of the passed channel with the fields set in the new
+// override, and returns the resulting override.
+func (d *DB) UpdateCommitFeePolicyOverride(chanPoint *wire.OutPoint,
+	newOverride *CommitFeePolicyOverride) (*CommitFeePolicyOverride, error) {
+
+	var chanPointBuf bytes.Buffer
+	if err := writeOutpoint(&chanPointBuf, chanPoint); err != nil {
+		return nil, err
+	}
+
+	var override *CommitFeePolicyOverride
+	err := d.Update(func(tx *bbolt.Tx) error {
+		policies, err := tx.CreateBucketIfNotExists(
+			commitFeePolicyBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		override, err = fetchCommitFeePolicyOverride(
+			policies, chanPointBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+		override.Update(newOverride)
+
+		var b bytes.Buffer
+		if err := serializeCommitFeePolicyOverride(&b, override); err != nil {
+			return err
+		}
+
+		return policies.Put(chanPointBuf.Bytes(), b.Bytes())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+// FetchCommitFeePolicyOverride returns the persisted override of the
+// commitment fee policy of the passed channel. If the policy of the channel
+// was never overridden, an override without any set fields is returned.
+func (d *DB) FetchCommitFeePolicyOverride(
+	chanPoint *wire.OutPoint) (*CommitFeePolicyOverride, error) {
+
+	var chanPointBuf bytes.Buffer
+	if err := writeOutpoint(&chanPointBuf, chanPoint); err != nil {
+		return nil, err
+	}
+
+	var override *CommitFeePolicyOverride
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		override, err = fetchCommitFeePolicyOverride(
+			tx.Bucket(commitFeePolicyBucket), chanPointBuf.Bytes(),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+// fetchCommitFeePolicyOverride reads the override of the commitment fee
+// policy of the channel from the passed bucket, which may be nil.
+func fetchCommitFeePolicyOverride(policies *bbolt.Bucket,
+	chanPoint []byte) (*CommitFeePolicyOverride, error) {
+
+	override := &CommitFeePolicyOverride{}
+	if policies == nil {
+		return override, nil
+	}
+
+	overrideBytes := policies.Get(chanPoint)
+	if overrideBytes == nil {
+		return override, nil
+	}
+
+	err := deserializeCommitFeePolicyOverride(
+		bytes.NewReader(overrideBytes), override,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+// deleteCommitFeePolicyOverride removes the override of the commitment fee
+// policy of the passed channel, if any.
+func deleteCommitFeePolicyOverride(tx *bbolt.Tx, chanPoint []byte) error {
+	policies := tx.Bucket(commitFeePolicyBucket)
+	if policies == nil {
+		return nil
+	}
+
+	return policies.Delete(chanPoint)
+}
+
+// serializeCommitFeePolicyOverride writes each field of the override as a flag
+// signaling whether it's set, followed by its value.
+func serializeCommitFeePolicyOverride(w io.Writer,
+	o *CommitFeePolicyOverride) error {
+
+	var (
+		confTarget, hysteresis   uint32
+		minFeePerKw, maxFeePerKw uint64
+	)
+	if o.ConfTarget != nil {
+		confTarget = *o.ConfTarget
+	}
+	if o.MinFeePerKw != nil {
+		minFeePerKw = *o.MinFeePerKw
+	}
+	if o.MaxFeePerKw != nil {
+		maxFeePerKw = *o.MaxFeePerKw
+	}
+	if o.Hysteresis != nil {
+		hysteresis = *o.Hysteresis
+	}
+
+	return WriteElements(w,
+		o.ConfTarget != nil, confTarget,
+		o.MinFeePerKw != nil, minFeePerKw,
+		o.MaxFeePerKw != nil, maxFeePerKw,
+		o.Hysteresis != nil, hysteresis,
+	)
+}
+
+// deserializeCommitFeePolicyOverride reads an override written by
+// serializeCommitFeePolicyOverride.
+func deserializeCommitFeePolicyOverride(r io.Reader,
+	o *CommitFeePolicyOverride) error {
+
+	var (
+		hasConfTarget, hasHysteresis   bool
+		hasMinFeePerKw, hasMaxFeePerKw bool
+		confTarget, hysteresis         uint32
+		minFeePerKw, maxFeePerKw       uint64
+	)
+	err := ReadElements(r,
+		&hasConfTarget, &confTarget,
+		&hasMinFeePerKw, &minFeePerKw,
+		&hasMaxFeePerKw, &maxFeePerKw,
+		&hasHysteresis, &hysteresis,
+	)
+	if err != nil {
+		return err
+	}
+
+	if hasConfTarget {
+		o.ConfTarget = &confTarget
+	}
+	if hasMinFeePerKw {
+		o.MinFeePerKw = &minFeePerKw
+	}
+	if hasMaxFeePerKw {
+		o.MaxFeePerKw = &maxFeePerKw
+	}
+	if hasHysteresis {
+		o.Hysteresis = &hysteresis
+	}
+
+	return nil
+}
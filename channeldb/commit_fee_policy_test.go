@@ -0,0 +1,103 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TestCommitFeePolicyOverride tests that the override of the commitment fee
+// policy of a channel is persisted, that fields can be reset to zero by later
+// updates while unset fields are kept, and that the override is removed once
+// the channel is closed.
+func TestCommitFeePolicyOverride(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := state.FullSync(); err != nil {
+		t.Fatalf("unable to save channel state: %v", err)
+	}
+	chanPoint := &state.FundingOutpoint
+
+	assertOverride := func(expected *CommitFeePolicyOverride) {
+		t.Helper()
+
+		override, err := cdb.FetchCommitFeePolicyOverride(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch override: %v", err)
+		}
+		if !reflect.DeepEqual(override, expected) {
+			t.Fatalf("expected override %v, got %v", expected,
+				override)
+		}
+	}
+
+	// A channel whose policy was never overridden has an empty override.
+	assertOverride(&CommitFeePolicyOverride{})
+
+	var (
+		confTarget  = uint32(6)
+		maxFeePerKw = uint64(10000)
+		hysteresis  = uint32(20)
+		zeroFee     = uint64(0)
+		zeroPercent = uint32(0)
+	)
+	_, err = cdb.UpdateCommitFeePolicyOverride(
+		chanPoint, &CommitFeePolicyOverride{
+			ConfTarget:  &confTarget,
+			MaxFeePerKw: &maxFeePerKw,
+			Hysteresis:  &hysteresis,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update override: %v", err)
+	}
+	assertOverride(&CommitFeePolicyOverride{
+		ConfTarget:  &confTarget,
+		MaxFeePerKw: &maxFeePerKw,
+		Hysteresis:  &hysteresis,
+	})
+
+	// Resetting the max fee rate and the hysteresis to zero keeps them set,
+	// along with the confirmation target that isn't updated.
+	override, err := cdb.UpdateCommitFeePolicyOverride(
+		chanPoint, &CommitFeePolicyOverride{
+			MaxFeePerKw: &zeroFee,
+			Hysteresis:  &zeroPercent,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update override: %v", err)
+	}
+	expected := &CommitFeePolicyOverride{
+		ConfTarget:  &confTarget,
+		MaxFeePerKw: &zeroFee,
+		Hysteresis:  &zeroPercent,
+	}
+	if !reflect.DeepEqual(override, expected) {
+		t.Fatalf("expected override %v, got %v", expected, override)
+	}
+	assertOverride(expected)
+
+	// Once the channel is closed, its override is removed.
+	closeSummary := &ChannelCloseSummary{
+		ChanPoint:      state.FundingOutpoint,
+		RemotePub:      state.IdentityPub,
+		SettledBalance: btcutil.Amount(500),
+		CloseType:      CooperativeClose,
+	}
+	if err := state.CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertOverride(&CommitFeePolicyOverride{})
+}
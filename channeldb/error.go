@@ -35,10 +35,26 @@ var (
 	// payment hash already exists.
 	ErrDuplicateInvoice = fmt.Errorf("invoice with payment hash already exists")
 
+	// ErrInvoiceAlreadySettled is returned when an invoice that has
+	// already been settled is attempted to be canceled.
+	ErrInvoiceAlreadySettled = fmt.Errorf("invoice already settled")
+
+	// ErrInvoiceAlreadyCanceled is returned when an invoice that has
+	// already been canceled is attempted to be accepted or settled.
+	ErrInvoiceAlreadyCanceled = fmt.Errorf("invoice already canceled")
+
+	// ErrInvoiceNotAccepted is returned when a hold invoice is attempted
+	// to be settled before the HTLC set paying to it has been accepted.
+	ErrInvoiceNotAccepted = fmt.Errorf("invoice not accepted")
+
 	// ErrNoPaymentsCreated is returned when bucket of payments hasn't been
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
 
+	// ErrPaymentAttemptNotFound is returned when an attempt to send a
+	// payment is attempted to be resolved, but it can't be found.
+	ErrPaymentAttemptNotFound = fmt.Errorf("payment attempt not found")
+
 	// ErrNodeNotFound is returned when node bucket exists, but node with
 	// specific identity can't be found.
 	ErrNodeNotFound = fmt.Errorf("link node with target identity not found")
@@ -29,6 +29,11 @@ func randInvoice(value lnwire.MilliSatoshi) (*Invoice, error) {
 	i.Memo = []byte("memo")
 	i.Receipt = []byte("receipt")
 
+	if _, err := rand.Read(i.PaymentAddr[:]); err != nil {
+		return nil, err
+	}
+	i.PaymentAddrRequired = i.PaymentAddr[0]&1 == 0
+
 	// Create a random byte slice of MaxPaymentRequestSize bytes to be used
 	// as a dummy paymentrequest, and  determine if it should be set based
 	// on one of the random bytes.
@@ -106,7 +111,7 @@ func TestInvoiceWorkflow(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to fetch invoice: %v", err)
 	}
-	if !dbInvoice2.Terms.Settled {
+	if dbInvoice2.Terms.State != ContractSettled {
 		t.Fatalf("invoice should now be settled but isn't")
 	}
 	if dbInvoice2.SettleDate.IsZero() {
@@ -348,7 +353,7 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	// We'll update what we expect the settle invoice to be so that our
 	// comparison below has the correct assumption.
 	invoice.SettleIndex = 1
-	invoice.Terms.Settled = true
+	invoice.Terms.State = ContractSettled
 	invoice.AmtPaid = amt
 	invoice.SettleDate = dbInvoice.SettleDate
 
@@ -376,6 +381,361 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	}
 }
 
+// TestInvoiceHtlcSet tests that the set of HTLCs paying to an invoice is
+// persisted, and that its HTLCs are resolved when the set is canceled or the
+// invoice is settled.
+func TestInvoiceHtlcSet(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+
+	// assertHtlcStates checks that the invoice stored in the database
+	// holds exactly the passed HTLCs in the given states.
+	assertHtlcStates := func(states map[CircuitKey]HtlcState) {
+		dbInvoice, err := db.LookupInvoice(payHash)
+		if err != nil {
+			t.Fatalf("unable to lookup invoice: %v", err)
+		}
+
+		if len(dbInvoice.Htlcs) != len(states) {
+			t.Fatalf("expected %v htlcs, got %v", len(states),
+				len(dbInvoice.Htlcs))
+		}
+		for key, state := range states {
+			htlc, ok := dbInvoice.Htlcs[key]
+			if !ok {
+				t.Fatalf("htlc %v not found", key)
+			}
+			if htlc.State != state {
+				t.Fatalf("expected htlc %v to be %v, got %v",
+					key, state, htlc.State)
+			}
+			if htlc.Amt != amt/2 {
+				t.Fatalf("expected htlc amount %v, got %v",
+					amt/2, htlc.Amt)
+			}
+			if htlc.MppTotal != amt {
+				t.Fatalf("expected htlc payment total %v, "+
+					"got %v", amt, htlc.MppTotal)
+			}
+		}
+	}
+
+	key1 := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(1), HtlcID: 1}
+	key2 := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(2), HtlcID: 1}
+	key3 := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(2), HtlcID: 2}
+
+	// We'll accept the first half of the payment, then cancel the set as
+	// if it timed out.
+	_, err = db.AcceptInvoiceHtlc(payHash, key1, amt/2, amt)
+	if err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+	assertHtlcStates(map[CircuitKey]HtlcState{
+		key1: HtlcStateAccepted,
+	})
+
+	if _, err := db.CancelInvoiceHtlcs(payHash); err != nil {
+		t.Fatalf("unable to cancel htlcs: %v", err)
+	}
+	assertHtlcStates(map[CircuitKey]HtlcState{
+		key1: HtlcStateCanceled,
+	})
+
+	// Accepting the same HTLC again shouldn't alter it.
+	_, err = db.AcceptInvoiceHtlc(payHash, key1, amt/2, amt)
+	if err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+	assertHtlcStates(map[CircuitKey]HtlcState{
+		key1: HtlcStateCanceled,
+	})
+
+	// Now, a new set completes the payment. Settling the invoice should
+	// settle both of its HTLCs, but leave the canceled one untouched.
+	_, err = db.AcceptInvoiceHtlc(payHash, key2, amt/2, amt)
+	if err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+	_, err = db.AcceptInvoiceHtlc(payHash, key3, amt/2, amt)
+	if err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+	assertHtlcStates(map[CircuitKey]HtlcState{
+		key1: HtlcStateCanceled,
+		key2: HtlcStateAccepted,
+		key3: HtlcStateAccepted,
+	})
+
+	dbInvoice, err := db.SettleInvoice(payHash, amt)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	if dbInvoice.Terms.State != ContractSettled || dbInvoice.AmtPaid != amt {
+		t.Fatalf("invoice not settled: %v", spew.Sdump(dbInvoice))
+	}
+	assertHtlcStates(map[CircuitKey]HtlcState{
+		key1: HtlcStateCanceled,
+		key2: HtlcStateSettled,
+		key3: HtlcStateSettled,
+	})
+}
+
+// TestHoldInvoice tests that an invoice created from only a payment hash moves
+// through the accepted state before being settled with its preimage, and that
+// invoices can be canceled until they're settled.
+func TestHoldInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	preimage := invoice.Terms.PaymentPreimage
+	payHash := sha256.Sum256(preimage[:])
+
+	if _, err := db.AddHoldInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	// The invoice should be found by its payment hash, without its
+	// preimage being known.
+	dbInvoice, err := db.LookupInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if dbInvoice.PaymentHash != payHash {
+		t.Fatalf("expected payment hash %x, got %x", payHash,
+			dbInvoice.PaymentHash)
+	}
+	if dbInvoice.Terms.PaymentPreimage != [32]byte{} {
+		t.Fatalf("preimage of hold invoice shouldn't be known")
+	}
+	if !dbInvoice.IsHold() {
+		t.Fatalf("invoice should be a hold invoice")
+	}
+	if dbInvoice.Terms.State != ContractOpen {
+		t.Fatalf("expected state %v, got %v", ContractOpen,
+			dbInvoice.Terms.State)
+	}
+
+	// Settling the invoice before its HTLC set was accepted should fail.
+	if _, err := db.SettleHoldInvoice(preimage); err != ErrInvoiceNotAccepted {
+		t.Fatalf("expected ErrInvoiceNotAccepted, got %v", err)
+	}
+
+	key := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(1), HtlcID: 1}
+	if _, err := db.AcceptInvoiceHtlc(payHash, key, amt, 0); err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+	dbInvoice2, err := db.AcceptInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to accept invoice: %v", err)
+	}
+	if dbInvoice2.Terms.State != ContractAccepted {
+		t.Fatalf("expected state %v, got %v", ContractAccepted,
+			dbInvoice2.Terms.State)
+	}
+
+	// A preimage not matching the payment hash shouldn't locate the
+	// invoice.
+	var fakePreimage [32]byte
+	if _, err := db.SettleHoldInvoice(fakePreimage); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+
+	// Settling it with its preimage should store the preimage, and settle
+	// the accepted HTLC.
+	dbInvoice2, err = db.SettleHoldInvoice(preimage)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	if dbInvoice2.Terms.State != ContractSettled {
+		t.Fatalf("expected state %v, got %v", ContractSettled,
+			dbInvoice2.Terms.State)
+	}
+	if dbInvoice2.Terms.PaymentPreimage != preimage {
+		t.Fatalf("expected preimage %x, got %x", preimage,
+			dbInvoice2.Terms.PaymentPreimage)
+	}
+	if dbInvoice2.AmtPaid != amt || dbInvoice2.SettleIndex != 1 {
+		t.Fatalf("invoice not settled: %v", spew.Sdump(dbInvoice2))
+	}
+	if dbInvoice2.Htlcs[key].State != HtlcStateSettled {
+		t.Fatalf("expected htlc state %v, got %v", HtlcStateSettled,
+			dbInvoice2.Htlcs[key].State)
+	}
+
+	// A settled invoice can no longer be canceled.
+	if _, err := db.CancelInvoice(payHash); err != ErrInvoiceAlreadySettled {
+		t.Fatalf("expected ErrInvoiceAlreadySettled, got %v", err)
+	}
+
+	// Finally, add a second hold invoice, accept an HTLC paying to it and
+	// cancel it. The HTLC should be canceled along with the invoice.
+	invoice, err = randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	preimage = invoice.Terms.PaymentPreimage
+	payHash = sha256.Sum256(preimage[:])
+	if _, err := db.AddHoldInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	if _, err := db.AcceptInvoiceHtlc(payHash, key, amt, 0); err != nil {
+		t.Fatalf("unable to accept htlc: %v", err)
+	}
+
+	dbInvoice2, err = db.CancelInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to cancel invoice: %v", err)
+	}
+	if dbInvoice2.Terms.State != ContractCanceled {
+		t.Fatalf("expected state %v, got %v", ContractCanceled,
+			dbInvoice2.Terms.State)
+	}
+	if dbInvoice2.Htlcs[key].State != HtlcStateCanceled {
+		t.Fatalf("expected htlc state %v, got %v", HtlcStateCanceled,
+			dbInvoice2.Htlcs[key].State)
+	}
+
+	// Canceled invoices are no longer pending, and can't be settled.
+	if dbInvoice2.IsPending() {
+		t.Fatalf("canceled invoice shouldn't be pending")
+	}
+	if _, err := db.AcceptInvoice(payHash); err != ErrInvoiceAlreadyCanceled {
+		t.Fatalf("expected ErrInvoiceAlreadyCanceled, got %v", err)
+	}
+	if _, err := db.SettleHoldInvoice(preimage); err != ErrInvoiceAlreadyCanceled {
+		t.Fatalf("expected ErrInvoiceAlreadyCanceled, got %v", err)
+	}
+}
+
+// TestDeleteInvoice tests that a deleted invoice can no longer be looked up,
+// and that it's removed from the add and settle indexes without affecting the
+// remaining invoices.
+func TestDeleteInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Add three invoices, and settle the first and the last one.
+	const numInvoices = 3
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	payHashes := make([][32]byte, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		if _, err := db.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+		payHashes[i] = invoice.PaymentHash
+	}
+	for _, i := range []int{0, 2} {
+		if _, err := db.SettleInvoice(payHashes[i], amt); err != nil {
+			t.Fatalf("unable to settle invoice: %v", err)
+		}
+	}
+
+	// Delete the first and the second invoice.
+	for _, i := range []int{0, 1} {
+		if err := db.DeleteInvoice(payHashes[i]); err != nil {
+			t.Fatalf("unable to delete invoice: %v", err)
+		}
+		_, err := db.LookupInvoice(payHashes[i])
+		if err != ErrInvoiceNotFound {
+			t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+		}
+	}
+
+	// Deleting an invoice that doesn't exist should fail.
+	if err := db.DeleteInvoice(payHashes[0]); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+
+	// Only the last invoice should remain within the database, and both of
+	// the indexes.
+	assertRemaining := func(invoices []Invoice) {
+		t.Helper()
+
+		if len(invoices) != 1 {
+			t.Fatalf("expected 1 invoice, got %v", len(invoices))
+		}
+		if invoices[0].PaymentHash != payHashes[2] {
+			t.Fatalf("expected invoice %x, got %x", payHashes[2],
+				invoices[0].PaymentHash)
+		}
+	}
+
+	invoices, err := db.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertRemaining(invoices)
+
+	invoices, err = db.InvoicesAddedSince(0)
+	if err != nil {
+		t.Fatalf("unable to fetch added invoices: %v", err)
+	}
+	if len(invoices) != 0 {
+		t.Fatalf("expected no invoices, got %v", len(invoices))
+	}
+
+	invoices, err = db.InvoicesAddedSince(1)
+	if err != nil {
+		t.Fatalf("unable to fetch added invoices: %v", err)
+	}
+	assertRemaining(invoices)
+
+	invoices, err = db.InvoicesSettledSince(1)
+	if err != nil {
+		t.Fatalf("unable to fetch settled invoices: %v", err)
+	}
+	assertRemaining(invoices)
+
+	// New invoices should continue the add index where it left off, rather
+	// than reusing the indexes of deleted invoices.
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	addIndex, err := db.AddInvoice(invoice)
+	if err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	if addIndex != numInvoices+1 {
+		t.Fatalf("expected add index %v, got %v", numInvoices+1,
+			addIndex)
+	}
+}
+
 // TestQueryInvoices ensures that we can properly query the invoice database for
 // invoices using different types of queries.
 func TestQueryInvoices(t *testing.T) {
@@ -645,3 +1005,76 @@ func TestQueryInvoices(t *testing.T) {
 		}
 	}
 }
+
+// TestFetchInvoicesByState tests that only the invoices in the requested
+// states are fetched.
+func TestFetchInvoicesByState(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Add three invoices, then settle the first one and cancel the second
+	// one, leaving the last one open.
+	const numInvoices = 3
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	payHashes := make([][32]byte, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		if _, err := db.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+		payHashes[i] = invoice.PaymentHash
+	}
+	if _, err := db.SettleInvoice(payHashes[0], amt); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	if _, err := db.CancelInvoice(payHashes[1]); err != nil {
+		t.Fatalf("unable to cancel invoice: %v", err)
+	}
+
+	testCases := []struct {
+		states   []ContractState
+		expected [][32]byte
+	}{
+		{
+			states:   []ContractState{ContractOpen},
+			expected: [][32]byte{payHashes[2]},
+		},
+		{
+			states: []ContractState{
+				ContractOpen, ContractCanceled,
+			},
+			expected: [][32]byte{payHashes[1], payHashes[2]},
+		},
+		{
+			states: []ContractState{ContractAccepted},
+		},
+	}
+
+	for _, testCase := range testCases {
+		invoices, err := db.FetchInvoicesByState(testCase.states...)
+		if err != nil {
+			t.Fatalf("unable to fetch invoices: %v", err)
+		}
+
+		if len(invoices) != len(testCase.expected) {
+			t.Fatalf("expected %v invoices in states %v, got %v",
+				len(testCase.expected), testCase.states,
+				len(invoices))
+		}
+		for i, invoice := range invoices {
+			if invoice.PaymentHash != testCase.expected[i] {
+				t.Fatalf("expected invoice %x, got %x",
+					testCase.expected[i],
+					invoice.PaymentHash)
+			}
+		}
+	}
+}
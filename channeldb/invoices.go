@@ -74,6 +74,94 @@ const (
 	MaxPaymentRequestSize = 4096
 )
 
+// HtlcState defines the state of an HTLC paying to an invoice.
+type HtlcState uint8
+
+const (
+	// HtlcStateAccepted indicates that the HTLC is held as part of the
+	// set of HTLCs paying to the invoice, which isn't complete yet.
+	HtlcStateAccepted HtlcState = 0
+
+	// HtlcStateSettled indicates that the HTLC was settled along with the
+	// rest of its set.
+	HtlcStateSettled HtlcState = 1
+
+	// HtlcStateCanceled indicates that the HTLC was failed back, because
+	// its set wasn't completed in time.
+	HtlcStateCanceled HtlcState = 2
+)
+
+// String returns a human readable identifier for the HTLC state.
+func (s HtlcState) String() string {
+	switch s {
+	case HtlcStateAccepted:
+		return "Accepted"
+	case HtlcStateSettled:
+		return "Settled"
+	case HtlcStateCanceled:
+		return "Canceled"
+	default:
+		return fmt.Sprintf("HtlcState(%d)", s)
+	}
+}
+
+// ContractState describes the state the invoice is in.
+type ContractState uint8
+
+const (
+	// ContractOpen means the invoice has only been created.
+	ContractOpen ContractState = 0
+
+	// ContractSettled means the htlc is settled and the invoice has been
+	// paid.
+	ContractSettled ContractState = 1
+
+	// ContractCanceled means the invoice has been canceled.
+	ContractCanceled ContractState = 2
+
+	// ContractAccepted means the HTLC set paying to a hold invoice has
+	// been accepted, and is held until the invoice is either settled with
+	// its preimage or canceled.
+	ContractAccepted ContractState = 3
+)
+
+// String returns a human readable identifier for the ContractState type.
+func (c ContractState) String() string {
+	switch c {
+	case ContractOpen:
+		return "Open"
+	case ContractSettled:
+		return "Settled"
+	case ContractCanceled:
+		return "Canceled"
+	case ContractAccepted:
+		return "Accepted"
+	default:
+		return fmt.Sprintf("ContractState(%d)", c)
+	}
+}
+
+// InvoiceHTLC contains the details of an HTLC paying to an invoice. Invoices
+// may be paid by a set of several HTLCs, which are held until their total
+// amount reaches the value of the invoice.
+type InvoiceHTLC struct {
+	// Amt is the amount that is carried by the HTLC.
+	Amt lnwire.MilliSatoshi
+
+	// MppTotal is the total amount of the payment the HTLC is part of, as
+	// passed by the sender. It's zero if the sender didn't pass one.
+	MppTotal lnwire.MilliSatoshi
+
+	// AcceptTime is the time at which the HTLC was added to the set.
+	AcceptTime time.Time
+
+	// ResolveTime is the time at which the HTLC was settled or canceled.
+	ResolveTime time.Time
+
+	// State is the current state of the HTLC.
+	State HtlcState
+}
+
 // ContractTerm is a companion struct to the Invoice struct. This struct houses
 // the necessary conditions required before the invoice can be considered fully
 // settled by the payee.
@@ -87,9 +175,8 @@ type ContractTerm struct {
 	// which can be satisfied by the above preimage.
 	Value lnwire.MilliSatoshi
 
-	// Settled indicates if this particular contract term has been fully
-	// settled by the payer.
-	Settled bool
+	// State describes the state the invoice is in.
+	State ContractState
 }
 
 // Invoice is a payment invoice generated by a payee in order to request
@@ -155,6 +242,43 @@ type Invoice struct {
 	// that the invoice originally didn't specify an amount, or the sender
 	// overpaid.
 	AmtPaid lnwire.MilliSatoshi
+
+	// Htlcs records all HTLCs that paid to this invoice as part of an HTLC
+	// set, keyed by the circuit key of the incoming HTLC.
+	Htlcs map[CircuitKey]*InvoiceHTLC
+
+	// PaymentHash is the payment hash of the invoice. For regular invoices
+	// it is the sha256 of the payment preimage. Hold invoices are created
+	// with only a payment hash, and their preimage remains unknown until
+	// the invoice is settled.
+	PaymentHash [32]byte
+
+	// PaymentAddr is a random secret included in the payment request of
+	// the invoice. Senders pass it to us within the final hop's payload,
+	// which prevents intermediate nodes that only learned the payment hash
+	// from probing for the invoice. A zero payment address, as is the case
+	// for invoices created before it was introduced, isn't enforced.
+	PaymentAddr [32]byte
+
+	// PaymentAddrRequired is true if the payment request of the invoice
+	// signals the payment address feature as required. Otherwise, senders
+	// may omit the payment address, though they may not pass an incorrect
+	// one.
+	PaymentAddrRequired bool
+}
+
+// IsPending returns true if the invoice is still awaiting payment, or the
+// payment is being held.
+func (i *Invoice) IsPending() bool {
+	return i.Terms.State == ContractOpen ||
+		i.Terms.State == ContractAccepted
+}
+
+// IsHold returns true if the preimage of the invoice wasn't known when it was
+// created, meaning HTLCs paying to it must be held until it is explicitly
+// settled or canceled.
+func (i *Invoice) IsHold() bool {
+	return i.PaymentHash != sha256.Sum256(i.Terms.PaymentPreimage[:])
 }
 
 func validateInvoice(i *Invoice) error {
@@ -180,6 +304,26 @@ func validateInvoice(i *Invoice) error {
 // insertion will be aborted and rejected due to the strict policy banning any
 // duplicate payment hashes.
 func (d *DB) AddInvoice(newInvoice *Invoice) (uint64, error) {
+	paymentHash := sha256.Sum256(newInvoice.Terms.PaymentPreimage[:])
+	return d.addInvoice(newInvoice, paymentHash)
+}
+
+// AddHoldInvoice inserts an invoice of which only the payment hash is known
+// into the database. HTLCs paying to it are held once accepted, until the
+// invoice is either settled with SettleHoldInvoice or canceled with
+// CancelInvoice. Any preimage set within the invoice's terms is ignored.
+func (d *DB) AddHoldInvoice(newInvoice *Invoice,
+	paymentHash [32]byte) (uint64, error) {
+
+	newInvoice.Terms.PaymentPreimage = [32]byte{}
+	return d.addInvoice(newInvoice, paymentHash)
+}
+
+// addInvoice inserts the invoice paying to the passed payment hash into the
+// database.
+func (d *DB) addInvoice(newInvoice *Invoice,
+	paymentHash [32]byte) (uint64, error) {
+
 	if err := validateInvoice(newInvoice); err != nil {
 		return 0, err
 	}
@@ -206,9 +350,6 @@ func (d *DB) AddInvoice(newInvoice *Invoice) (uint64, error) {
 
 		// Ensure that an invoice an identical payment hash doesn't
 		// already exist within the index.
-		paymentHash := sha256.Sum256(
-			newInvoice.Terms.PaymentPreimage[:],
-		)
 		if invoiceIndex.Get(paymentHash[:]) != nil {
 			return ErrDuplicateInvoice
 		}
@@ -230,6 +371,7 @@ func (d *DB) AddInvoice(newInvoice *Invoice) (uint64, error) {
 
 		newIndex, err := putInvoice(
 			invoices, invoiceIndex, addIndex, newInvoice, invoiceNum,
+			paymentHash,
 		)
 		if err != nil {
 			return err
@@ -283,8 +425,12 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 
 		// We'll seek to the starting index, then manually advance the
 		// cursor in order to skip the entry with the since add index.
-		invoiceCursor.Seek(startIndex[:])
-		addSeqNo, invoiceKey := invoiceCursor.Next()
+		// As invoices may have been deleted, the entry may no longer
+		// exist, in which case the cursor already points past it.
+		addSeqNo, invoiceKey := invoiceCursor.Seek(startIndex[:])
+		if bytes.Equal(addSeqNo, startIndex[:]) {
+			addSeqNo, invoiceKey = invoiceCursor.Next()
+		}
 
 		for ; addSeqNo != nil && bytes.Compare(addSeqNo, startIndex[:]) > 0; addSeqNo, invoiceKey = invoiceCursor.Next() {
 
@@ -358,6 +504,28 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 // If the pendingOnly param is true, then only unsettled invoices will be
 // returned, skipping all invoices that are fully settled.
 func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
+	return d.fetchInvoices(func(invoice *Invoice) bool {
+		return !pendingOnly || invoice.IsPending()
+	})
+}
+
+// FetchInvoicesByState returns all invoices stored within the database that
+// are in one of the passed states.
+func (d *DB) FetchInvoicesByState(states ...ContractState) ([]Invoice, error) {
+	return d.fetchInvoices(func(invoice *Invoice) bool {
+		for _, state := range states {
+			if invoice.Terms.State == state {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// fetchInvoices returns all invoices stored within the database that match the
+// passed filter.
+func (d *DB) fetchInvoices(filter func(*Invoice) bool) ([]Invoice, error) {
 	var invoices []Invoice
 
 	err := d.View(func(tx *bbolt.Tx) error {
@@ -374,13 +542,12 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 				return nil
 			}
 
-			invoiceReader := bytes.NewReader(v)
-			invoice, err := deserializeInvoice(invoiceReader)
+			invoice, err := fetchInvoice(k, invoiceB)
 			if err != nil {
 				return err
 			}
 
-			if pendingOnly && invoice.Terms.Settled {
+			if !filter(&invoice) {
 				return nil
 			}
 
@@ -504,9 +671,17 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 				return nil
 
 			// Otherwise we start iteration at the invoice prior to
-			// the offset.
+			// the offset. As invoices may have been deleted, we'll
+			// seek to the offset and step back to the closest
+			// invoice preceding it.
 			default:
-				invoiceKey = keyForIndex(c, q.IndexOffset-1)
+				var keyIndex [8]byte
+				byteOrder.PutUint64(keyIndex[:], q.IndexOffset)
+				if k, _ := c.Seek(keyIndex[:]); k == nil {
+					_, invoiceKey = c.Last()
+				} else {
+					_, invoiceKey = c.Prev()
+				}
 			}
 		}
 
@@ -528,7 +703,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 
 			// Skip any settled invoices if the caller is only
 			// interested in unsettled.
-			if q.PendingOnly && invoice.Terms.Settled {
+			if q.PendingOnly && !invoice.IsPending() {
 				continue
 			}
 
@@ -615,6 +790,320 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 	return settledInvoice, nil
 }
 
+// AcceptInvoiceHtlc adds the HTLC identified by the passed circuit key to the
+// set of HTLCs paying to the invoice with the passed payment hash, along with
+// the total amount of the payment it's part of. Accepting an HTLC that is
+// already known is a noop. The updated invoice is returned.
+func (d *DB) AcceptInvoiceHtlc(paymentHash [32]byte, circuitKey CircuitKey,
+	amt, mppTotal lnwire.MilliSatoshi) (*Invoice, error) {
+
+	var updatedInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		updatedInvoice = &invoice
+
+		if _, ok := invoice.Htlcs[circuitKey]; ok {
+			return nil
+		}
+
+		if invoice.Htlcs == nil {
+			invoice.Htlcs = make(map[CircuitKey]*InvoiceHTLC)
+		}
+		invoice.Htlcs[circuitKey] = &InvoiceHTLC{
+			Amt:        amt,
+			MppTotal:   mppTotal,
+			AcceptTime: time.Now(),
+			State:      HtlcStateAccepted,
+		}
+
+		return putInvoiceBytes(invoices, invoiceNum, &invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedInvoice, nil
+}
+
+// CancelInvoiceHtlcs marks all accepted HTLCs paying to the invoice with the
+// passed payment hash as canceled, as the set they're part of won't be
+// completed. The updated invoice is returned.
+func (d *DB) CancelInvoiceHtlcs(paymentHash [32]byte) (*Invoice, error) {
+	var updatedInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		updatedInvoice = &invoice
+
+		resolveInvoiceHtlcs(&invoice, HtlcStateCanceled)
+
+		return putInvoiceBytes(invoices, invoiceNum, &invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedInvoice, nil
+}
+
+// AcceptInvoice transitions the hold invoice with the passed payment hash to
+// the accepted state, as the HTLC set paying to it is complete. Its HTLCs are
+// then held until the invoice is either settled or canceled. Accepting an
+// invoice that was already accepted is a noop. The updated invoice is
+// returned.
+func (d *DB) AcceptInvoice(paymentHash [32]byte) (*Invoice, error) {
+	var updatedInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		updatedInvoice = &invoice
+
+		switch invoice.Terms.State {
+		case ContractAccepted:
+			return nil
+		case ContractSettled:
+			return ErrInvoiceAlreadySettled
+		case ContractCanceled:
+			return ErrInvoiceAlreadyCanceled
+		}
+
+		invoice.Terms.State = ContractAccepted
+
+		return putInvoiceBytes(invoices, invoiceNum, &invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedInvoice, nil
+}
+
+// SettleHoldInvoice settles the accepted hold invoice paying to the hash of
+// the passed preimage. The preimage is stored within the invoice, and the
+// amount paid is the total of its accepted HTLCs. Settling an invoice that was
+// already settled is a noop. The updated invoice is returned.
+func (d *DB) SettleHoldInvoice(preimage [32]byte) (*Invoice, error) {
+	paymentHash := sha256.Sum256(preimage[:])
+
+	var settledInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		settleIndex, err := invoices.CreateBucketIfNotExists(
+			settleIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		settledInvoice = &invoice
+
+		switch invoice.Terms.State {
+		case ContractSettled:
+			return nil
+		case ContractCanceled:
+			return ErrInvoiceAlreadyCanceled
+		case ContractOpen:
+			return ErrInvoiceNotAccepted
+		}
+
+		var amtPaid lnwire.MilliSatoshi
+		for _, htlc := range invoice.Htlcs {
+			if htlc.State == HtlcStateAccepted {
+				amtPaid += htlc.Amt
+			}
+		}
+
+		invoice.Terms.PaymentPreimage = preimage
+		err = markInvoiceSettled(settleIndex, invoiceNum, &invoice, amtPaid)
+		if err != nil {
+			return err
+		}
+
+		return putInvoiceBytes(invoices, invoiceNum, &invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settledInvoice, nil
+}
+
+// CancelInvoice transitions the open or accepted invoice with the passed
+// payment hash to the canceled state, along with all of its accepted HTLCs.
+// Canceling an invoice that was already canceled is a noop, while settled
+// invoices can't be canceled. The updated invoice is returned.
+func (d *DB) CancelInvoice(paymentHash [32]byte) (*Invoice, error) {
+	var canceledInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		canceledInvoice = &invoice
+
+		switch invoice.Terms.State {
+		case ContractCanceled:
+			return nil
+		case ContractSettled:
+			return ErrInvoiceAlreadySettled
+		}
+
+		invoice.Terms.State = ContractCanceled
+		resolveInvoiceHtlcs(&invoice, HtlcStateCanceled)
+
+		return putInvoiceBytes(invoices, invoiceNum, &invoice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return canceledInvoice, nil
+}
+
+// DeleteInvoice removes the invoice with the passed payment hash from the
+// database, along with its entries within the payment hash, add and settle
+// indexes. As the sequence numbers of the add and settle indexes are never
+// reused, both remain monotonically increasing for clients catching up on
+// invoice events.
+func (d *DB) DeleteInvoice(paymentHash [32]byte) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		invoices, invoiceNum, err := fetchInvoiceNum(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// Copy the key, as it's backed by the database page we're
+		// about to modify.
+		var invoiceKey [4]byte
+		copy(invoiceKey[:], invoiceNum)
+
+		invoice, err := fetchInvoice(invoiceKey[:], invoices)
+		if err != nil {
+			return err
+		}
+
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if err := invoiceIndex.Delete(paymentHash[:]); err != nil {
+			return err
+		}
+
+		var seqNoBytes [8]byte
+		addIndex := invoices.Bucket(addIndexBucket)
+		if addIndex != nil && invoice.AddIndex != 0 {
+			byteOrder.PutUint64(seqNoBytes[:], invoice.AddIndex)
+			if err := addIndex.Delete(seqNoBytes[:]); err != nil {
+				return err
+			}
+		}
+
+		settleIndex := invoices.Bucket(settleIndexBucket)
+		if settleIndex != nil && invoice.SettleIndex != 0 {
+			byteOrder.PutUint64(seqNoBytes[:], invoice.SettleIndex)
+			if err := settleIndex.Delete(seqNoBytes[:]); err != nil {
+				return err
+			}
+		}
+
+		return invoices.Delete(invoiceKey[:])
+	})
+}
+
+// fetchInvoiceNum returns the invoices bucket along with the key of the
+// invoice paying to the passed payment hash.
+func fetchInvoiceNum(tx *bbolt.Tx, paymentHash [32]byte) (*bbolt.Bucket,
+	[]byte, error) {
+
+	invoices := tx.Bucket(invoiceBucket)
+	if invoices == nil {
+		return nil, nil, ErrNoInvoicesCreated
+	}
+	invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+	if invoiceIndex == nil {
+		return nil, nil, ErrNoInvoicesCreated
+	}
+
+	invoiceNum := invoiceIndex.Get(paymentHash[:])
+	if invoiceNum == nil {
+		return nil, nil, ErrInvoiceNotFound
+	}
+
+	return invoices, invoiceNum, nil
+}
+
+// resolveInvoiceHtlcs transitions all accepted HTLCs of the invoice to the
+// passed final state.
+func resolveInvoiceHtlcs(invoice *Invoice, state HtlcState) {
+	now := time.Now()
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != HtlcStateAccepted {
+			continue
+		}
+
+		htlc.State = state
+		htlc.ResolveTime = now
+	}
+}
+
+// putInvoiceBytes serializes the invoice along with its set of HTLCs and
+// stores it under the passed invoice key.
+func putInvoiceBytes(invoices *bbolt.Bucket, invoiceNum []byte,
+	invoice *Invoice) error {
+
+	var buf bytes.Buffer
+	if err := serializeInvoice(&buf, invoice); err != nil {
+		return err
+	}
+	if err := serializeInvoiceHtlcs(&buf, invoice.Htlcs); err != nil {
+		return err
+	}
+	if _, err := buf.Write(invoice.PaymentHash[:]); err != nil {
+		return err
+	}
+	if _, err := buf.Write(invoice.PaymentAddr[:]); err != nil {
+		return err
+	}
+	err := binary.Write(&buf, byteOrder, invoice.PaymentAddrRequired)
+	if err != nil {
+		return err
+	}
+
+	return invoices.Put(invoiceNum[:], buf.Bytes())
+}
+
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
 // they missed within the settled invoice time series. We'll return all known
 // settled invoice that have a settle index higher than the passed
@@ -652,8 +1141,12 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 
 		// We'll seek to the starting index, then manually advance the
 		// cursor in order to skip the entry with the since add index.
-		invoiceCursor.Seek(startIndex[:])
-		seqNo, invoiceKey := invoiceCursor.Next()
+		// As invoices may have been deleted, the entry may no longer
+		// exist, in which case the cursor already points past it.
+		seqNo, invoiceKey := invoiceCursor.Seek(startIndex[:])
+		if bytes.Equal(seqNo, startIndex[:]) {
+			seqNo, invoiceKey = invoiceCursor.Next()
+		}
 
 		for ; seqNo != nil && bytes.Compare(seqNo, startIndex[:]) > 0; seqNo, invoiceKey = invoiceCursor.Next() {
 
@@ -677,7 +1170,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 }
 
 func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
-	i *Invoice, invoiceNum uint32) (uint64, error) {
+	i *Invoice, invoiceNum uint32, paymentHash [32]byte) (uint64, error) {
 
 	// Create the invoice key which is just the big-endian representation
 	// of the invoice number.
@@ -696,7 +1189,6 @@ func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
 	// Add the payment hash to the invoice index. This will let us quickly
 	// identify if we can settle an incoming payment, and also to possibly
 	// allow a single invoice to have multiple payment installations.
-	paymentHash := sha256.Sum256(i.Terms.PaymentPreimage[:])
 	err := invoiceIndex.Put(paymentHash[:], invoiceKey[:])
 	if err != nil {
 		return 0, err
@@ -720,14 +1212,10 @@ func putInvoice(invoices, invoiceIndex, addIndex *bbolt.Bucket,
 	}
 
 	i.AddIndex = nextAddSeqNo
+	i.PaymentHash = paymentHash
 
 	// Finally, serialize the invoice itself to be written to the disk.
-	var buf bytes.Buffer
-	if err := serializeInvoice(&buf, i); err != nil {
-		return 0, nil
-	}
-
-	if err := invoices.Put(invoiceKey[:], buf.Bytes()); err != nil {
+	if err := putInvoiceBytes(invoices, invoiceKey[:], i); err != nil {
 		return 0, err
 	}
 
@@ -773,7 +1261,7 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		return err
 	}
 
-	if err := binary.Write(w, byteOrder, i.Terms.Settled); err != nil {
+	if err := binary.Write(w, byteOrder, i.Terms.State); err != nil {
 		return err
 	}
 
@@ -790,6 +1278,55 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return nil
 }
 
+// serializeInvoiceHtlcs writes the set of HTLCs paying to an invoice.
+func serializeInvoiceHtlcs(w io.Writer,
+	htlcs map[CircuitKey]*InvoiceHTLC) error {
+
+	numHtlcs := uint32(len(htlcs))
+	if err := binary.Write(w, byteOrder, numHtlcs); err != nil {
+		return err
+	}
+
+	for key, htlc := range htlcs {
+		err := binary.Write(w, byteOrder, key.ChanID.ToUint64())
+		if err != nil {
+			return err
+		}
+		if err := binary.Write(w, byteOrder, key.HtlcID); err != nil {
+			return err
+		}
+		if err := binary.Write(w, byteOrder, uint64(htlc.Amt)); err != nil {
+			return err
+		}
+		err = binary.Write(w, byteOrder, uint64(htlc.MppTotal))
+		if err != nil {
+			return err
+		}
+
+		acceptBytes, err := htlc.AcceptTime.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, acceptBytes); err != nil {
+			return err
+		}
+
+		resolveBytes, err := htlc.ResolveTime.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, resolveBytes); err != nil {
+			return err
+		}
+
+		if err := binary.Write(w, byteOrder, htlc.State); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
@@ -798,7 +1335,51 @@ func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
 
-	return deserializeInvoice(invoiceReader)
+	invoice, err := deserializeInvoice(invoiceReader)
+	if err != nil {
+		return invoice, err
+	}
+
+	// The set of HTLCs paying to the invoice is stored after the invoice
+	// itself, followed by its payment hash, payment address and whether
+	// the payment address is required. Invoices written before these were
+	// tracked end early, in which case the payment hash is derived from
+	// the preimage, as only hold invoices lack one, and the payment
+	// address is left zero and optional.
+	invoice.PaymentHash = sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+
+	invoice.Htlcs, err = deserializeInvoiceHtlcs(invoiceReader)
+	switch {
+	case err == io.EOF:
+		return invoice, nil
+	case err != nil:
+		return invoice, err
+	}
+
+	_, err = io.ReadFull(invoiceReader, invoice.PaymentHash[:])
+	switch {
+	case err == io.EOF:
+		return invoice, nil
+	case err != nil:
+		return invoice, err
+	}
+
+	_, err = io.ReadFull(invoiceReader, invoice.PaymentAddr[:])
+	switch {
+	case err == io.EOF:
+		return invoice, nil
+	case err != nil:
+		return invoice, err
+	}
+
+	err = binary.Read(
+		invoiceReader, byteOrder, &invoice.PaymentAddrRequired,
+	)
+	if err != nil && err != io.EOF {
+		return invoice, err
+	}
+
+	return invoice, nil
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -845,7 +1426,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	}
 	invoice.Terms.Value = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
 
-	if err := binary.Read(r, byteOrder, &invoice.Terms.Settled); err != nil {
+	if err := binary.Read(r, byteOrder, &invoice.Terms.State); err != nil {
 		return invoice, err
 	}
 
@@ -862,6 +1443,71 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	return invoice, nil
 }
 
+// deserializeInvoiceHtlcs reads the set of HTLCs paying to an invoice. If the
+// set is empty, a nil map is returned.
+func deserializeInvoiceHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC,
+	error) {
+
+	var numHtlcs uint32
+	if err := binary.Read(r, byteOrder, &numHtlcs); err != nil {
+		return nil, err
+	}
+	if numHtlcs == 0 {
+		return nil, nil
+	}
+
+	htlcs := make(map[CircuitKey]*InvoiceHTLC, numHtlcs)
+	for i := uint32(0); i < numHtlcs; i++ {
+		var (
+			chanID uint64
+			key    CircuitKey
+			amt    uint64
+			total  uint64
+			htlc   InvoiceHTLC
+		)
+		if err := binary.Read(r, byteOrder, &chanID); err != nil {
+			return nil, err
+		}
+		key.ChanID = lnwire.NewShortChanIDFromInt(chanID)
+		if err := binary.Read(r, byteOrder, &key.HtlcID); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(r, byteOrder, &amt); err != nil {
+			return nil, err
+		}
+		htlc.Amt = lnwire.MilliSatoshi(amt)
+		if err := binary.Read(r, byteOrder, &total); err != nil {
+			return nil, err
+		}
+		htlc.MppTotal = lnwire.MilliSatoshi(total)
+
+		acceptBytes, err := wire.ReadVarBytes(r, 0, 300, "accept")
+		if err != nil {
+			return nil, err
+		}
+		if err := htlc.AcceptTime.UnmarshalBinary(acceptBytes); err != nil {
+			return nil, err
+		}
+
+		resolveBytes, err := wire.ReadVarBytes(r, 0, 300, "resolve")
+		if err != nil {
+			return nil, err
+		}
+		err = htlc.ResolveTime.UnmarshalBinary(resolveBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := binary.Read(r, byteOrder, &htlc.State); err != nil {
+			return nil, err
+		}
+
+		htlcs[key] = &htlc
+	}
+
+	return htlcs, nil
+}
+
 func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
@@ -872,37 +1518,53 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 
 	// Add idempotency to duplicate settles, return here to avoid
 	// overwriting the previous info.
-	if invoice.Terms.Settled {
+	switch invoice.Terms.State {
+	case ContractSettled:
 		return &invoice, nil
+	case ContractCanceled:
+		return nil, ErrInvoiceAlreadyCanceled
 	}
 
+	if err := markInvoiceSettled(settleIndex, invoiceNum, &invoice,
+		amtPaid); err != nil {
+
+		return nil, err
+	}
+
+	if err := putInvoiceBytes(invoices, invoiceNum, &invoice); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+// markInvoiceSettled places the invoice within the settle index, and
+// transitions it along with all of its accepted HTLCs to the settled state.
+func markInvoiceSettled(settleIndex *bbolt.Bucket, invoiceNum []byte,
+	invoice *Invoice, amtPaid lnwire.MilliSatoshi) error {
+
 	// Now that we know the invoice hasn't already been settled, we'll
 	// update the settle index so we can place this settle event in the
 	// proper location within our time series.
 	nextSettleSeqNo, err := settleIndex.NextSequence()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var seqNoBytes [8]byte
 	byteOrder.PutUint64(seqNoBytes[:], nextSettleSeqNo)
 	if err := settleIndex.Put(seqNoBytes[:], invoiceNum); err != nil {
-		return nil, err
+		return err
 	}
 
 	invoice.AmtPaid = amtPaid
-	invoice.Terms.Settled = true
+	invoice.Terms.State = ContractSettled
 	invoice.SettleDate = time.Now()
 	invoice.SettleIndex = nextSettleSeqNo
 
-	var buf bytes.Buffer
-	if err := serializeInvoice(&buf, &invoice); err != nil {
-		return nil, err
-	}
-
-	if err := invoices.Put(invoiceNum[:], buf.Bytes()); err != nil {
-		return nil, err
-	}
+	// All HTLCs that were held as part of the invoice's HTLC set are
+	// settled along with it.
+	resolveInvoiceHtlcs(invoice, HtlcStateSettled)
 
-	return &invoice, nil
+	return nil
 }
@@ -0,0 +1,160 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// missionControlResultsBucket is the name of the bucket within the
+	// database that stores the results of payment attempts observed by
+	// mission control. Each result is keyed by the time it was observed,
+	// followed by the pubkeys of the node pair it applies to, so that
+	// iterating over the bucket yields the results from oldest to newest.
+	missionControlResultsBucket = []byte("missioncontrol-results")
+)
+
+// MissionControlResult is a single observation of mission control about the
+// ability of a node to forward a payment to another node.
+type MissionControlResult struct {
+	// Timestamp is the time at which the result was observed.
+	Timestamp time.Time
+
+	// FromNode is the node that was asked to forward the payment.
+	FromNode [33]byte
+
+	// ToNode is the node the payment was to be forwarded to. If it is all
+	// zeroes, the result applies to FromNode as a whole, regardless of
+	// the channel the payment was to be forwarded on.
+	ToNode [33]byte
+
+	// Amount is the amount that was to be forwarded from FromNode to
+	// ToNode.
+	Amount lnwire.MilliSatoshi
+
+	// Success indicates whether the payment was forwarded successfully.
+	Success bool
+}
+
+// AddMissionControlResult persists a new mission control result. Once more
+// than maxResults results are stored, the oldest results are removed.
+func (db *DB) AddMissionControlResult(result *MissionControlResult,
+	maxResults int) error {
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		results, err := tx.CreateBucketIfNotExists(
+			missionControlResultsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		err = WriteElements(&b, result.Amount, result.Success)
+		if err != nil {
+			return err
+		}
+
+		err = results.Put(missionControlResultKey(result), b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Gather the keys of the results in excess of the limit. As
+		// keys are ordered by time, these are the oldest ones. They
+		// are deleted afterwards, as the bucket can't be modified
+		// while iterating over it.
+		var keys [][]byte
+		err = results.ForEach(func(k, _ []byte) error {
+			keys = append(keys, append([]byte(nil), k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		var oldKeys [][]byte
+		if len(keys) > maxResults {
+			oldKeys = keys[:len(keys)-maxResults]
+		}
+
+		for _, k := range oldKeys {
+			if err := results.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchMissionControlResults returns all stored mission control results,
+// ordered from oldest to newest.
+func (db *DB) FetchMissionControlResults() ([]*MissionControlResult, error) {
+	var results []*MissionControlResult
+
+	err := db.View(func(tx *bbolt.Tx) error {
+		// Reset the results, to avoid carrying over results from a
+		// previous execution of the db transaction.
+		results = nil
+
+		bucket := tx.Bucket(missionControlResultsBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			result := &MissionControlResult{}
+
+			nanos := binary.BigEndian.Uint64(k[:8])
+			result.Timestamp = time.Unix(0, int64(nanos))
+			copy(result.FromNode[:], k[8:41])
+			copy(result.ToNode[:], k[41:74])
+
+			err := ReadElements(
+				bytes.NewReader(v), &result.Amount,
+				&result.Success,
+			)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, result)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ResetMissionControlResults removes all stored mission control results.
+func (db *DB) ResetMissionControlResults() error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket(missionControlResultsBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// missionControlResultKey returns the key under which the result is stored:
+// its timestamp in BigEndian, followed by the pubkeys of its node pair.
+func missionControlResultKey(result *MissionControlResult) []byte {
+	var key [8 + 33 + 33]byte
+	binary.BigEndian.PutUint64(
+		key[:8], uint64(result.Timestamp.UnixNano()),
+	)
+	copy(key[8:41], result.FromNode[:])
+	copy(key[41:], result.ToNode[:])
+
+	return key[:]
+}
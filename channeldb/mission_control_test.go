@@ -0,0 +1,87 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+// TestMissionControlResults tests that mission control results are persisted
+// in order, that the oldest results are pruned once the limit is exceeded and
+// that all results can be reset.
+func TestMissionControlResults(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	defer cleanup()
+
+	results, err := db.FetchMissionControlResults()
+	if err != nil {
+		t.Fatalf("unable to fetch results: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results, got %v", len(results))
+	}
+
+	const maxResults = 3
+
+	now := time.Unix(0, time.Now().UnixNano())
+	var added []*MissionControlResult
+	for i := 0; i < 5; i++ {
+		result := &MissionControlResult{
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+			Amount:    lnwire.MilliSatoshi(1000 * (i + 1)),
+			Success:   i%2 == 0,
+		}
+		result.FromNode[0] = byte(i)
+		result.ToNode[0] = byte(i + 1)
+
+		err := db.AddMissionControlResult(result, maxResults)
+		if err != nil {
+			t.Fatalf("unable to add result: %v", err)
+		}
+		added = append(added, result)
+	}
+
+	results, err = db.FetchMissionControlResults()
+	if err != nil {
+		t.Fatalf("unable to fetch results: %v", err)
+	}
+
+	// Only the newest results should have been kept.
+	expected := added[len(added)-maxResults:]
+	if len(results) != len(expected) {
+		t.Fatalf("expected %v results, got %v", len(expected),
+			len(results))
+	}
+	for i, result := range results {
+		if !result.Timestamp.Equal(expected[i].Timestamp) {
+			t.Fatalf("result %v: expected timestamp %v, got %v", i,
+				expected[i].Timestamp, result.Timestamp)
+		}
+		result.Timestamp = expected[i].Timestamp
+
+		if !reflect.DeepEqual(result, expected[i]) {
+			t.Fatalf("result %v: expected %v, got %v", i,
+				expected[i], result)
+		}
+	}
+
+	if err := db.ResetMissionControlResults(); err != nil {
+		t.Fatalf("unable to reset results: %v", err)
+	}
+
+	results, err = db.FetchMissionControlResults()
+	if err != nil {
+		t.Fatalf("unable to fetch results: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results after reset, got %v",
+			len(results))
+	}
+}
@@ -0,0 +1,509 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// paymentAttemptsBucket is the name of the bucket within the database
+	// that stores the attempts made to send each payment.
+	//
+	// Within this bucket, there is a sub-bucket for each payment, keyed by
+	// its payment hash. It holds the attempts sub-bucket, in which each
+	// attempt is keyed by its monotonically increasing ID, along with the
+	// reason the payment failed, if it did.
+	paymentAttemptsBucket = []byte("payment-attempts")
+
+	// attemptsBucket is the name of the sub-bucket of a payment that
+	// stores its attempts.
+	attemptsBucket = []byte("attempts")
+
+	// failureReasonKey is the key under which the reason a payment failed
+	// is stored within the sub-bucket of the payment.
+	failureReasonKey = []byte("failure-reason")
+)
+
+// AttemptState is the state of a single attempt to send a payment.
+type AttemptState byte
+
+const (
+	// AttemptInFlight is the state of an attempt whose HTLC has been sent,
+	// but not resolved yet.
+	AttemptInFlight AttemptState = 0
+
+	// AttemptSucceeded is the state of an attempt whose HTLC was settled.
+	AttemptSucceeded AttemptState = 1
+
+	// AttemptFailed is the state of an attempt whose HTLC failed.
+	AttemptFailed AttemptState = 2
+)
+
+// String returns a human readable version of the attempt state.
+func (s AttemptState) String() string {
+	switch s {
+	case AttemptInFlight:
+		return "In Flight"
+	case AttemptSucceeded:
+		return "Succeeded"
+	case AttemptFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// FailureReason describes why a payment was given up on.
+type FailureReason byte
+
+const (
+	// FailureReasonNone is the failure reason of a payment that hasn't
+	// failed.
+	FailureReasonNone FailureReason = 0
+
+	// FailureReasonTimeout indicates that the payment wasn't completed
+	// before its payment attempt timeout.
+	FailureReasonTimeout FailureReason = 1
+
+	// FailureReasonNoRoute indicates that no route to the destination
+	// able to carry the payment could be found.
+	FailureReasonNoRoute FailureReason = 2
+
+	// FailureReasonError indicates that the payment failed because of an
+	// error that prevented it from being retried.
+	FailureReasonError FailureReason = 3
+
+	// FailureReasonIncorrectPaymentDetails indicates that the destination
+	// rejected the payment, because it doesn't know the payment hash or
+	// the amount or final time lock are incorrect.
+	FailureReasonIncorrectPaymentDetails FailureReason = 4
+)
+
+// String returns a human readable version of the failure reason.
+func (r FailureReason) String() string {
+	switch r {
+	case FailureReasonNone:
+		return "None"
+	case FailureReasonTimeout:
+		return "Timeout"
+	case FailureReasonNoRoute:
+		return "No Route"
+	case FailureReasonError:
+		return "Error"
+	case FailureReasonIncorrectPaymentDetails:
+		return "Incorrect Payment Details"
+	default:
+		return "Unknown"
+	}
+}
+
+// AttemptHop is a single hop of the route an attempt was sent along.
+type AttemptHop struct {
+	// PubKey is the compressed public key of the node of this hop.
+	PubKey [33]byte
+
+	// ChannelID is the short channel ID of the channel leading to the
+	// node of this hop.
+	ChannelID uint64
+
+	// AmtToForward is the amount this hop was instructed to forward, or
+	// to receive if it's the final hop.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingTimeLock is the time lock of the HTLC this hop was
+	// instructed to forward.
+	OutgoingTimeLock uint32
+}
+
+// PaymentAttempt is a single attempt to send a payment, or one of its shards,
+// along a route through the network.
+type PaymentAttempt struct {
+	// AttemptID uniquely identifies the attempt among those of the same
+	// payment. It's assigned when the attempt is registered.
+	AttemptID uint64
+
+	// Hops is the route the attempt was sent along, excluding our own
+	// node.
+	Hops []AttemptHop
+
+	// TotalAmount is the amount of the HTLC sent to the first hop,
+	// including all fees.
+	TotalAmount lnwire.MilliSatoshi
+
+	// TotalFees is the sum of the fees paid to the hops of the route.
+	TotalFees lnwire.MilliSatoshi
+
+	// TotalTimeLock is the time lock of the HTLC sent to the first hop.
+	TotalTimeLock uint32
+
+	// AttemptTime is the time at which the attempt was sent.
+	AttemptTime time.Time
+
+	// ResolveTime is the time at which the attempt settled or failed. It's
+	// zero while the attempt is in flight.
+	ResolveTime time.Time
+
+	// State is the current state of the attempt.
+	State AttemptState
+
+	// FailureSourceIndex is the index of the node that reported the
+	// failure of the attempt within the route, where zero is our own node
+	// and one is the first hop. It's only meaningful if the attempt
+	// failed.
+	FailureSourceIndex uint32
+
+	// FailureCode is the code of the failure message reported for the
+	// attempt. It's zero if the attempt didn't fail, or failed without a
+	// failure message.
+	FailureCode lnwire.FailCode
+}
+
+// PaymentHistory is the persisted status of an outgoing payment, along with
+// every attempt made to send it.
+type PaymentHistory struct {
+	// Status is the current status of the payment.
+	Status PaymentStatus
+
+	// FailureReason is the reason the payment failed, if its status is
+	// StatusFailed.
+	FailureReason FailureReason
+
+	// Attempts holds all attempts made to send the payment, in the order
+	// they were made.
+	Attempts []*PaymentAttempt
+}
+
+// RegisterPaymentAttempt persists a new in-flight attempt to send the payment
+// identified by the payment hash, assigning it its attempt ID. As a new
+// attempt means the payment is being retried, the reason of any prior failure
+// of the payment is cleared.
+func (db *DB) RegisterPaymentAttempt(paymentHash [32]byte,
+	attempt *PaymentAttempt) error {
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		attempts, err := tx.CreateBucketIfNotExists(paymentAttemptsBucket)
+		if err != nil {
+			return err
+		}
+
+		payment, err := attempts.CreateBucketIfNotExists(paymentHash[:])
+		if err != nil {
+			return err
+		}
+
+		if err := payment.Delete(failureReasonKey); err != nil {
+			return err
+		}
+
+		paymentAttempts, err := payment.CreateBucketIfNotExists(
+			attemptsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		attemptID, err := paymentAttempts.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		attempt.AttemptID = attemptID
+		attempt.State = AttemptInFlight
+
+		return putPaymentAttempt(paymentAttempts, attempt)
+	})
+}
+
+// SettlePaymentAttempt marks the attempt with the given ID to send the
+// payment identified by the payment hash as succeeded.
+func (db *DB) SettlePaymentAttempt(paymentHash [32]byte, attemptID uint64) error {
+	return db.updatePaymentAttempt(
+		paymentHash, attemptID, func(attempt *PaymentAttempt) {
+			attempt.State = AttemptSucceeded
+		},
+	)
+}
+
+// FailPaymentAttempt marks the attempt with the given ID to send the payment
+// identified by the payment hash as failed, recording the index of the node
+// that reported the failure within the route and the code of the failure
+// message.
+func (db *DB) FailPaymentAttempt(paymentHash [32]byte, attemptID uint64,
+	sourceIndex uint32, failureCode lnwire.FailCode) error {
+
+	return db.updatePaymentAttempt(
+		paymentHash, attemptID, func(attempt *PaymentAttempt) {
+			attempt.State = AttemptFailed
+			attempt.FailureSourceIndex = sourceIndex
+			attempt.FailureCode = failureCode
+		},
+	)
+}
+
+// updatePaymentAttempt applies the given resolution to an attempt to send the
+// payment identified by the payment hash, and stamps its resolve time.
+func (db *DB) updatePaymentAttempt(paymentHash [32]byte, attemptID uint64,
+	resolve func(*PaymentAttempt)) error {
+
+	return db.Batch(func(tx *bbolt.Tx) error {
+		paymentAttempts := fetchPaymentAttemptsBucket(tx, paymentHash)
+		if paymentAttempts == nil {
+			return ErrPaymentAttemptNotFound
+		}
+
+		var attemptKey [8]byte
+		binary.BigEndian.PutUint64(attemptKey[:], attemptID)
+
+		attemptBytes := paymentAttempts.Get(attemptKey[:])
+		if attemptBytes == nil {
+			return ErrPaymentAttemptNotFound
+		}
+
+		attempt, err := deserializePaymentAttempt(
+			bytes.NewReader(attemptBytes),
+		)
+		if err != nil {
+			return err
+		}
+		attempt.AttemptID = attemptID
+
+		resolve(attempt)
+		attempt.ResolveTime = time.Now()
+
+		return putPaymentAttempt(paymentAttempts, attempt)
+	})
+}
+
+// FailPaymentTx is a helper method that transitions the payment identified
+// by the payment hash to StatusFailed, and records the reason it failed. As a
+// failed payment has nothing left in flight, any of its attempts that weren't
+// resolved, such as those interrupted by a restart, are marked as failed. It
+// accepts a boltdb transaction such that it can be composed with the checks
+// of the current payment status.
+func FailPaymentTx(tx *bbolt.Tx, paymentHash [32]byte,
+	reason FailureReason) error {
+
+	attempts, err := tx.CreateBucketIfNotExists(paymentAttemptsBucket)
+	if err != nil {
+		return err
+	}
+
+	payment, err := attempts.CreateBucketIfNotExists(paymentHash[:])
+	if err != nil {
+		return err
+	}
+
+	err = payment.Put(failureReasonKey, []byte{byte(reason)})
+	if err != nil {
+		return err
+	}
+
+	paymentAttempts := payment.Bucket(attemptsBucket)
+	if paymentAttempts != nil {
+		// The bucket can't be modified while iterating over it, so
+		// we'll first gather the attempts left in flight.
+		var unresolved []*PaymentAttempt
+		err := paymentAttempts.ForEach(func(k, v []byte) error {
+			attempt, err := deserializePaymentAttempt(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			attempt.AttemptID = binary.BigEndian.Uint64(k)
+
+			if attempt.State == AttemptInFlight {
+				unresolved = append(unresolved, attempt)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, attempt := range unresolved {
+			attempt.State = AttemptFailed
+			attempt.ResolveTime = time.Now()
+
+			err := putPaymentAttempt(paymentAttempts, attempt)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return UpdatePaymentStatusTx(tx, paymentHash, StatusFailed)
+}
+
+// FetchPaymentHistory returns the current status of the payment identified by
+// the payment hash, along with all attempts made to send it. A payment that
+// was never attempted is returned as grounded, without any attempts.
+func (db *DB) FetchPaymentHistory(paymentHash [32]byte) (*PaymentHistory, error) {
+	history := &PaymentHistory{}
+
+	err := db.View(func(tx *bbolt.Tx) error {
+		// Reset the history, to avoid carrying over attempts from a
+		// previous execution of the db transaction.
+		history = &PaymentHistory{}
+
+		status, err := FetchPaymentStatusTx(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		history.Status = status
+
+		attempts := tx.Bucket(paymentAttemptsBucket)
+		if attempts == nil {
+			return nil
+		}
+		payment := attempts.Bucket(paymentHash[:])
+		if payment == nil {
+			return nil
+		}
+
+		reason := payment.Get(failureReasonKey)
+		if len(reason) == 1 {
+			history.FailureReason = FailureReason(reason[0])
+		}
+
+		paymentAttempts := payment.Bucket(attemptsBucket)
+		if paymentAttempts == nil {
+			return nil
+		}
+
+		return paymentAttempts.ForEach(func(k, v []byte) error {
+			attempt, err := deserializePaymentAttempt(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			attempt.AttemptID = binary.BigEndian.Uint64(k)
+
+			history.Attempts = append(history.Attempts, attempt)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// fetchPaymentAttemptsBucket returns the bucket holding the attempts to send
+// the payment identified by the payment hash, or nil if none were registered.
+func fetchPaymentAttemptsBucket(tx *bbolt.Tx, paymentHash [32]byte) *bbolt.Bucket {
+	attempts := tx.Bucket(paymentAttemptsBucket)
+	if attempts == nil {
+		return nil
+	}
+
+	payment := attempts.Bucket(paymentHash[:])
+	if payment == nil {
+		return nil
+	}
+
+	return payment.Bucket(attemptsBucket)
+}
+
+// putPaymentAttempt serializes the attempt into the attempts bucket of its
+// payment, keyed by its attempt ID.
+func putPaymentAttempt(paymentAttempts *bbolt.Bucket,
+	attempt *PaymentAttempt) error {
+
+	// We use BigEndian for keys as it orders keys in ascending order,
+	// which is the order in which the attempts were made.
+	var attemptKey [8]byte
+	binary.BigEndian.PutUint64(attemptKey[:], attempt.AttemptID)
+
+	var b bytes.Buffer
+	if err := serializePaymentAttempt(&b, attempt); err != nil {
+		return err
+	}
+
+	return paymentAttempts.Put(attemptKey[:], b.Bytes())
+}
+
+func serializePaymentAttempt(w io.Writer, a *PaymentAttempt) error {
+	var resolveTime uint64
+	if !a.ResolveTime.IsZero() {
+		resolveTime = uint64(a.ResolveTime.UnixNano())
+	}
+
+	err := WriteElements(w,
+		a.TotalAmount, a.TotalFees, a.TotalTimeLock,
+		uint64(a.AttemptTime.UnixNano()), resolveTime,
+		uint16(a.State), a.FailureSourceIndex,
+		uint16(a.FailureCode), uint32(len(a.Hops)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, hop := range a.Hops {
+		if _, err := w.Write(hop.PubKey[:]); err != nil {
+			return err
+		}
+
+		err := WriteElements(w,
+			hop.ChannelID, hop.AmtToForward, hop.OutgoingTimeLock,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializePaymentAttempt(r io.Reader) (*PaymentAttempt, error) {
+	var (
+		a           = &PaymentAttempt{}
+		attemptTime uint64
+		resolveTime uint64
+		state       uint16
+		failureCode uint16
+		numHops     uint32
+	)
+
+	err := ReadElements(r,
+		&a.TotalAmount, &a.TotalFees, &a.TotalTimeLock,
+		&attemptTime, &resolveTime, &state, &a.FailureSourceIndex,
+		&failureCode, &numHops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	a.AttemptTime = time.Unix(0, int64(attemptTime))
+	if resolveTime != 0 {
+		a.ResolveTime = time.Unix(0, int64(resolveTime))
+	}
+	a.State = AttemptState(state)
+	a.FailureCode = lnwire.FailCode(failureCode)
+
+	a.Hops = make([]AttemptHop, numHops)
+	for i := range a.Hops {
+		hop := &a.Hops[i]
+
+		if _, err := io.ReadFull(r, hop.PubKey[:]); err != nil {
+			return nil, err
+		}
+
+		err := ReadElements(r,
+			&hop.ChannelID, &hop.AmtToForward,
+			&hop.OutgoingTimeLock,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
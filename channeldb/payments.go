@@ -40,6 +40,12 @@ const (
 	// StatusCompleted is the status where a payment has been initiated and
 	// the payment was completed successfully.
 	StatusCompleted PaymentStatus = 2
+
+	// StatusFailed is the status where a payment has been initiated, and
+	// has been given up on without any of its HTLCs being left in flight.
+	// The reason it failed is recorded along with its attempts. Like a
+	// grounded payment, it may be attempted again.
+	StatusFailed PaymentStatus = 3
 )
 
 // Bytes returns status as slice of bytes.
@@ -54,7 +60,7 @@ func (ps *PaymentStatus) FromBytes(status []byte) error {
 	}
 
 	switch PaymentStatus(status[0]) {
-	case StatusGrounded, StatusInFlight, StatusCompleted:
+	case StatusGrounded, StatusInFlight, StatusCompleted, StatusFailed:
 		*ps = PaymentStatus(status[0])
 	default:
 		return errors.New("unknown payment status")
@@ -72,11 +78,32 @@ func (ps PaymentStatus) String() string {
 		return "In Flight"
 	case StatusCompleted:
 		return "Completed"
+	case StatusFailed:
+		return "Failed"
 	default:
 		return "Unknown"
 	}
 }
 
+// PaymentShard describes one of the HTLCs a multi-path payment was split into,
+// along with the route it took through the network.
+type PaymentShard struct {
+	// Amount is the amount the shard delivered to the destination in
+	// milli-satoshis.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the fee paid for the shard in milli-satoshis.
+	Fee lnwire.MilliSatoshi
+
+	// TimeLockLength is the total cumulative time-lock of the shard's
+	// HTLC.
+	TimeLockLength uint32
+
+	// Path encodes the path the shard took through the network, in the
+	// same way as the Path of an OutgoingPayment.
+	Path [][33]byte
+}
+
 // OutgoingPayment represents a successful payment between the daemon and a
 // remote node. Details such as the total fee paid, and the time of the payment
 // are stored.
@@ -98,6 +125,12 @@ type OutgoingPayment struct {
 	// PaymentPreimage is the preImage of a successful payment. This is used
 	// to calculate the PaymentHash as well as serve as a proof of payment.
 	PaymentPreimage [32]byte
+
+	// Shards holds every shard of a multi-path payment. In this case, Fee
+	// is the total fee paid for all shards, while Path and TimeLockLength
+	// describe the first one. For payments sent as a single HTLC, this is
+	// empty.
+	Shards []PaymentShard
 }
 
 // AddPayment saves a successful payment to the database. It is assumed that
@@ -285,6 +318,49 @@ func serializeOutgoingPayment(w io.Writer, p *OutgoingPayment) error {
 		return err
 	}
 
+	// The shards are appended last, such that payments stored before they
+	// were introduced can still be read.
+	byteOrder.PutUint32(scratch[:4], uint32(len(p.Shards)))
+	if _, err := w.Write(scratch[:4]); err != nil {
+		return err
+	}
+	for _, shard := range p.Shards {
+		if err := serializePaymentShard(w, &shard); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serializePaymentShard(w io.Writer, s *PaymentShard) error {
+	var scratch [8]byte
+
+	byteOrder.PutUint64(scratch[:], uint64(s.Amount))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint64(scratch[:], uint64(s.Fee))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:4], s.TimeLockLength)
+	if _, err := w.Write(scratch[:4]); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:4], uint32(len(s.Path)))
+	if _, err := w.Write(scratch[:4]); err != nil {
+		return err
+	}
+	for _, hop := range s.Path {
+		if _, err := w.Write(hop[:]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -326,5 +402,56 @@ func deserializeOutgoingPayment(r io.Reader) (*OutgoingPayment, error) {
 		return nil, err
 	}
 
+	// Payments stored before shards were introduced end here.
+	if _, err := io.ReadFull(r, scratch[:4]); err == io.EOF {
+		return p, nil
+	} else if err != nil {
+		return nil, err
+	}
+	numShards := byteOrder.Uint32(scratch[:4])
+
+	for i := uint32(0); i < numShards; i++ {
+		shard, err := deserializePaymentShard(r)
+		if err != nil {
+			return nil, err
+		}
+		p.Shards = append(p.Shards, *shard)
+	}
+
 	return p, nil
 }
+
+func deserializePaymentShard(r io.Reader) (*PaymentShard, error) {
+	var scratch [8]byte
+
+	s := &PaymentShard{}
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+	s.Amount = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+	s.Fee = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
+
+	if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+		return nil, err
+	}
+	s.TimeLockLength = byteOrder.Uint32(scratch[:4])
+
+	if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+		return nil, err
+	}
+	pathLen := byteOrder.Uint32(scratch[:4])
+
+	s.Path = make([][33]byte, pathLen)
+	for i := uint32(0); i < pathLen; i++ {
+		if _, err := io.ReadFull(r, s.Path[i][:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
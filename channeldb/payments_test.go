@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/breez/lightninglib/lnwire"
 )
@@ -133,6 +134,66 @@ func TestOutgoingPaymentSerialization(t *testing.T) {
 	}
 }
 
+// TestOutgoingPaymentShardsSerialization asserts that the shards of a
+// multi-path payment survive serialization, and that payments stored without
+// any shards can still be read.
+func TestOutgoingPaymentShardsSerialization(t *testing.T) {
+	t.Parallel()
+
+	fakePayment := makeFakePayment()
+	for i := 0; i < 2; i++ {
+		fakePayment.Shards = append(fakePayment.Shards, PaymentShard{
+			Amount:         lnwire.NewMSatFromSatoshis(5000),
+			Fee:            lnwire.MilliSatoshi(50 + i),
+			TimeLockLength: uint32(1000 + i),
+			Path:           fakePayment.Path[i:],
+		})
+	}
+
+	var b bytes.Buffer
+	if err := serializeOutgoingPayment(&b, fakePayment); err != nil {
+		t.Fatalf("unable to serialize outgoing payment: %v", err)
+	}
+
+	newPayment, err := deserializeOutgoingPayment(&b)
+	if err != nil {
+		t.Fatalf("unable to deserialize outgoing payment: %v", err)
+	}
+
+	if !reflect.DeepEqual(fakePayment, newPayment) {
+		t.Fatalf("Payments do not match after "+
+			"serialization/deserialization %v vs %v",
+			spew.Sdump(fakePayment),
+			spew.Sdump(newPayment),
+		)
+	}
+
+	// A payment serialized before shards were introduced lacks the
+	// trailing shard count.
+	legacyPayment := makeFakePayment()
+
+	b.Reset()
+	if err := serializeOutgoingPayment(&b, legacyPayment); err != nil {
+		t.Fatalf("unable to serialize outgoing payment: %v", err)
+	}
+	legacyBytes := b.Bytes()[:b.Len()-4]
+
+	newPayment, err = deserializeOutgoingPayment(
+		bytes.NewReader(legacyBytes),
+	)
+	if err != nil {
+		t.Fatalf("unable to deserialize legacy payment: %v", err)
+	}
+
+	if !reflect.DeepEqual(legacyPayment, newPayment) {
+		t.Fatalf("Payments do not match after "+
+			"serialization/deserialization %v vs %v",
+			spew.Sdump(legacyPayment),
+			spew.Sdump(newPayment),
+		)
+	}
+}
+
 func TestOutgoingPaymentWorkflow(t *testing.T) {
 	t.Parallel()
 
@@ -229,6 +290,10 @@ func TestPaymentStatusWorkflow(t *testing.T) {
 			paymentHash: makeFakePaymentHash(),
 			status:      StatusCompleted,
 		},
+		{
+			paymentHash: makeFakePaymentHash(),
+			status:      StatusFailed,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -251,3 +316,145 @@ func TestPaymentStatusWorkflow(t *testing.T) {
 		}
 	}
 }
+
+// TestPaymentAttemptsWorkflow checks that the attempts to send a payment are
+// persisted along with their resolution, and that failing the payment records
+// its reason until the payment is attempted again.
+func TestPaymentAttemptsWorkflow(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	paymentHash := makeFakePaymentHash()
+
+	// A payment that was never attempted should be reported as grounded,
+	// without any attempts.
+	history, err := db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != StatusGrounded || len(history.Attempts) != 0 {
+		t.Fatalf("unexpected history of unknown payment: %v",
+			spew.Sdump(history))
+	}
+
+	newAttempt := func() *PaymentAttempt {
+		return &PaymentAttempt{
+			Hops: []AttemptHop{
+				{
+					PubKey:           [33]byte{2, 1},
+					ChannelID:        1,
+					AmtToForward:     1000,
+					OutgoingTimeLock: 140,
+				},
+				{
+					PubKey:           [33]byte{3, 2},
+					ChannelID:        2,
+					AmtToForward:     1000,
+					OutgoingTimeLock: 140,
+				},
+			},
+			TotalAmount:   1001,
+			TotalFees:     1,
+			TotalTimeLock: 180,
+			AttemptTime:   time.Unix(1000, 0),
+		}
+	}
+
+	// Register a first attempt, and fail it as if the second hop
+	// reported an unknown payment hash.
+	first := newAttempt()
+	if err := db.RegisterPaymentAttempt(paymentHash, first); err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	err = db.FailPaymentAttempt(
+		paymentHash, first.AttemptID, 2, lnwire.CodeUnknownPaymentHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return FailPaymentTx(
+			tx, paymentHash, FailureReasonIncorrectPaymentDetails,
+		)
+	})
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+
+	history, err = db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != StatusFailed {
+		t.Fatalf("expected status %v, got %v", StatusFailed,
+			history.Status)
+	}
+	if history.FailureReason != FailureReasonIncorrectPaymentDetails {
+		t.Fatalf("expected failure reason %v, got %v",
+			FailureReasonIncorrectPaymentDetails,
+			history.FailureReason)
+	}
+	if len(history.Attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %v", len(history.Attempts))
+	}
+
+	attempt := history.Attempts[0]
+	if attempt.State != AttemptFailed ||
+		attempt.FailureSourceIndex != 2 ||
+		attempt.FailureCode != lnwire.CodeUnknownPaymentHash ||
+		attempt.ResolveTime.IsZero() {
+
+		t.Fatalf("unexpected failed attempt: %v", spew.Sdump(attempt))
+	}
+	if !reflect.DeepEqual(attempt.Hops, first.Hops) ||
+		attempt.TotalAmount != first.TotalAmount ||
+		attempt.TotalFees != first.TotalFees ||
+		attempt.TotalTimeLock != first.TotalTimeLock ||
+		!attempt.AttemptTime.Equal(first.AttemptTime) {
+
+		t.Fatalf("attempt doesn't match: expected %v, got %v",
+			spew.Sdump(first), spew.Sdump(attempt))
+	}
+
+	// Attempting the payment again should clear the failure reason, and
+	// settling the attempt should be reflected in its state.
+	second := newAttempt()
+	if err := db.RegisterPaymentAttempt(paymentHash, second); err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	if second.AttemptID == first.AttemptID {
+		t.Fatalf("attempts share ID %v", first.AttemptID)
+	}
+	err = db.SettlePaymentAttempt(paymentHash, second.AttemptID)
+	if err != nil {
+		t.Fatalf("unable to settle attempt: %v", err)
+	}
+
+	history, err = db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.FailureReason != FailureReasonNone {
+		t.Fatalf("failure reason wasn't cleared: %v",
+			history.FailureReason)
+	}
+	if len(history.Attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %v", len(history.Attempts))
+	}
+	if history.Attempts[1].State != AttemptSucceeded {
+		t.Fatalf("expected attempt state %v, got %v",
+			AttemptSucceeded, history.Attempts[1].State)
+	}
+
+	// Resolving an unknown attempt should fail.
+	err = db.SettlePaymentAttempt(paymentHash, second.AttemptID+1)
+	if err != ErrPaymentAttemptNotFound {
+		t.Fatalf("expected ErrPaymentAttemptNotFound, got %v", err)
+	}
+}
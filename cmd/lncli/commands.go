@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -389,6 +390,129 @@ func disconnectPeer(ctx *cli.Context) error {
 	return nil
 }
 
+var sendCustomCommand = cli.Command{
+	Name:     "sendcustom",
+	Category: "Peers",
+	Usage:    "Send a custom message to a connected peer.",
+	Description: `
+	Send a message of a type within the custom range (at least 32768) to a
+	connected peer. The message data is opaque to lnd and left for the
+	remote application to interpret.`,
+	ArgsUsage: "node_key type data",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "the hex-encoded compressed public key of the " +
+				"peer to send the message to",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the message type, at least 32768",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex-encoded message data",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		peer    []byte
+		msgType uint64
+		data    []byte
+		err     error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("node_key"):
+		peer, err = hex.DecodeString(ctx.String("node_key"))
+	case args.Present():
+		peer, err = hex.DecodeString(args.First())
+		args = args.Tail()
+	default:
+		return fmt.Errorf("node_key argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode node_key: %v", err)
+	}
+
+	switch {
+	case ctx.IsSet("type"):
+		msgType = ctx.Uint64("type")
+	case args.Present():
+		msgType, err = strconv.ParseUint(args.First(), 10, 16)
+		if err != nil {
+			return fmt.Errorf("unable to parse type: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("type argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("data"):
+		data, err = hex.DecodeString(ctx.String("data"))
+	case args.Present():
+		data, err = hex.DecodeString(args.First())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode data: %v", err)
+	}
+
+	req := &lnrpc.SendCustomMessageRequest{
+		Peer: peer,
+		Type: uint32(msgType),
+		Data: data,
+	}
+
+	resp, err := client.SendCustomMessage(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage: "Subscribe to the custom messages received from connected " +
+		"peers.",
+	Action: actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SubscribeCustomMessagesRequest{}
+	stream, err := client.SubscribeCustomMessages(
+		context.Background(), req,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
+
 // TODO(roasbeef): change default number of confirmations
 var openChannelCommand = cli.Command{
 	Name:     "openchannel",
@@ -481,6 +605,22 @@ var openChannelCommand = cli.Command{
 			Usage: "(optional) the minimum number of satoshis we " +
 				"require the remote node to keep as a direct payment",
 		},
+		cli.BoolFlag{
+			Name: "dual_fund",
+			Usage: "(optional) ask the remote node to contribute " +
+				"funds to the channel as well",
+		},
+		cli.BoolFlag{
+			Name: "psbt",
+			Usage: "(optional) fund the channel from an externally " +
+				"signed PSBT instead of the internal wallet",
+		},
+		cli.BoolFlag{
+			Name: "no_publish",
+			Usage: "(optional) when funding the channel from a " +
+				"PSBT, don't publish the funding transaction, " +
+				"as it'll be published externally",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -507,6 +647,7 @@ func openChannel(ctx *cli.Context) error {
 		RemoteCsvDelay:       uint32(ctx.Uint64("remote_csv_delay")),
 		MinConfs:             int32(ctx.Uint64("min_confs")),
 		RemoteChanReserveSat: ctx.Int64("remote_chan_reserve_sat"),
+		DualFund:             ctx.Bool("dual_fund"),
 	}
 
 	switch {
@@ -575,6 +716,14 @@ func openChannel(ctx *cli.Context) error {
 
 	req.Private = ctx.Bool("private")
 
+	if ctx.Bool("psbt") {
+		req.FundingShim = &lnrpc.FundingShim{
+			PsbtShim: &lnrpc.PsbtShim{
+				NoPublish: ctx.Bool("no_publish"),
+			},
+		}
+	}
+
 	stream, err := client.OpenChannel(ctxb, req)
 	if err != nil {
 		return err
@@ -589,6 +738,12 @@ func openChannel(ctx *cli.Context) error {
 		}
 
 		switch update := resp.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			err := fundPsbtChannel(ctxb, client, update.PsbtFund)
+			if err != nil {
+				return err
+			}
+
 		case *lnrpc.OpenStatusUpdate_ChanPending:
 			txid, err := chainhash.NewHash(update.ChanPending.Txid)
 			if err != nil {
@@ -642,6 +797,196 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+// fundPsbtChannel walks the user through funding a channel from an externally
+// signed PSBT. The funded PSBT is verified against the funding output first,
+// then the signed PSBT, or the final raw transaction, is handed over to
+// resume the funding workflow.
+func fundPsbtChannel(ctxb context.Context, client lnrpc.LightningClient,
+	psbtFund *lnrpc.ReadyForPsbtFunding) error {
+
+	pendingChanID := psbtFund.PendingChanId
+	printJSON(struct {
+		FundingAddress string `json:"funding_address"`
+		FundingAmount  int64  `json:"funding_amount"`
+		Psbt           string `json:"psbt"`
+		PendingChanID  string `json:"pending_chan_id"`
+	}{
+		FundingAddress: psbtFund.FundingAddress,
+		FundingAmount:  psbtFund.FundingAmount,
+		Psbt:           base64.StdEncoding.EncodeToString(psbtFund.Psbt),
+		PendingChanID:  hex.EncodeToString(pendingChanID),
+	})
+
+	reader := bufio.NewReader(os.Stdin)
+	cancelShim := func() {
+		_, _ = client.FundingStateStep(ctxb, &lnrpc.FundingTransitionMsg{
+			ShimCancel: &lnrpc.FundingShimCancel{
+				PendingChanId: pendingChanID,
+			},
+		})
+	}
+
+	fmt.Printf("Paste the base64 encoded PSBT that funds the above " +
+		"output, or leave empty to skip verification: ")
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		cancelShim()
+		return err
+	}
+	if answer = strings.TrimSpace(answer); answer != "" {
+		fundedPsbt, err := base64.StdEncoding.DecodeString(answer)
+		if err != nil {
+			cancelShim()
+			return fmt.Errorf("unable to decode PSBT: %v", err)
+		}
+		_, err = client.FundingStateStep(ctxb, &lnrpc.FundingTransitionMsg{
+			PsbtVerify: &lnrpc.FundingPsbtVerify{
+				FundedPsbt:    fundedPsbt,
+				PendingChanId: pendingChanID,
+			},
+		})
+		if err != nil {
+			cancelShim()
+			return err
+		}
+	}
+
+	fmt.Printf("Paste the base64 encoded signed PSBT, or the hex encoded " +
+		"final raw transaction: ")
+	answer, err = reader.ReadString('\n')
+	if err != nil {
+		cancelShim()
+		return err
+	}
+	answer = strings.TrimSpace(answer)
+
+	finalize := &lnrpc.FundingPsbtFinalize{
+		PendingChanId: pendingChanID,
+	}
+	if rawTx, err := hex.DecodeString(answer); err == nil {
+		finalize.FinalRawTx = rawTx
+	} else {
+		finalize.SignedPsbt, err = base64.StdEncoding.DecodeString(
+			answer,
+		)
+		if err != nil {
+			cancelShim()
+			return fmt.Errorf("unable to decode signed PSBT: %v",
+				err)
+		}
+	}
+
+	_, err = client.FundingStateStep(ctxb, &lnrpc.FundingTransitionMsg{
+		PsbtFinalize: finalize,
+	})
+	if err != nil {
+		cancelShim()
+		return err
+	}
+
+	return nil
+}
+
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to existing peers within a single " +
+		"funding transaction.",
+	Description: `
+	Attempt to open a new channel to each of the given existing peers, all
+	funded by a single transaction. The transaction is only signed once all
+	peers accepted their channel. If any of them fails before then, none of
+	the channels is opened.
+
+	The channels-json-string param decodes the channels to open in the
+	following format:
+
+	    '[{"node_pubkey": "HexNodeKey", "local_funding_amount": NumSatoshis,
+	       "push_sat": NumSatoshis, "private": false}, ...]'
+
+	One can manually set the fee to be used for the funding transaction via either
+	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	ArgsUsage: "channels-json-string",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	var channels []struct {
+		NodePubkey         string `json:"node_pubkey"`
+		LocalFundingAmount int64  `json:"local_funding_amount"`
+		PushSat            int64  `json:"push_sat"`
+		Private            bool   `json:"private"`
+	}
+
+	if !ctx.Args().Present() {
+		return fmt.Errorf("channels argument missing")
+	}
+	err := json.Unmarshal([]byte(ctx.Args().First()), &channels)
+	if err != nil {
+		return fmt.Errorf("unable to decode channels: %v", err)
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+	}
+	for _, channel := range channels {
+		nodePubKey, err := hex.DecodeString(channel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key: %v",
+				err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubKey,
+			LocalFundingAmount: channel.LocalFundingAmount,
+			PushSat:            channel.PushSat,
+			Private:            channel.Private,
+		})
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -659,7 +1004,10 @@ var closeChannelCommand = cli.Command{
 	In the case of a cooperative closure, One can manually set the fee to
 	be used for the closing transaction via either the --conf_target or
 	--sat_per_byte arguments. This will be the starting value used during
-	fee negotiation. This is optional.
+	fee negotiation. This is optional. The fee rate the closing transaction
+	may end up paying can be capped via the --max_sat_per_byte argument,
+	and the funds can be sent to a specific address via the --delivery_addr
+	argument rather than to a fresh wallet address.
 
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
@@ -701,6 +1049,17 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Int64Flag{
+			Name: "max_sat_per_byte",
+			Usage: "(optional) the maximum fee expressed in " +
+				"sat/byte that the transaction may pay during " +
+				"fee negotiation",
+		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) the address to send our funds to " +
+				"in a cooperative closure",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -722,10 +1081,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		MaxSatPerByte:   ctx.Int64("max_sat_per_byte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1811,6 +2172,10 @@ var sendPaymentCommand = cli.Command{
 	it'll use the hash of all zeroes. This mode allows one to quickly test
 	payment connectivity without having to create an invoice at the
 	destination.
+
+	If the --keysend flag is set, a spontaneous payment is sent to the
+	destination without an invoice, so only --dest and --amt need to be
+	specified. The destination must accept keysend payments.
 	`,
 	ArgsUsage: "dest amt payment_hash final_cltv_delta | --pay_req=[payment request]",
 	Flags: []cli.Flag{
@@ -1841,6 +2206,11 @@ var sendPaymentCommand = cli.Command{
 			Name:  "debug_send",
 			Usage: "use the debug rHash when sending the HTLC",
 		},
+		cli.BoolFlag{
+			Name: "keysend",
+			Usage: "send a spontaneous payment without an " +
+				"invoice, using a preimage we choose",
+		},
 		cli.StringFlag{
 			Name:  "pay_req",
 			Usage: "a zpay32 encoded payment request to fulfill",
@@ -1849,6 +2219,21 @@ var sendPaymentCommand = cli.Command{
 			Name:  "final_cltv_delta",
 			Usage: "the number of blocks the last hop has to reveal the preimage",
 		},
+		cli.Uint64Flag{
+			Name: "max_shards",
+			Usage: "(optional) the maximum number of HTLCs the " +
+				"payment may be split into",
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) the id of the channel the payment " +
+				"must leave through",
+		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "(optional) the hex-encoded public key of the " +
+				"node the payment must arrive from",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -1883,6 +2268,24 @@ func retrieveFeeLimit(ctx *cli.Context) (*lnrpc.FeeLimit, error) {
 	return nil, nil
 }
 
+// retrieveLastHop retrieves the public key of the last hop passed, if any.
+func retrieveLastHop(ctx *cli.Context) ([]byte, error) {
+	if !ctx.IsSet("last_hop") {
+		return nil, nil
+	}
+
+	lastHop, err := hex.DecodeString(ctx.String("last_hop"))
+	if err != nil {
+		return nil, err
+	}
+	if len(lastHop) != 33 {
+		return nil, fmt.Errorf("last hop pubkey must be exactly 33 "+
+			"bytes, is instead: %v", len(lastHop))
+	}
+
+	return lastHop, nil
+}
+
 func confirmPayReq(ctx *cli.Context, client lnrpc.LightningClient, payReq string) error {
 	ctxb := context.Background()
 
@@ -1932,6 +2335,11 @@ func sendPayment(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := retrieveLastHop(ctx)
+	if err != nil {
+		return err
+	}
+
 	// If a payment request was provided, we can exit early since all of the
 	// details of the payment are encoded within the request.
 	if ctx.IsSet("pay_req") {
@@ -1945,6 +2353,9 @@ func sendPayment(ctx *cli.Context) error {
 			PaymentRequest: ctx.String("pay_req"),
 			Amt:            ctx.Int64("amt"),
 			FeeLimit:       feeLimit,
+			MaxShards:      uint32(ctx.Uint64("max_shards")),
+			OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+			LastHopPubkey:  lastHop,
 		}
 
 		return sendPaymentRequest(client, req)
@@ -1986,9 +2397,24 @@ func sendPayment(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.SendRequest{
-		Dest:     destNode,
-		Amt:      amount,
-		FeeLimit: feeLimit,
+		Dest:           destNode,
+		Amt:            amount,
+		FeeLimit:       feeLimit,
+		MaxShards:      uint32(ctx.Uint64("max_shards")),
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
+	}
+
+	if ctx.Bool("keysend") {
+		if ctx.IsSet("payment_hash") || args.Present() {
+			return fmt.Errorf("do not provide a payment hash with " +
+				"keysend")
+		}
+
+		req.Keysend = true
+		req.FinalCltvDelta = int32(ctx.Int64("final_cltv_delta"))
+
+		return sendPaymentRequest(client, req)
 	}
 
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
@@ -2086,6 +2512,21 @@ var payInvoiceCommand = cli.Command{
 			Usage: "percentage of the payment's amount used as the" +
 				"maximum fee allowed when sending the payment",
 		},
+		cli.Uint64Flag{
+			Name: "max_shards",
+			Usage: "(optional) the maximum number of HTLCs the " +
+				"payment may be split into",
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) the id of the channel the payment " +
+				"must leave through",
+		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "(optional) the hex-encoded public key of the " +
+				"node the payment must arrive from",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2114,6 +2555,11 @@ func payInvoice(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := retrieveLastHop(ctx)
+	if err != nil {
+		return err
+	}
+
 	if !ctx.Bool("force") {
 		err = confirmPayReq(ctx, client, payReq)
 		if err != nil {
@@ -2125,30 +2571,139 @@ func payInvoice(ctx *cli.Context) error {
 		PaymentRequest: payReq,
 		Amt:            ctx.Int64("amt"),
 		FeeLimit:       feeLimit,
+		MaxShards:      uint32(ctx.Uint64("max_shards")),
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
 	}
 	return sendPaymentRequest(client, req)
 }
 
-var sendToRouteCommand = cli.Command{
-	Name:  "sendtoroute",
-	Usage: "send a payment over a predefined route",
+var rebalanceCommand = cli.Command{
+	Name:     "rebalance",
+	Category: "Payments",
+	Usage: "Shift balance from one of your channels to another by " +
+		"paying yourself.",
 	Description: `
-	Send a payment over Lightning using a specific route. One must specify
-	a list of routes to attempt and the payment hash. This command can even
-	be chained with the response to queryroutes. This command can be used
-	to implement channel rebalancing by crafting a self-route, or even
-	atomic swaps using a self-route that crosses multiple chains.
-
-	There are three ways to specify routes:
-	   * using the --routes parameter to manually specify a JSON encoded
-	     set of routes in the format of the return value of queryroutes:
-	         (lncli sendtoroute --payment_hash=<pay_hash> --routes=<route>)
-
-	   * passing the routes as a positional argument:
-	         (lncli sendtoroute --payment_hash=pay_hash <route>)
-
-	   * or reading in the routes from stdin, which can allow chaining the
-	     response from queryroutes, or even read in a file with a set of
+	Shift amt satoshis from the outgoing to the incoming channel, by paying
+	a self-invoice over a circular route leaving your node over the outgoing
+	channel, and returning to it over the incoming channel.`,
+	ArgsUsage: "outgoing_chan_id incoming_chan_id amt",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "outgoing_chan_id",
+			Usage: "the id of the channel to shift balance away from",
+		},
+		cli.Uint64Flag{
+			Name:  "incoming_chan_id",
+			Usage: "the id of the channel to shift balance to",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the number of satoshis to shift",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when paying " +
+				"yourself",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the amount used as the maximum " +
+				"fee allowed when paying yourself",
+		},
+	},
+	Action: actionDecorator(rebalance),
+}
+
+func rebalance(ctx *cli.Context) error {
+	var (
+		args = ctx.Args()
+		req  = &lnrpc.RebalanceRequest{}
+		err  error
+	)
+
+	switch {
+	case ctx.IsSet("outgoing_chan_id"):
+		req.OutgoingChanId = ctx.Uint64("outgoing_chan_id")
+	case args.Present():
+		req.OutgoingChanId, err = strconv.ParseUint(
+			args.First(), 10, 64,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode outgoing channel "+
+				"id: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("outgoing channel id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("incoming_chan_id"):
+		req.IncomingChanId = ctx.Uint64("incoming_chan_id")
+	case args.Present():
+		req.IncomingChanId, err = strconv.ParseUint(
+			args.First(), 10, 64,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode incoming channel "+
+				"id: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("incoming channel id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		req.Amt = ctx.Int64("amt")
+	case args.Present():
+		req.Amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amount: %v", err)
+		}
+	default:
+		return fmt.Errorf("amount argument missing")
+	}
+
+	req.FeeLimit, err = retrieveFeeLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.Rebalance(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sendToRouteCommand = cli.Command{
+	Name:  "sendtoroute",
+	Usage: "send a payment over a predefined route",
+	Description: `
+	Send a payment over Lightning using a specific route. One must specify
+	a list of routes to attempt and the payment hash. This command can even
+	be chained with the response to queryroutes. This command can be used
+	to implement channel rebalancing by crafting a self-route, or even
+	atomic swaps using a self-route that crosses multiple chains.
+
+	There are three ways to specify routes:
+	   * using the --routes parameter to manually specify a JSON encoded
+	     set of routes in the format of the return value of queryroutes:
+	         (lncli sendtoroute --payment_hash=<pay_hash> --routes=<route>)
+
+	   * passing the routes as a positional argument:
+	         (lncli sendtoroute --payment_hash=pay_hash <route>)
+
+	   * or reading in the routes from stdin, which can allow chaining the
+	     response from queryroutes, or even read in a file with a set of
 	     pre-computed routes:
 	         (lncli queryroutes --args.. | lncli sendtoroute --payment_hash= -
 
@@ -2411,6 +2966,227 @@ func addInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+var addHoldInvoiceCommand = cli.Command{
+	Name:     "addholdinvoice",
+	Category: "Payments",
+	Usage:    "Add a new hold invoice.",
+	Description: `
+	Add a new invoice, expressing intent for a future payment.
+
+	Only the payment hash of a hold invoice is known. Incoming HTLCs paying
+	to it are held until the invoice is either settled with its preimage
+	using settleinvoice, or canceled using cancelinvoice.`,
+	ArgsUsage: "hash [amt]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "memo",
+			Usage: "a description of the payment to attach along " +
+				"with the invoice (default=\"\")",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amt of satoshis in this invoice",
+		},
+		cli.StringFlag{
+			Name: "description_hash",
+			Usage: "SHA-256 hash of the description of the payment. " +
+				"Used if the purpose of payment cannot naturally " +
+				"fit within the memo. If provided this will be " +
+				"used instead of the description(memo) field in " +
+				"the encoded invoice.",
+		},
+		cli.StringFlag{
+			Name: "fallback_addr",
+			Usage: "fallback on-chain address that can be used in " +
+				"case the lightning payment fails",
+		},
+		cli.Int64Flag{
+			Name: "expiry",
+			Usage: "the invoice's expiry time in seconds. If not " +
+				"specified an expiry of 3600 seconds (1 hour) " +
+				"is implied.",
+		},
+		cli.BoolTFlag{
+			Name: "private",
+			Usage: "encode routing hints in the invoice with " +
+				"private channels in order to assist the " +
+				"payer in reaching you",
+		},
+	},
+	Action: actionDecorator(addHoldInvoice),
+}
+
+func addHoldInvoice(ctx *cli.Context) error {
+	var (
+		descHash []byte
+		amt      int64
+		err      error
+	)
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+	if !args.Present() {
+		return fmt.Errorf("hash argument missing")
+	}
+
+	hash, err := hex.DecodeString(args.First())
+	if err != nil {
+		return fmt.Errorf("unable to parse hash: %v", err)
+	}
+	args = args.Tail()
+
+	switch {
+	case ctx.IsSet("amt"):
+		amt = ctx.Int64("amt")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt argument: %v", err)
+		}
+	}
+
+	descHash, err = hex.DecodeString(ctx.String("description_hash"))
+	if err != nil {
+		return fmt.Errorf("unable to parse description_hash: %v", err)
+	}
+
+	req := &lnrpc.AddHoldInvoiceRequest{
+		Memo:            ctx.String("memo"),
+		Hash:            hash,
+		Value:           amt,
+		DescriptionHash: descHash,
+		FallbackAddr:    ctx.String("fallback_addr"),
+		Expiry:          ctx.Int64("expiry"),
+		Private:         ctx.Bool("private"),
+	}
+
+	resp, err := client.AddHoldInvoice(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		RHash    string `json:"r_hash"`
+		PayReq   string `json:"pay_req"`
+		AddIndex uint64 `json:"add_index"`
+	}{
+		RHash:    hex.EncodeToString(resp.RHash),
+		PayReq:   resp.PaymentRequest,
+		AddIndex: resp.AddIndex,
+	})
+
+	return nil
+}
+
+var settleInvoiceCommand = cli.Command{
+	Name:      "settleinvoice",
+	Category:  "Payments",
+	Usage:     "Reveal a preimage and use it to settle the corresponding invoice.",
+	ArgsUsage: "preimage",
+	Description: `
+	Settle an accepted hold invoice using the hex-encoded preimage of its
+	payment hash, settling all HTLCs held for it.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "preimage",
+			Usage: "the hex-encoded preimage (32 byte) of the invoice",
+		},
+	},
+	Action: actionDecorator(settleInvoice),
+}
+
+func settleInvoice(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		preimage []byte
+		err      error
+	)
+
+	switch {
+	case ctx.IsSet("preimage"):
+		preimage, err = hex.DecodeString(ctx.String("preimage"))
+	case ctx.Args().Present():
+		preimage, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("preimage argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to parse preimage: %v", err)
+	}
+
+	req := &lnrpc.SettleInvoiceRequest{
+		Preimage: preimage,
+	}
+
+	resp, err := client.SettleInvoice(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelInvoiceCommand = cli.Command{
+	Name:      "cancelinvoice",
+	Category:  "Payments",
+	Usage:     "Cancels a (hold) invoice.",
+	ArgsUsage: "paymenthash",
+	Description: `
+	Cancel an open or accepted invoice, failing back all HTLCs held for it.
+	Settled invoices can't be canceled.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "paymenthash",
+			Usage: "the hex-encoded payment hash (32 byte) of the " +
+				"invoice to cancel",
+		},
+	},
+	Action: actionDecorator(cancelInvoice),
+}
+
+func cancelInvoice(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		paymentHash []byte
+		err         error
+	)
+
+	switch {
+	case ctx.IsSet("paymenthash"):
+		paymentHash, err = hex.DecodeString(ctx.String("paymenthash"))
+	case ctx.Args().Present():
+		paymentHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("paymenthash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to parse paymenthash: %v", err)
+	}
+
+	req := &lnrpc.CancelInvoiceRequest{
+		PaymentHash: paymentHash,
+	}
+
+	resp, err := client.CancelInvoice(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var lookupInvoiceCommand = cli.Command{
 	Name:      "lookupinvoice",
 	Category:  "Payments",
@@ -2602,19 +3378,81 @@ var listPaymentsCommand = cli.Command{
 	Action:   actionDecorator(listPayments),
 }
 
-func listPayments(ctx *cli.Context) error {
-	client, cleanUp := getClient(ctx)
-	defer cleanUp()
+func listPayments(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPaymentsRequest{}
+
+	payments, err := client.ListPayments(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(payments)
+	return nil
+}
+
+var trackPaymentCommand = cli.Command{
+	Name:      "trackpayment",
+	Category:  "Payments",
+	Usage:     "Track the status and attempts of an outgoing payment.",
+	ArgsUsage: "paymenthash",
+	Description: `
+	Print the current status of an outgoing payment along with every attempt
+	made to send it, followed by an update each time it changes, until the
+	payment succeeded or failed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "paymenthash",
+			Usage: "the hex-encoded payment hash (32 byte) of the " +
+				"payment to track",
+		},
+	},
+	Action: actionDecorator(trackPayment),
+}
+
+func trackPayment(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		paymentHash []byte
+		err         error
+	)
+
+	switch {
+	case ctx.IsSet("paymenthash"):
+		paymentHash, err = hex.DecodeString(ctx.String("paymenthash"))
+	case ctx.Args().Present():
+		paymentHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("paymenthash argument missing")
+	}
 
-	req := &lnrpc.ListPaymentsRequest{}
+	if err != nil {
+		return fmt.Errorf("unable to parse paymenthash: %v", err)
+	}
 
-	payments, err := client.ListPayments(context.Background(), req)
+	req := &lnrpc.TrackPaymentRequest{
+		PaymentHash: paymentHash,
+	}
+
+	stream, err := client.TrackPayment(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(payments)
-	return nil
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
 }
 
 var getChanInfoCommand = cli.Command{
@@ -2747,6 +3585,16 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) number of blocks the last hop has to reveal " +
 				"the preimage",
 		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) the id of the channel the routes " +
+				"must leave through",
+		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "(optional) the hex-encoded public key of the " +
+				"node the routes must arrive from",
+		},
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -2791,12 +3639,19 @@ func queryRoutes(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := retrieveLastHop(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.QueryRoutesRequest{
 		PubKey:         dest,
 		Amt:            amt,
 		FeeLimit:       feeLimit,
 		NumRoutes:      int32(ctx.Int("num_max_routes")),
 		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
@@ -2808,6 +3663,222 @@ func queryRoutes(ctx *cli.Context) error {
 	return nil
 }
 
+var queryMissionControlCommand = cli.Command{
+	Name:     "querymc",
+	Category: "Payments",
+	Usage:    "Query the internal mission control state.",
+	Description: "Returns the payment results observed by mission " +
+		"control, which are used to estimate the success " +
+		"probabilities of routes during path finding.",
+	Action: actionDecorator(queryMissionControl),
+}
+
+func queryMissionControl(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.QueryMissionControlRequest{}
+	resp, err := client.QueryMissionControl(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var queryProbabilityCommand = cli.Command{
+	Name:     "queryprob",
+	Category: "Payments",
+	Usage:    "Estimate a success probability.",
+	Description: "Returns the estimated success probability of " +
+		"forwarding a payment of the given amount from one node to " +
+		"another, along with the last payment result observed for the " +
+		"pair of nodes.",
+	ArgsUsage: "from-node to-node amt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "from_node",
+			Usage: "the 33-byte hex-encoded public key of the " +
+				"forwarding node",
+		},
+		cli.StringFlag{
+			Name: "to_node",
+			Usage: "the 33-byte hex-encoded public key of the node " +
+				"forwarded to",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to forward expressed in satoshis",
+		},
+	},
+	Action: actionDecorator(queryProbability),
+}
+
+func queryProbability(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		fromNode string
+		toNode   string
+		amt      int64
+		err      error
+	)
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("from_node"):
+		fromNode = ctx.String("from_node")
+	case args.Present():
+		fromNode = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("from_node argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("to_node"):
+		toNode = ctx.String("to_node")
+	case args.Present():
+		toNode = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("to_node argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		amt = ctx.Int64("amt")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt argument: %v", err)
+		}
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	req := &lnrpc.QueryProbabilityRequest{
+		FromNode: fromNode,
+		ToNode:   toNode,
+		AmtMsat:  amt * 1000,
+	}
+
+	resp, err := client.QueryProbability(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var estimateRouteFeeCommand = cli.Command{
+	Name:     "estimateroutefee",
+	Category: "Payments",
+	Usage:    "Estimate the fee of a payment by probing routes.",
+	Description: `
+	Probes the candidate routes to a destination, in order of increasing
+	fees, by sending HTLCs with a random payment hash along them. As the
+	destination doesn't know the payment hash, no payment is made. The
+	cheapest route that reached the destination is returned along with
+	its fee.`,
+	ArgsUsage: "dest amt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "dest",
+			Usage: "the 33-byte hex-encoded public key for the payment " +
+				"destination",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to send expressed in satoshis",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when sending " +
+				"the payment",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the payment's amount used as the " +
+				"maximum fee allowed when sending the payment",
+		},
+		cli.Int64Flag{
+			Name:  "num_max_routes",
+			Usage: "the max number of routes to be probed",
+			Value: 3,
+		},
+		cli.Int64Flag{
+			Name: "final_cltv_delta",
+			Usage: "(optional) number of blocks the last hop has to reveal " +
+				"the preimage",
+		},
+	},
+	Action: actionDecorator(estimateRouteFee),
+}
+
+func estimateRouteFee(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		dest string
+		amt  int64
+		err  error
+	)
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("dest"):
+		dest = ctx.String("dest")
+	case args.Present():
+		dest = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("dest argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		amt = ctx.Int64("amt")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt argument: %v", err)
+		}
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	feeLimit, err := retrieveFeeLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.EstimateRouteFeeRequest{
+		PubKey:         dest,
+		Amt:            amt,
+		FeeLimit:       feeLimit,
+		NumRoutes:      int32(ctx.Int("num_max_routes")),
+		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+	}
+
+	resp, err := client.EstimateRouteFee(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getNetworkInfoCommand = cli.Command{
 	Name:     "getnetworkinfo",
 	Category: "Channels",
@@ -3720,3 +4791,114 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var subscribeHtlcEventsCommand = cli.Command{
+	Name:     "subscribehtlcevents",
+	Category: "Payments",
+	Usage: "Subscribe to the events of all htlcs sent, received and " +
+		"forwarded by the node.",
+	Action: actionDecorator(subscribeHtlcEvents),
+}
+
+func subscribeHtlcEvents(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SubscribeHtlcEventsRequest{}
+	stream, err := client.SubscribeHtlcEvents(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var updateCommitFeePolicyCommand = cli.Command{
+	Name:     "updatecommitfeepolicy",
+	Category: "Channels",
+	Usage: "Update the commitment fee policy of a channel we " +
+		"initiated.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Overrides the policy governing the commitment fee rate we propose for a
+	channel we initiated. Only the set flags override the current policy,
+	and flags set to zero reset their field to its default. Overrides are
+	persisted until the channel is closed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the confirmation target (in blocks) the fee " +
+				"estimator is queried with",
+		},
+		cli.Int64Flag{
+			Name:  "min_fee_per_kw",
+			Usage: "the lowest commitment fee rate (in sat/kw) we propose",
+		},
+		cli.Int64Flag{
+			Name:  "max_fee_per_kw",
+			Usage: "the highest commitment fee rate (in sat/kw) we propose",
+		},
+		cli.Uint64Flag{
+			Name: "hysteresis",
+			Usage: "the percentage by which the estimated fee rate " +
+				"must deviate from the current commitment fee " +
+				"rate before we propose a fee update",
+		},
+	},
+	Action: actionDecorator(updateCommitFeePolicy),
+}
+
+func updateCommitFeePolicy(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "updatecommitfeepolicy")
+		return nil
+	}
+
+	chanPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.CommitFeePolicyUpdateRequest{
+		ChanPoint:            chanPoint,
+		ConfTarget:           uint32(ctx.Uint64("conf_target")),
+		MinFeePerKw:          ctx.Int64("min_fee_per_kw"),
+		MaxFeePerKw:          ctx.Int64("max_fee_per_kw"),
+		Hysteresis:           uint32(ctx.Uint64("hysteresis")),
+		ConfTargetSpecified:  ctx.IsSet("conf_target"),
+		MinFeePerKwSpecified: ctx.IsSet("min_fee_per_kw"),
+		MaxFeePerKwSpecified: ctx.IsSet("max_fee_per_kw"),
+		HysteresisSpecified:  ctx.IsSet("hysteresis"),
+	}
+
+	resp, err := client.UpdateCommitFeePolicy(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
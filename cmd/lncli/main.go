@@ -267,7 +267,10 @@ func main() {
 		sendCoinsCommand,
 		connectCommand,
 		disconnectCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
@@ -280,17 +283,25 @@ func main() {
 		sendPaymentCommand,
 		payInvoiceCommand,
 		sendToRouteCommand,
+		rebalanceCommand,
 		addInvoiceCommand,
+		addHoldInvoiceCommand,
+		settleInvoiceCommand,
+		cancelInvoiceCommand,
 		lookupInvoiceCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
 		prunedChannelsCommand,
 		listPaymentsCommand,
+		trackPaymentCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
+		queryMissionControlCommand,
+		queryProbabilityCommand,
+		estimateRouteFeeCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
 		decodePayReqCommand,
@@ -300,7 +311,9 @@ func main() {
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		updateCommitFeePolicyCommand,
 		forwardingHistoryCommand,
+		subscribeHtlcEventsCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -25,6 +25,11 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// ErrFeeAboveMax is returned when the remote party insists on a
+	// closing fee above the maximum we're willing to pay, after we've
+	// already offered that maximum.
+	ErrFeeAboveMax = fmt.Errorf("remote closing fee exceeds max fee")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -116,6 +121,15 @@ type channelCloser struct {
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat btcutil.Amount
 
+	// maxFeeSat is the highest fee the state machine will offer or accept
+	// during negotiation. If zero, no limit is imposed.
+	maxFeeSat btcutil.Amount
+
+	// negotiatedFeeSat is the fee both parties agreed on for the closing
+	// transaction. This will only be populated once the state machine
+	// shifts to the closeFinished state.
+	negotiatedFeeSat btcutil.Amount
+
 	// lastFeeProposal is the last fee that we proposed to the remote
 	// party. We'll use this as a pivot point to rachet our next offer up,
 	// or down, or simply accept the remote party's prior offer.
@@ -147,11 +161,12 @@ type channelCloser struct {
 }
 
 // newChannelCloser creates a new instance of the channel closure given the
-// passed configuration, and delivery+fee preference. The final argument should
-// only be populated iff, we're the initiator of this closing request.
+// passed configuration, and delivery+fee preference. A zero maxFeePerKw
+// imposes no limit on the negotiated fee. The final argument should only be
+// populated iff, we're the initiator of this closing request.
 func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
-	idealFeePerKw lnwallet.SatPerKWeight, negotiationHeight uint32,
-	closeReq *htlcswitch.ChanClose) *channelCloser {
+	idealFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	negotiationHeight uint32, closeReq *htlcswitch.ChanClose) *channelCloser {
 
 	// Given the target fee-per-kw, we'll compute what our ideal _total_
 	// fee will be starting at for this fee negotiation.
@@ -173,6 +188,20 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		idealFeeSat = channelCommitFee
 	}
 
+	// Our ideal fee also shouldn't exceed the maximum fee we're willing to
+	// pay, if one was specified.
+	var maxFeeSat btcutil.Amount
+	if maxFeePerKw != 0 {
+		maxFeeSat = cfg.channel.CalcFee(maxFeePerKw)
+		if idealFeeSat > maxFeeSat {
+			peerLog.Infof("Ideal starting fee of %v is greater "+
+				"than max fee of %v, clamping",
+				int64(idealFeeSat), int64(maxFeeSat))
+
+			idealFeeSat = maxFeeSat
+		}
+	}
+
 	peerLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
 		cfg.channel.ChannelPoint(), int64(idealFeeSat))
 
@@ -185,6 +214,7 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		maxFeeSat:           maxFeeSat,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[btcutil.Amount]*lnwire.ClosingSigned),
 	}
@@ -253,6 +283,19 @@ func (c *channelCloser) ClosingTx() (*wire.MsgTx, error) {
 	return c.closingTx, nil
 }
 
+// NegotiatedFee returns the fee both parties agreed on for the closing
+// transaction.
+//
+// NOTE: This fee is only available if the state machine is in the
+// closeFinished state.
+func (c *channelCloser) NegotiatedFee() (btcutil.Amount, error) {
+	if c.state != closeFinished {
+		return 0, ErrChanCloseNotFinished
+	}
+
+	return c.negotiatedFeeSat, nil
+}
+
 // CloseRequest returns the original close request that prompted the creation
 // of the state machine.
 //
@@ -385,6 +428,22 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				remoteProposedFee,
 			)
 
+			// We'll never offer more than our maximum fee. If
+			// we've already offered it and the remote party still
+			// wants more, then there's no fee we can agree on.
+			if c.maxFeeSat != 0 && feeProposal > c.maxFeeSat {
+				if c.lastFeeProposal == c.maxFeeSat {
+					peerLog.Warnf("ChannelPoint(%v): remote "+
+						"fee of %v exceeds max fee of %v",
+						c.chanPoint,
+						int64(remoteProposedFee),
+						int64(c.maxFeeSat))
+					return nil, false, ErrFeeAboveMax
+				}
+
+				feeProposal = c.maxFeeSat
+			}
+
 			// With our new fee proposal calculated, we'll craft a
 			// new close signed signature to send to the other
 			// party so we can continue the fee negotiation
@@ -426,6 +485,7 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			return nil, false, err
 		}
 		c.closingTx = closeTx
+		c.negotiatedFeeSat = remoteProposedFee
 
 		// With the closing transaction crafted, we'll now broadcast it
 		// to the network.
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/breez/lightninglib/build"
+	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/htlcswitch/hodl"
 	"github.com/breez/lightninglib/lncfg"
 	"github.com/breez/lightninglib/lnwire"
@@ -54,6 +55,16 @@ const (
 	defaultMaxLogFileSize      = 10
 	defaultMaxBackoff          = time.Hour
 
+	defaultRebalanceInterval       = 10 * time.Minute
+	defaultRebalanceFeeBudget      = 1000
+	defaultRebalanceBudgetInterval = 24 * time.Hour
+
+	// defaultPrivateCommitFeeConfTarget is the default confirmation
+	// target of the commitment fee rate of private channels. These are
+	// mostly used by mobile nodes that rarely need to force close, so a
+	// longer target avoids overpaying fees.
+	defaultPrivateCommitFeeConfTarget = 6
+
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
 	defaultTorDNSPort              = 53
@@ -149,6 +160,30 @@ type autoPilotConfig struct {
 	MinConfs       int32   `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
 }
 
+type rebalanceConfig struct {
+	Active         bool          `long:"active" description:"If the rebalancer should keep the balance ratios of our channels within bounds or not."`
+	Interval       time.Duration `long:"interval" description:"How often the rebalancer checks the balance ratios of our channels"`
+	MinRatio       float64       `long:"minratio" description:"The lowest share of a channel's capacity that should be ours. Channels below it are topped up from other channels"`
+	MaxRatio       float64       `long:"maxratio" description:"The highest share of a channel's capacity that should be ours. Channels above it are drained into other channels"`
+	FeeBudget      int64         `long:"feebudget" description:"The maximum amount of fees (in satoshis) the rebalancer may spend within each budget interval"`
+	BudgetInterval time.Duration `long:"budgetinterval" description:"The interval after which the fee budget of the rebalancer is replenished"`
+}
+
+type commitFeeConfig struct {
+	ConfTarget        uint32 `long:"conftarget" description:"The confirmation target (in blocks) of the commitment fee rate we propose for the public channels we initiated"`
+	PrivateConfTarget uint32 `long:"privateconftarget" description:"The confirmation target (in blocks) of the commitment fee rate we propose for the private channels we initiated"`
+	MinFeeRate        int64  `long:"minfeerate" description:"The lowest commitment fee rate (in sat/kw) we propose. Fee rates below the floor of 253 sat/kw are raised to it"`
+	MaxFeeRate        int64  `long:"maxfeerate" description:"The highest commitment fee rate (in sat/kw) we propose. If zero, the fee rate isn't bounded"`
+	Hysteresis        uint32 `long:"hysteresis" description:"The percentage by which the estimated fee rate must deviate from the current commitment fee rate before we propose a fee update"`
+}
+
+type protocolConfig struct {
+	NoTLVOnion        bool `long:"notlvonion" description:"If true, we won't signal support for TLV onion payloads to our peers, the network, or within our invoices. Requires nopaymentaddr to be set as well"`
+	NoPaymentAddr     bool `long:"nopaymentaddr" description:"If true, our invoices won't include a payment address, nor signal the payment address feature. Requires nompp to be set as well"`
+	NoMPP             bool `long:"nompp" description:"If true, our invoices won't signal support for multi-path payments"`
+	NoGossipQueriesEx bool `long:"nogossipqueriesex" description:"If true, we won't signal support for extended gossip queries to our peers, and will query them for all channel updates"`
+}
+
 type torConfig struct {
 	Active          bool   `long:"active" description:"Allow outbound and inbound connections to be routed through Tor"`
 	SOCKS           string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
@@ -221,10 +256,16 @@ type config struct {
 
 	Autopilot *autoPilotConfig `group:"Autopilot" namespace:"autopilot"`
 
+	Rebalance *rebalanceConfig `group:"Rebalance" namespace:"rebalance"`
+
+	CommitFee *commitFeeConfig `group:"CommitFee" namespace:"commitfee"`
+
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
 
+	Protocol *protocolConfig `group:"protocol" namespace:"protocol"`
+
 	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
 
 	NoSeedBackup bool `long:"noseedbackup" description:"If true, NO SEED WILL BE EXPOSED AND THE WALLET WILL BE ENCRYPTED USING THE DEFAULT PASSPHRASE -- EVER. THIS FLAG IS ONLY FOR TESTING AND IS BEING DEPRECATED."`
@@ -240,6 +281,18 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	MaxDualFundContribution int64 `long:"maxdualfundcontribution" description:"The largest amount (in satoshis) we'll contribute to a channel when a peer requests a dual funded channel. We'll match the peer's contribution up to this amount. If zero, we won't contribute to any channels opened by peers"`
+
+	AcceptKeysend bool `long:"accept-keysend" description:"If true, spontaneous keysend payments that don't pay to an existing invoice are accepted, creating an invoice from the preimage passed by the sender on the fly."`
+
+	InvoiceRetention time.Duration `long:"invoiceretention" description:"If set, canceled invoices are deleted from the database once their payment request has been expired for this long. Expired unpaid invoices are always canceled. Valid time units are {s, m, h}. If zero, canceled invoices are kept"`
+
+	MaxPeerForwards     uint32        `long:"maxpeerforwards" description:"The maximum number of forwarded HTLCs that a single incoming peer may have in flight at the same time. If zero, the number isn't limited"`
+	MaxPeerForwardValue int64         `long:"maxpeerforwardvalue" description:"The maximum total value (in satoshis) of the forwarded HTLCs that a single incoming peer may have in flight at the same time. If zero, the value isn't limited"`
+	MaxForwardHoldTime  time.Duration `long:"maxforwardholdtime" description:"The maximum duration that a forwarded HTLC may wait to be added to its outgoing channel before it's failed back early. Valid time units are {s, m, h}. If zero, forwards wait indefinitely"`
+
+	MaxDustHTLCExposure int64 `long:"maxdusthtlcexposure" description:"The maximum total value (in satoshis) of the dust HTLCs on either commitment of a channel. Dust HTLCs go to the miners if the channel is force closed, so new HTLCs and fee updates that would exceed this value are rejected. If zero, the value isn't limited"`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -305,11 +358,27 @@ func loadConfig(args []string) (*config, error) {
 			MinChannelSize: int64(minChanFundingSize),
 			MaxChannelSize: int64(maxFundingAmount),
 		},
+		Rebalance: &rebalanceConfig{
+			Interval:       defaultRebalanceInterval,
+			MinRatio:       0.2,
+			MaxRatio:       0.8,
+			FeeBudget:      defaultRebalanceFeeBudget,
+			BudgetInterval: defaultRebalanceBudgetInterval,
+		},
+		CommitFee: &commitFeeConfig{
+			ConfTarget:        htlcswitch.DefaultCommitFeeConfTarget,
+			PrivateConfTarget: defaultPrivateCommitFeeConfTarget,
+			Hysteresis:        htlcswitch.DefaultCommitFeeHysteresis,
+		},
+		Protocol:            &protocolConfig{},
 		TrickleDelay:        defaultTrickleDelay,
 		InactiveChanTimeout: defaultInactiveChanTimeout,
 		Alias:               defaultAlias,
 		Color:               defaultColor,
 		MinChanSize:         int64(minChanFundingSize),
+		MaxDustHTLCExposure: int64(
+			htlcswitch.DefaultMaxDustHTLCExposure.ToSatoshis(),
+		),
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
@@ -455,6 +524,24 @@ func loadConfig(args []string) (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Ensure that the commitment fee rate bounds are consistent.
+	if cfg.CommitFee.MinFeeRate < 0 || cfg.CommitFee.MaxFeeRate < 0 {
+		str := "%s: commitfee.minfeerate and commitfee.maxfeerate " +
+			"must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.CommitFee.MaxFeeRate != 0 &&
+		cfg.CommitFee.MaxFeeRate < cfg.CommitFee.MinFeeRate {
+
+		str := "%s: commitfee.maxfeerate must not be below " +
+			"commitfee.minfeerate"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
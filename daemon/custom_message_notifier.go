@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"sync/atomic"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/subscribe"
+)
+
+// CustomMessageNotifier dispatches the custom messages received from our
+// peers to its subscribers, allowing applications to run their own protocols
+// over the existing peer connections.
+type CustomMessageNotifier struct {
+	started uint32
+	stopped uint32
+
+	ntfnServer *subscribe.Server
+}
+
+// CustomMessage is a custom message received from a peer.
+type CustomMessage struct {
+	// Peer is the public key of the peer that sent the message.
+	Peer [33]byte
+
+	// Msg is the received message.
+	Msg *lnwire.Custom
+}
+
+// NewCustomMessageNotifier creates a new CustomMessageNotifier.
+func NewCustomMessageNotifier() *CustomMessageNotifier {
+	return &CustomMessageNotifier{
+		ntfnServer: subscribe.NewServer(),
+	}
+}
+
+// Start starts the CustomMessageNotifier and all goroutines it needs to carry
+// out its task.
+func (c *CustomMessageNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&c.started, 0, 1) {
+		return nil
+	}
+
+	ltndLog.Tracef("CustomMessageNotifier %v starting", c)
+
+	return c.ntfnServer.Start()
+}
+
+// Stop signals the notifier for a graceful shutdown.
+func (c *CustomMessageNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&c.stopped, 0, 1) {
+		return nil
+	}
+
+	return c.ntfnServer.Stop()
+}
+
+// SubscribeCustomMessages returns a subscribe.Client that will receive a
+// CustomMessage for every custom message received from any of our peers.
+func (c *CustomMessageNotifier) SubscribeCustomMessages() (*subscribe.Client,
+	error) {
+
+	return c.ntfnServer.Subscribe()
+}
+
+// NotifyCustomMessage notifies the subscribers of a custom message received
+// from the peer with the passed public key.
+func (c *CustomMessageNotifier) NotifyCustomMessage(peer [33]byte,
+	msg *lnwire.Custom) {
+
+	event := &CustomMessage{
+		Peer: peer,
+		Msg:  msg,
+	}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		ltndLog.Warnf("Unable to send custom message update: %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/htlcswitch"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/lnwire"
+)
+
+var (
+	// ErrFwdNotExists is returned when a client attempts to resolve a
+	// forward that isn't held by the interceptor.
+	ErrFwdNotExists = errors.New("forward does not exist")
+)
+
+// forwardInterceptor connects the forward interceptor of the switch to an
+// HtlcInterceptor RPC stream. It hands all intercepted forwards to the client
+// and resolves them according to the client's responses.
+type forwardInterceptor struct {
+	htlcSwitch *htlcswitch.Switch
+	stream     lnrpc.Lightning_HtlcInterceptorServer
+
+	// holdForwards holds the forwards that have been handed to the client
+	// and haven't been resolved yet, keyed by their incoming circuit.
+	holdForwards map[channeldb.CircuitKey]htlcswitch.InterceptedForward
+	fwdMtx       sync.Mutex
+
+	// interceptedForwards is used to pass the intercepted forwards from
+	// the switch to the goroutine sending them to the client, as the
+	// stream must not be used concurrently.
+	interceptedForwards chan htlcswitch.InterceptedForward
+
+	quit chan struct{}
+}
+
+// newForwardInterceptor creates a new forwardInterceptor for the passed RPC
+// stream.
+func newForwardInterceptor(htlcSwitch *htlcswitch.Switch,
+	stream lnrpc.Lightning_HtlcInterceptorServer) *forwardInterceptor {
+
+	return &forwardInterceptor{
+		htlcSwitch: htlcSwitch,
+		stream:     stream,
+		holdForwards: make(
+			map[channeldb.CircuitKey]htlcswitch.InterceptedForward,
+		),
+		interceptedForwards: make(chan htlcswitch.InterceptedForward),
+		quit:                make(chan struct{}),
+	}
+}
+
+// run intercepts all forwards until either the client disconnects or the
+// server shuts down. Once it returns, all forwards still held are resumed.
+func (f *forwardInterceptor) run(serverQuit chan struct{}) error {
+	f.htlcSwitch.SetInterceptor(f.onForward)
+
+	defer func() {
+		f.htlcSwitch.SetInterceptor(nil)
+		close(f.quit)
+		f.resumeHeldForwards()
+	}()
+
+	// We'll read the client's responses within a separate goroutine, as
+	// receiving from the stream blocks.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := f.stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			if err := f.resolveFromClient(resp); err != nil {
+				rpcsLog.Errorf("Unable to resolve forward: %v",
+					err)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case fwd := <-f.interceptedForwards:
+			if err := f.holdAndSend(fwd); err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-serverQuit:
+			return nil
+		}
+	}
+}
+
+// onForward is the interceptor set on the switch. It hands the forward to the
+// client, unless the interceptor is shutting down.
+func (f *forwardInterceptor) onForward(
+	fwd htlcswitch.InterceptedForward) bool {
+
+	select {
+	case f.interceptedForwards <- fwd:
+		return true
+
+	case <-f.quit:
+		return false
+	}
+}
+
+// holdAndSend holds the passed forward until the client resolves it, and
+// sends it over to the client.
+func (f *forwardInterceptor) holdAndSend(
+	fwd htlcswitch.InterceptedForward) error {
+
+	pkt := fwd.Packet()
+
+	f.fwdMtx.Lock()
+	f.holdForwards[pkt.IncomingCircuit] = fwd
+	f.fwdMtx.Unlock()
+
+	return f.stream.Send(&lnrpc.ForwardHtlcInterceptRequest{
+		IncomingCircuitKey: &lnrpc.CircuitKey{
+			ChanId: pkt.IncomingCircuit.ChanID.ToUint64(),
+			HtlcId: pkt.IncomingCircuit.HtlcID,
+		},
+		IncomingAmountMsat:      uint64(pkt.IncomingAmount),
+		IncomingExpiry:          pkt.IncomingExpiry,
+		PaymentHash:             pkt.Hash[:],
+		OutgoingRequestedChanId: pkt.OutgoingChanID.ToUint64(),
+		OutgoingAmountMsat:      uint64(pkt.OutgoingAmount),
+		OutgoingExpiry:          pkt.OutgoingExpiry,
+	})
+}
+
+// resolveFromClient resolves a held forward according to the passed response
+// of the client.
+func (f *forwardInterceptor) resolveFromClient(
+	resp *lnrpc.ForwardHtlcInterceptResponse) error {
+
+	if resp.IncomingCircuitKey == nil {
+		return errors.New("missing incoming circuit key")
+	}
+
+	circuitKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(
+			resp.IncomingCircuitKey.ChanId,
+		),
+		HtlcID: resp.IncomingCircuitKey.HtlcId,
+	}
+
+	f.fwdMtx.Lock()
+	fwd, ok := f.holdForwards[circuitKey]
+	f.fwdMtx.Unlock()
+
+	if !ok {
+		return ErrFwdNotExists
+	}
+
+	// We'll only stop holding the forward once it's been resolved, such
+	// that the client can retry after an invalid response.
+	err := resolveForward(fwd, resp)
+	if err == nil || err == htlcswitch.ErrFwdAlreadyResolved {
+		f.fwdMtx.Lock()
+		delete(f.holdForwards, circuitKey)
+		f.fwdMtx.Unlock()
+	}
+
+	return err
+}
+
+// resolveForward resolves the passed forward using the action of the passed
+// response.
+func resolveForward(fwd htlcswitch.InterceptedForward,
+	resp *lnrpc.ForwardHtlcInterceptResponse) error {
+
+	switch resp.Action {
+	case lnrpc.ForwardHtlcInterceptResponse_RESUME:
+		return fwd.Resume()
+
+	case lnrpc.ForwardHtlcInterceptResponse_RESUME_MODIFIED:
+		return fwd.ResumeModified(
+			lnwire.NewShortChanIDFromInt(resp.OutgoingChanId),
+		)
+
+	case lnrpc.ForwardHtlcInterceptResponse_SETTLE:
+		if len(resp.Preimage) != 32 {
+			return fmt.Errorf("preimage must be exactly 32 "+
+				"bytes, is %v", len(resp.Preimage))
+		}
+
+		var preimage [32]byte
+		copy(preimage[:], resp.Preimage)
+
+		return fwd.Settle(preimage)
+
+	case lnrpc.ForwardHtlcInterceptResponse_FAIL:
+		return fwd.Fail(lnwire.FailCode(resp.FailureCode))
+
+	default:
+		return fmt.Errorf("unknown resolve action: %v", resp.Action)
+	}
+}
+
+// resumeHeldForwards resumes all forwards that the client hasn't resolved, so
+// they don't have to wait for the intercept timeout of the switch.
+func (f *forwardInterceptor) resumeHeldForwards() {
+	f.fwdMtx.Lock()
+	defer f.fwdMtx.Unlock()
+
+	for circuitKey, fwd := range f.holdForwards {
+		err := fwd.Resume()
+		if err != nil && err != htlcswitch.ErrFwdAlreadyResolved {
+			rpcsLog.Errorf("Unable to resume forward with incoming "+
+				"circuit %v: %v", circuitKey, err)
+		}
+	}
+
+	f.holdForwards = nil
+}
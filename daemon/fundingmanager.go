@@ -19,8 +19,10 @@ import (
 	"github.com/breez/lightninglib/routing"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
@@ -107,6 +109,41 @@ type reservationWithCtx struct {
 	remoteMinHtlc     lnwire.MilliSatoshi
 	remoteChanReserve btcutil.Amount
 
+	// isInitiator is true if we initiated the funding workflow.
+	isInitiator bool
+
+	// dualFund is true if both parties contribute funds to the channel.
+	// As the initiator, this is set when requesting a dual funded channel,
+	// and cleared if the responder ends up not contributing any funds.
+	dualFund bool
+
+	// fundingFeePerKw is the fee rate both parties of a dual funded channel
+	// pay for their own inputs and outputs to the funding transaction.
+	fundingFeePerKw lnwallet.SatPerKWeight
+
+	// remoteContribution is the remote party's contribution to a dual
+	// funded channel. It's held back until the remote party has added all
+	// their inputs and outputs to the funding transaction.
+	remoteContribution *lnwallet.ChannelContribution
+
+	// remoteSerialIDs is the set of serial IDs of the inputs and outputs
+	// the remote party added to the funding transaction of a dual funded
+	// channel.
+	remoteSerialIDs map[uint64]struct{}
+
+	// remoteCommitSig is the remote party's signature for our version of
+	// the commitment transaction of a dual funded channel. It's held back
+	// until we receive the signatures for their funding inputs.
+	remoteCommitSig []byte
+
+	// psbtShim is set if the channel is funded from an externally signed
+	// PSBT rather than from our wallet.
+	psbtShim *psbtShim
+
+	// verifiedFundingTx is the funding transaction of a PSBT funded
+	// channel, as verified by the caller before signing it.
+	verifiedFundingTx *wire.MsgTx
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -140,6 +177,32 @@ func (r *reservationWithCtx) updateTimestamp() {
 	r.lastUpdated = time.Now()
 }
 
+// addRemoteSerialID records the serial ID of an input or output the remote
+// party adds to the funding transaction of a dual funded channel. An error is
+// returned if the serial ID has already been used.
+func (r *reservationWithCtx) addRemoteSerialID(serialID uint64) error {
+	if _, ok := r.remoteSerialIDs[serialID]; ok {
+		return fmt.Errorf("duplicate serial ID %v", serialID)
+	}
+	r.remoteSerialIDs[serialID] = struct{}{}
+
+	return nil
+}
+
+// psbtShim instructs the funding manager to fund a channel we initiate from an
+// externally signed PSBT, rather than from our wallet. Once both parties'
+// contributions are known, the funding workflow pauses until the signed
+// funding transaction is handed over via the FundingStateStep RPC.
+type psbtShim struct {
+	// pendingChanID is the pending channel ID chosen by the caller to
+	// reference the channel with. If zero, a random one is generated.
+	pendingChanID [32]byte
+
+	// noPublish indicates that the funding transaction is published
+	// externally, so we won't broadcast it ourselves.
+	noPublish bool
+}
+
 // initFundingMsg is sent by an outside subsystem to the funding manager in
 // order to kick off a funding workflow with a specified target peer. The
 // original request which defines the parameters of the funding workflow are
@@ -190,6 +253,38 @@ type fundingLockedMsg struct {
 	peer lnpeer.Peer
 }
 
+// txAddInputMsg couples an lnwire.TxAddInput message with the peer who sent
+// the message. This allows the funding manager to add the input to the funding
+// transaction of the dual funded channel under construction.
+type txAddInputMsg struct {
+	msg  *lnwire.TxAddInput
+	peer lnpeer.Peer
+}
+
+// txAddOutputMsg couples an lnwire.TxAddOutput message with the peer who sent
+// the message. This allows the funding manager to add the output to the
+// funding transaction of the dual funded channel under construction.
+type txAddOutputMsg struct {
+	msg  *lnwire.TxAddOutput
+	peer lnpeer.Peer
+}
+
+// txCompleteMsg couples an lnwire.TxComplete message with the peer who sent
+// the message. This allows the funding manager to process the remote party's
+// contribution to a dual funded channel.
+type txCompleteMsg struct {
+	msg  *lnwire.TxComplete
+	peer lnpeer.Peer
+}
+
+// txSignaturesMsg couples an lnwire.TxSignatures message with the peer who
+// sent the message. This allows the funding manager to complete the funding
+// workflow of a dual funded channel.
+type txSignaturesMsg struct {
+	msg  *lnwire.TxSignatures
+	peer lnpeer.Peer
+}
+
 // fundingErrorMsg couples an lnwire.Error message with the peer who sent the
 // message. This allows the funding manager to properly process the error.
 type fundingErrorMsg struct {
@@ -333,6 +428,13 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// DualFundContribution is a function closure that, given the amount
+	// the initiator of a dual funder workflow commits to a new channel,
+	// returns the amount of funds we'll contribute to the channel
+	// ourselves. If zero is returned, then we won't contribute any funds,
+	// and the regular single funder workflow carries on.
+	DualFundContribution func(remoteAmt btcutil.Amount) btcutil.Amount
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -495,13 +597,19 @@ func (f *fundingManager) Start() error {
 		f.localDiscoverySignals[chanID] = make(chan struct{})
 
 		// Rebroadcast the funding transaction for any pending channel
-		// that we initiated. If this operation fails due to a reported
-		// double spend, we treat this as an indicator that we have
-		// already broadcast this transaction. Otherwise, we simply log
-		// the error as there isn't anything we can currently do to
-		// recover.
-		if channel.ChanType == channeldb.SingleFunder &&
-			channel.IsInitiator {
+		// that we initiated, or contributed funds to, as long as it
+		// carries the signatures for all of its inputs. Funding
+		// transactions signed outside of the wallet are left to
+		// whoever signed them, as they may never be meant to be
+		// published, such as the one of an aborted batch. If this
+		// operation fails due to a reported double spend, we treat this
+		// as an indicator that we have already broadcast this
+		// transaction. Otherwise, we simply log the error as there
+		// isn't anything we can currently do to recover.
+		rebroadcast := channel.ChanType == channeldb.DualFunder ||
+			(channel.ChanType == channeldb.SingleFunder &&
+				channel.IsInitiator)
+		if rebroadcast && fundingTxSigned(channel.FundingTxn) {
 
 			err := f.cfg.PublishTransaction(channel.FundingTxn)
 			if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -522,7 +630,8 @@ func (f *fundingManager) Start() error {
 				// Timeout channel will be triggered if the number of blocks
 				// mined since the channel was initiated reaches
 				// maxWaitNumBlocksFundingConf and we are not the channel
-				// initiator.
+				// initiator. If we contributed funds to the channel, it's
+				// only triggered once the funding tx was double spent.
 				localBalance := ch.LocalCommitment.LocalBalance.ToSatoshis()
 				closeInfo := &channeldb.ChannelCloseSummary{
 					ChainHash:               ch.ChainHash,
@@ -540,6 +649,7 @@ func (f *fundingManager) Start() error {
 					fndgLog.Errorf("Failed closing channel "+
 						"%v: %v", ch.FundingOutpoint, err)
 				}
+				f.releaseFundingInputs(ch)
 
 			case <-f.quit:
 				// The fundingManager is shutting down, and will
@@ -749,6 +859,33 @@ type pendingChansReq struct {
 	err  chan error
 }
 
+// psbtVerifyReq is a request to verify a funded, but not yet signed, PSBT
+// against the funding output of a PSBT funded channel.
+type psbtVerifyReq struct {
+	pendingChanID [32]byte
+	packet        *psbt.Packet
+	err           chan error
+}
+
+// psbtFinalizeReq is a request to resume the funding workflow of a PSBT funded
+// channel with its signed funding transaction. Either the signed PSBT, or the
+// final raw transaction is set.
+type psbtFinalizeReq struct {
+	pendingChanID [32]byte
+	packet        *psbt.Packet
+	finalTx       *wire.MsgTx
+	err           chan error
+}
+
+// shimCancelReq is a request to cancel the funding workflow of a channel
+// opened with a funding shim. If the channel point is set, the channel is
+// abandoned even if its funding transaction was already handed over.
+type shimCancelReq struct {
+	pendingChanID [32]byte
+	chanPoint     *wire.OutPoint
+	err           chan error
+}
+
 // PendingChannels returns a slice describing all the channels which are
 // currently pending at the last state of the funding workflow.
 func (f *fundingManager) PendingChannels() ([]*pendingChannel, error) {
@@ -892,6 +1029,14 @@ func (f *fundingManager) reservationCoordinator() {
 			case *fundingLockedMsg:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg)
+			case *txAddInputMsg:
+				f.handleTxAddInput(fmsg)
+			case *txAddOutputMsg:
+				f.handleTxAddOutput(fmsg)
+			case *txCompleteMsg:
+				f.handleTxComplete(fmsg)
+			case *txSignaturesMsg:
+				f.handleTxSignatures(fmsg)
 			case *fundingErrorMsg:
 				f.handleErrorMsg(fmsg)
 			}
@@ -905,6 +1050,12 @@ func (f *fundingManager) reservationCoordinator() {
 			switch msg := req.(type) {
 			case *pendingChansReq:
 				f.handlePendingChannels(msg)
+			case *psbtVerifyReq:
+				f.handlePsbtVerify(msg)
+			case *psbtFinalizeReq:
+				f.handlePsbtFinalize(msg)
+			case *shimCancelReq:
+				f.handleShimCancel(msg)
 			}
 		case <-f.quit:
 			return
@@ -1047,31 +1198,91 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		msg.CsvDelay, msg.PendingChannelID,
 		fmsg.peer.IdentityKey().SerializeCompressed())
 
+	// If the initiator requested a dual funded channel, then we'll consult
+	// our policy to determine how much we'll contribute to the channel
+	// ourselves, if anything at all. As there's no pushing for dual funder
+	// channels, we'll reject any such request with a non-zero push amount.
+	//
+	// The extra data of the message isn't required to be a TLV stream, so
+	// we'll treat any that we can't parse as a single funder request.
+	feePerKw, dualFund, err := msg.DualFund()
+	if err != nil {
+		fndgLog.Debugf("Unable to parse extra data of fundingRequest "+
+			"(pendingId=%x): %v", msg.PendingChannelID, err)
+		dualFund = false
+	}
+
+	var ourAmt btcutil.Amount
+	if dualFund {
+		if msg.PushAmount != 0 {
+			f.failFundingFlow(
+				fmsg.peer, fmsg.msg.PendingChannelID,
+				lnwallet.ErrNonZeroPushAmount(),
+			)
+			return
+		}
+
+		ourAmt = f.cfg.DualFundContribution(amt)
+		if amt+ourAmt > maxFundingAmount {
+			ourAmt = maxFundingAmount - amt
+		}
+	}
+
+	// Should we contribute any funds, then both parties pay for their own
+	// inputs and outputs to the funding transaction at the fee rate
+	// proposed by the initiator, which must be enough to get it relayed.
+	var fundingFeePerKw lnwallet.SatPerKWeight
+	if ourAmt != 0 {
+		fundingFeePerKw = lnwallet.SatPerKWeight(feePerKw)
+		if fundingFeePerKw < lnwallet.FeePerKwFloor {
+			err := fmt.Errorf("funding fee rate of %v sat/kw is "+
+				"below the floor of %v sat/kw", int64(fundingFeePerKw),
+				int64(lnwallet.FeePerKwFloor))
+			fndgLog.Warnf(err.Error())
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+	}
+
 	// Attempt to initialize a reservation within the wallet. If the wallet
 	// has insufficient resources to create the channel, then the
-	// reservation attempt may be rejected. Note that since we're on the
-	// responding side of a single funder workflow, we don't commit any
-	// funds to the channel ourselves.
+	// reservation attempt may be rejected. Note that unless we're on the
+	// responding side of a dual funder workflow, we don't commit any funds
+	// to the channel ourselves.
 	chainHash := chainhash.Hash(msg.ChainHash)
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:       &chainHash,
 		NodeID:          fmsg.peer.IdentityKey(),
 		NodeAddr:        fmsg.peer.Address(),
-		FundingAmount:   0,
-		Capacity:        amt,
+		FundingAmount:   ourAmt,
+		Capacity:        amt + ourAmt,
 		CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
-		FundingFeePerKw: 0,
+		FundingFeePerKw: fundingFeePerKw,
 		PushMSat:        msg.PushAmount,
 		Flags:           msg.ChannelFlags,
 		MinConfs:        1,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil && ourAmt != 0 {
+		// We may not have sufficient funds to make our contribution,
+		// in which case we'll fall back to the single funder workflow.
+		fndgLog.Warnf("Unable to contribute %v to pendingChan(%x), "+
+			"not contributing any funds: %v", ourAmt,
+			msg.PendingChannelID, err)
+
+		ourAmt = 0
+		req.FundingAmount = 0
+		req.Capacity = amt
+		req.FundingFeePerKw = 0
+		reservation, err = f.cfg.Wallet.InitChannelReservation(req)
+	}
 	if err != nil {
 		fndgLog.Errorf("Unable to initialize reservation: %v", err)
 		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
 		return
 	}
+	capacity := amt + ourAmt
 
 	// As we're the responder, we get to specify the number of confirmations
 	// that we require before both of us consider the channel open. We'll
@@ -1103,10 +1314,10 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		amt, msg.PushAmount)
 
 	// Generate our required constraints for the remote party.
-	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
-	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, msg.DustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(amt)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(amt)
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, msg.DustLimit)
+	maxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
 	minHtlc := f.cfg.DefaultRoutingPolicy.MinHTLC
 
 	// Once the reservation has been created successfully, we add it to
@@ -1118,10 +1329,12 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 	resCtx := &reservationWithCtx{
 		reservation:       reservation,
-		chanAmt:           amt,
+		chanAmt:           capacity,
 		remoteCsvDelay:    remoteCsvDelay,
 		remoteMinHtlc:     minHtlc,
 		remoteChanReserve: chanReserve,
+		dualFund:          ourAmt != 0,
+		fundingFeePerKw:   fundingFeePerKw,
 		err:               make(chan error, 1),
 		peer:              fmsg.peer,
 	}
@@ -1162,11 +1375,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			},
 		},
 	}
-	err = reservation.ProcessSingleContribution(remoteContribution)
-	if err != nil {
-		fndgLog.Errorf("unable to add contribution reservation: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+	// If we contribute funds to the channel as well, then we'll hold on
+	// to their contribution until they've added all their inputs and
+	// outputs to the funding transaction. Otherwise, we can process it
+	// right away.
+	if resCtx.dualFund {
+		resCtx.remoteContribution = remoteContribution
+		resCtx.remoteSerialIDs = make(map[uint64]struct{})
+	} else {
+		err = reservation.ProcessSingleContribution(remoteContribution)
+		if err != nil {
+			fndgLog.Errorf("unable to add contribution "+
+				"reservation: %v", err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
 	}
 
 	fndgLog.Infof("Sending fundingResp for pendingID(%x)",
@@ -1198,6 +1421,23 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
 		return
 	}
+
+	// If the initiator requested a dual funded channel, then we'll follow
+	// up with the inputs and outputs we add to the funding transaction,
+	// if any. Our TxComplete message lets the initiator know how much we
+	// contribute.
+	if !dualFund {
+		return
+	}
+	err = f.sendTxContribution(
+		fmsg.peer, msg.PendingChannelID, ourContribution, ourAmt, false,
+	)
+	if err != nil {
+		fndgLog.Errorf("unable to send funding contribution to "+
+			"peer: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
 }
 
 // processFundingAccept sends a message to the fundingManager allowing it to
@@ -1301,18 +1541,77 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
-	err = resCtx.reservation.ProcessContribution(remoteContribution)
+
+	fndgLog.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
+		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth, msg.CsvDelay)
+	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
+		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+
+	// If we requested a dual funded channel, then the remote party will
+	// follow up with the inputs and outputs they add to the funding
+	// transaction, so we'll hold on to their contribution until they're
+	// done.
+	if resCtx.dualFund {
+		resCtx.remoteContribution = remoteContribution
+		resCtx.remoteSerialIDs = make(map[uint64]struct{})
+		return
+	}
+
+	f.sendFundingCreated(fmsg.peer, resCtx, pendingChanID, remoteContribution)
+}
+
+// sendFundingCreated processes the responder's contribution to a channel we
+// initiated, which allows us to construct and sign both the commitment
+// transaction, and the funding transaction. We then send over the funding
+// outpoint along with our signature for their version of the commitment
+// transaction. Within a dual funder workflow, the inputs and outputs we add to
+// the funding transaction are sent beforehand.
+func (f *fundingManager) sendFundingCreated(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte,
+	remoteContribution *lnwallet.ChannelContribution) {
+
+	peerKey := peer.IdentityKey()
+
+	err := resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
 			peerKey, err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		f.failFundingFlow(peer, pendingChanID, err)
 		return
 	}
 
-	fndgLog.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
-		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth, msg.CsvDelay)
-	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
-		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+	// If the channel is funded from an externally signed PSBT, then we'll
+	// hand the funding output to the caller, and pause until they return
+	// the signed funding transaction.
+	if resCtx.psbtShim != nil {
+		f.sendPsbtFundingUpdate(peer, resCtx, pendingChanID)
+		return
+	}
+
+	// If the responder contributes funds as well, then we'll send over the
+	// inputs and outputs we add to the funding transaction, allowing them
+	// to construct it on their end.
+	if resCtx.dualFund {
+		err := f.sendTxContribution(
+			peer, pendingChanID, resCtx.reservation.OurContribution(),
+			resCtx.chanAmt, true,
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to send funding contribution "+
+				"to %v: %v", peerKey, err)
+			f.failFundingFlow(peer, pendingChanID, err)
+			return
+		}
+	}
+
+	f.sendFundingCreatedMsg(peer, resCtx, pendingChanID)
+}
+
+// sendFundingCreatedMsg sends the FundingCreated message of a channel we
+// initiated to the remote peer, once the funding transaction and both
+// commitment transactions have been constructed.
+func (f *fundingManager) sendFundingCreatedMsg(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte) {
 
 	// Now that we have their contribution, we can extract, then send over
 	// both the funding out point and our signature for their version of
@@ -1341,150 +1640,482 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	fndgLog.Infof("Generated ChannelPoint(%v) for pendingID(%x)", outPoint,
 		pendingChanID[:])
 
+	commitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
 	fundingCreated := &lnwire.FundingCreated{
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
+		CommitSig:        commitSig,
+	}
+	if err := peer.SendMessage(false, fundingCreated); err != nil {
+		fndgLog.Errorf("Unable to send funding complete message: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
 	}
-	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
+}
+
+// sendPsbtFundingUpdate hands the funding output of a PSBT funded channel to
+// the caller that initiated the funding workflow, as a PSBT that solely pays
+// to the funding output. The funding workflow pauses until the caller returns
+// the signed funding transaction.
+func (f *fundingManager) sendPsbtFundingUpdate(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte) {
+
+	fundingOut, err := resCtx.reservation.FundingOutput()
 	if err != nil {
-		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		fndgLog.Errorf("Unable to obtain funding output: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
 		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
-		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		fundingOut.PkScript, &f.cfg.Wallet.Cfg.NetParams,
+	)
+	if err != nil || len(addrs) != 1 {
+		err = fmt.Errorf("unable to derive funding address: %v", err)
+		fndgLog.Errorf(err.Error())
+		f.failFundingFlow(peer, pendingChanID, err)
 		return
 	}
-}
 
-// processFundingCreated queues a funding complete message coupled with the
-// source peer to the fundingManager.
-func (f *fundingManager) processFundingCreated(msg *lnwire.FundingCreated,
-	peer lnpeer.Peer) {
+	packet, err := psbt.New(
+		nil, []*wire.TxOut{fundingOut}, 2, 0, nil,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to create funding PSBT: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	var rawPsbt bytes.Buffer
+	if err := packet.Serialize(&rawPsbt); err != nil {
+		fndgLog.Errorf("Unable to serialize funding PSBT: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	fndgLog.Infof("Waiting for PSBT funding of pendingID(%x) paying %v "+
+		"to %v", pendingChanID[:], btcutil.Amount(fundingOut.Value),
+		addrs[0])
+
+	upd := &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+			PsbtFund: &lnrpc.ReadyForPsbtFunding{
+				FundingAddress: addrs[0].EncodeAddress(),
+				FundingAmount:  fundingOut.Value,
+				Psbt:           rawPsbt.Bytes(),
+				PendingChanId:  pendingChanID[:],
+			},
+		},
+	}
 
 	select {
-	case f.fundingMsgs <- &fundingCreatedMsg{msg, peer}:
+	case resCtx.updates <- upd:
 	case <-f.quit:
 		return
 	}
 }
 
-// handleFundingCreated progresses the funding workflow when the daemon is on
-// the responding side of a single funder workflow. Once this message has been
-// processed, a signature is sent to the remote peer allowing it to broadcast
-// the funding transaction, progressing the workflow into the final stage.
-func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
-	peerKey := fmsg.peer.IdentityKey()
-	pendingChanID := fmsg.msg.PendingChannelID
+// getShimReservationCtx returns the reservation context of the channel opened
+// with a funding shim identified by the given pending channel ID.
+func (f *fundingManager) getShimReservationCtx(
+	pendingChanID [32]byte) (*reservationWithCtx, error) {
 
-	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	for _, pendingReservations := range f.activeReservations {
+		resCtx, ok := pendingReservations[pendingChanID]
+		if ok && resCtx.psbtShim != nil {
+			return resCtx, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown funding shim for pending channel "+
+		"(id: %x)", pendingChanID[:])
+}
+
+// VerifyPsbt verifies that the passed funded, but not yet signed, PSBT pays
+// the exact amount of the channel to the funding output of the PSBT funded
+// channel identified by the given pending channel ID.
+func (f *fundingManager) VerifyPsbt(pendingChanID [32]byte,
+	packet *psbt.Packet) error {
+
+	req := &psbtVerifyReq{
+		pendingChanID: pendingChanID,
+		packet:        packet,
+		err:           make(chan error, 1),
+	}
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-req.err:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// handlePsbtVerify verifies a funded PSBT against the funding output of a PSBT
+// funded channel, recording its transaction so the signed version can later
+// be checked against it.
+func (f *fundingManager) handlePsbtVerify(req *psbtVerifyReq) {
+	resCtx, err := f.getShimReservationCtx(req.pendingChanID)
 	if err != nil {
-		fndgLog.Warnf("can't find reservation (peerID:%v, chanID:%x)",
-			peerKey, pendingChanID[:])
+		req.err <- err
 		return
 	}
 
-	// The channel initiator has responded with the funding outpoint of the
-	// final funding transaction, as well as a signature for our version of
-	// the commitment transaction. So at this point, we can validate the
-	// initiator's commitment transaction, then send our own if it's valid.
-	// TODO(roasbeef): make case (p vs P) consistent throughout
-	fundingOut := fmsg.msg.FundingPoint
-	fndgLog.Infof("completing pendingID(%x) with ChannelPoint(%v)",
-		pendingChanID[:], fundingOut)
+	if resCtx.reservation.FinalFundingTx() != nil {
+		req.err <- fmt.Errorf("funding of pending channel %x already "+
+			"finalized", req.pendingChanID[:])
+		return
+	}
 
-	// With all the necessary data available, attempt to advance the
-	// funding workflow to the next stage. If this succeeds then the
-	// funding transaction will broadcast after our next message.
-	// CompleteReservationSingle will also mark the channel as 'IsPending'
-	// in the database.
-	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
-	completeChan, err := resCtx.reservation.CompleteReservationSingle(
-		&fundingOut, commitSig)
-	if err != nil {
-		// TODO(roasbeef): better error logging: peerID, channelID, etc.
-		fndgLog.Errorf("unable to complete single reservation: %v", err)
-		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+	fundingTx := req.packet.UnsignedTx
+	if err := resCtx.reservation.VerifyFundingTx(fundingTx); err != nil {
+		req.err <- err
 		return
 	}
 
-	// The channel is marked IsPending in the database, and can be removed
-	// from the set of active reservations.
-	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
+	fndgLog.Infof("Verified PSBT funding tx %v for pendingID(%x)",
+		fundingTx.TxHash(), req.pendingChanID[:])
 
-	// If something goes wrong before the funding transaction is confirmed,
-	// we use this convenience method to delete the pending OpenChannel
-	// from the database.
-	deleteFromDatabase := func() {
-		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChanPoint:               completeChan.FundingOutpoint,
-			ChainHash:               completeChan.ChainHash,
-			RemotePub:               completeChan.IdentityPub,
-			CloseType:               channeldb.FundingCanceled,
-			Capacity:                completeChan.Capacity,
-			SettledBalance:          localBalance,
-			RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
-			RemoteNextRevocation:    completeChan.RemoteNextRevocation,
-			LocalChanConfig:         completeChan.LocalChanCfg,
-		}
-
-		if err := completeChan.CloseChannel(closeInfo); err != nil {
-			fndgLog.Errorf("Failed closing channel %v: %v",
-				completeChan.FundingOutpoint, err)
-		}
+	resCtx.verifiedFundingTx = fundingTx
+	resCtx.updateTimestamp()
+
+	req.err <- nil
+}
+
+// FinalizePsbt resumes the funding workflow of the PSBT funded channel
+// identified by the given pending channel ID with its signed funding
+// transaction, passed either as a signed PSBT, or as the final raw
+// transaction.
+func (f *fundingManager) FinalizePsbt(pendingChanID [32]byte,
+	packet *psbt.Packet, finalTx *wire.MsgTx) error {
+
+	req := &psbtFinalizeReq{
+		pendingChanID: pendingChanID,
+		packet:        packet,
+		finalTx:       finalTx,
+		err:           make(chan error, 1),
 	}
 
-	// A new channel has almost finished the funding process. In order to
-	// properly synchronize with the writeHandler goroutine, we add a new
-	// channel to the barriers map which will be closed once the channel is
-	// fully open.
-	f.barrierMtx.Lock()
-	channelID := lnwire.NewChanIDFromOutPoint(&fundingOut)
-	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
-	f.newChanBarriers[channelID] = make(chan struct{})
-	f.barrierMtx.Unlock()
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
 
-	fndgLog.Infof("sending FundingSigned for pendingID(%x) over "+
-		"ChannelPoint(%v)", pendingChanID[:], fundingOut)
+	select {
+	case err := <-req.err:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
 
-	// With their signature for our version of the commitment transaction
-	// verified, we can now send over our signature to the remote peer.
-	_, sig := resCtx.reservation.OurSignatures()
-	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
+// handlePsbtFinalize hands the signed funding transaction of a PSBT funded
+// channel over to the wallet, then resumes the funding workflow by sending
+// the FundingCreated message to the remote peer.
+func (f *fundingManager) handlePsbtFinalize(req *psbtFinalizeReq) {
+	resCtx, err := f.getShimReservationCtx(req.pendingChanID)
 	if err != nil {
-		fndgLog.Errorf("unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		req.err <- err
 		return
 	}
 
-	fundingSigned := &lnwire.FundingSigned{
-		ChanID:    channelID,
-		CommitSig: ourCommitSig,
+	if resCtx.reservation.FinalFundingTx() != nil {
+		req.err <- fmt.Errorf("funding of pending channel %x already "+
+			"finalized", req.pendingChanID[:])
+		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
-		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
-		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+
+	// A signed PSBT can only be accepted once its unsigned version has
+	// been verified, while a final raw transaction may have been
+	// constructed fully externally.
+	fundingTx := req.finalTx
+	if req.packet != nil {
+		if resCtx.verifiedFundingTx == nil {
+			req.err <- fmt.Errorf("PSBT for pending channel %x "+
+				"must be verified first", req.pendingChanID[:])
+			return
+		}
+
+		if err := psbt.MaybeFinalizeAll(req.packet); err != nil {
+			req.err <- fmt.Errorf("unable to finalize PSBT: %v",
+				err)
+			return
+		}
+		fundingTx, err = psbt.Extract(req.packet)
+		if err != nil {
+			req.err <- fmt.Errorf("unable to extract funding tx "+
+				"from PSBT: %v", err)
+			return
+		}
+	}
+
+	// If a PSBT has been verified before, then the signed funding
+	// transaction must match it. Otherwise, we'd sign commitment
+	// transactions spending from an output the caller never verified.
+	verifiedTx := resCtx.verifiedFundingTx
+	if verifiedTx != nil && verifiedTx.TxHash() != fundingTx.TxHash() {
+		req.err <- fmt.Errorf("funding tx %v doesn't match verified "+
+			"PSBT tx %v", fundingTx.TxHash(), verifiedTx.TxHash())
 		return
 	}
 
-	// Now that we've sent over our final signature for this channel, we'll
-	// send it to the ChainArbitrator so it can watch for any on-chain
-	// actions during this final confirmation stage.
-	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
-		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
-			"arbitration: %v", fundingOut, err)
+	// With the funding transaction known, the wallet is able to construct
+	// and sign the commitment transactions, allowing us to carry on with
+	// the funding workflow.
+	err = resCtx.reservation.ProcessExternalFunding(fundingTx)
+	if err != nil {
+		req.err <- err
+		return
 	}
 
-	// Create an entry in the local discovery map so we can ensure that we
-	// process the channel confirmation fully before we receive a funding
-	// locked message.
-	f.localDiscoveryMtx.Lock()
-	f.localDiscoverySignals[channelID] = make(chan struct{})
-	f.localDiscoveryMtx.Unlock()
+	fndgLog.Infof("Resuming funding workflow of pendingID(%x) with "+
+		"funding tx %v", req.pendingChanID[:], fundingTx.TxHash())
+
+	resCtx.updateTimestamp()
+	req.err <- nil
+
+	f.sendFundingCreatedMsg(resCtx.peer, resCtx, req.pendingChanID)
+}
+
+// CancelShim cancels the funding workflow of the channel opened with a funding
+// shim identified by the given pending channel ID.
+func (f *fundingManager) CancelShim(pendingChanID [32]byte) error {
+	req := &shimCancelReq{
+		pendingChanID: pendingChanID,
+		err:           make(chan error, 1),
+	}
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-req.err:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// AbandonShim abandons the channel opened with a funding shim identified by
+// the given pending channel ID, once the funding transaction handed over for
+// it is known to never be published. If its funding workflow is still
+// ongoing, it's canceled. Otherwise, the pending channel with the given
+// channel point is deleted from the database.
+func (f *fundingManager) AbandonShim(pendingChanID [32]byte,
+	chanPoint wire.OutPoint) error {
+
+	req := &shimCancelReq{
+		pendingChanID: pendingChanID,
+		chanPoint:     &chanPoint,
+		err:           make(chan error, 1),
+	}
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-req.err:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// handleShimCancel cancels the funding workflow of a channel opened with a
+// funding shim, as long as the FundingCreated message hasn't been sent yet,
+// or the channel is abandoned.
+func (f *fundingManager) handleShimCancel(req *shimCancelReq) {
+	resCtx, err := f.getShimReservationCtx(req.pendingChanID)
+	switch {
+	case err != nil && req.chanPoint != nil:
+		req.err <- f.abandonPendingChannel(*req.chanPoint)
+		return
+
+	case err != nil:
+		req.err <- err
+		return
+	}
+
+	if req.chanPoint == nil && resCtx.reservation.FinalFundingTx() != nil {
+		req.err <- fmt.Errorf("funding of pending channel %x already "+
+			"finalized", req.pendingChanID[:])
+		return
+	}
+
+	f.failFundingFlow(
+		resCtx.peer, req.pendingChanID,
+		fmt.Errorf("funding canceled by user"),
+	)
+
+	req.err <- nil
+}
+
+// abandonPendingChannel deletes the pending channel with the given channel
+// point from the database, once its funding workflow completed.
+func (f *fundingManager) abandonPendingChannel(chanPoint wire.OutPoint) error {
+	channels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if channel.FundingOutpoint != chanPoint {
+			continue
+		}
+
+		fndgLog.Infof("Abandoning pending ChannelPoint(%v)", chanPoint)
+
+		deletePendingChannel(channel)
+		return nil
+	}
+
+	return fmt.Errorf("unknown pending ChannelPoint(%v)", chanPoint)
+}
+
+// processFundingCreated queues a funding complete message coupled with the
+// source peer to the fundingManager.
+func (f *fundingManager) processFundingCreated(msg *lnwire.FundingCreated,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingCreatedMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingCreated progresses the funding workflow when the daemon is on
+// the responding side of a single funder workflow. Once this message has been
+// processed, a signature is sent to the remote peer allowing it to broadcast
+// the funding transaction, progressing the workflow into the final stage.
+func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
+	peerKey := fmsg.peer.IdentityKey()
+	pendingChanID := fmsg.msg.PendingChannelID
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("can't find reservation (peerID:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// If we contribute funds to this channel as well, then we've already
+	// constructed the funding transaction together with the initiator, and
+	// we'll need to hand over the signatures for our inputs to it.
+	if resCtx.dualFund {
+		f.handleDualFundingCreated(fmsg, resCtx)
+		return
+	}
+
+	// The channel initiator has responded with the funding outpoint of the
+	// final funding transaction, as well as a signature for our version of
+	// the commitment transaction. So at this point, we can validate the
+	// initiator's commitment transaction, then send our own if it's valid.
+	// TODO(roasbeef): make case (p vs P) consistent throughout
+	fundingOut := fmsg.msg.FundingPoint
+	fndgLog.Infof("completing pendingID(%x) with ChannelPoint(%v)",
+		pendingChanID[:], fundingOut)
+
+	// With all the necessary data available, attempt to advance the
+	// funding workflow to the next stage. If this succeeds then the
+	// funding transaction will broadcast after our next message.
+	// CompleteReservationSingle will also mark the channel as 'IsPending'
+	// in the database.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	completeChan, err := resCtx.reservation.CompleteReservationSingle(
+		&fundingOut, commitSig)
+	if err != nil {
+		// TODO(roasbeef): better error logging: peerID, channelID, etc.
+		fndgLog.Errorf("unable to complete single reservation: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// The channel is marked IsPending in the database, and can be removed
+	// from the set of active reservations.
+	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	f.barrierMtx.Lock()
+	channelID := lnwire.NewChanIDFromOutPoint(&fundingOut)
+	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	fndgLog.Infof("sending FundingSigned for pendingID(%x) over "+
+		"ChannelPoint(%v)", pendingChanID[:], fundingOut)
+
+	// With their signature for our version of the commitment transaction
+	// verified, we can now send over our signature to the remote peer.
+	_, sig := resCtx.reservation.OurSignatures()
+	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("unable to parse signature: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		deletePendingChannel(completeChan)
+		return
+	}
+
+	fundingSigned := &lnwire.FundingSigned{
+		ChanID:    channelID,
+		CommitSig: ourCommitSig,
+	}
+	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
+		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		deletePendingChannel(completeChan)
+		return
+	}
+
+	f.watchResponderFunding(fmsg.peer, pendingChanID, completeChan)
+}
+
+// watchResponderFunding hands the pending channel over to the
+// ChainArbitrator, then waits for the funding transaction of a channel we're
+// the responder of to confirm before starting normal operations.
+func (f *fundingManager) watchResponderFunding(peer lnpeer.Peer,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
+	peerKey := peer.IdentityKey()
+
+	// Now that we've sent over our final signature for this channel, we'll
+	// send it to the ChainArbitrator so it can watch for any on-chain
+	// actions during this final confirmation stage.
+	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
+		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", completeChan.FundingOutpoint, err)
+	}
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	channelID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[channelID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
 
 	// At this point we have sent our last funding message to the
 	// initiating peer before the funding transaction will be broadcast.
@@ -1501,7 +2132,10 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// on the blockchain, we must forget this channel. We therefore
 	// completely forget about this channel if we haven't seen the funding
 	// transaction in 288 blocks (~ 48 hrs), by canceling the reservation
-	// and canceling the wait for the funding confirmation.
+	// and canceling the wait for the funding confirmation. If we
+	// contributed funds to the channel, the initiator is able to broadcast
+	// the funding transaction at any time, so we'll only forget it once
+	// we've double spent our inputs, and release any that remain.
 	f.wg.Add(1)
 	go func() {
 		defer f.wg.Done()
@@ -1519,8 +2153,9 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			err := fmt.Errorf("timeout waiting for funding tx "+
 				"(%v) to confirm", completeChan.FundingOutpoint)
 			fndgLog.Warnf(err.Error())
-			f.failFundingFlow(fmsg.peer, pendingChanID, err)
-			deleteFromDatabase()
+			f.failFundingFlow(peer, pendingChanID, err)
+			deletePendingChannel(completeChan)
+			f.releaseFundingInputs(completeChan)
 			return
 		case <-f.quit:
 			// The fundingManager is shutting down, will resume
@@ -1535,48 +2170,786 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			// Fallthrough.
 		}
 
-		// Success, funding transaction was confirmed.
-		err := f.handleFundingConfirmation(
-			fmsg.peer, completeChan, shortChanID,
-		)
-		if err != nil {
-			fndgLog.Errorf("failed to handle funding"+
-				"confirmation: %v", err)
-			return
-		}
-	}()
+		// Success, funding transaction was confirmed.
+		err := f.handleFundingConfirmation(
+			peer, completeChan, shortChanID,
+		)
+		if err != nil {
+			fndgLog.Errorf("failed to handle funding"+
+				"confirmation: %v", err)
+			return
+		}
+	}()
+}
+
+// fundingTxSigned returns true if every input of the funding transaction
+// carries its signature. The signatures of the remote party's inputs are
+// verified before a dual funded channel is committed to disk, so a signed
+// funding transaction of a pending channel is complete.
+func fundingTxSigned(fundingTx *wire.MsgTx) bool {
+	if fundingTx == nil {
+		return false
+	}
+
+	for _, txIn := range fundingTx.TxIn {
+		if len(txIn.Witness) == 0 && len(txIn.SignatureScript) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deletePendingChannel deletes the passed pending channel from the database.
+// This is used if something goes wrong before the funding transaction is
+// confirmed.
+func deletePendingChannel(completeChan *channeldb.OpenChannel) {
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	if err := completeChan.CloseChannel(closeInfo); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
+
+// releaseFundingInputs makes the inputs we contributed to the funding
+// transaction of a dual funded channel that timed out available to our wallet
+// again.
+func (f *fundingManager) releaseFundingInputs(ch *channeldb.OpenChannel) {
+	if ch.ChanType != channeldb.DualFunder || ch.FundingTxn == nil {
+		return
+	}
+
+	fndgLog.Infof("Releasing inputs of funding tx for ChannelPoint(%v)",
+		ch.FundingOutpoint)
+
+	f.cfg.Wallet.ReleaseFundingInputs(ch.FundingTxn)
+}
+
+// processFundingSigned sends a single funding sign complete message along with
+// the source peer to the funding manager.
+func (f *fundingManager) processFundingSigned(msg *lnwire.FundingSigned,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &fundingSignedMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleFundingSigned processes the final message received in a single funder
+// workflow. Once this message is processed, the funding transaction is
+// broadcast. Once the funding transaction reaches a sufficient number of
+// confirmations, a message is sent to the responding peer along with a compact
+// encoding of the location of the channel within the blockchain.
+func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
+	// As the funding signed message will reference the reservation by its
+	// permanent channel ID, we'll need to perform an intermediate look up
+	// before we can obtain the reservation.
+	f.resMtx.Lock()
+	pendingChanID, ok := f.signedReservations[fmsg.msg.ChanID]
+	delete(f.signedReservations, fmsg.msg.ChanID)
+	f.resMtx.Unlock()
+	if !ok {
+		err := fmt.Errorf("Unable to find signed reservation for "+
+			"chan_id=%x", fmsg.msg.ChanID)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, fmsg.msg.ChanID, err)
+		return
+	}
+
+	peerKey := fmsg.peer.IdentityKey()
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Unable to find reservation (peerID:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		// TODO: add ErrChanNotFound?
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// If both of us contribute funds to the channel, then we'll need the
+	// signatures for the remote party's inputs to the funding transaction
+	// before we can complete the reservation. These will follow within a
+	// TxSignatures message, so we'll hold on to their commitment signature
+	// until then.
+	if resCtx.dualFund {
+		resCtx.remoteCommitSig = fmsg.msg.CommitSig.ToSignatureBytes()
+
+		f.resMtx.Lock()
+		f.signedReservations[fmsg.msg.ChanID] = pendingChanID
+		f.resMtx.Unlock()
+		return
+	}
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[permChanID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	// The remote peer has responded with a signature for our commitment
+	// transaction. We'll verify the signature for validity, then commit
+	// the state to disk as we can now open the channel.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to complete reservation sign "+
+			"complete: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	f.publishFundingTx(fmsg.peer, resCtx, pendingChanID, completeChan)
+}
+
+// publishFundingTx broadcasts the funding transaction of a channel we
+// initiated once the funding workflow is complete, and then waits for it to
+// confirm before announcing the channel. Updates are sent to the caller that
+// requested the channel along the way.
+func (f *fundingManager) publishFundingTx(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte,
+	completeChan *channeldb.OpenChannel) {
+
+	peerKey := peer.IdentityKey()
+	fundingPoint := &completeChan.FundingOutpoint
+
+	// The channel is now marked IsPending in the database, and we can
+	// delete it from our set of active reservations.
+	f.deleteReservationCtx(peerKey, pendingChanID)
+
+	// Broadcast the finalized funding transaction to the network, unless
+	// the caller intends to publish it themselves.
+	fundingTx := completeChan.FundingTxn
+	if resCtx.psbtShim != nil && resCtx.psbtShim.noPublish {
+		fndgLog.Infof("Not broadcasting externally published funding "+
+			"tx for ChannelPoint(%v)", completeChan.FundingOutpoint)
+	} else {
+		fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): "+
+			"%v", completeChan.FundingOutpoint,
+			spew.Sdump(fundingTx))
+
+		err := f.cfg.PublishTransaction(fundingTx)
+		if err != nil {
+			fndgLog.Errorf("Unable to broadcast funding tx for "+
+				"ChannelPoint(%v): %v",
+				completeChan.FundingOutpoint, err)
+			// We failed to broadcast the funding transaction, but
+			// watch the channel regardless, in case the
+			// transaction made it to the network. We will retry
+			// broadcast at startup.
+			// TODO(halseth): retry more often? Handle with CPFP?
+			// Just delete from the DB?
+		}
+	}
+
+	// Now that we have a finalized reservation for this funding flow,
+	// we'll send the to be active channel to the ChainArbitrator so it can
+	// watch for any on-chin actions before the channel has fully
+	// confirmed.
+	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
+		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", fundingPoint, err)
+	}
+
+	fndgLog.Infof("Finalizing pendingID(%x) over ChannelPoint(%v), "+
+		"waiting for channel open on-chain", pendingChanID[:],
+		fundingPoint)
+
+	// Send an update to the upstream client that the negotiation process
+	// is over.
+	// TODO(roasbeef): add abstraction over updates to accommodate
+	// long-polling, or SSE, etc.
+	upd := &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_ChanPending{
+			ChanPending: &lnrpc.PendingUpdate{
+				Txid:        fundingPoint.Hash[:],
+				OutputIndex: fundingPoint.Index,
+			},
+		},
+	}
+
+	select {
+	case resCtx.updates <- upd:
+	case <-f.quit:
+		return
+	}
+
+	// At this point we have broadcast the funding transaction and done all
+	// necessary processing.
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		confChan := make(chan *lnwire.ShortChannelID)
+		cancelChan := make(chan struct{})
+
+		// In case the fundingManager is stopped at some point during
+		// the remaining part of the opening process, we must wait for
+		// this process to finish (either successfully or with some
+		// error), before the fundingManager can be shut down.
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+			f.waitForFundingConfirmation(completeChan, cancelChan,
+				confChan)
+		}()
+
+		var shortChanID *lnwire.ShortChannelID
+		var ok bool
+		select {
+		case <-f.quit:
+			return
+		case shortChanID, ok = <-confChan:
+			if !ok {
+				fndgLog.Errorf("waiting for funding confirmation" +
+					" failed")
+				return
+			}
+		}
+
+		fndgLog.Debugf("Channel with ShortChanID %v now confirmed",
+			shortChanID.ToUint64())
+
+		// Go on adding the channel to the channel graph, and crafting
+		// channel announcements.
+		lnChannel, err := lnwallet.NewLightningChannel(
+			nil, nil, completeChan, nil,
+		)
+		if err != nil {
+			fndgLog.Errorf("failed creating lnChannel: %v", err)
+			return
+		}
+
+		err = f.sendFundingLocked(
+			peer, completeChan, lnChannel, shortChanID,
+		)
+		if err != nil {
+			fndgLog.Errorf("failed sending fundingLocked: %v", err)
+			return
+		}
+		fndgLog.Debugf("FundingLocked for channel with ShortChanID "+
+			"%v sent", shortChanID.ToUint64())
+
+		err = f.addToRouterGraph(completeChan, shortChanID)
+		if err != nil {
+			fndgLog.Errorf("failed adding to router graph: %v", err)
+			return
+		}
+		fndgLog.Debugf("Channel with ShortChanID %v added to "+
+			"router graph", shortChanID.ToUint64())
+
+		// Give the caller a final update notifying them that
+		// the channel is now open.
+		// TODO(roasbeef): only notify after recv of funding locked?
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanOpen{
+				ChanOpen: &lnrpc.ChannelOpenUpdate{
+					ChannelPoint: &lnrpc.ChannelPoint{
+						FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+							FundingTxidBytes: fundingPoint.Hash[:],
+						},
+						OutputIndex: fundingPoint.Index,
+					},
+				},
+			},
+		}
+
+		select {
+		case resCtx.updates <- upd:
+		case <-f.quit:
+			return
+		}
+
+		err = f.annAfterSixConfs(completeChan, shortChanID)
+		if err != nil {
+			fndgLog.Errorf("failed sending channel announcement: %v",
+				err)
+			return
+		}
+	}()
+}
+
+// sendTxContribution sends the inputs and outputs we add to the funding
+// transaction of a dual funded channel to the remote party, followed by a
+// TxComplete message carrying the amount we contribute to the channel.
+func (f *fundingManager) sendTxContribution(peer lnpeer.Peer,
+	pendingChanID [32]byte, contribution *lnwallet.ChannelContribution,
+	amt btcutil.Amount, initiator bool) error {
+
+	// The initiator uses even serial IDs for the inputs and outputs it
+	// adds, while the responder uses odd ones.
+	var serialID uint64
+	if !initiator {
+		serialID = 1
+	}
+
+	chanID := lnwire.ChannelID(pendingChanID)
+	msgs := make([]lnwire.Message, 0,
+		len(contribution.Inputs)+len(contribution.ChangeOutputs)+1)
+	for i, txIn := range contribution.Inputs {
+		prevOut := contribution.PrevOutputs[i]
+		msgs = append(msgs, &lnwire.TxAddInput{
+			ChannelID: chanID,
+			SerialID:  serialID,
+			PrevOut:   txIn.PreviousOutPoint,
+			Amount:    btcutil.Amount(prevOut.Value),
+			PkScript:  prevOut.PkScript,
+			Sequence:  txIn.Sequence,
+		})
+		serialID += 2
+	}
+	for _, txOut := range contribution.ChangeOutputs {
+		msgs = append(msgs, &lnwire.TxAddOutput{
+			ChannelID: chanID,
+			SerialID:  serialID,
+			Amount:    btcutil.Amount(txOut.Value),
+			PkScript:  txOut.PkScript,
+		})
+		serialID += 2
+	}
+	msgs = append(msgs, &lnwire.TxComplete{
+		ChannelID:     chanID,
+		FundingAmount: amt,
+	})
+
+	return peer.SendMessage(false, msgs...)
+}
+
+// sendTxSignatures sends the signatures for all our inputs to the funding
+// transaction of a dual funded channel to the remote party.
+func (f *fundingManager) sendTxSignatures(peer lnpeer.Peer,
+	resCtx *reservationWithCtx) error {
+
+	inputScripts, _ := resCtx.reservation.OurSignatures()
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+
+	txSigs := &lnwire.TxSignatures{
+		ChannelID: lnwire.NewChanIDFromOutPoint(fundingPoint),
+		TxHash:    fundingPoint.Hash,
+		Witnesses: make([]lnwire.InputWitness, len(inputScripts)),
+	}
+	for i, inputScript := range inputScripts {
+		txSigs.Witnesses[i] = lnwire.InputWitness{
+			SigScript: inputScript.ScriptSig,
+			Witness:   inputScript.Witness,
+		}
+	}
+
+	return peer.SendMessage(false, txSigs)
+}
+
+// processTxAddInput sends a message to the fundingManager allowing it to add
+// an input of the remote party to the funding transaction of a dual funded
+// channel.
+func (f *fundingManager) processTxAddInput(msg *lnwire.TxAddInput,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txAddInputMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleTxAddInput records an input the remote party adds to the funding
+// transaction of a dual funded channel within their pending contribution.
+func (f *fundingManager) handleTxAddInput(fmsg *txAddInputMsg) {
+	msg := fmsg.msg
+	pendingChanID := [32]byte(msg.ChannelID)
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txAddInputMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	contribution := resCtx.remoteContribution
+	if contribution == nil {
+		err := fmt.Errorf("unexpected tx_add_input for pendingID(%x)",
+			pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	if err := resCtx.addRemoteSerialID(msg.SerialID); err != nil {
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// We'll reject any attempt to add the same input twice, or to spend
+	// one of our own inputs, as this would result in an invalid funding
+	// transaction.
+	ourInputs := resCtx.reservation.OurContribution().Inputs
+	if spendsOutPoint(contribution.Inputs, msg.PrevOut) ||
+		spendsOutPoint(ourInputs, msg.PrevOut) {
+
+		err := fmt.Errorf("duplicate funding input %v", msg.PrevOut)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	txIn := wire.NewTxIn(&msg.PrevOut, nil, nil)
+	txIn.Sequence = msg.Sequence
+	contribution.Inputs = append(contribution.Inputs, txIn)
+	contribution.PrevOutputs = append(
+		contribution.PrevOutputs,
+		wire.NewTxOut(int64(msg.Amount), msg.PkScript),
+	)
+}
+
+// spendsOutPoint returns whether any of the given inputs spends the outpoint.
+func spendsOutPoint(inputs []*wire.TxIn, outPoint wire.OutPoint) bool {
+	for _, txIn := range inputs {
+		if txIn.PreviousOutPoint == outPoint {
+			return true
+		}
+	}
+
+	return false
+}
+
+// processTxAddOutput sends a message to the fundingManager allowing it to add
+// an output of the remote party to the funding transaction of a dual funded
+// channel.
+func (f *fundingManager) processTxAddOutput(msg *lnwire.TxAddOutput,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txAddOutputMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleTxAddOutput records an output the remote party adds to the funding
+// transaction of a dual funded channel within their pending contribution.
+func (f *fundingManager) handleTxAddOutput(fmsg *txAddOutputMsg) {
+	msg := fmsg.msg
+	pendingChanID := [32]byte(msg.ChannelID)
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txAddOutputMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	contribution := resCtx.remoteContribution
+	if contribution == nil {
+		err := fmt.Errorf("unexpected tx_add_output for pendingID(%x)",
+			pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	if err := resCtx.addRemoteSerialID(msg.SerialID); err != nil {
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	contribution.ChangeOutputs = append(
+		contribution.ChangeOutputs,
+		wire.NewTxOut(int64(msg.Amount), msg.PkScript),
+	)
+}
+
+// processTxComplete sends a message to the fundingManager allowing it to
+// process the remote party's contribution to a dual funded channel.
+func (f *fundingManager) processTxComplete(msg *lnwire.TxComplete,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txCompleteMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// handleTxComplete processes the remote party's contribution to a dual funded
+// channel once they've added all their inputs and outputs to the funding
+// transaction. As the initiator, we'll follow up with our own inputs and
+// outputs, and the FundingCreated message. If the responder doesn't
+// contribute any funds, then the single funder workflow carries on.
+func (f *fundingManager) handleTxComplete(fmsg *txCompleteMsg) {
+	msg := fmsg.msg
+	pendingChanID := [32]byte(msg.ChannelID)
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txCompleteMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	contribution := resCtx.remoteContribution
+	if contribution == nil {
+		err := fmt.Errorf("unexpected tx_complete for pendingID(%x)",
+			pendingChanID[:])
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	resCtx.remoteContribution = nil
+	resCtx.remoteSerialIDs = nil
+
+	fndgLog.Infof("pendingChan(%x): remote party contributes %v with "+
+		"%v inputs and %v outputs", pendingChanID[:], msg.FundingAmount,
+		len(contribution.Inputs), len(contribution.ChangeOutputs))
+
+	switch {
+	// As the responder, the initiator must contribute exactly the amount
+	// they proposed within their OpenChannel message.
+	case !resCtx.isInitiator && msg.FundingAmount != contribution.FundingAmount:
+		err = fmt.Errorf("initiator contributes %v, expected %v",
+			msg.FundingAmount, contribution.FundingAmount)
+
+	// As the initiator, we only requested the responder to match our own
+	// contribution, so they may not contribute more than that.
+	case resCtx.isInitiator && msg.FundingAmount > resCtx.chanAmt:
+		err = fmt.Errorf("responder contributes %v, more than the "+
+			"requested %v", msg.FundingAmount, resCtx.chanAmt)
+
+	case resCtx.isInitiator &&
+		resCtx.chanAmt+msg.FundingAmount > maxFundingAmount:
+
+		err = lnwire.ErrChanTooLarge
+
+	default:
+		contribution.FundingAmount = msg.FundingAmount
+		err = validateTxContribution(
+			contribution, resCtx.fundingFeePerKw,
+		)
+	}
+	if err != nil {
+		fndgLog.Warnf("Invalid contribution for pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// As the initiator, we can now construct the funding transaction, and
+	// send over our own contribution. If the responder didn't contribute
+	// any funds, this will simply carry on with the single funder
+	// workflow.
+	if resCtx.isInitiator {
+		resCtx.dualFund = msg.FundingAmount != 0
+		f.sendFundingCreated(
+			fmsg.peer, resCtx, pendingChanID, contribution,
+		)
+		return
+	}
+
+	// As the responder, we'll construct the funding transaction and sign
+	// the initiator's version of the commitment transaction. We'll send
+	// both our signatures once we receive the FundingCreated message.
+	if err := resCtx.reservation.ProcessContribution(contribution); err != nil {
+		fndgLog.Errorf("Unable to process contribution from %v: %v",
+			peerKey, err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+}
+
+// validateTxContribution ensures that the inputs the remote party adds to the
+// funding transaction of a dual funded channel cover the amount they
+// contribute to the channel, along with any change outputs they add, and the
+// fees for the weight of their inputs and outputs at the given fee rate.
+func validateTxContribution(c *lnwallet.ChannelContribution,
+	feeRate lnwallet.SatPerKWeight) error {
+
+	if len(c.Inputs) == 0 && len(c.ChangeOutputs) == 0 {
+		if c.FundingAmount != 0 {
+			return fmt.Errorf("no inputs to fund contribution "+
+				"of %v", c.FundingAmount)
+		}
+
+		return nil
+	}
+
+	var (
+		inputTotal, changeTotal btcutil.Amount
+		weight                  int64
+	)
+	for _, prevOut := range c.PrevOutputs {
+		inputTotal += btcutil.Amount(prevOut.Value)
+
+		// We only accept the witness inputs our wallet spends itself,
+		// as we can't estimate the weight of arbitrary inputs.
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(prevOut.PkScript):
+			weight += lnwallet.InputSize*4 +
+				lnwallet.P2WKHWitnessSize
+
+		case txscript.IsPayToScriptHash(prevOut.PkScript):
+			weight += (lnwallet.InputSize+lnwallet.P2WPKHSize+1)*4 +
+				lnwallet.P2WKHWitnessSize
+
+		default:
+			return fmt.Errorf("unsupported funding input script "+
+				"%x", prevOut.PkScript)
+		}
+	}
+	for _, txOut := range c.ChangeOutputs {
+		changeTotal += btcutil.Amount(txOut.Value)
+		weight += int64(txOut.SerializeSize()) * 4
+	}
+
+	fee := feeRate.FeeForWeight(weight)
+	if inputTotal < c.FundingAmount+changeTotal+fee {
+		return fmt.Errorf("inputs of %v don't cover contribution of "+
+			"%v with change of %v and fee of %v", inputTotal,
+			c.FundingAmount, changeTotal, fee)
+	}
+
+	return nil
+}
+
+// handleDualFundingCreated progresses the funding workflow of a dual funded
+// channel we're the responder of. Once we've verified the initiator's
+// signature for our version of the commitment transaction, we'll send over
+// our signature for theirs, along with the signatures for our inputs to the
+// funding transaction.
+func (f *fundingManager) handleDualFundingCreated(fmsg *fundingCreatedMsg,
+	resCtx *reservationWithCtx) {
+
+	pendingChanID := fmsg.msg.PendingChannelID
+
+	// Update the timestamp once the fundingCreatedMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	// We've constructed the funding transaction ourselves, so the funding
+	// outpoint of the initiator must match ours.
+	fundingOut := resCtx.reservation.FundingOutpoint()
+	if fundingOut == nil || *fundingOut != fmsg.msg.FundingPoint {
+		err := fmt.Errorf("funding outpoint mismatch: expected %v, "+
+			"got %v", fundingOut, fmsg.msg.FundingPoint)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// Before handing over the signatures for our inputs, we'll make sure
+	// that we hold a valid commitment transaction.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	if err := resCtx.reservation.VerifyCommitmentSig(commitSig); err != nil {
+		fndgLog.Errorf("Invalid commitment signature for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	resCtx.remoteCommitSig = commitSig
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	f.barrierMtx.Lock()
+	channelID := lnwire.NewChanIDFromOutPoint(fundingOut)
+	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	// The initiator's TxSignatures message will reference the channel via
+	// its permanent channel ID, so we'll set up this mapping so we can
+	// retrieve the reservation context once we receive it.
+	f.resMtx.Lock()
+	f.signedReservations[channelID] = pendingChanID
+	f.resMtx.Unlock()
+
+	fndgLog.Infof("sending FundingSigned and TxSignatures for "+
+		"pendingID(%x) over ChannelPoint(%v)", pendingChanID[:],
+		fundingOut)
+
+	_, sig := resCtx.reservation.OurSignatures()
+	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("unable to parse signature: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	fundingSigned := &lnwire.FundingSigned{
+		ChanID:    channelID,
+		CommitSig: ourCommitSig,
+	}
+	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
+		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	if err := f.sendTxSignatures(fmsg.peer, resCtx); err != nil {
+		fndgLog.Errorf("unable to send TxSignatures message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
 }
 
-// processFundingSigned sends a single funding sign complete message along with
-// the source peer to the funding manager.
-func (f *fundingManager) processFundingSigned(msg *lnwire.FundingSigned,
+// processTxSignatures sends a message to the fundingManager allowing it to
+// complete the funding workflow of a dual funded channel.
+func (f *fundingManager) processTxSignatures(msg *lnwire.TxSignatures,
 	peer lnpeer.Peer) {
 
 	select {
-	case f.fundingMsgs <- &fundingSignedMsg{msg, peer}:
+	case f.fundingMsgs <- &txSignaturesMsg{msg, peer}:
 	case <-f.quit:
 		return
 	}
 }
 
-// handleFundingSigned processes the final message received in a single funder
-// workflow. Once this message is processed, the funding transaction is
-// broadcast. Once the funding transaction reaches a sufficient number of
-// confirmations, a message is sent to the responding peer along with a compact
-// encoding of the location of the channel within the blockchain.
-func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
-	// As the funding signed message will reference the reservation by its
+// handleTxSignatures processes the final message of a dual funder workflow.
+// Once the signatures for the remote party's inputs have been verified, the
+// channel is committed to disk, and the funding transaction broadcast. As the
+// initiator, we'll also send over the signatures for our own inputs, so the
+// responder is able to do the same.
+func (f *fundingManager) handleTxSignatures(fmsg *txSignaturesMsg) {
+	// As the TxSignatures message will reference the reservation by its
 	// permanent channel ID, we'll need to perform an intermediate look up
 	// before we can obtain the reservation.
 	f.resMtx.Lock()
-	pendingChanID, ok := f.signedReservations[fmsg.msg.ChanID]
-	delete(f.signedReservations, fmsg.msg.ChanID)
+	pendingChanID, ok := f.signedReservations[fmsg.msg.ChannelID]
+	delete(f.signedReservations, fmsg.msg.ChannelID)
 	f.resMtx.Unlock()
 	if !ok {
 		err := fmt.Errorf("Unable to find signed reservation for "+
-			"chan_id=%x", fmsg.msg.ChanID)
+			"chan_id=%x", fmsg.msg.ChannelID)
 		fndgLog.Warnf(err.Error())
-		f.failFundingFlow(fmsg.peer, fmsg.msg.ChanID, err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.ChannelID, err)
 		return
 	}
 
@@ -1585,26 +2958,42 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	if err != nil {
 		fndgLog.Warnf("Unable to find reservation (peerID:%v, chanID:%x)",
 			peerKey, pendingChanID[:])
-		// TODO: add ErrChanNotFound?
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+	if resCtx.remoteCommitSig == nil || fundingPoint.Hash != fmsg.msg.TxHash {
+		err := fmt.Errorf("unexpected tx_signatures for txid %v",
+			fmsg.msg.TxHash)
+		fndgLog.Warnf(err.Error())
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
 		return
 	}
 
 	// Create an entry in the local discovery map so we can ensure that we
 	// process the channel confirmation fully before we receive a funding
-	// locked message.
-	fundingPoint := resCtx.reservation.FundingOutpoint()
-	permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
-	f.localDiscoveryMtx.Lock()
-	f.localDiscoverySignals[permChanID] = make(chan struct{})
-	f.localDiscoveryMtx.Unlock()
+	// locked message. As the responder, this is done once we start
+	// waiting for the funding transaction to confirm.
+	if resCtx.isInitiator {
+		permChanID := lnwire.NewChanIDFromOutPoint(fundingPoint)
+		f.localDiscoveryMtx.Lock()
+		f.localDiscoverySignals[permChanID] = make(chan struct{})
+		f.localDiscoveryMtx.Unlock()
+	}
 
-	// The remote peer has responded with a signature for our commitment
-	// transaction. We'll verify the signature for validity, then commit
-	// the state to disk as we can now open the channel.
-	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	// With the signatures for the remote party's inputs, we're now able to
+	// verify them along with their signature for our version of the
+	// commitment transaction, and commit the state to disk.
+	inputScripts := make([]*lnwallet.InputScript, len(fmsg.msg.Witnesses))
+	for i, witness := range fmsg.msg.Witnesses {
+		inputScripts[i] = &lnwallet.InputScript{
+			ScriptSig: witness.SigScript,
+			Witness:   witness.Witness,
+		}
+	}
 	completeChan, err := resCtx.reservation.CompleteReservation(
-		nil, commitSig,
+		inputScripts, resCtx.remoteCommitSig,
 	)
 	if err != nil {
 		fndgLog.Errorf("Unable to complete reservation sign "+
@@ -1613,156 +3002,44 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
-	// The channel is now marked IsPending in the database, and we can
-	// delete it from our set of active reservations.
+	// As the initiator, we'll send over the signatures for our inputs,
+	// then broadcast the funding transaction.
+	if resCtx.isInitiator {
+		if err := f.sendTxSignatures(fmsg.peer, resCtx); err != nil {
+			fndgLog.Errorf("Unable to send TxSignatures message: "+
+				"%v", err)
+		}
+
+		f.publishFundingTx(fmsg.peer, resCtx, pendingChanID, completeChan)
+		return
+	}
+
+	// As the responder, our own funds are at stake as well, so we'll
+	// also broadcast the funding transaction, then wait for it to confirm.
 	f.deleteReservationCtx(peerKey, pendingChanID)
 
-	// Broadcast the finalized funding transaction to the network.
 	fundingTx := completeChan.FundingTxn
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
 	err = f.cfg.PublishTransaction(fundingTx)
-	if err != nil {
+	if err != nil && err != lnwallet.ErrDoubleSpend {
 		fndgLog.Errorf("Unable to broadcast funding tx for "+
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
 			err)
-		// We failed to broadcast the funding transaction, but watch
-		// the channel regardless, in case the transaction made it to
-		// the network. We will retry broadcast at startup.
-		// TODO(halseth): retry more often? Handle with CPFP? Just
-		// delete from the DB?
-	}
-
-	// Now that we have a finalized reservation for this funding flow,
-	// we'll send the to be active channel to the ChainArbitrator so it can
-	// watch for any on-chin actions before the channel has fully
-	// confirmed.
-	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
-		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
-			"arbitration: %v", fundingPoint, err)
-	}
-
-	fndgLog.Infof("Finalizing pendingID(%x) over ChannelPoint(%v), "+
-		"waiting for channel open on-chain", pendingChanID[:],
-		fundingPoint)
-
-	// Send an update to the upstream client that the negotiation process
-	// is over.
-	// TODO(roasbeef): add abstraction over updates to accommodate
-	// long-polling, or SSE, etc.
-	upd := &lnrpc.OpenStatusUpdate{
-		Update: &lnrpc.OpenStatusUpdate_ChanPending{
-			ChanPending: &lnrpc.PendingUpdate{
-				Txid:        fundingPoint.Hash[:],
-				OutputIndex: fundingPoint.Index,
-			},
-		},
-	}
-
-	select {
-	case resCtx.updates <- upd:
-	case <-f.quit:
-		return
 	}
 
-	// At this point we have broadcast the funding transaction and done all
-	// necessary processing.
-	f.wg.Add(1)
-	go func() {
-		defer f.wg.Done()
-		confChan := make(chan *lnwire.ShortChannelID)
-		cancelChan := make(chan struct{})
-
-		// In case the fundingManager is stopped at some point during
-		// the remaining part of the opening process, we must wait for
-		// this process to finish (either successfully or with some
-		// error), before the fundingManager can be shut down.
-		f.wg.Add(1)
-		go func() {
-			defer f.wg.Done()
-			f.waitForFundingConfirmation(completeChan, cancelChan,
-				confChan)
-		}()
-
-		var shortChanID *lnwire.ShortChannelID
-		var ok bool
-		select {
-		case <-f.quit:
-			return
-		case shortChanID, ok = <-confChan:
-			if !ok {
-				fndgLog.Errorf("waiting for funding confirmation" +
-					" failed")
-				return
-			}
-		}
-
-		fndgLog.Debugf("Channel with ShortChanID %v now confirmed",
-			shortChanID.ToUint64())
-
-		// Go on adding the channel to the channel graph, and crafting
-		// channel announcements.
-		lnChannel, err := lnwallet.NewLightningChannel(
-			nil, nil, completeChan, nil,
-		)
-		if err != nil {
-			fndgLog.Errorf("failed creating lnChannel: %v", err)
-			return
-		}
-
-		err = f.sendFundingLocked(
-			fmsg.peer, completeChan, lnChannel, shortChanID,
-		)
-		if err != nil {
-			fndgLog.Errorf("failed sending fundingLocked: %v", err)
-			return
-		}
-		fndgLog.Debugf("FundingLocked for channel with ShortChanID "+
-			"%v sent", shortChanID.ToUint64())
-
-		err = f.addToRouterGraph(completeChan, shortChanID)
-		if err != nil {
-			fndgLog.Errorf("failed adding to router graph: %v", err)
-			return
-		}
-		fndgLog.Debugf("Channel with ShortChanID %v added to "+
-			"router graph", shortChanID.ToUint64())
-
-		// Give the caller a final update notifying them that
-		// the channel is now open.
-		// TODO(roasbeef): only notify after recv of funding locked?
-		upd := &lnrpc.OpenStatusUpdate{
-			Update: &lnrpc.OpenStatusUpdate_ChanOpen{
-				ChanOpen: &lnrpc.ChannelOpenUpdate{
-					ChannelPoint: &lnrpc.ChannelPoint{
-						FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
-							FundingTxidBytes: fundingPoint.Hash[:],
-						},
-						OutputIndex: fundingPoint.Index,
-					},
-				},
-			},
-		}
-
-		select {
-		case resCtx.updates <- upd:
-		case <-f.quit:
-			return
-		}
-
-		err = f.annAfterSixConfs(completeChan, shortChanID)
-		if err != nil {
-			fndgLog.Errorf("failed sending channel announcement: %v",
-				err)
-			return
-		}
-	}()
+	f.watchResponderFunding(fmsg.peer, pendingChanID, completeChan)
 }
 
 // waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation that
 // will cancel the wait for confirmation if we are not the channel initiator and
 // the maxWaitNumBlocksFundingConf has passed from bestHeight.
+// As the responder of a dual funded channel, we've handed over the signatures
+// for our inputs, so the initiator is able to broadcast the funding
+// transaction at any time. Rather than timing out right away, we'll then
+// double spend our inputs, and only time out once one of the inputs of the
+// funding transaction is spent by another transaction.
 // In the case of timeout, the timeoutChan will be closed. In case of error,
 // confChan will be closed. In case of success, a *lnwire.ShortChannelID will be
 // passed to confChan.
@@ -1793,6 +3070,10 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 
 	// On block maxHeight we will cancel the funding confirmation wait.
 	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
+
+	// doubleSpent is closed once the funding transaction of a dual funded
+	// channel is known to never confirm.
+	var doubleSpent chan struct{}
 	for {
 		select {
 		case epoch, ok := <-epochClient.Epochs:
@@ -1801,9 +3082,34 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 				return
 			}
 
-			// If we are not the channel initiator it's safe
-			// to timeout the channel
-			if uint32(epoch.Height) >= maxHeight && !completeChan.IsInitiator {
+			// If we contributed funds to the channel, we'll double
+			// spend our inputs and keep waiting until the funding
+			// transaction is unable to confirm.
+			if uint32(epoch.Height) >= maxHeight &&
+				!completeChan.IsInitiator &&
+				completeChan.ChanType == channeldb.DualFunder {
+
+				if doubleSpent != nil {
+					continue
+				}
+
+				doubleSpent, err = f.cancelDualFunding(
+					completeChan,
+				)
+				if err != nil {
+					fndgLog.Errorf("Unable to cancel funding "+
+						"of ChannelPoint(%v): %v",
+						completeChan.FundingOutpoint,
+						err)
+				}
+				continue
+			}
+
+			// If we are not the channel initiator, then it's safe
+			// to timeout the channel.
+			if uint32(epoch.Height) >= maxHeight &&
+				!completeChan.IsInitiator {
+
 				fndgLog.Warnf("waited for %v blocks without "+
 					"seeing funding transaction confirmed,"+
 					" cancelling.", maxWaitNumBlocksFundingConf)
@@ -1821,6 +3127,15 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 			// a method for recovering the funds from the funding
 			// transaction
 
+		case <-doubleSpent:
+			fndgLog.Warnf("Funding tx of ChannelPoint(%v) was "+
+				"double spent, cancelling.",
+				completeChan.FundingOutpoint)
+
+			close(cancelChan)
+			close(timeoutChan)
+			return
+
 		case <-f.quit:
 			// The fundingManager is shutting down, will resume
 			// waiting for the funding transaction on startup.
@@ -1842,6 +3157,90 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 	}
 }
 
+// cancelDualFunding attempts to double spend the inputs we contributed to the
+// funding transaction of a dual funded channel that didn't confirm in time,
+// and watches all of its inputs for spends. The returned channel is closed
+// once any of them is spent by another transaction, at which point the
+// funding transaction is unable to confirm. The inputs are watched even if
+// we're unable to double spend ours, as the initiator may still do so.
+func (f *fundingManager) cancelDualFunding(
+	ch *channeldb.OpenChannel) (chan struct{}, error) {
+
+	fundingTxid := ch.FundingTxn.TxHash()
+
+	// We'll only be able to learn about the spends of the inputs whose
+	// outputs we know, which are ours.
+	var spendEvents []*chainntnfs.SpendEvent
+	for _, txIn := range ch.FundingTxn.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		info, err := f.cfg.Wallet.FetchInputInfo(&prevOut)
+		if err == lnwallet.ErrNotMine {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		spendEvent, err := f.cfg.Notifier.RegisterSpendNtfn(
+			&prevOut, info.PkScript, ch.FundingBroadcastHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+		spendEvents = append(spendEvents, spendEvent)
+	}
+	if len(spendEvents) == 0 {
+		return nil, fmt.Errorf("funding tx %v spends none of our "+
+			"outputs", fundingTxid)
+	}
+
+	// If the funding transaction spends our inputs, it confirmed after
+	// all. Otherwise, the first spend of any of them by another
+	// transaction signals the double spend.
+	doubleSpent := make(chan struct{})
+	var signalDoubleSpent sync.Once
+	for _, spendEvent := range spendEvents {
+		f.wg.Add(1)
+		go func(spendEvent *chainntnfs.SpendEvent) {
+			defer f.wg.Done()
+			defer spendEvent.Cancel()
+
+			select {
+			case spend, ok := <-spendEvent.Spend:
+				if !ok || *spend.SpenderTxHash == fundingTxid {
+					return
+				}
+				signalDoubleSpent.Do(func() {
+					close(doubleSpent)
+				})
+
+			case <-doubleSpent:
+			case <-f.quit:
+			}
+		}(spendEvent)
+	}
+
+	feePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		return doubleSpent, err
+	}
+	cancelTx, err := f.cfg.Wallet.CreateFundingCancelTx(
+		ch.FundingTxn, feePerKw,
+	)
+	if err != nil {
+		return doubleSpent, err
+	}
+
+	fndgLog.Infof("Double spending funding tx of ChannelPoint(%v) with "+
+		"tx %v", ch.FundingOutpoint, cancelTx.TxHash())
+
+	err = f.cfg.PublishTransaction(cancelTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return doubleSpent, err
+	}
+
+	return doubleSpent, nil
+}
+
 // makeFundingScript re-creates the funding script for the funding transaction
 // of the target channel.
 func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
@@ -2752,6 +4151,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		ExternalFunding: msg.psbtShim != nil,
+	}
+
+	// Obtain a new pending channel ID which is used to track this
+	// reservation throughout its lifetime. If the channel is funded from
+	// a PSBT, then the caller may have chosen the ID to reference the
+	// channel with.
+	var chanID [32]byte
+	switch {
+	case msg.psbtShim != nil && msg.psbtShim.pendingChanID != zeroHash:
+		chanID = msg.psbtShim.pendingChanID
+		if _, err := f.getShimReservationCtx(chanID); err == nil {
+			msg.err <- fmt.Errorf("pending channel ID %x already "+
+				"in use", chanID[:])
+			return
+		}
+
+	default:
+		chanID = f.nextPendingChanID()
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2760,10 +4178,6 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		return
 	}
 
-	// Obtain a new pending channel ID which is used to track this
-	// reservation throughout its lifetime.
-	chanID := f.nextPendingChanID()
-
 	fndgLog.Infof("Target commit tx sat/kw for pendingID(%x): %v", chanID,
 		int64(commitFeePerKw))
 
@@ -2799,6 +4213,10 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		remoteMinHtlc:     minHtlc,
 		remoteChanReserve: chanReserve,
 		reservation:       reservation,
+		isInitiator:       true,
+		dualFund:          msg.openChanReq.dualFund,
+		fundingFeePerKw:   msg.fundingFeePerKw,
+		psbtShim:          msg.openChanReq.psbtShim,
 		peer:              msg.peer,
 		updates:           msg.updates,
 		err:               msg.err,
@@ -2842,6 +4260,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
 		ChannelFlags:         channelFlags,
 	}
+
+	// If we'd like the remote party to contribute funds to the channel as
+	// well, then we'll request this within the extra data of the message.
+	if msg.openChanReq.dualFund {
+		err := fundingOpen.SetDualFund(uint32(msg.fundingFeePerKw))
+		if err != nil {
+			fndgLog.Errorf("Unable to request dual funding: %v", err)
+
+			_, cancelErr := f.cancelReservationCtx(peerKey, chanID)
+			if cancelErr != nil {
+				fndgLog.Errorf("unable to cancel reservation: %v",
+					cancelErr)
+			}
+
+			msg.err <- err
+			return
+		}
+	}
+
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
 			err)
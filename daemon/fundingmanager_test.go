@@ -17,7 +17,6 @@ import (
 
 	"github.com/breez/lightninglib/chainntnfs"
 	"github.com/breez/lightninglib/channeldb"
-	"github.com/breez/lightninglib/contractcourt"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/keychain"
 	"github.com/breez/lightninglib/lnpeer"
@@ -97,6 +96,7 @@ type mockNotifier struct {
 	oneConfChannel chan *chainntnfs.TxConfirmation
 	sixConfChannel chan *chainntnfs.TxConfirmation
 	epochChan      chan *chainntnfs.BlockEpoch
+	spendChan      chan *chainntnfs.SpendDetail
 }
 
 func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
@@ -131,7 +131,7 @@ func (m *mockNotifier) Stop() error {
 func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 	heightHint uint32) (*chainntnfs.SpendEvent, error) {
 	return &chainntnfs.SpendEvent{
-		Spend:  make(chan *chainntnfs.SpendDetail),
+		Spend:  m.spendChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -239,6 +239,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		oneConfChannel: make(chan *chainntnfs.TxConfirmation, 1),
 		sixConfChannel: make(chan *chainntnfs.TxConfirmation, 1),
 		epochChan:      make(chan *chainntnfs.BlockEpoch, 1),
+		spendChan:      make(chan *chainntnfs.SpendDetail),
 	}
 
 	sentMessages := make(chan lnwire.Message)
@@ -1605,6 +1606,107 @@ func TestFundingManagerFundingTimeout(t *testing.T) {
 	assertNumPendingChannelsBecomes(t, bob, 0)
 }
 
+// TestFundingManagerDualFundingTimeout checks that the responder of a dual
+// funded channel whose funding transaction didn't confirm in time double
+// spends its inputs, and only forgets the channel once the funding transaction
+// is unable to confirm.
+func TestFundingManagerDualFundingTimeout(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob has handed over the signatures for both inputs of the funding
+	// transaction, which are his according to his wallet.
+	fundingTx := wire.NewMsgTx(2)
+	for i := uint32(0); i < 2; i++ {
+		txIn := wire.NewTxIn(&wire.OutPoint{Index: i}, nil, nil)
+		txIn.Witness = wire.TxWitness{[]byte{1}, []byte{2}}
+		fundingTx.AddTxIn(txIn)
+	}
+	fundingTx.AddTxOut(wire.NewTxOut(1000000, []byte{}))
+	fundingTxid := fundingTx.TxHash()
+
+	channel := &channeldb.OpenChannel{
+		ChanType:               channeldb.DualFunder,
+		FundingOutpoint:        wire.OutPoint{Hash: fundingTxid},
+		FundingTxn:             fundingTx,
+		FundingBroadcastHeight: fundingBroadcastHeight,
+		NumConfsRequired:       1,
+		LocalChanCfg: channeldb.ChannelConfig{
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: bobPubKey,
+			},
+		},
+		RemoteChanCfg: channeldb.ChannelConfig{
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: alicePubKey,
+			},
+		},
+	}
+
+	confChan := make(chan *lnwire.ShortChannelID)
+	timeoutChan := make(chan struct{})
+	go bob.fundingMgr.waitForFundingWithTimeout(
+		channel, confChan, timeoutChan,
+	)
+
+	assertNotTimedOut := func() {
+		t.Helper()
+
+		select {
+		case <-timeoutChan:
+			t.Fatalf("dual funded channel timed out")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// Once the timeout is reached, Bob should double spend both inputs
+	// back to his wallet rather than forgetting the channel.
+	bob.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight + maxWaitNumBlocksFundingConf,
+	}
+
+	var cancelTx *wire.MsgTx
+	select {
+	case cancelTx = <-bob.publTxChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("bob did not double spend the funding inputs")
+	}
+	if len(cancelTx.TxIn) != len(fundingTx.TxIn) {
+		t.Fatalf("expected %v inputs to be double spent, got %v",
+			len(fundingTx.TxIn), len(cancelTx.TxIn))
+	}
+	for i, txIn := range cancelTx.TxIn {
+		prevOut := fundingTx.TxIn[i].PreviousOutPoint
+		if txIn.PreviousOutPoint != prevOut {
+			t.Fatalf("expected input %v to spend %v, got %v", i,
+				prevOut, txIn.PreviousOutPoint)
+		}
+	}
+	assertNotTimedOut()
+
+	// The funding transaction spending one of the inputs doesn't cancel
+	// the channel.
+	cancelTxid := cancelTx.TxHash()
+	bob.mockNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &fundingTx.TxIn[0].PreviousOutPoint,
+		SpenderTxHash: &fundingTxid,
+		SpendingTx:    fundingTx,
+	}
+	assertNotTimedOut()
+
+	// Once the double spend of an input is seen, the channel times out.
+	bob.mockNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &fundingTx.TxIn[1].PreviousOutPoint,
+		SpenderTxHash: &cancelTxid,
+		SpendingTx:    cancelTx,
+	}
+	select {
+	case <-timeoutChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dual funded channel did not time out")
+	}
+}
+
 // TestFundingManagerFundingNotTimeoutInitiator checks that if the user was
 // the channel initiator, that it does not timeout when the lnd restarts.
 func TestFundingManagerFundingNotTimeoutInitiator(t *testing.T) {
@@ -1672,6 +1774,125 @@ func TestFundingManagerFundingNotTimeoutInitiator(t *testing.T) {
 	assertNumPendingChannelsBecomes(t, bob, 0)
 }
 
+// TestFundingManagerAbandonShim checks that the funding transaction of a
+// channel funded with a shim is never broadcast by the funding manager, not
+// even after a restart, and that the channel is deleted from the database once
+// abandoned.
+func TestFundingManagerAbandonShim(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Start the funding workflow with a shim, such that Alice pauses once
+	// Bob accepted the channel.
+	pendingChanID := [32]byte{1}
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		psbtShim: &psbtShim{
+			pendingChanID: pendingChanID,
+			noPublish:     true,
+		},
+		updates: updateChan,
+		err:     errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var openChannelReq *lnwire.OpenChannel
+	select {
+	case msg := <-alice.msgChan:
+		openChannelReq = msg.(*lnwire.OpenChannel)
+	case err := <-errChan:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	var psbtFund *lnrpc.ReadyForPsbtFunding
+	select {
+	case update := <-updateChan:
+		psbtFund = update.GetPsbtFund()
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_PsbtFund")
+	}
+	if psbtFund == nil {
+		t.Fatal("OpenStatusUpdate was not OpenStatusUpdate_PsbtFund")
+	}
+
+	// Hand Alice a funding transaction paying to the funding output, then
+	// carry on with the funding workflow.
+	fundingOutput, err := batchFundingOutput(psbtFund)
+	if err != nil {
+		t.Fatalf("unable to obtain funding output: %v", err)
+	}
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+		Witness:          wire.TxWitness{[]byte{1}},
+	})
+	fundingTx.AddTxOut(fundingOutput)
+
+	err = alice.fundingMgr.FinalizePsbt(pendingChanID, nil, fundingTx)
+	if err != nil {
+		t.Fatalf("unable to finalize funding: %v", err)
+	}
+
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case update := <-updateChan:
+		if update.GetChanPending() == nil {
+			t.Fatal("OpenStatusUpdate was not " +
+				"OpenStatusUpdate_ChanPending")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	assertNumPendingChannelsBecomes(t, alice, 1)
+
+	// Alice must not broadcast the funding transaction, neither now nor
+	// after a restart.
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("alice published funding tx")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	recreateAliceFundingManager(t, alice)
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("alice rebroadcast funding tx")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once abandoned, the channel is deleted from the database.
+	chanPoint := wire.OutPoint{Hash: fundingTx.TxHash()}
+	err = alice.fundingMgr.AbandonShim(pendingChanID, chanPoint)
+	if err != nil {
+		t.Fatalf("unable to abandon channel: %v", err)
+	}
+
+	assertNumPendingChannelsBecomes(t, alice, 0)
+}
+
 // TestFundingManagerReceiveFundingLockedTwice checks that the fundingManager
 // continues to operate as expected in case we receive a duplicate fundingLocked
 // message.
@@ -2656,3 +2877,80 @@ func TestFundingManagerMaxConfs(t *testing.T) {
 			string(err.Data))
 	}
 }
+
+// TestValidateTxContribution tests that the inputs of a contribution to a dual
+// funded channel are required to cover the funding amount, the change and the
+// fees for the contribution's own weight at the funding fee rate.
+func TestValidateTxContribution(t *testing.T) {
+	t.Parallel()
+
+	p2wkhScript := append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	p2pkhScript := append([]byte{0x76, 0xa9, 0x14}, make([]byte, 20)...)
+	p2pkhScript = append(p2pkhScript, 0x88, 0xac)
+
+	const feeRate = lnwallet.SatPerKWeight(1000)
+
+	// A P2WKH input along with a P2WKH change output weigh 397 WU, which
+	// at a fee rate of 1000 sat/kw costs 397 satoshis.
+	newContribution := func(pkScript []byte,
+		change int64) *lnwallet.ChannelContribution {
+
+		return &lnwallet.ChannelContribution{
+			FundingAmount: 50000,
+			Inputs: []*wire.TxIn{
+				wire.NewTxIn(&wire.OutPoint{}, nil, nil),
+			},
+			PrevOutputs: []*wire.TxOut{
+				wire.NewTxOut(100000, pkScript),
+			},
+			ChangeOutputs: []*wire.TxOut{
+				wire.NewTxOut(change, p2wkhScript),
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		contribution *lnwallet.ChannelContribution
+		valid        bool
+	}{
+		{
+			name:         "fee covered",
+			contribution: newContribution(p2wkhScript, 49603),
+			valid:        true,
+		},
+		{
+			name:         "fee not covered",
+			contribution: newContribution(p2wkhScript, 49604),
+			valid:        false,
+		},
+		{
+			name:         "unsupported input",
+			contribution: newContribution(p2pkhScript, 40000),
+			valid:        false,
+		},
+		{
+			name: "no inputs",
+			contribution: &lnwallet.ChannelContribution{
+				FundingAmount: 50000,
+			},
+			valid: false,
+		},
+		{
+			name:         "no contribution",
+			contribution: &lnwallet.ChannelContribution{},
+			valid:        true,
+		},
+	}
+
+	for _, test := range tests {
+		err := validateTxContribution(test.contribution, feeRate)
+		if test.valid && err != nil {
+			t.Fatalf("%v: unexpected error: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%v: expected contribution to be invalid",
+				test.name)
+		}
+	}
+}
@@ -2,15 +2,19 @@ package daemon
 
 import (
 	"bytes"
+	"container/heap"
 	"crypto/sha256"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/breez/lightninglib/chainntnfs"
 	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lnwire"
 	"github.com/breez/lightninglib/queue"
+	"github.com/breez/lightninglib/routing"
 	"github.com/breez/lightninglib/zpay32"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -29,6 +33,79 @@ var (
 	debugHash = chainhash.Hash(sha256.Sum256(debugPre[:]))
 )
 
+const (
+	// htlcSetTimeout is the time we'll wait for the set of HTLCs paying to
+	// an invoice to reach the invoice amount, measured from the arrival of
+	// its first HTLC. Once it expires, all HTLCs of the incomplete set are
+	// failed back.
+	htlcSetTimeout = 2 * time.Minute
+
+	// holdInvoiceCancelDelta is the number of blocks before the earliest
+	// expiry of the HTLCs held for an accepted hold invoice at which the
+	// invoice is canceled. This gives us the chance to fail the HTLCs back
+	// off-chain before our peers would go on-chain to time them out.
+	holdInvoiceCancelDelta = 10
+
+	// invoiceSweepInterval is the interval at which we check for unpaid
+	// invoices that expired, and canceled invoices that are due to be
+	// deleted.
+	invoiceSweepInterval = time.Minute
+)
+
+// invoiceExpiry tracks when an invoice is next due to be swept. Unpaid
+// invoices are canceled once their payment request expires, and canceled
+// invoices are deleted once the retention period following their expiry has
+// passed.
+type invoiceExpiry struct {
+	paymentHash chainhash.Hash
+
+	// expiry is the time the payment request of the invoice expires.
+	expiry time.Time
+
+	// deadline is the time at which the invoice is to be swept.
+	deadline time.Time
+}
+
+// invoiceExpiryHeap is a min-heap of invoices ordered by the time they're due
+// to be swept.
+type invoiceExpiryHeap []*invoiceExpiry
+
+// Len returns the number of invoices within the heap.
+//
+// NOTE: Part of the heap.Interface implementation.
+func (h invoiceExpiryHeap) Len() int { return len(h) }
+
+// Less returns whether the invoice at index i is due before the invoice at
+// index j.
+//
+// NOTE: Part of the heap.Interface implementation.
+func (h invoiceExpiryHeap) Less(i, j int) bool {
+	return h[i].deadline.Before(h[j].deadline)
+}
+
+// Swap swaps the invoices at the passed indexes.
+//
+// NOTE: Part of the heap.Interface implementation.
+func (h invoiceExpiryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push adds an invoice to the end of the heap.
+//
+// NOTE: Part of the heap.Interface implementation.
+func (h *invoiceExpiryHeap) Push(x interface{}) {
+	*h = append(*h, x.(*invoiceExpiry))
+}
+
+// Pop removes the last invoice of the heap.
+//
+// NOTE: Part of the heap.Interface implementation.
+func (h *invoiceExpiryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
 // invoiceRegistry is a central registry of all the outstanding invoices
 // created by the daemon. The registry is a thin wrapper around a map in order
 // to ensure that all updates/reads are thread safe.
@@ -37,6 +114,8 @@ type invoiceRegistry struct {
 
 	cdb *channeldb.DB
 
+	notifier chainntnfs.ChainNotifier
+
 	clientMtx           sync.Mutex
 	nextClientID        uint32
 	notificationClients map[uint32]*invoiceSubscription
@@ -50,6 +129,31 @@ type invoiceRegistry struct {
 	// that *all* nodes are able to fully settle.
 	debugInvoices map[chainhash.Hash]*channeldb.Invoice
 
+	// htlcSubscribers maps each held exit hop HTLC to the channel its link
+	// expects the resolution of the HTLC on.
+	htlcSubscribers map[channeldb.CircuitKey]chan<- interface{}
+
+	// htlcSetTimers holds a timer for each invoice that is paid by an
+	// incomplete set of HTLCs. Once the timer fires, the set is failed
+	// back.
+	htlcSetTimers map[chainhash.Hash]*time.Timer
+
+	// htlcExpiries records the expiry height of each held exit hop HTLC.
+	htlcExpiries map[channeldb.CircuitKey]uint32
+
+	// heldInvoices maps each accepted hold invoice to the earliest expiry
+	// of the HTLCs held for it. Once the chain approaches this height, the
+	// invoice is canceled.
+	heldInvoices map[chainhash.Hash]uint32
+
+	// invoiceRetention is the time canceled invoices are kept after their
+	// payment request expired. If zero, they're never deleted.
+	invoiceRetention time.Duration
+
+	// expiryQueue holds the invoices that are due to be canceled or
+	// deleted, ordered by the time they're due.
+	expiryQueue invoiceExpiryHeap
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -57,11 +161,21 @@ type invoiceRegistry struct {
 // newInvoiceRegistry creates a new invoice registry. The invoice registry
 // wraps the persistent on-disk invoice storage with an additional in-memory
 // layer. The in-memory layer is in place such that debug invoices can be added
-// which are volatile yet available system wide within the daemon.
-func newInvoiceRegistry(cdb *channeldb.DB) *invoiceRegistry {
+// which are volatile yet available system wide within the daemon. Canceled
+// invoices are deleted once their payment request has been expired for the
+// passed retention period, unless it's zero.
+func newInvoiceRegistry(cdb *channeldb.DB, notifier chainntnfs.ChainNotifier,
+	invoiceRetention time.Duration) *invoiceRegistry {
+
 	return &invoiceRegistry{
 		cdb:                 cdb,
+		notifier:            notifier,
+		invoiceRetention:    invoiceRetention,
 		debugInvoices:       make(map[chainhash.Hash]*channeldb.Invoice),
+		htlcSubscribers:     make(map[channeldb.CircuitKey]chan<- interface{}),
+		htlcSetTimers:       make(map[chainhash.Hash]*time.Timer),
+		htlcExpiries:        make(map[channeldb.CircuitKey]uint32),
+		heldInvoices:        make(map[chainhash.Hash]uint32),
 		notificationClients: make(map[uint32]*invoiceSubscription),
 		newSubscriptions:    make(chan *invoiceSubscription),
 		subscriptionCancels: make(chan uint32),
@@ -72,15 +186,28 @@ func newInvoiceRegistry(cdb *channeldb.DB) *invoiceRegistry {
 
 // Start starts the registry and all goroutines it needs to carry out its task.
 func (i *invoiceRegistry) Start() error {
-	i.wg.Add(1)
+	blockEpochs, err := i.notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	i.wg.Add(3)
 
 	go i.invoiceEventNotifier()
+	go i.holdInvoiceExpiryWatcher(blockEpochs)
+	go i.invoiceSweeper()
 
 	return nil
 }
 
 // Stop signals the registry for a graceful shutdown.
 func (i *invoiceRegistry) Stop() error {
+	i.Lock()
+	for _, timer := range i.htlcSetTimers {
+		timer.Stop()
+	}
+	i.Unlock()
+
 	close(i.quit)
 
 	i.wg.Wait()
@@ -88,10 +215,11 @@ func (i *invoiceRegistry) Stop() error {
 }
 
 // invoiceEvent represents a new event that has modified on invoice on disk.
-// Only two event types are currently supported: newly created invoices, and
-// instance where invoices are settled.
+// The state of the event denotes its type: newly created invoices are open,
+// and settled invoices are settled. Hold invoices additionally emit events
+// when they're accepted or canceled.
 type invoiceEvent struct {
-	isSettle bool
+	state channeldb.ContractState
 
 	invoice *channeldb.Invoice
 }
@@ -145,27 +273,27 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 				switch {
 				// If we've already sent this settle event to
 				// the client, then we can skip this.
-				case event.isSettle &&
+				case event.state == channeldb.ContractSettled &&
 					client.settleIndex >= invoice.SettleIndex:
 					continue
 
 				// Similarly, if we've already sent this add to
 				// the client then we can skip this one.
-				case !event.isSettle &&
+				case event.state == channeldb.ContractOpen &&
 					client.addIndex >= invoice.AddIndex:
 					continue
 
 				// These two states should never happen, but we
 				// log them just in case so we can detect this
 				// instance.
-				case !event.isSettle &&
+				case event.state == channeldb.ContractOpen &&
 					client.addIndex+1 != invoice.AddIndex:
 					ltndLog.Warnf("client=%v for invoice "+
 						"notifications missed an update, "+
 						"add_index=%v, new add event index=%v",
 						clientID, client.addIndex,
 						invoice.AddIndex)
-				case event.isSettle &&
+				case event.state == channeldb.ContractSettled &&
 					client.settleIndex+1 != invoice.SettleIndex:
 					ltndLog.Warnf("client=%v for invoice "+
 						"notifications missed an update, "+
@@ -176,8 +304,8 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 
 				select {
 				case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-					isSettle: event.isSettle,
-					invoice:  invoice,
+					state:   event.state,
+					invoice: invoice,
 				}:
 				case <-i.quit:
 					return
@@ -188,10 +316,12 @@ func (i *invoiceRegistry) invoiceEventNotifier() {
 				// index it has. We'll use this to ensure we
 				// don't send a notification twice, which can
 				// happen if a new event is added while we're
-				// catching up a new client.
-				if event.isSettle {
+				// catching up a new client. Accepted and
+				// canceled hold invoices aren't indexed.
+				switch event.state {
+				case channeldb.ContractSettled:
 					client.settleIndex = invoice.SettleIndex
-				} else {
+				case channeldb.ContractOpen:
 					client.addIndex = invoice.AddIndex
 				}
 			}
@@ -227,8 +357,8 @@ func (i *invoiceRegistry) deliverBacklogEvents(client *invoiceSubscription) erro
 
 		select {
 		case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-			isSettle: false,
-			invoice:  &addEvent,
+			state:   channeldb.ContractOpen,
+			invoice: &addEvent,
 		}:
 		case <-i.quit:
 			return fmt.Errorf("registry shutting down")
@@ -241,8 +371,8 @@ func (i *invoiceRegistry) deliverBacklogEvents(client *invoiceSubscription) erro
 
 		select {
 		case client.ntfnQueue.ChanIn() <- &invoiceEvent{
-			isSettle: true,
-			invoice:  &settleEvent,
+			state:   channeldb.ContractSettled,
+			invoice: &settleEvent,
 		}:
 		case <-i.quit:
 			return fmt.Errorf("registry shutting down")
@@ -298,11 +428,260 @@ func (i *invoiceRegistry) AddInvoice(invoice *channeldb.Invoice) (uint64, error)
 
 	// Now that we've added the invoice, we'll send dispatch a message to
 	// notify the clients of this new invoice.
-	i.notifyClients(invoice, false)
+	i.notifyClients(invoice, channeldb.ContractOpen)
+
+	i.scheduleInvoiceExpiry(invoice)
 
 	return addIndex, nil
 }
 
+// AddHoldInvoice adds a hold invoice for the specified amount, of which only
+// the payment hash is known. HTLCs paying to the invoice are accepted and held
+// until the invoice is either settled with its preimage by SettleHoldInvoice,
+// or canceled by CancelInvoice. The addIndex of the newly created invoice is
+// returned.
+func (i *invoiceRegistry) AddHoldInvoice(invoice *channeldb.Invoice,
+	paymentHash chainhash.Hash) (uint64, error) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	ltndLog.Debugf("Adding hold invoice %x %v", paymentHash[:],
+		newLogClosure(func() string {
+			return spew.Sdump(invoice)
+		}),
+	)
+
+	addIndex, err := i.cdb.AddHoldInvoice(invoice, paymentHash)
+	if err != nil {
+		return 0, err
+	}
+
+	i.notifyClients(invoice, channeldb.ContractOpen)
+
+	i.scheduleInvoiceExpiry(invoice)
+
+	return addIndex, nil
+}
+
+// SettleHoldInvoice settles the accepted hold invoice paying to the hash of
+// the passed preimage, and with it all HTLCs held for it.
+func (i *invoiceRegistry) SettleHoldInvoice(preimage [32]byte) error {
+	i.Lock()
+	defer i.Unlock()
+
+	rHash := chainhash.Hash(sha256.Sum256(preimage[:]))
+
+	ltndLog.Debugf("Settling hold invoice %x", rHash[:])
+
+	invoice, err := i.cdb.SettleHoldInvoice(preimage)
+	if err != nil {
+		return err
+	}
+
+	ltndLog.Infof("Payment received: %v", spew.Sdump(invoice))
+
+	delete(i.heldInvoices, rHash)
+	i.resolveHtlcs(invoice)
+	i.notifyClients(invoice, channeldb.ContractSettled)
+
+	return nil
+}
+
+// CancelInvoice cancels the invoice with the passed payment hash, failing back
+// all HTLCs held for it. Invoices can't be canceled once they're settled.
+func (i *invoiceRegistry) CancelInvoice(rHash chainhash.Hash) error {
+	i.Lock()
+	defer i.Unlock()
+
+	return i.cancelInvoice(rHash)
+}
+
+// cancelInvoice cancels the invoice with the passed payment hash, and fails
+// back all HTLCs held for it.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) cancelInvoice(rHash chainhash.Hash) error {
+	ltndLog.Debugf("Canceling invoice %x", rHash[:])
+
+	invoice, err := i.cdb.CancelInvoice(rHash)
+	if err != nil {
+		return err
+	}
+
+	if timer, ok := i.htlcSetTimers[rHash]; ok {
+		timer.Stop()
+		delete(i.htlcSetTimers, rHash)
+	}
+	delete(i.heldInvoices, rHash)
+
+	ltndLog.Infof("Canceled invoice %x", rHash[:])
+
+	i.resolveHtlcs(invoice)
+	i.notifyClients(invoice, channeldb.ContractCanceled)
+
+	// The canceled invoice is queued for deletion once its retention
+	// period passed.
+	i.scheduleInvoiceExpiry(invoice)
+
+	return nil
+}
+
+// scheduleInvoiceExpiry queues the invoice to be swept once it's due. Open
+// invoices are due once their payment request expires, at which point they're
+// canceled. Canceled invoices are due for deletion once the retention period
+// following their expiry passed, if one is configured. Invoices without a
+// payment request, like the ones created for keysend payments, never expire.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) scheduleInvoiceExpiry(invoice *channeldb.Invoice) {
+	if len(invoice.PaymentRequest) == 0 {
+		return
+	}
+
+	var retention time.Duration
+	switch invoice.Terms.State {
+	case channeldb.ContractOpen:
+
+	case channeldb.ContractCanceled:
+		if i.invoiceRetention <= 0 {
+			return
+		}
+		retention = i.invoiceRetention
+
+	default:
+		return
+	}
+
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), activeNetParams.Params,
+	)
+	if err != nil {
+		ltndLog.Errorf("unable to decode payment request of invoice "+
+			"%x: %v", invoice.PaymentHash[:], err)
+		return
+	}
+
+	expiry := payReq.Timestamp.Add(payReq.Expiry())
+	heap.Push(&i.expiryQueue, &invoiceExpiry{
+		paymentHash: invoice.PaymentHash,
+		expiry:      expiry,
+		deadline:    expiry.Add(retention),
+	})
+}
+
+// invoiceSweeper is a goroutine that periodically cancels unpaid invoices
+// that expired, and deletes canceled invoices once their retention period
+// passed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *invoiceRegistry) invoiceSweeper() {
+	defer i.wg.Done()
+
+	// Before we start sweeping invoices, we'll queue all invoices that are
+	// to be swept by the time they're due. This requires a scan of the
+	// invoice database, so it's done here rather than within Start to not
+	// delay the start up.
+	if err := i.queueInvoiceSweeps(); err != nil {
+		ltndLog.Errorf("unable to queue invoices to sweep: %v", err)
+	}
+
+	ticker := time.NewTicker(invoiceSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		// Sweep right away, so invoices that expired while we were
+		// offline are handled on start up.
+		i.sweepInvoices(time.Now())
+
+		select {
+		case <-ticker.C:
+
+		case <-i.quit:
+			return
+		}
+	}
+}
+
+// queueInvoiceSweeps queues all unpaid invoices by the time they expire. If a
+// retention period is configured, canceled invoices are queued as well, by the
+// time they're due to be deleted.
+func (i *invoiceRegistry) queueInvoiceSweeps() error {
+	states := []channeldb.ContractState{channeldb.ContractOpen}
+	if i.invoiceRetention > 0 {
+		states = append(states, channeldb.ContractCanceled)
+	}
+
+	invoices, err := i.cdb.FetchInvoicesByState(states...)
+	if err != nil && err != channeldb.ErrNoInvoicesCreated {
+		return err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	for _, invoice := range invoices {
+		invoice := invoice
+		i.scheduleInvoiceExpiry(&invoice)
+	}
+
+	return nil
+}
+
+// sweepInvoices cancels or deletes all queued invoices that are due at the
+// passed time.
+func (i *invoiceRegistry) sweepInvoices(now time.Time) {
+	i.Lock()
+	defer i.Unlock()
+
+	for i.expiryQueue.Len() > 0 && !i.expiryQueue[0].deadline.After(now) {
+		entry := heap.Pop(&i.expiryQueue).(*invoiceExpiry)
+		rHash := entry.paymentHash
+
+		invoice, err := i.cdb.LookupInvoice(rHash)
+		switch {
+		// The invoice may already have been deleted if it was queued
+		// more than once.
+		case err == channeldb.ErrInvoiceNotFound:
+			continue
+
+		case err != nil:
+			ltndLog.Errorf("unable to look up expired invoice %x: %v",
+				rHash[:], err)
+			continue
+		}
+
+		switch invoice.Terms.State {
+		// Unpaid invoices are canceled once they expire, which queues
+		// them for deletion if a retention period is configured.
+		case channeldb.ContractOpen:
+			ltndLog.Infof("Canceling expired invoice %x", rHash[:])
+
+			if err := i.cancelInvoice(rHash); err != nil {
+				ltndLog.Errorf("unable to cancel expired "+
+					"invoice %x: %v", rHash[:], err)
+			}
+
+		// Canceled invoices are deleted once their retention period
+		// passed. If the invoice was canceled after it was queued to
+		// expire, it's still queued for deletion separately.
+		case channeldb.ContractCanceled:
+			if i.invoiceRetention <= 0 ||
+				now.Before(entry.expiry.Add(i.invoiceRetention)) {
+
+				continue
+			}
+
+			ltndLog.Infof("Deleting canceled invoice %x", rHash[:])
+
+			if err := i.cdb.DeleteInvoice(rHash); err != nil {
+				ltndLog.Errorf("unable to delete canceled "+
+					"invoice %x: %v", rHash[:], err)
+			}
+		}
+	}
+}
+
 // LookupInvoice looks up an invoice by its payment hash (R-Hash), if found
 // then we're able to pull the funds pending within an HTLC. We'll also return
 // what the expected min final CLTV delta is, pre-parsed from the payment
@@ -329,6 +708,12 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 		return channeldb.Invoice{}, 0, err
 	}
 
+	// Invoices created for keysend payments don't have a payment request,
+	// so the default final CLTV delta used by their senders applies.
+	if len(invoice.PaymentRequest) == 0 {
+		return invoice, routing.DefaultFinalCLTVDelta, nil
+	}
+
 	payReq, err := zpay32.Decode(
 		string(invoice.PaymentRequest), activeNetParams.Params,
 	)
@@ -339,6 +724,37 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 	return invoice, uint32(payReq.MinFinalCLTVExpiry()), nil
 }
 
+// AddKeysendInvoice adds an invoice for a keysend payment of the passed amount
+// that doesn't pay to an existing invoice, using the preimage chosen by its
+// sender. The invoice is returned along with its min final CLTV delta.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (i *invoiceRegistry) AddKeysendInvoice(rHash chainhash.Hash,
+	preimage [32]byte, amt lnwire.MilliSatoshi) (channeldb.Invoice, uint32,
+	error) {
+
+	if chainhash.Hash(sha256.Sum256(preimage[:])) != rHash {
+		return channeldb.Invoice{}, 0, fmt.Errorf("keysend preimage "+
+			"doesn't match payment hash %x", rHash[:])
+	}
+
+	invoice := &channeldb.Invoice{
+		CreationDate: time.Now(),
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           amt,
+		},
+	}
+	if _, err := i.AddInvoice(invoice); err != nil {
+		return channeldb.Invoice{}, 0, err
+	}
+
+	ltndLog.Infof("Added invoice %x for keysend payment of %v", rHash[:],
+		amt)
+
+	return *invoice, routing.DefaultFinalCLTVDelta, nil
+}
+
 // SettleInvoice attempts to mark an invoice as settled. If the invoice is a
 // debug invoice, then this method is a noop as debug invoices are never fully
 // settled.
@@ -348,6 +764,18 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 	i.Lock()
 	defer i.Unlock()
 
+	_, err := i.settleInvoice(rHash, amtPaid)
+	return err
+}
+
+// settleInvoice marks the invoice as settled, along with all HTLCs held as part
+// of its HTLC set, and notifies the clients. The settled invoice is returned,
+// or nil in case of a debug invoice.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) settleInvoice(rHash chainhash.Hash,
+	amtPaid lnwire.MilliSatoshi) (*channeldb.Invoice, error) {
+
 	ltndLog.Debugf("Settling invoice %x", rHash[:])
 
 	// First check the in-memory debug invoice index to see if this is an
@@ -355,29 +783,419 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 	if _, ok := i.debugInvoices[rHash]; ok {
 		// Debug invoices are never fully settled, so we simply return
 		// immediately in this case.
-		return nil
+		return nil, nil
 	}
 
 	// If this isn't a debug invoice, then we'll attempt to settle an
 	// invoice matching this rHash on disk (if one exists).
 	invoice, err := i.cdb.SettleInvoice(rHash, amtPaid)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ltndLog.Infof("Payment received: %v", spew.Sdump(invoice))
 
-	i.notifyClients(invoice, true)
+	i.notifyClients(invoice, channeldb.ContractSettled)
 
-	return nil
+	return invoice, nil
+}
+
+// NotifyExitHopHtlc adds the HTLC identified by the circuit key to the set of
+// HTLCs paying to the invoice with the passed payment hash. HTLCs are held
+// until the amount of the set reaches the total amount passed by the sender,
+// or the value of the invoice if the sender didn't pass one, at which point
+// the invoice and all HTLCs of the set are settled at once. HTLCs whose total
+// amount differs from the one of the set, or doesn't cover the invoice, are
+// failed. If the set isn't complete within htlcSetTimeout, all of its HTLCs
+// are failed back. The resolution of the passed HTLC is returned if it's known
+// right away. Otherwise, nil is returned and the resolution is later sent on
+// the subscriber channel.
+//
+// Hold invoices aren't settled once their set is complete. Instead, they're
+// accepted, and their HTLCs are held until the invoice is explicitly settled
+// or canceled. Should neither happen before the earliest expiry of the held
+// HTLCs approaches, the invoice is canceled.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (i *invoiceRegistry) NotifyExitHopHtlc(rHash chainhash.Hash,
+	amtPaid, totalAmt lnwire.MilliSatoshi, expiry uint32,
+	circuitKey channeldb.CircuitKey,
+	subscriber chan<- interface{}) (*htlcswitch.HtlcResolution, error) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	// Debug invoices are settled by any HTLC paying to them.
+	if debugInv, ok := i.debugInvoices[rHash]; ok {
+		return &htlcswitch.HtlcResolution{
+			CircuitKey: circuitKey,
+			Preimage:   &debugInv.Terms.PaymentPreimage,
+		}, nil
+	}
+
+	invoice, err := i.cdb.LookupInvoice(rHash)
+	if err != nil {
+		return nil, err
+	}
+	settleResolution := &htlcswitch.HtlcResolution{
+		CircuitKey: circuitKey,
+		Preimage:   &invoice.Terms.PaymentPreimage,
+	}
+	failResolution := &htlcswitch.HtlcResolution{
+		CircuitKey: circuitKey,
+	}
+
+	// If the HTLC is already part of the set, which happens when its link
+	// replays it after a restart, we'll return its recorded outcome, or
+	// keep holding it.
+	if htlc, ok := invoice.Htlcs[circuitKey]; ok {
+		switch htlc.State {
+		case channeldb.HtlcStateSettled:
+			return settleResolution, nil
+
+		case channeldb.HtlcStateCanceled:
+			return failResolution, nil
+		}
+
+		i.holdHtlc(circuitKey, expiry, subscriber)
+
+		// The HTLCs of an accepted hold invoice are held until the
+		// invoice is settled or canceled, rather than until their set
+		// times out.
+		if invoice.Terms.State == channeldb.ContractAccepted {
+			i.trackHeldInvoice(rHash, &invoice)
+		} else {
+			i.startHtlcSetTimer(rHash, &invoice)
+		}
+
+		return nil, nil
+	}
+
+	// Canceled invoices can no longer be paid.
+	if invoice.Terms.State == channeldb.ContractCanceled {
+		return failResolution, nil
+	}
+
+	// If the invoice is already settled, we accept the payment to simplify
+	// failure recovery. Invoices that don't specify a value are settled by
+	// the first HTLC paying to them, unless they're hold invoices or the
+	// sender passed the total amount of a payment that may be split.
+	if invoice.Terms.State == channeldb.ContractSettled ||
+		(invoice.Terms.Value == 0 && totalAmt == 0 &&
+			!invoice.IsHold()) {
+
+		if _, err := i.settleInvoice(rHash, amtPaid); err != nil {
+			return nil, err
+		}
+
+		return settleResolution, nil
+	}
+
+	// The set is complete once it pays the total amount passed by the
+	// sender, which must cover the invoice. If no total was passed, the
+	// set needs to pay the invoice's value instead.
+	setTotal := invoice.Terms.Value
+	if totalAmt != 0 {
+		if totalAmt < invoice.Terms.Value {
+			ltndLog.Debugf("Failing htlc %v paying to invoice %x: "+
+				"total amount %v is below the invoice value "+
+				"%v", circuitKey, rHash[:], totalAmt,
+				invoice.Terms.Value)
+
+			return failResolution, nil
+		}
+
+		setTotal = totalAmt
+	}
+
+	// All HTLCs of the set must agree on its total amount, which is the
+	// one of the first HTLC for invoices that don't specify a value.
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if htlc.MppTotal != totalAmt {
+			ltndLog.Debugf("Failing htlc %v paying to invoice %x: "+
+				"total amount %v doesn't match the set's %v",
+				circuitKey, rHash[:], totalAmt, htlc.MppTotal)
+
+			return failResolution, nil
+		}
+	}
+
+	updatedInvoice, err := i.cdb.AcceptInvoiceHtlc(
+		rHash, circuitKey, amtPaid, totalAmt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var amtAccepted lnwire.MilliSatoshi
+	for _, htlc := range updatedInvoice.Htlcs {
+		if htlc.State == channeldb.HtlcStateAccepted {
+			amtAccepted += htlc.Amt
+		}
+	}
+
+	// If the set doesn't pay its full total yet, we'll hold the HTLC until
+	// the rest of the set arrives.
+	if amtAccepted < setTotal {
+		ltndLog.Debugf("Holding htlc %v paying %v to invoice %x, "+
+			"%v of %v accepted", circuitKey, amtPaid, rHash[:],
+			amtAccepted, setTotal)
+
+		i.holdHtlc(circuitKey, expiry, subscriber)
+		i.startHtlcSetTimer(rHash, updatedInvoice)
+
+		return nil, nil
+	}
+
+	// Otherwise, the set is complete, so it no longer times out.
+	if timer, ok := i.htlcSetTimers[rHash]; ok {
+		timer.Stop()
+		delete(i.htlcSetTimers, rHash)
+	}
+
+	// A hold invoice is accepted, and all HTLCs of its set are held until
+	// it's settled or canceled.
+	if invoice.IsHold() {
+		acceptedInvoice, err := i.cdb.AcceptInvoice(rHash)
+		if err != nil {
+			return nil, err
+		}
+
+		ltndLog.Infof("Accepted hold invoice %x, holding its htlcs "+
+			"until it's settled or canceled", rHash[:])
+
+		i.holdHtlc(circuitKey, expiry, subscriber)
+		i.trackHeldInvoice(rHash, acceptedInvoice)
+
+		// Only the transition to the accepted state is notified, not
+		// any further HTLCs that overpay the invoice.
+		if invoice.Terms.State == channeldb.ContractOpen {
+			i.notifyClients(
+				acceptedInvoice, channeldb.ContractAccepted,
+			)
+		}
+
+		return nil, nil
+	}
+
+	// Otherwise, we'll settle the invoice, and with it all HTLCs held as
+	// part of the set.
+
+	settledInvoice, err := i.settleInvoice(rHash, amtAccepted)
+	if err != nil {
+		return nil, err
+	}
+	i.resolveHtlcs(settledInvoice)
+
+	return settleResolution, nil
+}
+
+// UnsubscribeHtlcResolutions removes the passed subscriber channel from all
+// held HTLCs it awaits the resolution of.
+//
+// NOTE: Part of the htlcswitch.InvoiceDatabase interface.
+func (i *invoiceRegistry) UnsubscribeHtlcResolutions(
+	subscriber chan<- interface{}) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	for circuitKey, htlcSubscriber := range i.htlcSubscribers {
+		if htlcSubscriber == subscriber {
+			delete(i.htlcSubscribers, circuitKey)
+		}
+	}
+}
+
+// holdHtlc records the subscriber channel the resolution of the held HTLC is
+// to be sent on, along with the HTLC's expiry.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) holdHtlc(circuitKey channeldb.CircuitKey,
+	expiry uint32, subscriber chan<- interface{}) {
+
+	i.htlcSubscribers[circuitKey] = subscriber
+	i.htlcExpiries[circuitKey] = expiry
+}
+
+// trackHeldInvoice records the earliest expiry of the HTLCs held for the
+// accepted hold invoice, so the invoice can be canceled before they expire.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) trackHeldInvoice(rHash chainhash.Hash,
+	invoice *channeldb.Invoice) {
+
+	for circuitKey, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		expiry, ok := i.htlcExpiries[circuitKey]
+		if !ok {
+			continue
+		}
+
+		heldExpiry, ok := i.heldInvoices[rHash]
+		if !ok || expiry < heldExpiry {
+			i.heldInvoices[rHash] = expiry
+		}
+	}
+}
+
+// holdInvoiceExpiryWatcher is a goroutine that cancels accepted hold invoices
+// once the chain comes within holdInvoiceCancelDelta blocks of the earliest
+// expiry of their held HTLCs.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *invoiceRegistry) holdInvoiceExpiryWatcher(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer i.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			i.cancelExpiringInvoices(uint32(epoch.Height))
+
+		case <-i.quit:
+			return
+		}
+	}
+}
+
+// cancelExpiringInvoices cancels all accepted hold invoices whose earliest
+// held HTLC expires within holdInvoiceCancelDelta blocks of the passed height.
+func (i *invoiceRegistry) cancelExpiringInvoices(height uint32) {
+	i.Lock()
+	defer i.Unlock()
+
+	for rHash, expiry := range i.heldInvoices {
+		if expiry > height+holdInvoiceCancelDelta {
+			continue
+		}
+
+		ltndLog.Infof("Canceling hold invoice %x, as its htlcs expire "+
+			"at height %v", rHash[:], expiry)
+
+		if err := i.cancelInvoice(rHash); err != nil {
+			ltndLog.Errorf("unable to cancel hold invoice %x: %v",
+				rHash[:], err)
+			delete(i.heldInvoices, rHash)
+		}
+	}
+}
+
+// startHtlcSetTimer starts the timer that fails back the incomplete HTLC set
+// paying to the invoice, unless it's already running. The timeout is measured
+// from the arrival of the first HTLC of the set, which may predate a restart.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) startHtlcSetTimer(rHash chainhash.Hash,
+	invoice *channeldb.Invoice) {
+
+	if _, ok := i.htlcSetTimers[rHash]; ok {
+		return
+	}
+
+	var firstAcceptTime time.Time
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if firstAcceptTime.IsZero() ||
+			htlc.AcceptTime.Before(firstAcceptTime) {
+
+			firstAcceptTime = htlc.AcceptTime
+		}
+	}
+
+	timeout := htlcSetTimeout - time.Since(firstAcceptTime)
+	i.htlcSetTimers[rHash] = time.AfterFunc(timeout, func() {
+		i.cancelHtlcSet(rHash)
+	})
+}
+
+// cancelHtlcSet fails back all HTLCs of the incomplete set paying to the
+// invoice with the passed payment hash.
+func (i *invoiceRegistry) cancelHtlcSet(rHash chainhash.Hash) {
+	i.Lock()
+	defer i.Unlock()
+
+	// If the timer was stopped in the meantime, the set was completed.
+	if _, ok := i.htlcSetTimers[rHash]; !ok {
+		return
+	}
+	delete(i.htlcSetTimers, rHash)
+
+	ltndLog.Infof("Htlc set paying to invoice %x timed out, failing "+
+		"its htlcs", rHash[:])
+
+	invoice, err := i.cdb.CancelInvoiceHtlcs(rHash)
+	if err != nil {
+		ltndLog.Errorf("unable to cancel htlcs of invoice %x: %v",
+			rHash[:], err)
+		return
+	}
+
+	i.resolveHtlcs(invoice)
+}
+
+// resolveHtlcs sends the resolutions of all HTLCs of the invoice that were
+// settled or canceled to the links that hold them.
+//
+// NOTE: The registry's lock MUST be held when calling this method.
+func (i *invoiceRegistry) resolveHtlcs(invoice *channeldb.Invoice) {
+	for circuitKey, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			delete(i.htlcExpiries, circuitKey)
+		}
+
+		subscriber, ok := i.htlcSubscribers[circuitKey]
+		if !ok {
+			continue
+		}
+
+		resolution := &htlcswitch.HtlcResolution{
+			CircuitKey: circuitKey,
+		}
+		switch htlc.State {
+		case channeldb.HtlcStateSettled:
+			resolution.Preimage = &invoice.Terms.PaymentPreimage
+
+		case channeldb.HtlcStateCanceled:
+
+		default:
+			continue
+		}
+
+		select {
+		case subscriber <- resolution:
+		case <-i.quit:
+			return
+		}
+
+		delete(i.htlcSubscribers, circuitKey)
+	}
 }
 
 // notifyClients notifies all currently registered invoice notification clients
-// of a newly added/settled invoice.
-func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool) {
+// of an invoice that transitioned to the passed state.
+func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice,
+	state channeldb.ContractState) {
+
 	event := &invoiceEvent{
-		isSettle: settle,
-		invoice:  invoice,
+		state:   state,
+		invoice: invoice,
 	}
 
 	select {
@@ -390,7 +1208,8 @@ func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool)
 // or settled invoices. For each newly added invoice, a copy of the invoice
 // will be sent over the NewInvoices channel. Similarly, for each newly settled
 // invoice, a copy of the invoice will be sent over the SettledInvoices
-// channel.
+// channel. Hold invoices that are accepted or canceled are sent over the
+// UpdatedInvoices channel.
 type invoiceSubscription struct {
 	cancelled uint32 // To be used atomically.
 
@@ -404,6 +1223,11 @@ type invoiceSubscription struct {
 	// StartingInvoiceIndex field.
 	SettledInvoices chan *channeldb.Invoice
 
+	// UpdatedInvoices is a channel that we'll use to send all invoices
+	// that were accepted or canceled. As these events aren't indexed, no
+	// backlog of them is delivered.
+	UpdatedInvoices chan *channeldb.Invoice
+
 	// addIndex is the highest add index the caller knows of. We'll use
 	// this information to send out an event backlog to the notifications
 	// subscriber. Any new add events with an index greater than this will
@@ -455,6 +1279,7 @@ func (i *invoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *
 	client := &invoiceSubscription{
 		NewInvoices:     make(chan *channeldb.Invoice),
 		SettledInvoices: make(chan *channeldb.Invoice),
+		UpdatedInvoices: make(chan *channeldb.Invoice),
 		addIndex:        addIndex,
 		settleIndex:     settleIndex,
 		inv:             i,
@@ -480,14 +1305,19 @@ func (i *invoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *
 			select {
 			// A new invoice event has been sent by the
 			// invoiceRegistry! We'll figure out if this is an add
-			// event or a settle event, then dispatch the event to
-			// the client.
+			// event, a settle event or an update of a hold
+			// invoice, then dispatch the event to the client.
 			case ntfn := <-client.ntfnQueue.ChanOut():
 				invoiceEvent := ntfn.(*invoiceEvent)
 
-				targetChan := client.NewInvoices
-				if invoiceEvent.isSettle {
+				var targetChan chan *channeldb.Invoice
+				switch invoiceEvent.state {
+				case channeldb.ContractOpen:
+					targetChan = client.NewInvoices
+				case channeldb.ContractSettled:
 					targetChan = client.SettledInvoices
+				default:
+					targetChan = client.UpdatedInvoices
 				}
 
 				select {
@@ -0,0 +1,186 @@
+// +build !rpctest
+
+package daemon
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/htlcswitch"
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// newTestInvoiceRegistry creates an invoice registry backed by a fresh
+// database, along with a function to clean it up.
+func newTestInvoiceRegistry(t *testing.T) (*invoiceRegistry, func()) {
+	tempDir, err := ioutil.TempDir("", "invoiceregistry")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	cdb, err := channeldb.Open(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	registry := newInvoiceRegistry(cdb, nil, 0)
+
+	cleanUp := func() {
+		registry.Stop()
+		cdb.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	return registry, cleanUp
+}
+
+// TestInvoiceRegistryMppTotal tests that the HTLCs paying to an invoice are
+// held until their sum reaches the total amount passed by the sender, and that
+// HTLCs whose total amount doesn't match the one of their set, or doesn't
+// cover the invoice, are failed.
+func TestInvoiceRegistryMppTotal(t *testing.T) {
+	t.Parallel()
+
+	registry, cleanUp := newTestInvoiceRegistry(t)
+	defer cleanUp()
+
+	subscriber := make(chan interface{}, 10)
+
+	// addInvoice adds an invoice of the passed value, and returns its
+	// payment hash.
+	addInvoice := func(preimage byte,
+		value lnwire.MilliSatoshi) chainhash.Hash {
+
+		invoice := &channeldb.Invoice{
+			CreationDate: time.Unix(time.Now().Unix(), 0),
+			Terms: channeldb.ContractTerm{
+				PaymentPreimage: [32]byte{preimage},
+				Value:           value,
+			},
+		}
+		if _, err := registry.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		return sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+	}
+
+	htlcID := uint64(0)
+	notifyHtlc := func(rHash chainhash.Hash, amt,
+		total lnwire.MilliSatoshi) (channeldb.CircuitKey,
+		*htlcswitch.HtlcResolution) {
+
+		htlcID++
+		circuitKey := channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: htlcID,
+		}
+		resolution, err := registry.NotifyExitHopHtlc(
+			rHash, amt, total, 1000, circuitKey, subscriber,
+		)
+		if err != nil {
+			t.Fatalf("unable to notify htlc: %v", err)
+		}
+
+		return circuitKey, resolution
+	}
+
+	assertHeld := func(resolution *htlcswitch.HtlcResolution) {
+		if resolution != nil {
+			t.Fatalf("expected htlc to be held, got resolution %v",
+				resolution)
+		}
+	}
+
+	assertFailed := func(resolution *htlcswitch.HtlcResolution) {
+		if resolution == nil || resolution.Preimage != nil {
+			t.Fatalf("expected htlc to be failed, got resolution "+
+				"%v", resolution)
+		}
+	}
+
+	assertSettled := func(resolution *htlcswitch.HtlcResolution) {
+		if resolution == nil || resolution.Preimage == nil {
+			t.Fatalf("expected htlc to be settled, got resolution "+
+				"%v", resolution)
+		}
+	}
+
+	// assertHeldSettled checks that the passed held HTLCs are settled
+	// through the subscriber channel.
+	assertHeldSettled := func(circuitKeys ...channeldb.CircuitKey) {
+		held := make(map[channeldb.CircuitKey]struct{})
+		for _, circuitKey := range circuitKeys {
+			held[circuitKey] = struct{}{}
+		}
+
+		for len(held) > 0 {
+			select {
+			case r := <-subscriber:
+				resolution := r.(*htlcswitch.HtlcResolution)
+				if _, ok := held[resolution.CircuitKey]; !ok {
+					t.Fatalf("unexpected resolution of "+
+						"htlc %v", resolution.CircuitKey)
+				}
+				assertSettled(resolution)
+				delete(held, resolution.CircuitKey)
+
+			case <-time.After(5 * time.Second):
+				t.Fatalf("no resolution of held htlcs %v", held)
+			}
+		}
+	}
+
+	// An HTLC whose total amount doesn't cover the invoice is failed
+	// right away.
+	rHash := addInvoice(1, 1000)
+	_, resolution := notifyHtlc(rHash, 500, 500)
+	assertFailed(resolution)
+
+	// The set paying more than the invoice is held until it reaches its
+	// total amount, rather than the invoice value. HTLCs passing a
+	// different total are failed.
+	firstKey, resolution := notifyHtlc(rHash, 600, 1500)
+	assertHeld(resolution)
+
+	_, resolution = notifyHtlc(rHash, 400, 1000)
+	assertFailed(resolution)
+
+	secondKey, resolution := notifyHtlc(rHash, 400, 1500)
+	assertHeld(resolution)
+
+	_, resolution = notifyHtlc(rHash, 500, 1500)
+	assertSettled(resolution)
+	assertHeldSettled(firstKey, secondKey)
+
+	// Invoices that don't specify a value are paid by the set once it
+	// reaches the total amount of its first HTLC.
+	rHash = addInvoice(2, 0)
+	firstKey, resolution = notifyHtlc(rHash, 300, 800)
+	assertHeld(resolution)
+
+	_, resolution = notifyHtlc(rHash, 300, 900)
+	assertFailed(resolution)
+
+	_, resolution = notifyHtlc(rHash, 500, 800)
+	assertSettled(resolution)
+	assertHeldSettled(firstKey)
+
+	invoice, err := registry.cdb.LookupInvoice(rHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+	if invoice.Terms.State != channeldb.ContractSettled ||
+		invoice.AmtPaid != 800 {
+
+		t.Fatalf("expected invoice to be settled with 800 msat, "+
+			"got state %v with %v", invoice.Terms.State,
+			invoice.AmtPaid)
+	}
+}
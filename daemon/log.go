@@ -14,6 +14,7 @@ import (
 	"github.com/breez/lightninglib/discovery"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lnwallet"
+	"github.com/breez/lightninglib/rebalance"
 	"github.com/breez/lightninglib/routing"
 	"github.com/breez/lightninglib/signal"
 	"github.com/breez/lightninglib/sweep"
@@ -64,6 +65,7 @@ var (
 	cnctLog = build.NewSubLogger("CNCT", backendLog.Logger)
 	sphxLog = build.NewSubLogger("SPHX", backendLog.Logger)
 	swprLog = build.NewSubLogger("SWPR", backendLog.Logger)
+	rblcLog = build.NewSubLogger("RBLC", backendLog.Logger)
 )
 
 // Initialize package-global logger variables.
@@ -81,6 +83,7 @@ func init() {
 	sphinx.UseLogger(sphxLog)
 	signal.UseLogger(ltndLog)
 	sweep.UseLogger(swprLog)
+	rebalance.UseLogger(rblcLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -104,6 +107,7 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"CNCT": cnctLog,
 	"SPHX": sphxLog,
 	"SWPR": swprLog,
+	"RBLC": rblcLog,
 }
 
 // initLogRotator initializes the logging rotator to write logs to logFile and
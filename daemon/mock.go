@@ -206,8 +206,11 @@ func (*mockWalletController) BackEnd() string {
 func (*mockWalletController) FetchInputInfo(
 	prevOut *wire.OutPoint) (*wire.TxOut, error) {
 	txOut := &wire.TxOut{
-		Value:    int64(10 * btcutil.SatoshiPerBitcoin),
-		PkScript: []byte("dummy"),
+		Value: int64(10 * btcutil.SatoshiPerBitcoin),
+		PkScript: append(
+			[]byte{txscript.OP_0, txscript.OP_DATA_20},
+			make([]byte, 20)...,
+		),
 	}
 	return txOut, nil
 }
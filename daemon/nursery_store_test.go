@@ -10,7 +10,6 @@ import (
 
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/btcsuite/btclog"
 )
 
 // makeTestDB creates a new instance of the ChannelDB for testing purposes. A
@@ -14,6 +14,7 @@ import (
 	"github.com/breez/lightninglib/chainntnfs"
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/contractcourt"
+	"github.com/breez/lightninglib/feature"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lnpeer"
 	"github.com/breez/lightninglib/lnrpc"
@@ -25,7 +26,9 @@ import (
 	"github.com/btcsuite/btcd/connmgr"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -52,8 +55,23 @@ const (
 	// messages to be sent across the wire, requested by objects outside
 	// this struct.
 	outgoingQueueLen = 50
+
+	// customMessageRate is the number of custom messages per second that
+	// may be exchanged with a peer in either direction, once the burst of
+	// customMessageBurst messages is used up. Custom messages received in
+	// excess of the limit are dropped, while attempts to send them fail.
+	customMessageRate = 10
+
+	// customMessageBurst is the number of custom messages that may be
+	// exchanged with a peer in either direction in a quick burst.
+	customMessageBurst = 50
 )
 
+// ErrCustomMessageRateExceeded is returned when sending a custom message to a
+// peer exceeds the rate limit for custom messages.
+var ErrCustomMessageRateExceeded = errors.New("custom message rate limit " +
+	"exceeded")
+
 // outgoingMsg packages an lnwire.Message to be sent out on the wire, along with
 // a buffered channel which will be sent upon once the write is complete. This
 // buffered channel acts as a semaphore to be used for synchronization purposes.
@@ -162,9 +180,13 @@ type peer struct {
 
 	server *server
 
-	// localFeatures is the set of local features that we advertised to the
-	// remote node.
-	localFeatures *lnwire.RawFeatureVector
+	// features is the set of features that we advertised to the remote
+	// node within the local features of the init message.
+	features *lnwire.FeatureVector
+
+	// legacyFeatures is the set of features that we advertised to the
+	// remote node within the global features of the init message.
+	legacyFeatures *lnwire.FeatureVector
 
 	// remoteLocalFeatures is the local feature vector received from the
 	// peer during the connection handshake.
@@ -174,6 +196,10 @@ type peer struct {
 	// peer during the connection handshake.
 	remoteGlobalFeatures *lnwire.FeatureVector
 
+	// remoteFeatures is the union of the local and global feature vectors
+	// received from the peer during the connection handshake.
+	remoteFeatures *lnwire.FeatureVector
+
 	// failedChannels is a set that tracks channels we consider `failed`.
 	// This is a temporary measure until we have implemented real failure
 	// handling at the link level, to handle the case where we reconnect to
@@ -182,6 +208,11 @@ type peer struct {
 	// TODO(halseth): remove when link failure is properly handled.
 	failedChannels map[lnwire.ChannelID]struct{}
 
+	// customMsgRecvLimiter and customMsgSendLimiter limit the rate of
+	// custom messages we receive from and send to the peer.
+	customMsgRecvLimiter *rate.Limiter
+	customMsgSendLimiter *rate.Limiter
+
 	// writeBuf is a buffer that we'll re-use in order to encode wire
 	// messages to write out directly on the socket. By re-using this
 	// buffer, we avoid needing to allocate more memory each time a new
@@ -200,7 +231,7 @@ var _ lnpeer.Peer = (*peer)(nil)
 // pointer to the main server.
 func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 	addr *lnwire.NetAddress, inbound bool,
-	localFeatures *lnwire.RawFeatureVector) (*peer, error) {
+	features, legacyFeatures *lnwire.FeatureVector) (*peer, error) {
 
 	nodePub := addr.IdentityKey
 
@@ -213,7 +244,8 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 
 		server: server,
 
-		localFeatures: localFeatures,
+		features:       features,
+		legacyFeatures: legacyFeatures,
 
 		sendQueue:     make(chan outgoingMsg),
 		outgoingQueue: make(chan outgoingMsg),
@@ -227,6 +259,13 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		chanCloseMsgs:      make(chan *closeMsg),
 		failedChannels:     make(map[lnwire.ChannelID]struct{}),
 
+		customMsgRecvLimiter: rate.NewLimiter(
+			customMessageRate, customMessageBurst,
+		),
+		customMsgSendLimiter: rate.NewLimiter(
+			customMessageRate, customMessageBurst,
+		),
+
 		queueQuit: make(chan struct{}),
 		quit:      make(chan struct{}),
 	}
@@ -347,11 +386,19 @@ func (p *peer) initGossipSync() {
 		// peers
 		recvUpdates := !cfg.NoChanUpdates
 
+		// If both sides support the extended gossip queries, we'll
+		// only query for the channel updates that we're missing.
+		queryEx := p.features.HasFeature(
+			lnwire.GossipQueriesExOptional,
+		) && p.remoteLocalFeatures.HasFeature(
+			lnwire.GossipQueriesExOptional,
+		)
+
 		// Register the this peer's for gossip syncer with the gossiper.
 		// This is blocks synchronously to ensure the gossip syncer is
 		// registered with the gossiper before attempting to read
 		// messages from the remote peer.
-		p.server.authGossiper.InitSyncState(p, recvUpdates)
+		p.server.authGossiper.InitSyncState(p, recvUpdates, queryEx)
 
 	// If the remote peer has the initial sync feature bit set, then we'll
 	// being the synchronization protocol to exchange authenticated channel
@@ -542,6 +589,10 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		}
 	}
 
+	// The configured commitment fee policy of the channel depends on
+	// whether it's private.
+	private := lnChan.State().ChannelFlags&lnwire.FFAnnounceChannel == 0
+
 	linkCfg := htlcswitch.ChannelLinkConfig{
 		Peer:                   p,
 		DecodeHopIterators:     p.server.sphinx.DecodeHopIterators,
@@ -555,6 +606,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		ForwardPackets:         p.server.htlcSwitch.ForwardPackets,
 		FwrdingPolicy:          *forwardingPolicy,
 		FeeEstimator:           p.server.cc.feeEstimator,
+		CommitFeePolicy:        p.server.commitFeePolicy(*chanPoint, private),
 		PreimageCache:          p.server.witnessBeacon,
 		ChainEvents:            chainEvents,
 		UpdateContractSignals: func(signals *contractcourt.ContractSignals) error {
@@ -571,8 +623,13 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		FwdPkgGCTicker:      ticker.New(time.Minute),
 		BatchSize:           10,
 		UnsafeReplay:        cfg.UnsafeReplay,
+		AcceptKeysend:       cfg.AcceptKeysend,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		HtlcNotifier:        p.server.htlcNotifier,
+		MaxDustHTLCExposure: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(cfg.MaxDustHTLCExposure),
+		),
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -1032,6 +1089,14 @@ out:
 			p.server.fundingMgr.processFundingSigned(msg, p)
 		case *lnwire.FundingLocked:
 			p.server.fundingMgr.processFundingLocked(msg, p)
+		case *lnwire.TxAddInput:
+			p.server.fundingMgr.processTxAddInput(msg, p)
+		case *lnwire.TxAddOutput:
+			p.server.fundingMgr.processTxAddOutput(msg, p)
+		case *lnwire.TxComplete:
+			p.server.fundingMgr.processTxComplete(msg, p)
+		case *lnwire.TxSignatures:
+			p.server.fundingMgr.processTxSignatures(msg, p)
 
 		case *lnwire.Shutdown:
 			select {
@@ -1118,6 +1183,9 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.Custom:
+			p.handleCustomMessage(msg)
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1182,6 +1250,24 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
 
+	case *lnwire.TxAddInput:
+		return fmt.Sprintf("temp_chan_id=%x, serial_id=%v, prev_out=%v, "+
+			"amt=%v", msg.ChannelID[:], msg.SerialID, msg.PrevOut,
+			msg.Amount)
+
+	case *lnwire.TxAddOutput:
+		return fmt.Sprintf("temp_chan_id=%x, serial_id=%v, amt=%v, "+
+			"script=%x", msg.ChannelID[:], msg.SerialID, msg.Amount,
+			msg.PkScript[:])
+
+	case *lnwire.TxComplete:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v", msg.ChannelID[:],
+			msg.FundingAmount)
+
+	case *lnwire.TxSignatures:
+		return fmt.Sprintf("chan_id=%v, txid=%v, num_witnesses=%v",
+			msg.ChannelID, msg.TxHash, len(msg.Witnesses))
+
 	case *lnwire.Shutdown:
 		return fmt.Sprintf("chan_id=%v, script=%x", msg.ChannelID,
 			msg.Address[:])
@@ -1835,6 +1921,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			},
 			deliveryAddr,
 			feePerKw,
+			0,
 			uint32(startingHeight),
 			nil,
 		)
@@ -1866,14 +1953,19 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
+		// First, we'll determine the delivery address that we'll use
 		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf(err.Error())
-			req.Err <- err
-			return
+		// negotiation. Unless the caller specified one, we'll fetch a
+		// fresh one from the wallet.
+		deliveryAddr := req.DeliveryScript
+		if len(deliveryAddr) == 0 {
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
 		}
 
 		// Next, we'll create a new channel closer state machine to
@@ -1898,6 +1990,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
+			req.MaxFeePerKw,
 			uint32(startingHeight),
 			req,
 		)
@@ -2044,13 +2137,18 @@ func (p *peer) finalizeChanClosure(chanCloser *channelCloser) {
 
 	closingTxid := closingTx.TxHash()
 
+	// The fee can only be unavailable if the closing transaction is, which
+	// we've already reported above.
+	closingFee, _ := chanCloser.NegotiatedFee()
+
 	// If this is a locally requested shutdown, update the caller with a
 	// new event detailing the current pending state of this request.
 	if closeReq != nil {
 		closeReq.Updates <- &lnrpc.CloseStatusUpdate{
 			Update: &lnrpc.CloseStatusUpdate_ClosePending{
 				ClosePending: &lnrpc.PendingUpdate{
-					Txid: closingTxid[:],
+					Txid:   closingTxid[:],
+					FeeSat: int64(closingFee),
 				},
 			},
 		}
@@ -2067,6 +2165,7 @@ func (p *peer) finalizeChanClosure(chanCloser *channelCloser) {
 						ChanClose: &lnrpc.ChannelCloseUpdate{
 							ClosingTxid: closingTxid[:],
 							Success:     true,
+							FeeSat:      int64(closingFee),
 						},
 					},
 				}
@@ -2135,9 +2234,9 @@ func (p *peer) WipeChannel(chanPoint *wire.OutPoint) error {
 // local features vectors. If feature vectors are incompatible then disconnect.
 func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 	p.remoteLocalFeatures = lnwire.NewFeatureVector(msg.LocalFeatures,
-		lnwire.LocalFeatures)
+		lnwire.Features)
 	p.remoteGlobalFeatures = lnwire.NewFeatureVector(msg.GlobalFeatures,
-		lnwire.GlobalFeatures)
+		lnwire.Features)
 
 	unknownLocalFeatures := p.remoteLocalFeatures.UnknownRequiredFeatures()
 	if len(unknownLocalFeatures) > 0 {
@@ -2153,6 +2252,17 @@ func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 		return err
 	}
 
+	// Features signaled within either vector may depend on each other, so
+	// we'll validate their dependencies on the union of both.
+	remoteFeatures := msg.LocalFeatures.Clone()
+	remoteFeatures.Merge(msg.GlobalFeatures)
+	p.remoteFeatures = lnwire.NewFeatureVector(
+		remoteFeatures, lnwire.Features,
+	)
+	if err := feature.ValidateDeps(p.remoteFeatures); err != nil {
+		return fmt.Errorf("Peer set invalid feature vector: %v", err)
+	}
+
 	return nil
 }
 
@@ -2160,8 +2270,8 @@ func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 // supported local and global features.
 func (p *peer) sendInitMsg() error {
 	msg := lnwire.NewInitMessage(
-		p.server.globalFeatures.RawFeatureVector,
-		p.localFeatures,
+		p.legacyFeatures.RawFeatureVector,
+		p.features.RawFeatureVector,
 	)
 
 	return p.writeMessage(msg)
@@ -2196,6 +2306,30 @@ func (p *peer) resendChanSyncMsg(cid lnwire.ChannelID) error {
 	return nil
 }
 
+// handleCustomMessage hands a custom message received from the remote peer to
+// its subscribers, unless the peer exceeds its rate limit for custom messages,
+// in which case the message is dropped.
+func (p *peer) handleCustomMessage(msg *lnwire.Custom) {
+	if !p.customMsgRecvLimiter.Allow() {
+		peerLog.Warnf("Dropping custom message of type %v from peer "+
+			"%v, rate limit exceeded", uint16(msg.Type), p)
+		return
+	}
+
+	p.server.customMessageNotifier.NotifyCustomMessage(p.pubKeyBytes, msg)
+}
+
+// SendCustomMessage sends a custom message to the remote peer, blocking until
+// it has been sent. ErrCustomMessageRateExceeded is returned if sending it
+// would exceed the peer's rate limit for custom messages.
+func (p *peer) SendCustomMessage(msg *lnwire.Custom) error {
+	if !p.customMsgSendLimiter.Allow() {
+		return ErrCustomMessageRateExceeded
+	}
+
+	return p.SendMessage(true, msg)
+}
+
 // SendMessage sends a variadic number of message to remote peer. The first
 // argument denotes if the method should block until the message has been sent
 // to the remote peer.
@@ -3,18 +3,19 @@
 package daemon
 
 import (
+	"bytes"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/breez/lightninglib/chainntnfs"
-	"github.com/breez/lightninglib/channeldb"
-	"github.com/breez/lightninglib/contractcourt"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lnrpc"
 	"github.com/breez/lightninglib/lnwallet"
 	"github.com/breez/lightninglib/lnwire"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"golang.org/x/time/rate"
 )
 
 // TestPeerChannelClosureAcceptFeeResponder tests the shutdown responder's
@@ -591,3 +592,255 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureMaxFeeInitiator tests that the shutdown initiator
+// delivers its funds to the requested script, and refuses to agree on a fee
+// above the requested maximum.
+func TestPeerChannelClosureMaxFeeInitiator(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We make the initiator send a shutdown request to a delivery script
+	// of our choosing, with its max fee rate equal to its target one.
+	deliveryScript := append(
+		[]byte{0x00, 0x14}, bytes.Repeat([]byte{1}, 20)...,
+	)
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, 1)
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        updateChan,
+		TargetFeePerKw: 12500,
+		MaxFeePerKw:    12500,
+		DeliveryScript: deliveryScript,
+		Err:            errChan,
+	}
+	initiator.localCloseChanReqs <- closeCommand
+
+	// We should now be getting the shutdown request, paying to the
+	// requested script.
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, deliveryScript) {
+		t.Fatalf("expected delivery script %x, got %x",
+			deliveryScript, shutdownMsg.Address)
+	}
+
+	// We'll answer the shutdown message with our own Shutdown, and then a
+	// ClosingSigned message proposing a fee well above the maximum.
+	chanID := lnwire.NewChanIDFromOutPoint(initiatorChan.ChannelPoint())
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	maxFee := responderChan.CalcFee(12500)
+	increasedFee := btcutil.Amount(float64(maxFee) * 2.5)
+	closeSig, _, _, err := responderChan.CreateCloseProposal(
+		increasedFee, dummyDeliveryScript, deliveryScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create close proposal: %v", err)
+	}
+	parsedSig, err := lnwire.NewSigFromRawSignature(closeSig)
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewClosingSigned(chanID, increasedFee, parsedSig),
+	}
+
+	// The initiator's first proposal should be its ideal fee, which is
+	// also its maximum.
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed")
+	}
+	closingSignedMsg, ok := msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+	if closingSignedMsg.FeeSatoshis != maxFee {
+		t.Fatalf("expected ClosingSigned fee to be %v, instead got %v",
+			maxFee, closingSignedMsg.FeeSatoshis)
+	}
+
+	// As it can't go any higher, the initiator should fail the closure
+	// rather than compromise.
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("expected closure to fail")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("closure did not fail")
+	}
+}
+
+// newCustomMessageTestPeer creates a peer that's only able to exchange custom
+// messages, whose rate limiters won't replenish during the test, along with
+// a function to clean it up.
+func newCustomMessageTestPeer(t *testing.T) (*peer, func()) {
+	notifier := NewCustomMessageNotifier()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start custom message notifier: %v", err)
+	}
+
+	localConn, remoteConn := net.Pipe()
+
+	p := &peer{
+		conn:        localConn,
+		pubKeyBytes: [33]byte{2},
+		server: &server{
+			customMessageNotifier: notifier,
+		},
+		outgoingQueue: make(chan outgoingMsg, outgoingQueueLen),
+		customMsgRecvLimiter: rate.NewLimiter(
+			rate.Every(time.Hour), customMessageBurst,
+		),
+		customMsgSendLimiter: rate.NewLimiter(
+			rate.Every(time.Hour), customMessageBurst,
+		),
+		quit: make(chan struct{}),
+	}
+
+	cleanUp := func() {
+		close(p.quit)
+		localConn.Close()
+		remoteConn.Close()
+		notifier.Stop()
+	}
+
+	return p, cleanUp
+}
+
+// TestPeerCustomMessageRecvRateLimit tests that the custom messages received
+// from a peer are dispatched to the subscribers of the custom message
+// notifier, and that the messages exceeding the peer's rate limit are
+// dropped.
+func TestPeerCustomMessageRecvRateLimit(t *testing.T) {
+	t.Parallel()
+
+	p, cleanUp := newCustomMessageTestPeer(t)
+	defer cleanUp()
+
+	sub, err := p.server.customMessageNotifier.SubscribeCustomMessages()
+	if err != nil {
+		t.Fatalf("unable to subscribe to custom messages: %v", err)
+	}
+	defer sub.Cancel()
+
+	for i := 0; i < customMessageBurst+1; i++ {
+		msg, err := lnwire.NewCustom(
+			lnwire.CustomTypeStart, []byte{byte(i)},
+		)
+		if err != nil {
+			t.Fatalf("unable to create custom message: %v", err)
+		}
+		p.handleCustomMessage(msg)
+	}
+
+	// Only the messages within the burst are dispatched, in the order
+	// they were received.
+	for i := 0; i < customMessageBurst; i++ {
+		select {
+		case update := <-sub.Updates():
+			event := update.(*CustomMessage)
+			if event.Peer != p.pubKeyBytes {
+				t.Fatalf("expected message from peer %x, got "+
+					"%x", p.pubKeyBytes, event.Peer)
+			}
+			if event.Msg.Type != lnwire.CustomTypeStart ||
+				!bytes.Equal(event.Msg.Data, []byte{byte(i)}) {
+
+				t.Fatalf("unexpected message %v", event.Msg)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("custom message %v not dispatched", i)
+		}
+	}
+
+	select {
+	case update := <-sub.Updates():
+		t.Fatalf("message exceeding rate limit dispatched: %v",
+			update)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestPeerSendCustomMessageRateLimit tests that custom messages are sent to
+// the peer until its rate limit is exceeded, after which sending them fails
+// with ErrCustomMessageRateExceeded.
+func TestPeerSendCustomMessageRateLimit(t *testing.T) {
+	t.Parallel()
+
+	p, cleanUp := newCustomMessageTestPeer(t)
+	defer cleanUp()
+
+	// We'll stand in for the writeHandler, acknowledging every queued
+	// message as sent.
+	sent := make(chan lnwire.Message, customMessageBurst)
+	go func() {
+		for {
+			select {
+			case outMsg := <-p.outgoingQueue:
+				sent <- outMsg.msg
+				outMsg.errChan <- nil
+			case <-p.quit:
+				return
+			}
+		}
+	}()
+
+	msg, err := lnwire.NewCustom(lnwire.CustomTypeStart, []byte{1})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+
+	for i := 0; i < customMessageBurst; i++ {
+		if err := p.SendCustomMessage(msg); err != nil {
+			t.Fatalf("unable to send custom message %v: %v", i,
+				err)
+		}
+		if sentMsg := <-sent; sentMsg != msg {
+			t.Fatalf("expected %v to be sent, got %v", msg,
+				sentMsg)
+		}
+	}
+
+	err = p.SendCustomMessage(msg)
+	if err != ErrCustomMessageRateExceeded {
+		t.Fatalf("expected ErrCustomMessageRateExceeded, got %v", err)
+	}
+	select {
+	case sentMsg := <-sent:
+		t.Fatalf("message exceeding rate limit sent: %v", sentMsg)
+	default:
+	}
+}
@@ -18,14 +18,17 @@ import (
 	"github.com/breez/lightninglib/backup"
 	"github.com/breez/lightninglib/build"
 	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/feature"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lnrpc"
 	"github.com/breez/lightninglib/lnwallet"
 	"github.com/breez/lightninglib/lnwallet/btcwallet"
 	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/rebalance"
 	"github.com/breez/lightninglib/routing"
 	"github.com/breez/lightninglib/signal"
 	"github.com/breez/lightninglib/submarine"
+	"github.com/breez/lightninglib/ticker"
 	"github.com/breez/lightninglib/zpay32"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
@@ -33,6 +36,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/psbt"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
@@ -50,6 +54,10 @@ const (
 	// permitted.
 	maxLtcPaymentMSat = lnwire.MilliSatoshi(math.MaxUint32) *
 		btcToLtcConversionRate
+
+	// defaultProbeRoutes is the number of routes probed by
+	// EstimateRouteFee if the caller doesn't specify it.
+	defaultProbeRoutes = 3
 )
 
 var (
@@ -218,6 +226,27 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/FundingStateStep": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}, {
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -285,6 +314,18 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/AddHoldInvoice": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SettleInvoice": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/CancelInvoice": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/LookupInvoice": {{
 			Entity: "invoices",
 			Action: "read",
@@ -325,6 +366,18 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/QueryMissionControl": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/QueryProbability": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/EstimateRouteFee": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetNetworkInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -341,6 +394,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/TrackPayment": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/DeleteAllPayments": {{
 			Entity: "offchain",
 			Action: "write",
@@ -361,6 +418,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/UpdateCommitFeePolicy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
@@ -369,6 +430,22 @@ var (
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/HtlcInterceptor": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 )
 
@@ -380,6 +457,16 @@ type rpcServer struct {
 
 	server *server
 
+	// rebalancer shifts balance between our channels, either on request
+	// or periodically according to its policy.
+	rebalancer *rebalance.Rebalancer
+
+	// interceptorActive is set to 1 while a client is connected to the
+	// HtlcInterceptor stream.
+	//
+	// NOTE: This MUST be used atomically.
+	interceptorActive int32
+
 	wg sync.WaitGroup
 
 	quit chan struct{}
@@ -391,10 +478,110 @@ var _ lnrpc.LightningServer = (*rpcServer)(nil)
 
 // newRPCServer creates and returns a new instance of the rpcServer.
 func newRPCServer(s *server) *rpcServer {
-	return &rpcServer{
+	r := &rpcServer{
 		server: s,
 		quit:   make(chan struct{}, 1),
 	}
+	r.rebalancer = rebalance.New(r.newRebalanceConfig())
+
+	return r
+}
+
+// newRebalanceConfig returns the configuration of the rebalancer, which pays
+// self-invoices added through the rpcServer over circular routes found by the
+// router.
+func (r *rpcServer) newRebalanceConfig() *rebalance.Config {
+	// The final CLTV delta of circular routes must match the one of the
+	// self-invoices they pay.
+	finalCLTVDelta := uint16(routing.DefaultFinalCLTVDelta)
+
+	rebalanceCfg := &rebalance.Config{
+		FindRoute: func(outgoingChan, incomingChan uint64,
+			amt, feeLimit lnwire.MilliSatoshi) (*routing.Route,
+			error) {
+
+			return r.server.chanRouter.FindCircularRoute(
+				outgoingChan, incomingChan, amt, feeLimit,
+				finalCLTVDelta,
+			)
+		},
+		AddInvoice: func(amt btcutil.Amount,
+			memo string) ([32]byte, error) {
+
+			var paymentHash [32]byte
+			resp, err := r.AddInvoice(
+				context.Background(), &lnrpc.Invoice{
+					Memo:       memo,
+					Value:      int64(amt),
+					CltvExpiry: uint64(finalCLTVDelta),
+				},
+			)
+			if err != nil {
+				return paymentHash, err
+			}
+			copy(paymentHash[:], resp.RHash)
+
+			return paymentHash, nil
+		},
+		SendToRoute: func(paymentHash [32]byte,
+			route *routing.Route) ([32]byte, error) {
+
+			preimage, _, _, err := r.server.chanRouter.SendToRoute(
+				[]*routing.Route{route},
+				&routing.LightningPayment{
+					PaymentHash: paymentHash,
+				},
+			)
+			return preimage, err
+		},
+		CancelInvoice: func(paymentHash [32]byte) error {
+			return r.server.invoices.CancelInvoice(paymentHash)
+		},
+		FetchChannels: r.fetchChannelBalances,
+	}
+
+	if cfg.Rebalance.Active {
+		rebalanceCfg.Policy = &rebalance.Policy{
+			MinRatio: cfg.Rebalance.MinRatio,
+			MaxRatio: cfg.Rebalance.MaxRatio,
+			FeeBudget: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(cfg.Rebalance.FeeBudget),
+			),
+			BudgetInterval: cfg.Rebalance.BudgetInterval,
+		}
+		rebalanceCfg.Ticker = ticker.New(cfg.Rebalance.Interval)
+	}
+
+	return rebalanceCfg
+}
+
+// fetchChannelBalances returns the balances of all channels that are
+// currently eligible to forward payments.
+func (r *rpcServer) fetchChannelBalances() ([]*rebalance.ChannelBalance,
+	error) {
+
+	openChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*rebalance.ChannelBalance, 0, len(openChannels))
+	for _, channel := range openChannels {
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		link, err := r.server.htlcSwitch.GetLink(chanID)
+		if err != nil || !link.EligibleToForward() {
+			continue
+		}
+
+		localCommit := channel.LocalCommitment
+		balances = append(balances, &rebalance.ChannelBalance{
+			ChanID:       channel.ShortChanID().ToUint64(),
+			Capacity:     channel.Capacity,
+			LocalBalance: localCommit.LocalBalance.ToSatoshis(),
+		})
+	}
+
+	return balances, nil
 }
 
 // Start launches any helper goroutines required for the rpcServer to function.
@@ -403,7 +590,7 @@ func (r *rpcServer) Start() error {
 		return nil
 	}
 
-	return nil
+	return r.rebalancer.Start()
 }
 
 // Stop signals any active goroutines for a graceful closure.
@@ -414,7 +601,7 @@ func (r *rpcServer) Stop() error {
 
 	close(r.quit)
 
-	return nil
+	return r.rebalancer.Stop()
 }
 
 // addrPairsToOutputs converts a map describing a set of outputs to be created,
@@ -966,6 +1153,29 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 	}
 }
 
+// parsePsbtShim extracts the PSBT funding shim from the funding shim of an
+// OpenChannelRequest, if any.
+func parsePsbtShim(shim *lnrpc.FundingShim) (*psbtShim, error) {
+	if shim == nil || shim.PsbtShim == nil {
+		return nil, nil
+	}
+
+	var pendingChanID [32]byte
+	switch len(shim.PsbtShim.PendingChanId) {
+	case 0:
+	case len(pendingChanID):
+		copy(pendingChanID[:], shim.PsbtShim.PendingChanId)
+	default:
+		return nil, fmt.Errorf("pending channel ID must be %v bytes",
+			len(pendingChanID))
+	}
+
+	return &psbtShim{
+		pendingChanID: pendingChanID,
+		noPublish:     shim.PsbtShim.NoPublish,
+	}, nil
+}
+
 // OpenChannel attempts to open a singly funded channel specified in the
 // request to a remote peer.
 func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
@@ -1064,6 +1274,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// If a funding shim was specified, then the channel won't be funded
+	// from our wallet, but from a PSBT handed to us by the caller.
+	shim, err := parsePsbtShim(in.FundingShim)
+	if err != nil {
+		return err
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -1078,6 +1295,8 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		remoteCsvDelay:    remoteCsvDelay,
 		minConfs:          minConfs,
 		remoteChanReserve: remoteChanReserve,
+		dualFund:          in.DualFund,
+		psbtShim:          shim,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1148,6 +1367,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 			"not active yet")
 	}
 
+	// Funding shims require the caller to take part in the funding
+	// workflow, which isn't possible within a synchronous call.
+	if in.FundingShim != nil {
+		return nil, fmt.Errorf("funding shims are only supported by " +
+			"the streaming OpenChannel call")
+	}
+
 	// Creation of channels before the wallet syncs up is currently
 	// disallowed.
 	isSynced, _, err := r.server.cc.wallet.IsSynced()
@@ -1229,6 +1455,7 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		remoteCsvDelay:    remoteCsvDelay,
 		minConfs:          minConfs,
 		remoteChanReserve: remoteChanReserve,
+		dualFund:          in.DualFund,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1285,6 +1512,236 @@ func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
 	return txid, nil
 }
 
+// FundingStateStep is an advanced funding related call that allows the caller
+// to progress the funding workflow of a channel opened with a funding shim.
+// The caller is able to verify a funded PSBT against the funding output of
+// the channel, hand over the signed PSBT or the final raw funding
+// transaction, or cancel the funding workflow altogether.
+func (r *rpcServer) FundingStateStep(ctx context.Context,
+	in *lnrpc.FundingTransitionMsg) (*lnrpc.FundingStateStepResp, error) {
+
+	parsePendingChanID := func(b []byte) ([32]byte, error) {
+		var pendingChanID [32]byte
+		if len(b) != len(pendingChanID) {
+			return pendingChanID, fmt.Errorf("pending channel ID "+
+				"must be %v bytes", len(pendingChanID))
+		}
+		copy(pendingChanID[:], b)
+		return pendingChanID, nil
+	}
+
+	switch {
+	case in.ShimCancel != nil:
+		pendingChanID, err := parsePendingChanID(
+			in.ShimCancel.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] canceling funding shim "+
+			"for pendingID(%x)", pendingChanID[:])
+
+		err = r.server.fundingMgr.CancelShim(pendingChanID)
+		if err != nil {
+			return nil, err
+		}
+
+	case in.PsbtVerify != nil:
+		pendingChanID, err := parsePendingChanID(
+			in.PsbtVerify.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		packet, err := psbt.NewFromRawBytes(
+			bytes.NewReader(in.PsbtVerify.FundedPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse PSBT: %v", err)
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] verifying PSBT for "+
+			"pendingID(%x)", pendingChanID[:])
+
+		err = r.server.fundingMgr.VerifyPsbt(pendingChanID, packet)
+		if err != nil {
+			return nil, err
+		}
+
+	case in.PsbtFinalize != nil:
+		pendingChanID, err := parsePendingChanID(
+			in.PsbtFinalize.PendingChanId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			packet  *psbt.Packet
+			finalTx *wire.MsgTx
+		)
+		switch {
+		case len(in.PsbtFinalize.SignedPsbt) != 0 &&
+			len(in.PsbtFinalize.FinalRawTx) != 0:
+
+			return nil, fmt.Errorf("only one of signed_psbt and " +
+				"final_raw_tx can be set")
+
+		case len(in.PsbtFinalize.SignedPsbt) != 0:
+			packet, err = psbt.NewFromRawBytes(
+				bytes.NewReader(in.PsbtFinalize.SignedPsbt),
+				false,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse PSBT: "+
+					"%v", err)
+			}
+
+		case len(in.PsbtFinalize.FinalRawTx) != 0:
+			finalTx = &wire.MsgTx{}
+			err := finalTx.Deserialize(
+				bytes.NewReader(in.PsbtFinalize.FinalRawTx),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse final "+
+					"tx: %v", err)
+			}
+
+		default:
+			return nil, fmt.Errorf("either signed_psbt or " +
+				"final_raw_tx must be set")
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] finalizing funding of "+
+			"pendingID(%x)", pendingChanID[:])
+
+		err = r.server.fundingMgr.FinalizePsbt(
+			pendingChanID, packet, finalTx,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("no funding state transition specified")
+	}
+
+	return &lnrpc.FundingStateStepResp{}, nil
+}
+
+// BatchOpenChannel attempts to open multiple singly funded channels to
+// different remote peers within a single funding transaction.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	rpcsLog.Tracef("[batchopenchannel] request to open %v channels",
+		len(in.Channels))
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, " +
+			"server not active yet")
+	}
+
+	if len(in.Channels) == 0 {
+		return nil, fmt.Errorf("no channels specified")
+	}
+
+	// The minimum number of confirmations and the fee rate apply to the
+	// single funding transaction of the batch.
+	minConfs, err := extractOpenChannelMinConfs(&lnrpc.OpenChannelRequest{
+		MinConfs:         in.MinConfs,
+		SpendUnconfirmed: in.SpendUnconfirmed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[batchopenchannel]: using fee of %v sat/kw for "+
+		"funding tx", int64(feeRate))
+
+	// Each channel of the batch is validated just like a channel opened
+	// on its own, and only a single channel per peer may be opened.
+	reqs := make([]*openChanReq, 0, len(in.Channels))
+	peers := make(map[string]struct{}, len(in.Channels))
+	for _, channel := range in.Channels {
+		localFundingAmt := btcutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := btcutil.Amount(channel.PushSat)
+		remoteChanReserve := btcutil.Amount(channel.RemoteChanReserveSat)
+
+		if remoteInitialBalance >= localFundingAmt {
+			return nil, fmt.Errorf("amount pushed to remote peer " +
+				"for initial state must be below the local " +
+				"funding amount")
+		}
+		if localFundingAmt > maxFundingAmount {
+			return nil, fmt.Errorf("funding amount is too large, "+
+				"the max channel size is: %v", maxFundingAmount)
+		}
+		if localFundingAmt < minChanFundingSize {
+			return nil, fmt.Errorf("channel is too small, the "+
+				"minimum channel size is: %v SAT",
+				int64(minChanFundingSize))
+		}
+		if remoteChanReserve >= localFundingAmt {
+			return nil, fmt.Errorf("remote channel reservation is " +
+				"too high, must be less than channel capacity")
+		}
+
+		if len(channel.NodePubkey) == 0 {
+			return nil, fmt.Errorf("NodePubKey is not set")
+		}
+		nodePubKey, err := btcec.ParsePubKey(
+			channel.NodePubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+			return nil, fmt.Errorf("cannot open channel to self")
+		}
+
+		nodePubKeyBytes := nodePubKey.SerializeCompressed()
+		if _, ok := peers[string(nodePubKeyBytes)]; ok {
+			return nil, fmt.Errorf("duplicate channel to "+
+				"NodeKey(%x) in batch", nodePubKeyBytes)
+		}
+		peers[string(nodePubKeyBytes)] = struct{}{}
+
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:      nodePubKey,
+			chainHash:         *activeNetParams.GenesisHash,
+			localFundingAmt:   localFundingAmt,
+			pushAmt:           lnwire.NewMSatFromSatoshis(remoteInitialBalance),
+			minHtlc:           lnwire.MilliSatoshi(channel.MinHtlcMsat),
+			private:           channel.Private,
+			remoteCsvDelay:    uint16(channel.RemoteCsvDelay),
+			remoteChanReserve: remoteChanReserve,
+		})
+	}
+
+	pendingChannels, err := r.server.BatchOpenChannel(
+		reqs, feeRate, minConfs,
+	)
+	if err != nil {
+		rpcsLog.Errorf("unable to open batch of %v channels: %v",
+			len(reqs), err)
+		return nil, err
+	}
+
+	return &lnrpc.BatchOpenChannelResponse{
+		PendingChannels: pendingChannels,
+	}, nil
+}
+
 // CloseChannel attempts to close an active channel identified by its channel
 // point. The actions of this method can additionally be augmented to attempt
 // a force close after a timeout period in the case of an inactive peer.
@@ -1416,6 +1873,45 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target sat/kw for closing transaction: %v",
 			int64(feeRate))
 
+		// If the caller capped the fee rate of the closing
+		// transaction, then a manually set target fee rate must not
+		// exceed it. An estimated one is instead clamped down to the
+		// maximum during negotiation.
+		var maxFeeRate lnwallet.SatPerKWeight
+		if in.MaxSatPerByte != 0 {
+			maxFeeRate = lnwallet.SatPerKVByte(
+				in.MaxSatPerByte * 1000,
+			).FeePerKWeight()
+			if in.SatPerByte > in.MaxSatPerByte {
+				return fmt.Errorf("fee rate of %v sat/byte "+
+					"exceeds max fee rate of %v sat/byte",
+					in.SatPerByte, in.MaxSatPerByte)
+			}
+		}
+
+		// If the caller specified an address to deliver our funds to,
+		// then we'll pay to it rather than to a fresh wallet address.
+		var deliveryScript []byte
+		if in.DeliveryAddress != "" {
+			addr, err := btcutil.DecodeAddress(
+				in.DeliveryAddress, activeNetParams.Params,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid delivery address: "+
+					"%v", err)
+			}
+			if !addr.IsForNet(activeNetParams.Params) {
+				return fmt.Errorf("delivery address %v is not "+
+					"for %v", in.DeliveryAddress,
+					activeNetParams.Params.Name)
+			}
+
+			deliveryScript, err = txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -1429,7 +1925,8 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// the htlc switch which will handle the negotiation and
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
-			chanPoint, htlcswitch.CloseRegular, feeRate,
+			chanPoint, htlcswitch.CloseRegular, feeRate, maxFeeRate,
+			deliveryScript,
 		)
 	}
 out:
@@ -1652,6 +2149,9 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		Alias:               nodeAnn.Alias.String(),
 		BestHeaderTimestamp: int64(bestHeaderTimestamp),
 		Version:             build.Version(),
+		Features: marshalFeatures(
+			r.server.featureMgr.Get(feature.SetNodeAnn),
+		),
 	}, nil
 }
 
@@ -1701,6 +2201,12 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			PingTime:  serverPeer.PingTime(),
 		}
 
+		// The peer's features are only known once we've received its
+		// init message.
+		if serverPeer.remoteFeatures != nil {
+			peer.Features = marshalFeatures(serverPeer.remoteFeatures)
+		}
+
 		resp.Peers = append(resp.Peers, peer)
 	}
 
@@ -1709,6 +2215,22 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// marshalFeatures converts a feature vector into its rpc representation,
+// naming the known feature bits.
+func marshalFeatures(fv *lnwire.FeatureVector) []*lnrpc.Feature {
+	var features []*lnrpc.Feature
+	for _, bit := range fv.Features() {
+		features = append(features, &lnrpc.Feature{
+			Bit:        uint32(bit),
+			Name:       lnwire.Features[bit],
+			IsRequired: bit%2 == 0,
+			IsKnown:    fv.IsKnown(bit),
+		})
+	}
+
+	return features
+}
+
 // SubscribePeers creates a subscription for peers connected/disconnected
 // Notifications. A notification will be dispatched for every peer that
 // becomes connected to this node or disconnected from it.
@@ -1735,6 +2257,206 @@ func (r *rpcServer) SubscribePeers(in *lnrpc.PeerSubscription,
 	}
 }
 
+// SendCustomMessage sends a custom message to a connected peer. The message
+// type must be within the custom range, and the peer's rate limit for custom
+// messages applies.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	in *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	if len(in.Peer) != 33 {
+		return nil, fmt.Errorf("peer must be a 33-byte public key")
+	}
+	if in.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %v exceeds the maximum "+
+			"of %v", in.Type, math.MaxUint16)
+	}
+
+	var peerPub [33]byte
+	copy(peerPub[:], in.Peer)
+
+	err := r.server.SendCustomMessage(
+		peerPub, lnwire.MessageType(in.Type), in.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[sendcustommessage] sent message of type %v to %x",
+		in.Type, in.Peer)
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// SubscribeCustomMessages creates a uni-directional stream over which the
+// custom messages received from any peer are sent.
+func (r *rpcServer) SubscribeCustomMessages(
+	in *lnrpc.SubscribeCustomMessagesRequest,
+	updateStream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	msgSub, err := r.server.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer msgSub.Cancel()
+
+	for {
+		select {
+		case update := <-msgSub.Updates():
+			msg, ok := update.(*CustomMessage)
+			if !ok {
+				continue
+			}
+
+			err := updateStream.Send(&lnrpc.CustomMessage{
+				Peer: msg.Peer[:],
+				Type: uint32(msg.Msg.Type),
+				Data: msg.Msg.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// HtlcInterceptor dispatches a bi-directional streaming RPC in which HTLCs
+// that are about to be forwarded are intercepted and handed to the client,
+// which responds with how to resolve each of them. Only one client can
+// intercept forwards at a time.
+func (r *rpcServer) HtlcInterceptor(
+	stream lnrpc.Lightning_HtlcInterceptorServer) error {
+
+	if !atomic.CompareAndSwapInt32(&r.interceptorActive, 0, 1) {
+		return errors.New("an htlc interceptor is already active")
+	}
+	defer atomic.StoreInt32(&r.interceptorActive, 0)
+
+	rpcsLog.Infof("Htlc interceptor connected")
+	defer rpcsLog.Infof("Htlc interceptor disconnected")
+
+	return newForwardInterceptor(r.server.htlcSwitch, stream).run(r.quit)
+}
+
+// SubscribeHtlcEvents creates a uni-directional stream over which the events
+// of all htlcs sent, received and forwarded by the node are sent.
+func (r *rpcServer) SubscribeHtlcEvents(in *lnrpc.SubscribeHtlcEventsRequest,
+	updateStream lnrpc.Lightning_SubscribeHtlcEventsServer) error {
+
+	htlcSub, err := r.server.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer htlcSub.Cancel()
+
+	for {
+		select {
+		case update := <-htlcSub.Updates():
+			event, err := rpcHtlcEvent(update)
+			if err != nil {
+				rpcsLog.Errorf("Unable to convert htlc event: %v",
+					err)
+				continue
+			}
+
+			if err := updateStream.Send(event); err != nil {
+				return err
+			}
+
+		case <-htlcSub.Quit():
+			return errors.New("htlc event subscription terminated")
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// rpcHtlcEvent converts an htlc event of the htlc notifier into its RPC
+// representation.
+func rpcHtlcEvent(update interface{}) (*lnrpc.HtlcEvent, error) {
+	var (
+		key       htlcswitch.HtlcKey
+		eventType htlcswitch.HtlcEventType
+		timestamp time.Time
+		rpcEvent  = &lnrpc.HtlcEvent{}
+	)
+
+	rpcHtlcInfo := func(info htlcswitch.HtlcInfo) *lnrpc.HtlcInfo {
+		return &lnrpc.HtlcInfo{
+			IncomingTimelock: info.IncomingTimeLock,
+			OutgoingTimelock: info.OutgoingTimeLock,
+			IncomingAmtMsat:  uint64(info.IncomingAmt),
+			OutgoingAmtMsat:  uint64(info.OutgoingAmt),
+		}
+	}
+
+	switch e := update.(type) {
+	case *htlcswitch.ForwardingEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.ForwardEvent = &lnrpc.ForwardEvent{
+			Info: rpcHtlcInfo(e.HtlcInfo),
+		}
+
+	case *htlcswitch.ForwardingFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.ForwardFailEvent = &lnrpc.ForwardFailEvent{}
+
+	case *htlcswitch.SettleEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.SettleEvent = &lnrpc.SettleEvent{}
+
+	case *htlcswitch.LinkFailEvent:
+		key, eventType, timestamp = e.HtlcKey, e.HtlcEventType, e.Timestamp
+		rpcEvent.LinkFailEvent = &lnrpc.LinkFailEvent{
+			Info:          rpcHtlcInfo(e.HtlcInfo),
+			FailureDetail: e.FailureDetail,
+			Incoming:      e.Incoming,
+		}
+		if e.FailureMessage != nil {
+			rpcEvent.LinkFailEvent.FailureCode = uint32(
+				e.FailureMessage.Code(),
+			)
+			rpcEvent.LinkFailEvent.FailureString =
+				e.FailureMessage.Error()
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event type: %T", update)
+	}
+
+	switch eventType {
+	case htlcswitch.HtlcEventTypeSend:
+		rpcEvent.EventType = lnrpc.HtlcEvent_SEND
+
+	case htlcswitch.HtlcEventTypeReceive:
+		rpcEvent.EventType = lnrpc.HtlcEvent_RECEIVE
+
+	case htlcswitch.HtlcEventTypeForward:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FORWARD
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event type: %v", eventType)
+	}
+
+	rpcEvent.IncomingChannelId = key.IncomingCircuit.ChanID.ToUint64()
+	rpcEvent.OutgoingChannelId = key.OutgoingCircuit.ChanID.ToUint64()
+	rpcEvent.IncomingHtlcId = key.IncomingCircuit.HtlcID
+	rpcEvent.OutgoingHtlcId = key.OutgoingCircuit.HtlcID
+	rpcEvent.TimestampNs = uint64(timestamp.UnixNano())
+
+	return rpcEvent, nil
+}
+
 func (r *rpcServer) createRPCPeerNotification(serverPeer *peer, connected bool) *lnrpc.PeerNotification {
 	nodePub := serverPeer.addr.IdentityKey.SerializeCompressed()
 	return &lnrpc.PeerNotification{
@@ -2159,12 +2881,19 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 		}
 
 		channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
-		var linkActive bool
+		var (
+			linkActive        bool
+			localFeeProposal  lnwallet.SatPerKWeight
+			remoteFeeProposal lnwallet.SatPerKWeight
+		)
 		if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
 			// A channel is only considered active if it is known
 			// by the switch *and* able to forward
 			// incoming/outgoing payments.
 			linkActive = link.EligibleToForward()
+
+			localFeeProposal, remoteFeeProposal =
+				link.CommitFeeProposals()
 		}
 
 		// Next, we'll determine whether we should add this channel to
@@ -2212,24 +2941,26 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 		externalCommitFee := dbChannel.Capacity - sumOutputs
 
 		channel := &lnrpc.Channel{
-			Active:                isActive,
-			Private:               !isPublic,
-			RemotePubkey:          nodeID,
-			ChannelPoint:          chanPoint.String(),
-			ChanId:                chanID,
-			Capacity:              int64(dbChannel.Capacity),
-			LocalBalance:          int64(localBalance.ToSatoshis()),
-			RemoteBalance:         int64(remoteBalance.ToSatoshis()),
-			CommitFee:             int64(externalCommitFee),
-			CommitWeight:          commitWeight,
-			FeePerKw:              int64(localCommit.FeePerKw),
-			TotalSatoshisSent:     int64(dbChannel.TotalMSatSent.ToSatoshis()),
-			TotalSatoshisReceived: int64(dbChannel.TotalMSatReceived.ToSatoshis()),
-			NumUpdates:            localCommit.CommitHeight,
-			PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
-			CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
-			LocalChanReserve:      int64(dbChannel.LocalChanCfg.ChanReserve),
-			RemoteChanReserve:     int64(dbChannel.RemoteChanCfg.ChanReserve),
+			Active:                 isActive,
+			Private:                !isPublic,
+			RemotePubkey:           nodeID,
+			ChannelPoint:           chanPoint.String(),
+			ChanId:                 chanID,
+			Capacity:               int64(dbChannel.Capacity),
+			LocalBalance:           int64(localBalance.ToSatoshis()),
+			RemoteBalance:          int64(remoteBalance.ToSatoshis()),
+			CommitFee:              int64(externalCommitFee),
+			CommitWeight:           commitWeight,
+			FeePerKw:               int64(localCommit.FeePerKw),
+			TotalSatoshisSent:      int64(dbChannel.TotalMSatSent.ToSatoshis()),
+			TotalSatoshisReceived:  int64(dbChannel.TotalMSatReceived.ToSatoshis()),
+			NumUpdates:             localCommit.CommitHeight,
+			PendingHtlcs:           make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
+			CsvDelay:               uint32(dbChannel.LocalChanCfg.CsvDelay),
+			LocalChanReserve:       int64(dbChannel.LocalChanCfg.ChanReserve),
+			RemoteChanReserve:      int64(dbChannel.RemoteChanCfg.ChanReserve),
+			LocalProposedFeePerKw:  int64(localFeeProposal),
+			RemoteProposedFeePerKw: int64(remoteFeeProposal),
 		}
 
 		for i, htlc := range localCommit.Htlcs {
@@ -2252,17 +2983,24 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	return resp, nil
 }
 
-// savePayment saves a successfully completed payment to the database for
-// historical record keeping.
-func (r *rpcServer) savePayment(route *routing.Route,
-	amount lnwire.MilliSatoshi, preImage []byte) error {
-
+// routePath returns the public keys of all hops along the passed route.
+func routePath(route *routing.Route) [][33]byte {
 	paymentPath := make([][33]byte, len(route.Hops))
 	for i, hop := range route.Hops {
 		hopPub := hop.PubKeyBytes
 		copy(paymentPath[i][:], hopPub[:])
 	}
 
+	return paymentPath
+}
+
+// savePayment saves a successfully completed payment to the database for
+// historical record keeping. If the payment was split into several shards,
+// each of the passed routes is recorded as a shard of the payment.
+func (r *rpcServer) savePayment(routes []*routing.Route,
+	amount lnwire.MilliSatoshi, preImage []byte) error {
+
+	route := routes[0]
 	payment := &channeldb.OutgoingPayment{
 		Invoice: channeldb.Invoice{
 			Terms: channeldb.ContractTerm{
@@ -2270,12 +3008,25 @@ func (r *rpcServer) savePayment(route *routing.Route,
 			},
 			CreationDate: time.Now(),
 		},
-		Path:           paymentPath,
+		Path:           routePath(route),
 		Fee:            route.TotalFees,
 		TimeLockLength: route.TotalTimeLock,
 	}
 	copy(payment.PaymentPreimage[:], preImage)
 
+	if len(routes) > 1 {
+		payment.Fee = 0
+		for _, shardRoute := range routes {
+			payment.Shards = append(payment.Shards, channeldb.PaymentShard{
+				Amount:         shardRoute.TotalAmount - shardRoute.TotalFees,
+				Fee:            shardRoute.TotalFees,
+				TimeLockLength: shardRoute.TotalTimeLock,
+				Path:           routePath(shardRoute),
+			})
+			payment.Fee += shardRoute.TotalFees
+		}
+	}
+
 	return r.server.chanDB.AddPayment(payment)
 }
 
@@ -2413,6 +3164,14 @@ type rpcPaymentIntent struct {
 	rHash      [32]byte
 	cltvDelta  uint16
 	routeHints [][]routing.HopHint
+	maxShards  uint32
+
+	keysendPreimage *[32]byte
+	paymentAddr     *[32]byte
+	destFeatures    *lnwire.FeatureVector
+
+	outgoingChannelID *uint64
+	lastHop           *routing.Vertex
 
 	routes []*routing.Route
 }
@@ -2446,10 +3205,29 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		return payIntent, nil
 	}
 
+	// Otherwise, we'll restrict the routes found for the payment to the
+	// outgoing channel and last hop, if any were specified.
+	if rpcPayReq.OutgoingChanId != 0 {
+		outgoingChannelID := rpcPayReq.OutgoingChanId
+		payIntent.outgoingChannelID = &outgoingChannelID
+	}
+	if len(rpcPayReq.LastHopPubkey) != 0 {
+		lastHop, err := parseLastHop(rpcPayReq.LastHopPubkey)
+		if err != nil {
+			return payIntent, err
+		}
+		payIntent.lastHop = lastHop
+	}
+
 	// If the payment request field isn't blank, then the details of the
 	// invoice are encoded entirely within the encoded payReq.  So we'll
 	// attempt to decode it, populating the payment accordingly.
 	if rpcPayReq.PaymentRequest != "" {
+		if rpcPayReq.Keysend {
+			return payIntent, fmt.Errorf("keysend payments can't " +
+				"be made to a payment request")
+		}
+
 		payReq, err := zpay32.Decode(
 			rpcPayReq.PaymentRequest, activeNetParams.Params,
 		)
@@ -2463,6 +3241,21 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 			return payIntent, err
 		}
 
+		// We can't pay invoices requiring features we don't know of.
+		if payReq.Features != nil {
+			unknown := payReq.Features.UnknownRequiredFeatures()
+			if len(unknown) > 0 {
+				return payIntent, fmt.Errorf("invoice requires "+
+					"unknown features: %v", unknown)
+			}
+
+			err := feature.ValidateDeps(payReq.Features)
+			if err != nil {
+				return payIntent, fmt.Errorf("invalid invoice "+
+					"features: %v", err)
+			}
+		}
+
 		// If the amount was not included in the invoice, then we let
 		// the payee specify the amount of satoshis they wish to send.
 		// We override the amount to pay with the amount provided from
@@ -2490,6 +3283,9 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.dest = payReq.Destination
 		payIntent.cltvDelta = uint16(payReq.MinFinalCLTVExpiry())
 		payIntent.routeHints = payReq.RouteHints
+		payIntent.maxShards = rpcPayReq.MaxShards
+		payIntent.paymentAddr = payReq.PaymentAddr
+		payIntent.destFeatures = payReq.Features
 
 		return payIntent, nil
 	}
@@ -2529,10 +3325,27 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	)
 
 	payIntent.cltvDelta = uint16(rpcPayReq.FinalCltvDelta)
+	payIntent.maxShards = rpcPayReq.MaxShards
 
 	// If the user is manually specifying payment details, then the payment
-	// hash may be encoded as a string.
+	// hash may be encoded as a string. For keysend payments, we'll pick
+	// the preimage ourselves and pay to its hash.
 	switch {
+	case rpcPayReq.Keysend:
+		if rpcPayReq.PaymentHashString != "" ||
+			len(rpcPayReq.PaymentHash) != 0 {
+
+			return payIntent, fmt.Errorf("payment hash must not " +
+				"be specified for keysend payments")
+		}
+
+		var preimage [32]byte
+		if _, err := rand.Read(preimage[:]); err != nil {
+			return payIntent, err
+		}
+		payIntent.keysendPreimage = &preimage
+		payIntent.rHash = sha256.Sum256(preimage[:])
+
 	case rpcPayReq.PaymentHashString != "":
 		paymentHash, err := hex.DecodeString(
 			rpcPayReq.PaymentHashString,
@@ -2570,6 +3383,7 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 
 type paymentIntentResponse struct {
 	Route          *routing.Route
+	Shards         []*routing.Route
 	Preimage       [32]byte
 	FailedAttempts []*routing.FailedPaymentAttempt
 	Err            error
@@ -2589,6 +3403,7 @@ func (r *rpcServer) dispatchPaymentIntent(
 	var (
 		preImage     [32]byte
 		route        *routing.Route
+		routes       []*routing.Route
 		routerErr    error
 		failedRoutes []*routing.FailedPaymentAttempt
 	)
@@ -2602,6 +3417,14 @@ func (r *rpcServer) dispatchPaymentIntent(
 			FeeLimit:    payIntent.feeLimit,
 			PaymentHash: payIntent.rHash,
 			RouteHints:  payIntent.routeHints,
+			MaxShards:   payIntent.maxShards,
+
+			KeysendPreimage: payIntent.keysendPreimage,
+			PaymentAddr:     payIntent.paymentAddr,
+			DestFeatures:    payIntent.destFeatures,
+
+			OutgoingChannelID: payIntent.outgoingChannelID,
+			LastHop:           payIntent.lastHop,
 		}
 
 		// If the final CLTV value was specified, then we'll use that
@@ -2610,9 +3433,12 @@ func (r *rpcServer) dispatchPaymentIntent(
 			payment.FinalCLTVDelta = &payIntent.cltvDelta
 		}
 
-		preImage, route, failedRoutes, routerErr = r.server.chanRouter.SendPayment(
+		preImage, routes, failedRoutes, routerErr = r.server.chanRouter.SendMultiPathPayment(
 			payment,
 		)
+		if routerErr == nil {
+			route = routes[0]
+		}
 	} else {
 		payment := &routing.LightningPayment{
 			PaymentHash: payIntent.rHash,
@@ -2621,6 +3447,7 @@ func (r *rpcServer) dispatchPaymentIntent(
 		preImage, route, failedRoutes, routerErr = r.server.chanRouter.SendToRoute(
 			payIntent.routes, payment,
 		)
+		routes = []*routing.Route{route}
 	}
 
 	// If the route failed, then we'll return a nil save err, but a non-nil
@@ -2643,7 +3470,7 @@ func (r *rpcServer) dispatchPaymentIntent(
 
 	// Save the completed payment to the database for record keeping
 	// purposes.
-	err := r.savePayment(route, amt, preImage[:])
+	err := r.savePayment(routes, amt, preImage[:])
 	if err != nil {
 		// We weren't able to save the payment, so we return the save
 		// err, but a nil routing err.
@@ -2652,10 +3479,26 @@ func (r *rpcServer) dispatchPaymentIntent(
 
 	return &paymentIntentResponse{
 		Route:    route,
+		Shards:   routes,
 		Preimage: preImage,
 	}, nil
 }
 
+// marshallShardRoutes converts the routes of a payment that was split into
+// several shards into their RPC representation. Nil is returned for payments
+// that were sent as a single HTLC.
+func (r *rpcServer) marshallShardRoutes(routes []*routing.Route) []*lnrpc.Route {
+	if len(routes) <= 1 {
+		return nil
+	}
+
+	shardRoutes := make([]*lnrpc.Route, len(routes))
+	for i, route := range routes {
+		shardRoutes[i] = r.marshallRoute(route)
+	}
+	return shardRoutes
+}
+
 func (r *rpcServer) marshalFaileRoutes(failedAttempts []*routing.FailedPaymentAttempt) []*lnrpc.RouteError {
 	failedRoutes := make([]*lnrpc.RouteError, len(failedAttempts))
 	for i, v := range failedAttempts {
@@ -2808,6 +3651,7 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				err := stream.send(&lnrpc.SendResponse{
 					PaymentPreimage: resp.Preimage[:],
 					PaymentRoute:    marshalledRouted,
+					ShardRoutes:     r.marshallShardRoutes(resp.Shards),
 				})
 				if err != nil {
 					errChan <- err
@@ -2897,6 +3741,43 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 	return &lnrpc.SendResponse{
 		PaymentPreimage: resp.Preimage[:],
 		PaymentRoute:    r.marshallRoute(resp.Route),
+		ShardRoutes:     r.marshallShardRoutes(resp.Shards),
+	}, nil
+}
+
+// Rebalance shifts balance from one of our channels to another by paying a
+// self-invoice over a circular route.
+func (r *rpcServer) Rebalance(ctx context.Context,
+	in *lnrpc.RebalanceRequest) (*lnrpc.RebalanceResponse, error) {
+
+	amt := btcutil.Amount(in.Amt)
+	if amt <= 0 {
+		return nil, fmt.Errorf("rebalance amount must be positive")
+	}
+
+	feeLimit := calculateFeeLimit(
+		in.FeeLimit, lnwire.NewMSatFromSatoshis(amt),
+	)
+
+	rpcsLog.Debugf("[rebalance] shifting %v from channel %v to "+
+		"channel %v, fee limit %v", amt, in.OutgoingChanId,
+		in.IncomingChanId, feeLimit)
+
+	result, err := r.rebalancer.Rebalance(&rebalance.Request{
+		OutgoingChanID: in.OutgoingChanId,
+		IncomingChanID: in.IncomingChanId,
+		Amount:         amt,
+		FeeLimit:       feeLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.RebalanceResponse{
+		PaymentHash:     result.PaymentHash[:],
+		PaymentPreimage: result.Preimage[:],
+		Route:           r.marshallRoute(result.Route),
+		FeeMsat:         int64(result.Fee),
 	}, nil
 }
 
@@ -2906,9 +3787,48 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
+	return r.addInvoice(invoice, nil)
+}
+
+// AddHoldInvoice adds a new invoice of which only the payment hash is known.
+// HTLCs paying to the invoice are held until it's either settled with its
+// preimage using SettleInvoice, or canceled using CancelInvoice.
+func (r *rpcServer) AddHoldInvoice(ctx context.Context,
+	req *lnrpc.AddHoldInvoiceRequest) (*lnrpc.AddInvoiceResponse, error) {
+
+	if len(req.Hash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly 32 "+
+			"bytes, is instead %v", len(req.Hash))
+	}
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.Hash)
+
+	invoice := &lnrpc.Invoice{
+		Memo:            req.Memo,
+		Value:           req.Value,
+		DescriptionHash: req.DescriptionHash,
+		Expiry:          req.Expiry,
+		FallbackAddr:    req.FallbackAddr,
+		CltvExpiry:      req.CltvExpiry,
+		Private:         req.Private,
+	}
+
+	return r.addInvoice(invoice, &paymentHash)
+}
+
+// addInvoice adds the invoice to the invoice database. If a hold hash is
+// passed, a hold invoice paying to it is added, of which the preimage isn't
+// known. Otherwise, the invoice pays to the hash of its preimage.
+func (r *rpcServer) addInvoice(invoice *lnrpc.Invoice,
+	holdHash *[32]byte) (*lnrpc.AddInvoiceResponse, error) {
+
 	var paymentPreimage [32]byte
 
 	switch {
+	// The preimage of hold invoices isn't known.
+	case holdHash != nil:
+
 	// If a preimage wasn't specified, then we'll generate a new preimage
 	// from fresh cryptographic randomness.
 	case len(invoice.RPreimage) == 0:
@@ -2959,9 +3879,13 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 			"payment allowed is %v", amt, maxPaymentMSat.ToSatoshis())
 	}
 
-	// Next, generate the payment hash itself from the preimage. This will
-	// be used by clients to query for the state of a particular invoice.
+	// Next, generate the payment hash itself from the preimage, unless
+	// it's a hold invoice. This will be used by clients to query for the
+	// state of a particular invoice.
 	rHash := sha256.Sum256(paymentPreimage[:])
+	if holdHash != nil {
+		rHash = *holdHash
+	}
 
 	// We also create an encoded payment request which allows the
 	// caller to compactly send the invoice to the payer. We'll create a
@@ -2970,6 +3894,23 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 	// expiry, fallback address, and the amount field.
 	var options []func(*zpay32.Invoice)
 
+	// The invoice advertises the features of our invoice feature set.
+	invoiceFeatures := r.server.featureMgr.Get(feature.SetInvoice)
+	options = append(options, zpay32.Features(invoiceFeatures))
+
+	// Unless disabled, each invoice gets a random payment address, which
+	// senders pass to us within the final hop's payload. This prevents
+	// intermediate nodes that learn the payment hash from probing for the
+	// invoice. Senders may only omit it if the feature is optional.
+	var paymentAddr [32]byte
+	paymentAddrRequired := invoiceFeatures.IsSet(lnwire.PaymentAddrRequired)
+	if invoiceFeatures.HasFeature(lnwire.PaymentAddrOptional) {
+		if _, err := rand.Read(paymentAddr[:]); err != nil {
+			return nil, err
+		}
+		options = append(options, zpay32.PaymentAddr(paymentAddr))
+	}
+
 	// We only include the amount in the invoice if it is greater than 0.
 	// By not including the amount, we enable the creation of invoices that
 	// allow the payee to specify the amount of satoshis they wish to send.
@@ -3190,6 +4131,8 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Terms: channeldb.ContractTerm{
 			Value: amtMSat,
 		},
+		PaymentAddr:         paymentAddr,
+		PaymentAddrRequired: paymentAddrRequired,
 	}
 	copy(newInvoice.Terms.PaymentPreimage[:], paymentPreimage[:])
 
@@ -3200,7 +4143,14 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 	)
 
 	// With all sanity checks passed, write the invoice to the database.
-	addIndex, err := r.server.invoices.AddInvoice(newInvoice)
+	var addIndex uint64
+	if holdHash != nil {
+		addIndex, err = r.server.invoices.AddHoldInvoice(
+			newInvoice, rHash,
+		)
+	} else {
+		addIndex, err = r.server.invoices.AddInvoice(newInvoice)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -3246,10 +4196,31 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 	// Convert between the `lnrpc` and `routing` types.
 	routeHints := createRPCRouteHints(decoded.RouteHints)
 
+	var paymentAddr []byte
+	var zeroAddr [32]byte
+	if invoice.PaymentAddr != zeroAddr {
+		paymentAddr = invoice.PaymentAddr[:]
+	}
+
 	preimage := invoice.Terms.PaymentPreimage
 	satAmt := invoice.Terms.Value.ToSatoshis()
 	satAmtPaid := invoice.AmtPaid.ToSatoshis()
 
+	var state lnrpc.Invoice_InvoiceState
+	switch invoice.Terms.State {
+	case channeldb.ContractOpen:
+		state = lnrpc.Invoice_OPEN
+	case channeldb.ContractSettled:
+		state = lnrpc.Invoice_SETTLED
+	case channeldb.ContractCanceled:
+		state = lnrpc.Invoice_CANCELED
+	case channeldb.ContractAccepted:
+		state = lnrpc.Invoice_ACCEPTED
+	default:
+		return nil, fmt.Errorf("unknown invoice state %v",
+			invoice.Terms.State)
+	}
+
 	return &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
 		Receipt:         invoice.Receipt[:],
@@ -3258,7 +4229,7 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		Value:           int64(satAmt),
 		CreationDate:    invoice.CreationDate.Unix(),
 		SettleDate:      settleDate,
-		Settled:         invoice.Terms.Settled,
+		Settled:         invoice.Terms.State == channeldb.ContractSettled,
 		PaymentRequest:  paymentRequest,
 		DescriptionHash: descHash,
 		Expiry:          expiry,
@@ -3271,6 +4242,8 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		AmtPaidSat:      int64(satAmtPaid),
 		AmtPaidMsat:     int64(invoice.AmtPaid),
 		AmtPaid:         int64(invoice.AmtPaid),
+		State:           state,
+		PaymentAddr:     paymentAddr,
 	}, nil
 }
 
@@ -3354,6 +4327,51 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 	return rpcInvoice, nil
 }
 
+// SettleInvoice settles an accepted hold invoice using the preimage of its
+// payment hash, settling all HTLCs held for it.
+func (r *rpcServer) SettleInvoice(ctx context.Context,
+	req *lnrpc.SettleInvoiceRequest) (*lnrpc.SettleInvoiceResponse, error) {
+
+	if len(req.Preimage) != 32 {
+		return nil, fmt.Errorf("payment preimage must be exactly 32 "+
+			"bytes, is instead %v", len(req.Preimage))
+	}
+
+	var preimage [32]byte
+	copy(preimage[:], req.Preimage)
+
+	rpcsLog.Debugf("[settleinvoice] settling invoice %x",
+		sha256.Sum256(preimage[:]))
+
+	if err := r.server.invoices.SettleHoldInvoice(preimage); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SettleInvoiceResponse{}, nil
+}
+
+// CancelInvoice cancels an open or accepted invoice, failing back all HTLCs
+// held for it.
+func (r *rpcServer) CancelInvoice(ctx context.Context,
+	req *lnrpc.CancelInvoiceRequest) (*lnrpc.CancelInvoiceResponse, error) {
+
+	if len(req.PaymentHash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly 32 "+
+			"bytes, is instead %v", len(req.PaymentHash))
+	}
+
+	var payHash chainhash.Hash
+	copy(payHash[:], req.PaymentHash)
+
+	rpcsLog.Debugf("[cancelinvoice] canceling invoice %v", payHash)
+
+	if err := r.server.invoices.CancelInvoice(payHash); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CancelInvoiceResponse{}, nil
+}
+
 // ListInvoices returns a list of all the invoices currently stored within the
 // database. Any active debug invoices are ignored.
 func (r *rpcServer) ListInvoices(ctx context.Context,
@@ -3427,6 +4445,16 @@ func (r *rpcServer) SubscribeInvoices(req *lnrpc.InvoiceSubscription,
 				return err
 			}
 
+		case updatedInvoice := <-invoiceClient.UpdatedInvoices:
+			rpcInvoice, err := createRPCInvoice(updatedInvoice)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(rpcInvoice); err != nil {
+				return err
+			}
+
 		case <-r.quit:
 			return nil
 		}
@@ -3770,6 +4798,23 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 
 	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
 
+	// If the routes are restricted to an outgoing channel or a last hop,
+	// we'll pass these restrictions on to the channel router.
+	var restrictions *routing.RouteRestrictions
+	if in.OutgoingChanId != 0 || len(in.LastHopPubkey) != 0 {
+		restrictions = &routing.RouteRestrictions{}
+	}
+	if in.OutgoingChanId != 0 {
+		outgoingChannelID := in.OutgoingChanId
+		restrictions.OutgoingChannelID = &outgoingChannelID
+	}
+	if len(in.LastHopPubkey) != 0 {
+		restrictions.LastHop, err = parseLastHop(in.LastHopPubkey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Query the channel router for a possible path to the destination that
 	// can carry `in.Amt` satoshis _including_ the total fee required on
 	// the route.
@@ -3778,13 +4823,14 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 		findErr error
 	)
 	if in.FinalCltvDelta == 0 {
-		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, uint32(in.NumRoutes),
+		routes, findErr = r.server.chanRouter.FindRestrictedRoutes(
+			pubKey, amtMSat, feeLimit, restrictions,
+			uint32(in.NumRoutes),
 		)
 	} else {
-		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, uint32(in.NumRoutes),
-			uint16(in.FinalCltvDelta),
+		routes, findErr = r.server.chanRouter.FindRestrictedRoutes(
+			pubKey, amtMSat, feeLimit, restrictions,
+			uint32(in.NumRoutes), uint16(in.FinalCltvDelta),
 		)
 	}
 	if findErr != nil {
@@ -3851,6 +4897,7 @@ func (r *rpcServer) marshallRoute(route *routing.Route) *lnrpc.Route {
 			Expiry:           uint32(hop.OutgoingTimeLock),
 			PubKey: hex.EncodeToString(
 				hop.PubKeyBytes[:]),
+			TlvPayload: hop.TLVPayload,
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -3858,6 +4905,166 @@ func (r *rpcServer) marshallRoute(route *routing.Route) *lnrpc.Route {
 	return resp
 }
 
+// QueryMissionControl returns the payment results observed by mission control,
+// which are used to estimate the success probabilities of routes during path
+// finding.
+func (r *rpcServer) QueryMissionControl(ctx context.Context,
+	in *lnrpc.QueryMissionControlRequest) (
+	*lnrpc.QueryMissionControlResponse, error) {
+
+	snapshot := r.server.chanRouter.QueryMissionControl()
+
+	resp := &lnrpc.QueryMissionControlResponse{
+		Nodes: make([]*lnrpc.NodeHistory, 0, len(snapshot.Nodes)),
+		Pairs: make([]*lnrpc.PairHistory, 0, len(snapshot.Pairs)),
+	}
+
+	for _, node := range snapshot.Nodes {
+		resp.Nodes = append(resp.Nodes, &lnrpc.NodeHistory{
+			Pubkey:       hex.EncodeToString(node.Node[:]),
+			LastFailTime: node.LastFail.Unix(),
+		})
+	}
+
+	for _, pair := range snapshot.Pairs {
+		resp.Pairs = append(resp.Pairs, marshallPairHistory(&pair))
+	}
+
+	return resp, nil
+}
+
+// QueryProbability returns the estimated success probability of forwarding a
+// payment of the given amount from one node to another, along with the last
+// payment result observed for the pair of nodes.
+func (r *rpcServer) QueryProbability(ctx context.Context,
+	in *lnrpc.QueryProbabilityRequest) (*lnrpc.QueryProbabilityResponse,
+	error) {
+
+	fromNode, err := parseVertex(in.FromNode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from node: %v", err)
+	}
+	toNode, err := parseVertex(in.ToNode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to node: %v", err)
+	}
+
+	if in.AmtMsat < 0 {
+		return nil, fmt.Errorf("amount must not be negative")
+	}
+	amt := lnwire.MilliSatoshi(in.AmtMsat)
+
+	probability, pair := r.server.chanRouter.QueryProbability(
+		fromNode, toNode, amt,
+	)
+
+	resp := &lnrpc.QueryProbabilityResponse{
+		Probability: probability,
+	}
+	if pair != nil {
+		resp.History = marshallPairHistory(pair)
+	}
+
+	return resp, nil
+}
+
+// EstimateRouteFee probes the candidate routes to a destination, in order of
+// increasing fees, by sending HTLCs with a random payment hash along them, and
+// returns the cheapest route that was probed successfully along with its fee.
+func (r *rpcServer) EstimateRouteFee(ctx context.Context,
+	in *lnrpc.EstimateRouteFeeRequest) (*lnrpc.EstimateRouteFeeResponse,
+	error) {
+
+	pubKeyBytes, err := hex.DecodeString(in.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	amt := btcutil.Amount(in.Amt)
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	if amtMSat > maxPaymentMSat {
+		return nil, fmt.Errorf("payment of %v is too large, max payment "+
+			"allowed is %v", amt, maxPaymentMSat.ToSatoshis())
+	}
+
+	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
+
+	numRoutes := uint32(defaultProbeRoutes)
+	if in.NumRoutes > 0 {
+		numRoutes = uint32(in.NumRoutes)
+	}
+
+	var (
+		route        *routing.Route
+		failedRoutes []*routing.FailedPaymentAttempt
+	)
+	if in.FinalCltvDelta == 0 {
+		route, failedRoutes, err = r.server.chanRouter.ProbePayment(
+			pubKey, amtMSat, feeLimit, numRoutes,
+		)
+	} else {
+		route, failedRoutes, err = r.server.chanRouter.ProbePayment(
+			pubKey, amtMSat, feeLimit, numRoutes,
+			uint16(in.FinalCltvDelta),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.EstimateRouteFeeResponse{
+		Route:           r.marshallRoute(route),
+		Fee:             int64(route.TotalFees.ToSatoshis()),
+		FeeMsat:         int64(route.TotalFees),
+		NumFailedProbes: int32(len(failedRoutes)),
+	}, nil
+}
+
+// parseVertex parses a hex-encoded compressed public key into a vertex.
+func parseVertex(pubKeyStr string) (routing.Vertex, error) {
+	pubKeyBytes, err := hex.DecodeString(pubKeyStr)
+	if err != nil {
+		return routing.Vertex{}, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return routing.Vertex{}, err
+	}
+
+	return routing.NewVertex(pubKey), nil
+}
+
+// parseLastHop parses the serialized public key of the last hop a payment is
+// restricted to.
+func parseLastHop(pubKeyBytes []byte) (*routing.Vertex, error) {
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	lastHop := routing.NewVertex(pubKey)
+	return &lastHop, nil
+}
+
+// marshallPairHistory converts the last result of a node pair, as observed by
+// mission control, into its rpc representation.
+func marshallPairHistory(
+	pair *routing.MissionControlPairSnapshot) *lnrpc.PairHistory {
+
+	return &lnrpc.PairHistory{
+		NodeFrom:    hex.EncodeToString(pair.FromNode[:]),
+		NodeTo:      hex.EncodeToString(pair.ToNode[:]),
+		Timestamp:   pair.Timestamp.Unix(),
+		AmtMsat:     int64(pair.Amount),
+		Success:     pair.Success,
+		SuccessProb: pair.SuccessProb,
+	}
+}
+
 // unmarshallHopByChannelLookup unmarshalls an rpc hop for which the pub key is
 // not known. This function will query the channel graph with channel id to
 // retrieve both endpoints and determine the hop pubkey using the previous hop
@@ -3887,6 +5094,7 @@ func unmarshallHopByChannelLookup(graph *channeldb.ChannelGraph, hop *lnrpc.Hop,
 		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
 		PubKeyBytes:      pubKeyBytes,
 		ChannelID:        edgeInfo.ChannelID,
+		TLVPayload:       hop.TlvPayload,
 	}, nil
 }
 
@@ -3907,6 +5115,7 @@ func unmarshallKnownPubkeyHop(hop *lnrpc.Hop) (*routing.Hop, error) {
 		AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForwardMsat),
 		PubKeyBytes:      pubKeyBytes,
 		ChannelID:        hop.ChanId,
+		TLVPayload:       hop.TlvPayload,
 	}, nil
 }
 
@@ -4263,6 +5472,154 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 	return &lnrpc.DeleteAllPaymentsResponse{}, nil
 }
 
+// TrackPayment returns a uni-directional stream (server -> client) of the
+// status of an outgoing payment, along with every attempt made to send it. The
+// current state of the payment is sent first, followed by an update each time
+// it changes, until the payment succeeded or failed.
+func (r *rpcServer) TrackPayment(req *lnrpc.TrackPaymentRequest,
+	updateStream lnrpc.Lightning_TrackPaymentServer) error {
+
+	if len(req.PaymentHash) != 32 {
+		return fmt.Errorf("payment hash must be exactly 32 bytes, is "+
+			"instead %v", len(req.PaymentHash))
+	}
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.PaymentHash)
+
+	rpcsLog.Debugf("[trackpayment] payment_hash=%x", paymentHash)
+
+	subscription, err := r.server.paymentControl.SubscribePayment(
+		paymentHash,
+	)
+	if err != nil {
+		return err
+	}
+	defer subscription.Cancel()
+
+	for {
+		select {
+		case history := <-subscription.Updates:
+			update, err := r.createRPCPaymentUpdate(
+				paymentHash, history,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+
+			// Once the payment succeeded or failed, there's
+			// nothing left to track.
+			switch history.Status {
+			case channeldb.StatusCompleted, channeldb.StatusFailed:
+				return nil
+			}
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// createRPCPaymentUpdate converts the persisted history of a payment to its
+// RPC representation.
+func (r *rpcServer) createRPCPaymentUpdate(paymentHash [32]byte,
+	history *channeldb.PaymentHistory) (*lnrpc.PaymentUpdate, error) {
+
+	var status lnrpc.PaymentUpdate_PaymentStatus
+	switch history.Status {
+	case channeldb.StatusGrounded:
+		status = lnrpc.PaymentUpdate_GROUNDED
+	case channeldb.StatusInFlight:
+		status = lnrpc.PaymentUpdate_IN_FLIGHT
+	case channeldb.StatusCompleted:
+		status = lnrpc.PaymentUpdate_SUCCEEDED
+	case channeldb.StatusFailed:
+		status = lnrpc.PaymentUpdate_FAILED
+	default:
+		return nil, fmt.Errorf("unknown payment status %v",
+			history.Status)
+	}
+
+	var reason lnrpc.PaymentUpdate_FailureReason
+	switch history.FailureReason {
+	case channeldb.FailureReasonNone:
+		reason = lnrpc.PaymentUpdate_FAILURE_REASON_NONE
+	case channeldb.FailureReasonTimeout:
+		reason = lnrpc.PaymentUpdate_FAILURE_REASON_TIMEOUT
+	case channeldb.FailureReasonNoRoute:
+		reason = lnrpc.PaymentUpdate_FAILURE_REASON_NO_ROUTE
+	case channeldb.FailureReasonError:
+		reason = lnrpc.PaymentUpdate_FAILURE_REASON_ERROR
+	case channeldb.FailureReasonIncorrectPaymentDetails:
+		reason = lnrpc.PaymentUpdate_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS
+	default:
+		return nil, fmt.Errorf("unknown payment failure reason %v",
+			history.FailureReason)
+	}
+
+	update := &lnrpc.PaymentUpdate{
+		PaymentHash:   paymentHash[:],
+		Status:        status,
+		FailureReason: reason,
+		Attempts: make(
+			[]*lnrpc.PaymentAttempt, len(history.Attempts),
+		),
+	}
+
+	for i, attempt := range history.Attempts {
+		var state lnrpc.PaymentAttempt_AttemptState
+		switch attempt.State {
+		case channeldb.AttemptInFlight:
+			state = lnrpc.PaymentAttempt_IN_FLIGHT
+		case channeldb.AttemptSucceeded:
+			state = lnrpc.PaymentAttempt_SUCCEEDED
+		case channeldb.AttemptFailed:
+			state = lnrpc.PaymentAttempt_FAILED
+		default:
+			return nil, fmt.Errorf("unknown payment attempt state "+
+				"%v", attempt.State)
+		}
+
+		route := &routing.Route{
+			TotalTimeLock: attempt.TotalTimeLock,
+			TotalFees:     attempt.TotalFees,
+			TotalAmount:   attempt.TotalAmount,
+			Hops:          make([]*routing.Hop, len(attempt.Hops)),
+		}
+		for j, hop := range attempt.Hops {
+			route.Hops[j] = &routing.Hop{
+				PubKeyBytes:      hop.PubKey,
+				ChannelID:        hop.ChannelID,
+				OutgoingTimeLock: hop.OutgoingTimeLock,
+				AmtToForward:     hop.AmtToForward,
+			}
+		}
+
+		var resolveTime int64
+		if !attempt.ResolveTime.IsZero() {
+			resolveTime = attempt.ResolveTime.UnixNano()
+		}
+
+		update.Attempts[i] = &lnrpc.PaymentAttempt{
+			AttemptId:          attempt.AttemptID,
+			State:              state,
+			Route:              r.marshallRoute(route),
+			AttemptTimeNs:      attempt.AttemptTime.UnixNano(),
+			ResolveTimeNs:      resolveTime,
+			FailureSourceIndex: attempt.FailureSourceIndex,
+			FailureCode:        uint32(attempt.FailureCode),
+		}
+	}
+
+	return update, nil
+}
+
 // DebugLevel allows a caller to programmatically set the logging verbosity of
 // lnd. The logging can be targeted according to a coarse daemon-wide logging
 // level, or in a granular fashion to specify the logging for a target
@@ -4328,6 +5685,11 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 	// Convert between the `lnrpc` and `routing` types.
 	routeHints := createRPCRouteHints(payReq.RouteHints)
 
+	var paymentAddr []byte
+	if payReq.PaymentAddr != nil {
+		paymentAddr = payReq.PaymentAddr[:]
+	}
+
 	amt := int64(0)
 	if payReq.MilliSat != nil {
 		amt = int64(payReq.MilliSat.ToSatoshis())
@@ -4345,6 +5707,7 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		Expiry:          expiry,
 		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
 		RouteHints:      routeHints,
+		PaymentAddr:     paymentAddr,
 	}, nil
 }
 
@@ -4595,6 +5958,78 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// UpdateCommitFeePolicy overrides the policy governing the commitment fee rate
+// we propose for a particular channel we initiated. Only the non-zero fields of
+// the request, and the ones flagged as specified, override the current policy.
+func (r *rpcServer) UpdateCommitFeePolicy(ctx context.Context,
+	req *lnrpc.CommitFeePolicyUpdateRequest) (
+	*lnrpc.CommitFeePolicyUpdateResponse, error) {
+
+	if req.ChanPoint == nil {
+		return nil, fmt.Errorf("chan_point must be set")
+	}
+	txidHash, err := getChanPointFundingTxid(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChanPoint.OutputIndex,
+	}
+
+	if req.MinFeePerKw < 0 || req.MaxFeePerKw < 0 {
+		return nil, fmt.Errorf("fee rates must be non-negative")
+	}
+	if req.MaxFeePerKw != 0 && req.MaxFeePerKw < req.MinFeePerKw {
+		return nil, fmt.Errorf("max fee rate of %v sat/kw is below min "+
+			"fee rate of %v sat/kw", req.MaxFeePerKw,
+			req.MinFeePerKw)
+	}
+
+	// Only the initiator of a channel proposes its commitment fee rate, so
+	// we'll refuse to set a policy that would never be used.
+	dbChan, err := r.fetchOpenDbChannel(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+	if !dbChan.IsInitiator {
+		return nil, fmt.Errorf("ChannelPoint(%v) wasn't initiated by "+
+			"us, so we don't propose its commitment fee rate",
+			chanPoint)
+	}
+
+	override := &channeldb.CommitFeePolicyOverride{}
+	if req.ConfTarget != 0 || req.ConfTargetSpecified {
+		override.ConfTarget = &req.ConfTarget
+	}
+	if req.MinFeePerKw != 0 || req.MinFeePerKwSpecified {
+		minFeePerKw := uint64(req.MinFeePerKw)
+		override.MinFeePerKw = &minFeePerKw
+	}
+	if req.MaxFeePerKw != 0 || req.MaxFeePerKwSpecified {
+		maxFeePerKw := uint64(req.MaxFeePerKw)
+		override.MaxFeePerKw = &maxFeePerKw
+	}
+	if req.Hysteresis != 0 || req.HysteresisSpecified {
+		override.Hysteresis = &req.Hysteresis
+	}
+
+	rpcsLog.Debugf("[updatecommitfeepolicy] updating commitment fee "+
+		"policy of ChannelPoint(%v): %v", chanPoint,
+		spew.Sdump(override))
+
+	err = r.server.UpdateCommitFeePolicy(chanPoint, override)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CommitFeePolicyUpdateResponse{}, nil
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -22,6 +22,7 @@ import (
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/contractcourt"
 	"github.com/breez/lightninglib/discovery"
+	"github.com/breez/lightninglib/feature"
 	"github.com/breez/lightninglib/htlcswitch"
 	"github.com/breez/lightninglib/lncfg"
 	"github.com/breez/lightninglib/lnpeer"
@@ -30,12 +31,14 @@ import (
 	"github.com/breez/lightninglib/lnwire"
 	"github.com/breez/lightninglib/nat"
 	"github.com/breez/lightninglib/routing"
+	"github.com/breez/lightninglib/subscribe"
 	"github.com/breez/lightninglib/sweep"
 	"github.com/breez/lightninglib/ticker"
 	"github.com/breez/lightninglib/tor"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
@@ -114,6 +117,10 @@ type server struct {
 
 	backupNotifier *BackupNotifier
 
+	customMessageNotifier *CustomMessageNotifier
+
+	htlcNotifier *htlcswitch.HtlcNotifier
+
 	inboundPeers  map[string]*peer
 	outboundPeers map[string]*peer
 
@@ -146,6 +153,10 @@ type server struct {
 
 	htlcSwitch *htlcswitch.Switch
 
+	// paymentControl tracks the status and attempts of our outgoing
+	// payments. It's shared by the switch and the router.
+	paymentControl htlcswitch.ControlTower
+
 	invoices *invoiceRegistry
 
 	witnessBeacon contractcourt.WitnessBeacon
@@ -166,9 +177,9 @@ type server struct {
 
 	sigPool *lnwallet.SigPool
 
-	// globalFeatures feature vector which affects HTLCs and thus are also
-	// advertised to other nodes.
-	globalFeatures *lnwire.FeatureVector
+	// featureMgr dispatches feature vectors for various contexts within
+	// the daemon.
+	featureMgr *feature.Manager
 
 	// currentNodeAnn is the node announcement that has been broadcast to
 	// the network upon startup, if the attributes of the node (us) has
@@ -250,7 +261,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		}
 	}
 
-	globalFeatures := lnwire.NewRawFeatureVector()
+	// We only signal support for dual funded channels if we're willing to
+	// contribute funds to the channels our peers open.
+	featureMgr, err := feature.NewManager(feature.Config{
+		NoTLVOnion:        cfg.Protocol.NoTLVOnion,
+		NoPaymentAddr:     cfg.Protocol.NoPaymentAddr,
+		NoMPP:             cfg.Protocol.NoMPP,
+		NoDualFund:        cfg.MaxDualFundContribution == 0,
+		NoGossipQueriesEx: cfg.Protocol.NoGossipQueriesEx,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	var serializedPubKey [33]byte
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
@@ -267,7 +289,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		cc:      cc,
 		sigPool: lnwallet.NewSigPool(runtime.NumCPU()*2, cc.signer),
 
-		invoices: newInvoiceRegistry(chanDB),
+		invoices: newInvoiceRegistry(
+			chanDB, cc.chainNotifier, cfg.InvoiceRetention,
+		),
 
 		identityPriv: privKey,
 		nodeSigner:   newNodeSigner(privKey),
@@ -278,7 +302,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		// schedule
 		sphinx: htlcswitch.NewOnionProcessor(sphinxRouter),
 
-		backupNotifier: NewBackupNotifier(),
+		backupNotifier:        NewBackupNotifier(),
+		customMessageNotifier: NewCustomMessageNotifier(),
+		htlcNotifier:          htlcswitch.NewHtlcNotifier(),
 
 		persistentPeers:         make(map[string]struct{}),
 		persistentPeersBackoff:  make(map[string]time.Duration),
@@ -294,9 +320,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		peerSubscriptions:      make(map[uint]*PeerSubscription),
 		sentDisabled:           make(map[wire.OutPoint]bool),
 
-		globalFeatures: lnwire.NewFeatureVector(globalFeatures,
-			lnwire.GlobalFeatures),
-		quit: make(chan struct{}),
+		featureMgr: featureMgr,
+		quit:       make(chan struct{}),
 	}
 
 	s.witnessBeacon = &preimageBeacon{
@@ -320,8 +345,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	s.paymentControl = htlcswitch.NewPaymentControl(false, chanDB)
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:      chanDB,
+		Control: s.paymentControl,
 		SelfKey: s.identityPriv.PubKey(),
 		LocalChannelClose: func(pubKey []byte,
 			request *htlcswitch.ChanClose) {
@@ -354,6 +382,14 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		HtlcNotifier: s.htlcNotifier,
+		ForwardLimits: htlcswitch.ForwardLimits{
+			MaxPeerForwards: cfg.MaxPeerForwards,
+			MaxPeerForwardValue: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(cfg.MaxPeerForwardValue),
+			),
+			MaxForwardHoldTime: cfg.MaxForwardHoldTime,
+		},
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -476,7 +512,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		LastUpdate:           time.Now(),
 		Addresses:            selfAddrs,
 		Alias:                nodeAlias.String(),
-		Features:             s.globalFeatures,
+		Features:             s.featureMgr.Get(feature.SetNodeAnn),
 		Color:                color,
 	}
 	copy(selfNode.PubKeyBytes[:], privKey.PubKey().SerializeCompressed())
@@ -532,6 +568,19 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				firstHop, htlcAdd, errorDecryptor,
 			)
 		},
+		SendShardToSwitch: func(firstHop lnwire.ShortChannelID,
+			htlcAdd *lnwire.UpdateAddHTLC,
+			circuit *sphinx.Circuit) ([32]byte, error) {
+
+			errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+				OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+			}
+
+			return s.htlcSwitch.SendHTLCShard(
+				firstHop, htlcAdd, errorDecryptor,
+			)
+		},
+		Control:            s.paymentControl,
 		ChannelPruneExpiry: time.Duration(time.Hour * 24 * 14),
 		GraphPruneInterval: time.Duration(time.Hour),
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
@@ -629,7 +678,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -903,6 +952,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval: 1 * time.Minute,
 		ReservationTimeout:    10 * time.Minute,
 		MinChanSize:           btcutil.Amount(cfg.MinChanSize),
+		DualFundContribution: func(remoteAmt btcutil.Amount) btcutil.Amount {
+			// By default, we'll match the contribution of the
+			// remote party, up to our configured maximum.
+			maxAmt := btcutil.Amount(cfg.MaxDualFundContribution)
+			if remoteAmt > maxAmt {
+				return maxAmt
+			}
+
+			return remoteAmt
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -999,6 +1058,16 @@ func (s *server) Start() error {
 		return err
 	}
 	cleanup = cleanup.add(s.backupNotifier.Stop)
+	if err := s.customMessageNotifier.Start(); err != nil {
+		cleanup.run()
+		return err
+	}
+	cleanup = cleanup.add(s.customMessageNotifier.Stop)
+	if err := s.htlcNotifier.Start(); err != nil {
+		cleanup.run()
+		return err
+	}
+	cleanup = cleanup.add(s.htlcNotifier.Stop)
 	if err := s.sphinx.Start(); err != nil {
 		cleanup.run()
 		return err
@@ -1110,8 +1179,10 @@ func (s *server) Stop() error {
 	s.sigPool.Stop()
 	s.cc.chainNotifier.Stop()
 	s.backupNotifier.Stop()
+	s.customMessageNotifier.Stop()
 	s.chanRouter.Stop()
 	s.htlcSwitch.Stop()
+	s.htlcNotifier.Stop()
 	s.sphinx.Stop()
 	s.utxoNursery.Stop()
 	s.breachArbiter.Stop()
@@ -1615,7 +1686,7 @@ func (s *server) initTorController() error {
 		Addresses:            newNodeAnn.Addresses,
 		Alias:                newNodeAnn.Alias.String(),
 		Features: lnwire.NewFeatureVector(
-			newNodeAnn.Features, lnwire.GlobalFeatures,
+			newNodeAnn.Features, lnwire.Features,
 		),
 		Color:        newNodeAnn.RGBColor,
 		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
@@ -2073,6 +2144,89 @@ func (s *server) findPeerByPubStr(pubStr string) (*peer, error) {
 	return peer, nil
 }
 
+// SendCustomMessage sends a custom message of the given type carrying the
+// passed data to the connected peer with the given public key. The type must
+// be within the custom range, and the peer's rate limit for custom messages
+// applies.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
+	data []byte) error {
+
+	msg, err := lnwire.NewCustom(msgType, data)
+	if err != nil {
+		return err
+	}
+
+	targetPeer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	return targetPeer.SendCustomMessage(msg)
+}
+
+// SubscribeCustomMessages returns a subscribe.Client that will receive a
+// CustomMessage for every custom message received from any of our peers.
+func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
+	return s.customMessageNotifier.SubscribeCustomMessages()
+}
+
+// SubscribeHtlcEvents returns a subscribe.Client that will receive the htlc
+// events of the switch and its links.
+func (s *server) SubscribeHtlcEvents() (*subscribe.Client, error) {
+	return s.htlcNotifier.SubscribeHtlcEvents()
+}
+
+// commitFeePolicy returns the commitment fee policy of the passed channel. The
+// configured policy depends on whether the channel is private, and may be
+// overridden for the individual channel.
+func (s *server) commitFeePolicy(chanPoint wire.OutPoint,
+	private bool) htlcswitch.CommitFeePolicy {
+
+	policy := htlcswitch.CommitFeePolicy{
+		ConfTarget: cfg.CommitFee.ConfTarget,
+		MinFeeRate: lnwallet.SatPerKWeight(cfg.CommitFee.MinFeeRate),
+		MaxFeeRate: lnwallet.SatPerKWeight(cfg.CommitFee.MaxFeeRate),
+		Hysteresis: cfg.CommitFee.Hysteresis,
+	}
+	if private {
+		policy.ConfTarget = cfg.CommitFee.PrivateConfTarget
+	}
+
+	override, err := s.chanDB.FetchCommitFeePolicyOverride(&chanPoint)
+	if err != nil {
+		srvrLog.Errorf("Unable to fetch commitment fee policy override "+
+			"of ChannelPoint(%v): %v", chanPoint, err)
+		return policy
+	}
+	policy.Merge(override)
+
+	return policy
+}
+
+// UpdateCommitFeePolicy overrides the commitment fee policy of the passed
+// channel with the fields set in the passed override. The override is
+// persisted, and if the channel is active, its link uses the new policy right
+// away.
+func (s *server) UpdateCommitFeePolicy(chanPoint wire.OutPoint,
+	override *channeldb.CommitFeePolicyOverride) error {
+
+	_, err := s.chanDB.UpdateCommitFeePolicyOverride(&chanPoint, override)
+	if err != nil {
+		return err
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+	link, err := s.htlcSwitch.GetLink(chanID)
+	if err != nil {
+		return nil
+	}
+	link.UpdateCommitFeePolicy(override)
+
+	return nil
+}
+
 // nextPeerBackoff computes the next backoff duration for a peer's pubkey using
 // exponential backoff. If no previous backoff was known, the default is
 // returned.
@@ -2472,18 +2626,17 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		ChainNet:    activeNetParams.Net,
 	}
 
-	// With the brontide connection established, we'll now craft the local
-	// feature vector to advertise to the remote node.
-	localFeatures := lnwire.NewRawFeatureVector()
-
-	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
-	localFeatures.Set(lnwire.DataLossProtectOptional)
-	localFeatures.Set(lnwire.GossipQueriesOptional)
+	// With the brontide connection established, we'll now craft the
+	// feature vectors to advertise to the remote node.
+	initFeatures := s.featureMgr.Get(feature.SetInit)
+	legacyFeatures := s.featureMgr.Get(feature.SetLegacyGlobal)
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
-	p, err := newPeer(conn, connReq, s, peerAddr, inbound, localFeatures)
+	p, err := newPeer(
+		conn, connReq, s, peerAddr, inbound, initFeatures,
+		legacyFeatures,
+	)
 	if err != nil {
 		srvrLog.Errorf("unable to create peer %v", err)
 		return
@@ -2804,6 +2957,14 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// dualFund indicates whether we'd like the remote party to contribute
+	// funds to the channel as well.
+	dualFund bool
+
+	// psbtShim, if set, indicates that the channel is funded from an
+	// externally signed PSBT, rather than from our wallet.
+	psbtShim *psbtShim
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2948,8 +3109,13 @@ func (s *server) OpenChannel(
 
 	// The updateChan will have a buffer of 2, since we expect a ChanPending
 	// + a ChanOpen update, and we want to make sure the funding process is
-	// not blocked if the caller is not reading the updates.
-	req.updates = make(chan *lnrpc.OpenStatusUpdate, 2)
+	// not blocked if the caller is not reading the updates. Channels
+	// funded from a PSBT will also receive a PsbtFund update beforehand.
+	numUpdates := 2
+	if req.psbtShim != nil {
+		numUpdates++
+	}
+	req.updates = make(chan *lnrpc.OpenStatusUpdate, numUpdates)
 	req.err = make(chan error, 1)
 
 	// First attempt to locate the target peer to open a channel with, if
@@ -2965,6 +3131,26 @@ func (s *server) OpenChannel(
 	}
 	s.mu.RUnlock()
 
+	// We can only request a dual funded channel if the remote peer
+	// understands the dual funder workflow.
+	if req.dualFund {
+		if !peer.remoteLocalFeatures.HasFeature(lnwire.DualFundOptional) {
+			req.err <- fmt.Errorf("peer %x doesn't support dual "+
+				"funded channels", pubKeyBytes)
+			return req.updates, req.err
+		}
+		if req.pushAmt != 0 {
+			req.err <- fmt.Errorf("dual funded channels can't " +
+				"have a push amount")
+			return req.updates, req.err
+		}
+		if req.psbtShim != nil {
+			req.err <- fmt.Errorf("dual funded channels can't " +
+				"be funded from a PSBT")
+			return req.updates, req.err
+		}
+	}
+
 	// If the fee rate wasn't specified, then we'll use a default
 	// confirmation target.
 	if req.fundingFeePerKw == 0 {
@@ -2986,6 +3172,235 @@ func (s *server) OpenChannel(
 	return req.updates, req.err
 }
 
+// batchChannel tracks the funding workflow of a single channel opened as part
+// of a batch.
+type batchChannel struct {
+	req     *openChanReq
+	updates chan *lnrpc.OpenStatusUpdate
+	err     chan error
+
+	// reserved indicates whether the remote peer accepted the channel,
+	// leaving a reservation behind that must be canceled if the batch
+	// fails.
+	reserved bool
+
+	// fundingOutput is the output the batch funding transaction must pay
+	// to, known once the remote peer accepted the channel.
+	fundingOutput *wire.TxOut
+
+	// finalized indicates whether the funding workflow has been resumed
+	// with the batch funding transaction.
+	finalized bool
+}
+
+// BatchOpenChannel opens channels to multiple peers, all funded by a single
+// transaction. Every funding workflow is run in parallel through the funding
+// manager with a PSBT funding shim, such that each of them pauses once the
+// remote peer accepted the channel. Only once all of them got that far is the
+// batch funding transaction created and signed. If any of the peers fails
+// before then, every reservation of the batch is canceled. The transaction is
+// published once every peer has signed our version of the commitment
+// transaction of its channel.
+func (s *server) BatchOpenChannel(reqs []*openChanReq,
+	feeRate lnwallet.SatPerKWeight,
+	minConfs int32) ([]*lnrpc.PendingUpdate, error) {
+
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("no channels to open")
+	}
+
+	// Each channel is assigned its pending channel ID upfront, as we'll
+	// need it to cancel its reservation should another channel of the
+	// batch fail. None of the funding workflows must broadcast the
+	// funding transaction, as that'll only be done once all of them are
+	// complete.
+	channels := make([]*batchChannel, len(reqs))
+	for i, req := range reqs {
+		var pendingChanID [32]byte
+		if _, err := rand.Read(pendingChanID[:]); err != nil {
+			return nil, err
+		}
+
+		req.fundingFeePerKw = feeRate
+		req.minConfs = minConfs
+		req.psbtShim = &psbtShim{
+			pendingChanID: pendingChanID,
+			noPublish:     true,
+		}
+
+		updates, errChan := s.OpenChannel(req)
+		channels[i] = &batchChannel{
+			req:     req,
+			updates: updates,
+			err:     errChan,
+		}
+	}
+
+	// cancelChannels cancels the reservations of all channels of the
+	// batch that are still pending.
+	cancelChannels := func() {
+		for _, channel := range channels {
+			if !channel.reserved {
+				continue
+			}
+
+			pendingChanID := channel.req.psbtShim.pendingChanID
+			err := s.fundingMgr.CancelShim(pendingChanID)
+			if err != nil {
+				srvrLog.Warnf("Unable to cancel batch channel "+
+					"(id: %x): %v", pendingChanID[:], err)
+			}
+		}
+	}
+
+	// We'll now wait for every peer to accept its channel, or fail. Even
+	// if one of them fails, we wait for all of them, such that no
+	// reservation is left behind once we cancel the batch.
+	var batchErr error
+	for _, channel := range channels {
+		select {
+		case update := <-channel.updates:
+			psbtFund := update.GetPsbtFund()
+			if psbtFund == nil {
+				batchErr = fmt.Errorf("unexpected funding update "+
+					"for batch channel: %v", update)
+				continue
+			}
+
+			channel.reserved = true
+
+			fundingOutput, err := batchFundingOutput(psbtFund)
+			if err != nil {
+				batchErr = err
+				continue
+			}
+			channel.fundingOutput = fundingOutput
+
+		case err := <-channel.err:
+			srvrLog.Errorf("Unable to open batch channel to "+
+				"NodeKey(%x): %v",
+				channel.req.targetPubkey.SerializeCompressed(),
+				err)
+			batchErr = err
+
+		case <-s.quit:
+			return nil, ErrServerShuttingDown
+		}
+	}
+	if batchErr != nil {
+		cancelChannels()
+		return nil, batchErr
+	}
+
+	// With all peers ready, we'll construct the single transaction funding
+	// all channels of the batch.
+	outputs := make([]*wire.TxOut, len(channels))
+	for i, channel := range channels {
+		outputs[i] = channel.fundingOutput
+	}
+	fundingTx, err := s.cc.wallet.CreateBatchFundingTx(
+		outputs, feeRate, minConfs,
+	)
+	if err != nil {
+		cancelChannels()
+		return nil, err
+	}
+
+	// abortBatch is called if any of the channels fails once the funding
+	// transaction is signed. As it's never published, its inputs are
+	// released again. Channels the funding transaction was already handed
+	// over to are abandoned, deleting them from the database if their
+	// funding workflow completed.
+	abortBatch := func(err error) ([]*lnrpc.PendingUpdate, error) {
+		for _, channel := range channels {
+			pendingChanID := channel.req.psbtShim.pendingChanID
+
+			var cancelErr error
+			if channel.finalized {
+				_, index := lnwallet.FindScriptOutputIndex(
+					fundingTx, channel.fundingOutput.PkScript,
+				)
+				chanPoint := wire.OutPoint{
+					Hash:  fundingTx.TxHash(),
+					Index: index,
+				}
+				cancelErr = s.fundingMgr.AbandonShim(
+					pendingChanID, chanPoint,
+				)
+			} else {
+				cancelErr = s.fundingMgr.CancelShim(pendingChanID)
+			}
+			if cancelErr != nil {
+				srvrLog.Warnf("Unable to cancel batch channel "+
+					"(id: %x): %v", pendingChanID[:], cancelErr)
+			}
+		}
+
+		s.cc.wallet.ReleaseBatchFundingTx(fundingTx)
+
+		return nil, err
+	}
+
+	// Resume the funding workflow of every channel with the batch funding
+	// transaction, then wait for all peers to sign our version of the
+	// commitment transaction.
+	for _, channel := range channels {
+		pendingChanID := channel.req.psbtShim.pendingChanID
+		err := s.fundingMgr.FinalizePsbt(pendingChanID, nil, fundingTx)
+		if err != nil {
+			return abortBatch(err)
+		}
+		channel.finalized = true
+	}
+
+	pendingUpdates := make([]*lnrpc.PendingUpdate, len(channels))
+	for i, channel := range channels {
+		select {
+		case update := <-channel.updates:
+			chanPending := update.GetChanPending()
+			if chanPending == nil {
+				return abortBatch(fmt.Errorf("unexpected funding "+
+					"update for batch channel: %v", update))
+			}
+			pendingUpdates[i] = chanPending
+
+		case err := <-channel.err:
+			return abortBatch(err)
+
+		case <-s.quit:
+			return nil, ErrServerShuttingDown
+		}
+	}
+
+	srvrLog.Infof("Broadcasting batch funding tx %v for %v channels",
+		fundingTx.TxHash(), len(channels))
+
+	if err := s.cc.wallet.PublishTransaction(fundingTx); err != nil {
+		return nil, err
+	}
+
+	return pendingUpdates, nil
+}
+
+// batchFundingOutput returns the funding output a batch funding transaction
+// must contain for the channel described by the given PsbtFund update.
+func batchFundingOutput(psbtFund *lnrpc.ReadyForPsbtFunding) (*wire.TxOut,
+	error) {
+
+	addr, err := btcutil.DecodeAddress(
+		psbtFund.FundingAddress, activeNetParams.Params,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(psbtFund.FundingAmount, pkScript), nil
+}
+
 // Peers returns a slice of all active peers.
 //
 // NOTE: This function is safe for concurrent access.
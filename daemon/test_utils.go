@@ -352,6 +352,11 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		return nil, nil, nil, nil, err
 	}
 
+	htlcNotifier := htlcswitch.NewHtlcNotifier()
+	if err := htlcNotifier.Start(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	htlcSwitch, err := htlcswitch.New(htlcswitch.Config{
 		DB:             dbAlice,
 		SwitchPackager: channeldb.NewSwitchPackager(),
@@ -360,11 +365,13 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		HtlcNotifier: htlcNotifier,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 	s.htlcSwitch = htlcSwitch
+	s.htlcNotifier = htlcNotifier
 	s.htlcSwitch.Start()
 
 	alicePeer := &peer{
@@ -83,8 +83,8 @@ func (p *preimageBeacon) LookupPreimage(payHash []byte) ([]byte, bool) {
 	}
 
 	// If we've found the invoice, then we can return the preimage
-	// directly.
-	if err != channeldb.ErrInvoiceNotFound {
+	// directly, unless it's a hold invoice whose preimage isn't known yet.
+	if err != channeldb.ErrInvoiceNotFound && !invoice.IsHold() {
 		return invoice.Terms.PaymentPreimage[:], true
 	}
 
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/breez/lightninglib/channeldb"
@@ -49,8 +50,22 @@ type ChannelGraphTimeSeries interface {
 	// remote peer. The response will contain a unique set of
 	// ChannelAnnouncements, the latest ChannelUpdate for each of the
 	// announcements, and a unique set of NodeAnnouncements.
+	//
+	// If query flags are passed, then each flag determines which of the
+	// announcements and updates of the corresponding channel are
+	// returned. Otherwise, all of them are returned.
 	FetchChanAnns(chain chainhash.Hash,
-		shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error)
+		shortChanIDs []lnwire.ShortChannelID,
+		queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error)
+
+	// FetchChanUpdateInfos returns the timestamps and checksums of the
+	// latest channel updates of each of the specified short channel ID's.
+	// The returned slice has the same length as the passed short channel
+	// ID's, with zero values for any unknown channels or updates. We'll
+	// use this to reply to extended channel range queries, and to
+	// determine which updates to request from a remote peer.
+	FetchChanUpdateInfos(chain chainhash.Hash,
+		shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error)
 
 	// FetchChanUpdates returns the latest channel update messages for the
 	// specified short channel ID. If no channel updates are known for the
@@ -59,6 +74,17 @@ type ChannelGraphTimeSeries interface {
 		shortChanID lnwire.ShortChannelID) ([]*lnwire.ChannelUpdate, error)
 }
 
+// ChanUpdateInfo holds the timestamps and checksums of the latest channel
+// updates of both directions of a channel, as exchanged within the extended
+// gossip queries.
+type ChanUpdateInfo struct {
+	// Timestamps holds the timestamps of the latest channel updates.
+	Timestamps lnwire.ChanUpdateTimestamps
+
+	// Checksums holds the checksums of the latest channel updates.
+	Checksums lnwire.ChanUpdateChecksums
+}
+
 // ChanSeries is an implementation of the ChannelGraphTimeSeries
 // interface backed by the channeldb ChannelGraph database. We'll provide this
 // implementation to the AuthenticatedGossiper so it can properly use the
@@ -228,17 +254,36 @@ func (c *ChanSeries) FilterChannelRange(chain chainhash.Hash,
 // updates that match the set of specified short channel ID's.  We'll use this
 // to reply to a QueryShortChanIDs message sent by a remote peer. The response
 // will contain a unique set of ChannelAnnouncements, the latest ChannelUpdate
-// for each of the announcements, and a unique set of NodeAnnouncements.
+// for each of the announcements, and a unique set of NodeAnnouncements. If
+// query flags are passed, then only the messages requested by the flag of each
+// channel are returned.
 //
 // NOTE: This is part of the ChannelGraphTimeSeries interface.
 func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
-	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error) {
+	shortChanIDs []lnwire.ShortChannelID,
+	queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error) {
+
+	if queryFlags != nil && len(queryFlags) != len(shortChanIDs) {
+		return nil, fmt.Errorf("number of query flags (%v) doesn't "+
+			"match number of short chan ID's (%v)",
+			len(queryFlags), len(shortChanIDs))
+	}
 
 	chanIDs := make([]uint64, 0, len(shortChanIDs))
 	for _, chanID := range shortChanIDs {
 		chanIDs = append(chanIDs, chanID.ToUint64())
 	}
 
+	// As we may not know of all the queried channels, we'll index the
+	// query flags by channel ID.
+	var flagIndex map[uint64]lnwire.QueryFlag
+	if queryFlags != nil {
+		flagIndex = make(map[uint64]lnwire.QueryFlag, len(queryFlags))
+		for i, chanID := range chanIDs {
+			flagIndex[chanID] = queryFlags[i]
+		}
+	}
+
 	channels, err := c.graph.FetchChanInfos(chanIDs)
 	if err != nil {
 		return nil, err
@@ -250,6 +295,29 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 	nodePubsSent := make(map[routing.Vertex]struct{})
 
 	chanAnns := make([]lnwire.Message, 0, len(channels)*3)
+
+	// addNodeAnn adds the node announcement of the passed node, if it has
+	// a validated one that we haven't yet sent.
+	addNodeAnn := func(node *channeldb.LightningNode) error {
+		nodePub := node.PubKeyBytes
+		if _, ok := nodePubsSent[nodePub]; ok {
+			return nil
+		}
+		if !node.HaveNodeAnnouncement {
+			return nil
+		}
+
+		nodeAnn, err := node.NodeAnnouncement(true)
+		if err != nil {
+			return err
+		}
+
+		chanAnns = append(chanAnns, nodeAnn)
+		nodePubsSent[nodePub] = struct{}{}
+
+		return nil
+	}
+
 	for _, channel := range channels {
 		// If the channel doesn't have an authentication proof, then we
 		// won't send it over as it may not yet be finalized, or be a
@@ -258,6 +326,11 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 			continue
 		}
 
+		queryFlag := lnwire.QueryFlagAll
+		if flagIndex != nil {
+			queryFlag = flagIndex[channel.Info.ChannelID]
+		}
+
 		chanAnn, edge1, edge2, err := CreateChanAnnouncement(
 			channel.Info.AuthProof, channel.Info, channel.Policy1,
 			channel.Policy2,
@@ -266,39 +339,33 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 			return nil, err
 		}
 
-		chanAnns = append(chanAnns, chanAnn)
+		if queryFlag.IsSet(lnwire.QueryFlagChanAnn) {
+			chanAnns = append(chanAnns, chanAnn)
+		}
+
+		// Each edge policy leads to the opposite node of the channel,
+		// so we'll send the node announcement of the second node
+		// along with the first policy, and vice versa.
 		if edge1 != nil {
-			chanAnns = append(chanAnns, edge1)
-
-			// If this edge has a validated node announcement, that
-			// we haven't yet sent, then we'll send that as well.
-			nodePub := channel.Policy1.Node.PubKeyBytes
-			hasNodeAnn := channel.Policy1.Node.HaveNodeAnnouncement
-			if _, ok := nodePubsSent[nodePub]; !ok && hasNodeAnn {
-				nodeAnn, err := channel.Policy1.Node.NodeAnnouncement(true)
+			if queryFlag.IsSet(lnwire.QueryFlagChanUpdate1) {
+				chanAnns = append(chanAnns, edge1)
+			}
+			if queryFlag.IsSet(lnwire.QueryFlagNodeAnn2) {
+				err := addNodeAnn(channel.Policy1.Node)
 				if err != nil {
 					return nil, err
 				}
-
-				chanAnns = append(chanAnns, nodeAnn)
-				nodePubsSent[nodePub] = struct{}{}
 			}
 		}
 		if edge2 != nil {
-			chanAnns = append(chanAnns, edge2)
-
-			// If this edge has a validated node announcement, that
-			// we haven't yet sent, then we'll send that as well.
-			nodePub := channel.Policy2.Node.PubKeyBytes
-			hasNodeAnn := channel.Policy2.Node.HaveNodeAnnouncement
-			if _, ok := nodePubsSent[nodePub]; !ok && hasNodeAnn {
-				nodeAnn, err := channel.Policy2.Node.NodeAnnouncement(true)
+			if queryFlag.IsSet(lnwire.QueryFlagChanUpdate2) {
+				chanAnns = append(chanAnns, edge2)
+			}
+			if queryFlag.IsSet(lnwire.QueryFlagNodeAnn1) {
+				err := addNodeAnn(channel.Policy2.Node)
 				if err != nil {
 					return nil, err
 				}
-
-				chanAnns = append(chanAnns, nodeAnn)
-				nodePubsSent[nodePub] = struct{}{}
 			}
 		}
 	}
@@ -306,6 +373,54 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 	return chanAnns, nil
 }
 
+// FetchChanUpdateInfos returns the timestamps and checksums of the latest
+// channel updates of each of the specified short channel ID's. The returned
+// slice has the same length as the passed short channel ID's, with zero values
+// for any unknown channels or updates.
+//
+// NOTE: This is part of the ChannelGraphTimeSeries interface.
+func (c *ChanSeries) FetchChanUpdateInfos(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error) {
+
+	infos := make([]ChanUpdateInfo, len(shortChanIDs))
+	for i, shortChanID := range shortChanIDs {
+		chanInfo, e1, e2, err := c.graph.FetchChannelEdgesByID(
+			shortChanID.ToUint64(),
+		)
+		switch {
+		case err == channeldb.ErrEdgeNotFound,
+			err == channeldb.ErrGraphNoEdgesFound:
+
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if e1 != nil {
+			update := newChanUpdate(chanInfo, shortChanID, e1)
+			checksum, err := lnwire.ChanUpdateChecksum(update)
+			if err != nil {
+				return nil, err
+			}
+
+			infos[i].Timestamps.Timestamp1 = update.Timestamp
+			infos[i].Checksums.Checksum1 = checksum
+		}
+		if e2 != nil {
+			update := newChanUpdate(chanInfo, shortChanID, e2)
+			checksum, err := lnwire.ChanUpdateChecksum(update)
+			if err != nil {
+				return nil, err
+			}
+
+			infos[i].Timestamps.Timestamp2 = update.Timestamp
+			infos[i].Checksums.Checksum2 = checksum
+		}
+	}
+
+	return infos, nil
+}
+
 // FetchChanUpdates returns the latest channel update messages for the
 // specified short channel ID. If no channel updates are known for the channel,
 // then an empty slice will be returned.
@@ -323,19 +438,7 @@ func (c *ChanSeries) FetchChanUpdates(chain chainhash.Hash,
 
 	chanUpdates := make([]*lnwire.ChannelUpdate, 0, 2)
 	if e1 != nil {
-		chanUpdate := &lnwire.ChannelUpdate{
-			ChainHash:       chanInfo.ChainHash,
-			ShortChannelID:  shortChanID,
-			Timestamp:       uint32(e1.LastUpdate.Unix()),
-			MessageFlags:    e1.MessageFlags,
-			ChannelFlags:    e1.ChannelFlags,
-			TimeLockDelta:   e1.TimeLockDelta,
-			HtlcMinimumMsat: e1.MinHTLC,
-			HtlcMaximumMsat: e1.MaxHTLC,
-			BaseFee:         uint32(e1.FeeBaseMSat),
-			FeeRate:         uint32(e1.FeeProportionalMillionths),
-			ExtraOpaqueData: e1.ExtraOpaqueData,
-		}
+		chanUpdate := newChanUpdate(chanInfo, shortChanID, e1)
 		chanUpdate.Signature, err = lnwire.NewSigFromRawSignature(e1.SigBytes)
 		if err != nil {
 			return nil, err
@@ -344,19 +447,7 @@ func (c *ChanSeries) FetchChanUpdates(chain chainhash.Hash,
 		chanUpdates = append(chanUpdates, chanUpdate)
 	}
 	if e2 != nil {
-		chanUpdate := &lnwire.ChannelUpdate{
-			ChainHash:       chanInfo.ChainHash,
-			ShortChannelID:  shortChanID,
-			Timestamp:       uint32(e2.LastUpdate.Unix()),
-			MessageFlags:    e2.MessageFlags,
-			ChannelFlags:    e2.ChannelFlags,
-			TimeLockDelta:   e2.TimeLockDelta,
-			HtlcMinimumMsat: e2.MinHTLC,
-			HtlcMaximumMsat: e2.MaxHTLC,
-			BaseFee:         uint32(e2.FeeBaseMSat),
-			FeeRate:         uint32(e2.FeeProportionalMillionths),
-			ExtraOpaqueData: e2.ExtraOpaqueData,
-		}
+		chanUpdate := newChanUpdate(chanInfo, shortChanID, e2)
 		chanUpdate.Signature, err = lnwire.NewSigFromRawSignature(e2.SigBytes)
 		if err != nil {
 			return nil, err
@@ -368,6 +459,27 @@ func (c *ChanSeries) FetchChanUpdates(chain chainhash.Hash,
 	return chanUpdates, nil
 }
 
+// newChanUpdate creates the unsigned channel update message of the passed
+// edge policy.
+func newChanUpdate(chanInfo *channeldb.ChannelEdgeInfo,
+	shortChanID lnwire.ShortChannelID,
+	policy *channeldb.ChannelEdgePolicy) *lnwire.ChannelUpdate {
+
+	return &lnwire.ChannelUpdate{
+		ChainHash:       chanInfo.ChainHash,
+		ShortChannelID:  shortChanID,
+		Timestamp:       uint32(policy.LastUpdate.Unix()),
+		MessageFlags:    policy.MessageFlags,
+		ChannelFlags:    policy.ChannelFlags,
+		TimeLockDelta:   policy.TimeLockDelta,
+		HtlcMinimumMsat: policy.MinHTLC,
+		HtlcMaximumMsat: policy.MaxHTLC,
+		BaseFee:         uint32(policy.FeeBaseMSat),
+		FeeRate:         uint32(policy.FeeProportionalMillionths),
+		ExtraOpaqueData: policy.ExtraOpaqueData,
+	}
+}
+
 // A compile-time assertion to ensure that ChanSeries meets the
 // ChannelGraphTimeSeries interface.
 var _ ChannelGraphTimeSeries = (*ChanSeries)(nil)
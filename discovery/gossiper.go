@@ -1219,9 +1219,10 @@ func (d *AuthenticatedGossiper) networkHandler() {
 // queries. We'll allocate a new gossip syncer for it, and start any goroutines
 // needed to handle new queries. The recvUpdates bool indicates if we should
 // continue to receive real-time updates from the remote peer once we've synced
-// channel state.
+// channel state. The queryEx bool indicates if both we and the remote peer
+// support the extended gossip queries.
 func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
-	recvUpdates bool) {
+	recvUpdates, queryEx bool) {
 
 	d.syncerMtx.Lock()
 	defer d.syncerMtx.Unlock()
@@ -1242,6 +1243,7 @@ func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
 		channelSeries:   d.cfg.ChanSeries,
 		encodingType:    encoding,
 		chunkSize:       encodingTypeToChunkSize[encoding],
+		useQueryEx:      queryEx,
 		sendToPeer: func(msgs ...lnwire.Message) error {
 			return syncPeer.SendMessage(false, msgs...)
 		},
@@ -1606,7 +1608,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		}
 
 		features := lnwire.NewFeatureVector(
-			msg.Features, lnwire.GlobalFeatures,
+			msg.Features, lnwire.Features,
 		)
 		node := &channeldb.LightningNode{
 			HaveNodeAnnouncement: true,
@@ -15,6 +15,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/breez/lightninglib/chainntnfs"
+	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/lnpeer"
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/routing"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -105,6 +105,12 @@ const (
 	// asking the remote peer for their any channels they know of beyond
 	// our highest known channel ID.
 	chanRangeQueryBuffer = 144
+
+	// chanUpdateStaleInterval is the age after which we consider one of
+	// our channel updates stale. We'll request a newer channel update
+	// with an identical checksum from the remote peer only if ours is
+	// stale, as the channel would otherwise be pruned from our graph.
+	chanUpdateStaleInterval = time.Hour * 24 * 14
 )
 
 // gossipSyncerCfg is a struct that packages all the information a gossipSyncer
@@ -129,6 +135,12 @@ type gossipSyncerCfg struct {
 	// encoding type that we can fit into a single message safely.
 	chunkSize int32
 
+	// useQueryEx signals whether the remote peer supports the extended
+	// gossip queries, in which case we'll ask it for the timestamps and
+	// checksums of its channel updates, and only query for the channel
+	// updates that we're missing.
+	useQueryEx bool
+
 	// sendToPeer is a function closure that should send the set of
 	// targeted messages to the peer we've been assigned to sync the graph
 	// state from.
@@ -178,11 +190,21 @@ type gossipSyncer struct {
 	// buffer all the chunked response to our query.
 	bufferedChanRangeReplies []lnwire.ShortChannelID
 
+	// bufferedChanUpdateInfos holds the timestamps and checksums of the
+	// channel updates attached to the buffered replies, if the remote
+	// peer sent them. Each entry corresponds to the short channel ID at
+	// the same index within bufferedChanRangeReplies.
+	bufferedChanUpdateInfos []ChanUpdateInfo
+
 	// newChansToQuery is used to pass the set of channels we should query
 	// for from the waitingQueryChanReply state to the queryNewChannels
 	// state.
 	newChansToQuery []lnwire.ShortChannelID
 
+	// newChanQueryFlags holds the query flags of each of the channels
+	// within newChansToQuery, if we're using the extended gossip queries.
+	newChanQueryFlags []lnwire.QueryFlag
+
 	// peerPub is the public key of the peer we're syncing with, serialized
 	// in compressed format.
 	peerPub [33]byte
@@ -462,21 +484,36 @@ func (g *gossipSyncer) synchronizeChanIDs() (bool, error) {
 	}
 
 	// Otherwise, we'll issue our next chunked query to receive replies
-	// for.
-	var queryChunk []lnwire.ShortChannelID
+	// for. If we're attaching query flags, each channel takes up more
+	// space, so we'll need to shrink our chunks accordingly.
+	var (
+		queryChunk []lnwire.ShortChannelID
+		flagsChunk []lnwire.QueryFlag
+	)
+	chunkSize := g.cfg.chunkSize
+	if g.newChanQueryFlags != nil {
+		chunkSize = chunkSize * 8 / 9
+	}
 
 	// If the number of channels to query for is less than the chunk size,
 	// then we can issue a single query.
-	if int32(len(g.newChansToQuery)) < g.cfg.chunkSize {
+	if int32(len(g.newChansToQuery)) < chunkSize {
 		queryChunk = g.newChansToQuery
+		flagsChunk = g.newChanQueryFlags
 		g.newChansToQuery = nil
+		g.newChanQueryFlags = nil
 
 	} else {
 		// Otherwise, we'll need to only query for the next chunk.
 		// We'll slice into our query chunk, then slide down our main
 		// pointer down by the chunk size.
-		queryChunk = g.newChansToQuery[:g.cfg.chunkSize]
-		g.newChansToQuery = g.newChansToQuery[g.cfg.chunkSize:]
+		queryChunk = g.newChansToQuery[:chunkSize]
+		g.newChansToQuery = g.newChansToQuery[chunkSize:]
+
+		if g.newChanQueryFlags != nil {
+			flagsChunk = g.newChanQueryFlags[:chunkSize]
+			g.newChanQueryFlags = g.newChanQueryFlags[chunkSize:]
+		}
 	}
 
 	log.Infof("gossipSyncer(%x): querying for %v new channels",
@@ -488,6 +525,7 @@ func (g *gossipSyncer) synchronizeChanIDs() (bool, error) {
 		ChainHash:    g.cfg.chainHash,
 		EncodingType: lnwire.EncodingSortedPlain,
 		ShortChanIDs: queryChunk,
+		QueryFlags:   flagsChunk,
 	})
 
 	return false, err
@@ -501,6 +539,24 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		g.bufferedChanRangeReplies, msg.ShortChanIDs...,
 	)
 
+	// If the remote peer attached the timestamps of its channel updates,
+	// we'll buffer them along with any checksums, so we can later decide
+	// which of the updates we need.
+	if msg.Timestamps != nil {
+		for i := range msg.ShortChanIDs {
+			info := ChanUpdateInfo{
+				Timestamps: msg.Timestamps[i],
+			}
+			if msg.Checksums != nil {
+				info.Checksums = msg.Checksums[i]
+			}
+
+			g.bufferedChanUpdateInfos = append(
+				g.bufferedChanUpdateInfos, info,
+			)
+		}
+	}
+
 	log.Infof("gossipSyncer(%x): buffering chan range reply of size=%v",
 		g.peerPub[:], len(msg.ShortChanIDs))
 
@@ -522,10 +578,24 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		return fmt.Errorf("unable to filter chan ids: %v", err)
 	}
 
+	// If we're using the extended gossip queries and the remote peer
+	// sent us the timestamps of all its channel updates, then we'll also
+	// query for the updates of known channels that are newer than ours.
+	var queryFlags []lnwire.QueryFlag
+	if g.cfg.useQueryEx && len(g.bufferedChanUpdateInfos) ==
+		len(g.bufferedChanRangeReplies) {
+
+		newChans, queryFlags, err = g.filterChanUpdates(newChans)
+		if err != nil {
+			return err
+		}
+	}
+
 	// As we've received the entirety of the reply, we no longer need to
 	// hold on to the set of buffered replies, so we'll let that be garbage
 	// collected now.
 	g.bufferedChanRangeReplies = nil
+	g.bufferedChanUpdateInfos = nil
 
 	// If there aren't any channels that we don't know of, then we can
 	// switch straight to our terminal state.
@@ -540,6 +610,7 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	// Otherwise, we'll set the set of channels that we need to query for
 	// the next state, and also transition our state.
 	g.newChansToQuery = newChans
+	g.newChanQueryFlags = queryFlags
 	atomic.StoreUint32(&g.state, uint32(queryNewChannels))
 
 	log.Infof("gossipSyncer(%x): starting query for %v new chans",
@@ -548,6 +619,102 @@ func (g *gossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	return nil
 }
 
+// filterChanUpdates uses the buffered timestamps and checksums of the remote
+// peer's channel updates to determine which of them we should query for. It
+// returns the set of channels to query for, along with the query flag of each
+// of them. Channels that we don't know of at all, as passed in newChans, will
+// be queried for in their entirety.
+func (g *gossipSyncer) filterChanUpdates(newChans []lnwire.ShortChannelID) (
+	[]lnwire.ShortChannelID, []lnwire.QueryFlag, error) {
+
+	unknownChans := make(map[lnwire.ShortChannelID]struct{}, len(newChans))
+	for _, chanID := range newChans {
+		unknownChans[chanID] = struct{}{}
+	}
+
+	// We'll fetch the timestamps and checksums of our own updates for
+	// all the channels that we already know of.
+	var (
+		knownChans  []lnwire.ShortChannelID
+		remoteInfos []ChanUpdateInfo
+	)
+	for i, chanID := range g.bufferedChanRangeReplies {
+		if _, ok := unknownChans[chanID]; ok {
+			continue
+		}
+
+		knownChans = append(knownChans, chanID)
+		remoteInfos = append(remoteInfos, g.bufferedChanUpdateInfos[i])
+	}
+
+	localInfos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+		g.cfg.chainHash, knownChans,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch chan update "+
+			"infos: %v", err)
+	}
+
+	chansToQuery := make([]lnwire.ShortChannelID, 0, len(newChans))
+	queryFlags := make([]lnwire.QueryFlag, 0, len(newChans))
+	for _, chanID := range newChans {
+		chansToQuery = append(chansToQuery, chanID)
+		queryFlags = append(queryFlags, lnwire.QueryFlagAll)
+	}
+
+	staleTime := uint32(time.Now().Add(-chanUpdateStaleInterval).Unix())
+	shouldQuery := func(localTimestamp, localChecksum, remoteTimestamp,
+		remoteChecksum uint32) bool {
+
+		if remoteTimestamp <= localTimestamp {
+			return false
+		}
+
+		// If the remote peer didn't send us checksums, we'll request
+		// any newer update.
+		if remoteChecksum == 0 {
+			return true
+		}
+
+		// Otherwise, we'll only request updates that actually change
+		// the channel policy, unless ours has become stale.
+		isStale := localTimestamp < staleTime &&
+			remoteTimestamp >= staleTime
+		return remoteChecksum != localChecksum || isStale
+	}
+
+	var numUpdates int
+	for i, chanID := range knownChans {
+		local, remote := localInfos[i], remoteInfos[i]
+
+		var queryFlag lnwire.QueryFlag
+		if shouldQuery(
+			local.Timestamps.Timestamp1, local.Checksums.Checksum1,
+			remote.Timestamps.Timestamp1, remote.Checksums.Checksum1,
+		) {
+			queryFlag |= lnwire.QueryFlagChanUpdate1
+		}
+		if shouldQuery(
+			local.Timestamps.Timestamp2, local.Checksums.Checksum2,
+			remote.Timestamps.Timestamp2, remote.Checksums.Checksum2,
+		) {
+			queryFlag |= lnwire.QueryFlagChanUpdate2
+		}
+		if queryFlag == 0 {
+			continue
+		}
+
+		chansToQuery = append(chansToQuery, chanID)
+		queryFlags = append(queryFlags, queryFlag)
+		numUpdates++
+	}
+
+	log.Infof("gossipSyncer(%x): querying for updates of %v known chans",
+		g.peerPub[:], numUpdates)
+
+	return chansToQuery, queryFlags, nil
+}
+
 // genChanRangeQuery generates the initial message we'll send to the remote
 // party when we're kicking off the channel graph synchronization upon
 // connection.
@@ -581,11 +748,20 @@ func (g *gossipSyncer) genChanRangeQuery() (*lnwire.QueryChannelRange, error) {
 	// Finally, we'll craft the channel range query, using our starting
 	// height, then asking for all known channels to the foreseeable end of
 	// the main chain.
-	return &lnwire.QueryChannelRange{
+	query := &lnwire.QueryChannelRange{
 		ChainHash:        g.cfg.chainHash,
 		FirstBlockHeight: startHeight,
 		NumBlocks:        math.MaxUint32 - startHeight,
-	}, nil
+	}
+
+	// If the remote peer supports the extended gossip queries, we'll also
+	// ask for the timestamps and checksums of its channel updates.
+	if g.cfg.useQueryEx {
+		query.QueryOptions = lnwire.QueryOptionTimestamps |
+			lnwire.QueryOptionChecksums
+	}
+
+	return query, nil
 }
 
 // replyPeerQueries is called in response to any query by the remote peer.
@@ -648,6 +824,21 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	// TODO(roasbeef): means can't send max uint above?
 	//  * or make internal 64
 
+	// If the remote peer asked for the timestamps or checksums of our
+	// channel updates, then each channel takes up more space within our
+	// replies, so we'll shrink our chunks accordingly.
+	withTimestamps := query.QueryOptions.IsSet(lnwire.QueryOptionTimestamps)
+	withChecksums := query.QueryOptions.IsSet(lnwire.QueryOptionChecksums)
+
+	bytesPerChan := int32(8)
+	if withTimestamps {
+		bytesPerChan += 8
+	}
+	if withChecksums {
+		bytesPerChan += 8
+	}
+	chunkSize := g.cfg.chunkSize * 8 / bytesPerChan
+
 	numChannels := int32(len(channelRange))
 	numChansSent := int32(0)
 	for {
@@ -659,7 +850,7 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		// We know this is the final chunk, if the difference between
 		// the total number of channels, and the number of channels
 		// we've sent is less-than-or-equal to the chunk size.
-		isFinalChunk := (numChannels - numChansSent) <= g.cfg.chunkSize
+		isFinalChunk := (numChannels - numChansSent) <= chunkSize
 
 		// If this is indeed the last chunk, then we'll send the
 		// remainder of the channels.
@@ -672,7 +863,7 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		} else {
 			// Otherwise, we'll only send off a fragment exactly
 			// sized to the proper chunk size.
-			channelChunk = channelRange[numChansSent : numChansSent+g.cfg.chunkSize]
+			channelChunk = channelRange[numChansSent : numChansSent+chunkSize]
 
 			log.Infof("gossipSyncer(%x): sending range chunk of "+
 				"size=%v", g.peerPub[:], len(channelChunk))
@@ -689,6 +880,33 @@ func (g *gossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		if isFinalChunk {
 			replyChunk.Complete = 1
 		}
+
+		// Attach the timestamps and checksums of our channel updates if
+		// they were requested.
+		if withTimestamps || withChecksums {
+			infos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+				query.ChainHash, channelChunk,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, info := range infos {
+				if withTimestamps {
+					replyChunk.Timestamps = append(
+						replyChunk.Timestamps,
+						info.Timestamps,
+					)
+				}
+				if withChecksums {
+					replyChunk.Checksums = append(
+						replyChunk.Checksums,
+						info.Checksums,
+					)
+				}
+			}
+		}
+
 		if err := g.cfg.sendToPeer(&replyChunk); err != nil {
 			return err
 		}
@@ -737,7 +955,7 @@ func (g *gossipSyncer) replyShortChanIDs(query *lnwire.QueryShortChanIDs) error
 	// the requirement of being a chan ann, chan update, or a node ann
 	// related to the set of queried channels.
 	replyMsgs, err := g.cfg.channelSeries.FetchChanAnns(
-		query.ChainHash, query.ShortChanIDs,
+		query.ChainHash, query.ShortChanIDs, query.QueryFlags,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to fetch chan anns for %v..., %v",
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"fmt"
 	"math"
 	"reflect"
 	"testing"
@@ -46,6 +47,9 @@ type mockChannelGraphTimeSeries struct {
 
 	updateReq  chan lnwire.ShortChannelID
 	updateResp chan []*lnwire.ChannelUpdate
+
+	infoReq  chan []lnwire.ShortChannelID
+	infoResp chan []ChanUpdateInfo
 }
 
 func newMockChannelGraphTimeSeries(
@@ -68,6 +72,9 @@ func newMockChannelGraphTimeSeries(
 
 		updateReq:  make(chan lnwire.ShortChannelID, 1),
 		updateResp: make(chan []*lnwire.ChannelUpdate, 1),
+
+		infoReq:  make(chan []lnwire.ShortChannelID, 1),
+		infoResp: make(chan []ChanUpdateInfo, 1),
 	}
 }
 
@@ -98,7 +105,8 @@ func (m *mockChannelGraphTimeSeries) FilterChannelRange(chain chainhash.Hash,
 	return <-m.filterRangeResp, nil
 }
 func (m *mockChannelGraphTimeSeries) FetchChanAnns(chain chainhash.Hash,
-	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error) {
+	shortChanIDs []lnwire.ShortChannelID,
+	queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error) {
 
 	m.annReq <- shortChanIDs
 
@@ -111,6 +119,13 @@ func (m *mockChannelGraphTimeSeries) FetchChanUpdates(chain chainhash.Hash,
 
 	return <-m.updateResp, nil
 }
+func (m *mockChannelGraphTimeSeries) FetchChanUpdateInfos(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) ([]ChanUpdateInfo, error) {
+
+	m.infoReq <- shortChanIDs
+
+	return <-m.infoResp, nil
+}
 
 var _ ChannelGraphTimeSeries = (*mockChannelGraphTimeSeries)(nil)
 
@@ -866,6 +881,120 @@ func TestGossipSyncerProcessChanRangeReply(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerProcessChanRangeReplyQueryEx tests that when using the
+// extended gossip queries, we'll query for all unknown channels in their
+// entirety, and only for those updates of known channels that are newer and
+// differ from ours.
+func TestGossipSyncerProcessChanRangeReplyQueryEx(t *testing.T) {
+	t.Parallel()
+
+	_, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding, defaultChunkSize,
+	)
+	syncer.cfg.useQueryEx = true
+
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(10),
+		lnwire.NewShortChanIDFromInt(11),
+		lnwire.NewShortChanIDFromInt(12),
+	}
+	now := uint32(time.Now().Unix())
+
+	// We'll have the remote peer send us a reply containing a channel
+	// we don't know of, a channel with a newer first update and a newer
+	// second update that doesn't change the channel policy, and a channel
+	// with older updates.
+	reply := &lnwire.ReplyChannelRange{
+		Complete:     1,
+		ShortChanIDs: chanIDs,
+		Timestamps: []lnwire.ChanUpdateTimestamps{
+			{Timestamp1: now, Timestamp2: now},
+			{Timestamp1: now, Timestamp2: now},
+			{Timestamp1: now - 20, Timestamp2: now - 20},
+		},
+		Checksums: []lnwire.ChanUpdateChecksums{
+			{Checksum1: 1, Checksum2: 2},
+			{Checksum1: 3, Checksum2: 4},
+			{Checksum1: 5, Checksum2: 6},
+		},
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		select {
+		case <-time.After(time.Second * 15):
+			errChan <- fmt.Errorf("no filter request recvd")
+			return
+
+		case <-chanSeries.filterReq:
+			chanSeries.filterResp <- chanIDs[:1]
+		}
+
+		select {
+		case <-time.After(time.Second * 15):
+			errChan <- fmt.Errorf("no update info request recvd")
+			return
+
+		case req := <-chanSeries.infoReq:
+			if !reflect.DeepEqual(req, chanIDs[1:]) {
+				errChan <- fmt.Errorf("wrong request: "+
+					"expected %v, got %v", chanIDs[1:], req)
+				return
+			}
+
+			chanSeries.infoResp <- []ChanUpdateInfo{
+				{
+					Timestamps: lnwire.ChanUpdateTimestamps{
+						Timestamp1: now - 10,
+						Timestamp2: now - 10,
+					},
+					Checksums: lnwire.ChanUpdateChecksums{
+						Checksum1: 7,
+						Checksum2: 4,
+					},
+				},
+				{
+					Timestamps: lnwire.ChanUpdateTimestamps{
+						Timestamp1: now - 10,
+						Timestamp2: now - 10,
+					},
+					Checksums: lnwire.ChanUpdateChecksums{
+						Checksum1: 8,
+						Checksum2: 9,
+					},
+				},
+			}
+		}
+
+		errChan <- nil
+	}()
+
+	if err := syncer.processChanRangeReply(reply); err != nil {
+		t.Fatalf("unable to process reply: %v", err)
+	}
+	if err := <-errChan; err != nil {
+		t.Fatal(err)
+	}
+
+	if syncer.SyncState() != queryNewChannels {
+		t.Fatalf("wrong state: expected %v instead got %v",
+			queryNewChannels, syncer.state)
+	}
+
+	expectedChans := chanIDs[:2]
+	if !reflect.DeepEqual(syncer.newChansToQuery, expectedChans) {
+		t.Fatalf("wrong set of chans to query: expected %v, got %v",
+			expectedChans, syncer.newChansToQuery)
+	}
+	expectedFlags := []lnwire.QueryFlag{
+		lnwire.QueryFlagAll, lnwire.QueryFlagChanUpdate1,
+	}
+	if !reflect.DeepEqual(syncer.newChanQueryFlags, expectedFlags) {
+		t.Fatalf("wrong query flags: expected %v, got %v",
+			expectedFlags, syncer.newChanQueryFlags)
+	}
+}
+
 // TestGossipSyncerSynchronizeChanIDs tests that we properly request chunks of
 // the short chan ID's which were unknown to us. We'll ensure that we request
 // chunk by chunk, and after the last chunk, we return true indicating that we
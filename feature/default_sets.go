@@ -0,0 +1,36 @@
+package feature
+
+import "github.com/breez/lightninglib/lnwire"
+
+// setDesc describes which feature bits should be enabled for particular
+// feature sets.
+type setDesc map[lnwire.FeatureBit]map[Set]struct{}
+
+// defaultSetDesc are the default set descriptors for generating feature
+// vectors. Each set is annotated with the corresponding identifier from BOLT
+// 9 indicating where it should be advertised.
+var defaultSetDesc = setDesc{
+	lnwire.DataLossProtectOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.GossipQueriesOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.GossipQueriesExOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.DualFundOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.TLVOnionPayloadOptional: {
+		SetLegacyGlobal: {},
+		SetNodeAnn:      {}, // N
+		SetInvoice:      {}, // 9
+	},
+	lnwire.PaymentAddrOptional: {
+		SetInvoice: {}, // 9
+	},
+	lnwire.MPPOptional: {
+		SetInvoice: {}, // 9
+	},
+}
@@ -0,0 +1,133 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+type (
+	// featureSet contains a set of feature bits.
+	featureSet map[lnwire.FeatureBit]struct{}
+
+	// supportedFeatures maps the feature bit from a feature vector to a
+	// boolean indicating if this feature's dependencies have already been
+	// verified. This allows us to short circuit verification if multiple
+	// features have common dependencies, or map back to the same feature.
+	supportedFeatures map[lnwire.FeatureBit]bool
+
+	// depDesc maps a features to its set of dependent features, which
+	// must also be present for the vector to be valid. This can be used to
+	// recursively check the dependency chain for features in a feature
+	// vector.
+	depDesc map[lnwire.FeatureBit]featureSet
+)
+
+// ErrMissingFeatureDep is an error signaling that a transitive dependency in a
+// feature vector is not set properly.
+type ErrMissingFeatureDep struct {
+	dep lnwire.FeatureBit
+}
+
+// NewErrMissingFeatureDep creates a new ErrMissingFeatureDep error.
+func NewErrMissingFeatureDep(dep lnwire.FeatureBit) ErrMissingFeatureDep {
+	return ErrMissingFeatureDep{dep: dep}
+}
+
+// Error returns a human-readable description of the missing dep error.
+func (e ErrMissingFeatureDep) Error() string {
+	return fmt.Sprintf("missing feature dependency: %v",
+		lnwire.NewFeatureVector(nil, lnwire.Features).Name(e.dep))
+}
+
+// deps is the default set of dependencies for assigned feature bits. If a
+// feature is not present in the depDesc it is assumed to have no dependencies.
+//
+// NOTE: For proper functioning, only the optional variant of feature bits
+// should be used in the following descriptor. In the future it may be
+// necessary to distinguish the dependencies for optional and required bits,
+// but for now the validation code maps required bits to optional ones since
+// it simplifies the number of constraints.
+var deps = depDesc{
+	lnwire.GossipQueriesExOptional: {
+		lnwire.GossipQueriesOptional: {},
+	},
+	lnwire.PaymentAddrOptional: {
+		lnwire.TLVOnionPayloadOptional: {},
+	},
+	lnwire.MPPOptional: {
+		lnwire.PaymentAddrOptional: {},
+	},
+}
+
+// ValidateDeps asserts that a feature vector sets all features and their
+// transitive dependencies properly. It assumes that the dependencies between
+// optional and required features are identical, e.g. if a feature is required
+// but its dependency is optional, that is sufficient.
+func ValidateDeps(fv *lnwire.FeatureVector) error {
+	features := fv.Features()
+	supported := initSupported(features)
+
+	return validateDeps(features, supported)
+}
+
+// validateDeps is a subroutine that recursively checks that the passed
+// features have all of their associated dependencies in the supported map.
+func validateDeps(features []lnwire.FeatureBit,
+	supported supportedFeatures) error {
+
+	for _, bit := range features {
+		// Convert any required bits to optional.
+		bit = mapToOptional(bit)
+
+		// If the supported feature map doesn't contain this bit, it's
+		// a dependency that wasn't set, so fail.
+		checked, ok := supported[bit]
+		if !ok {
+			return NewErrMissingFeatureDep(bit)
+		}
+
+		// If we've already checked this feature's dependencies, move
+		// on to the next.
+		if checked {
+			continue
+		}
+
+		// Otherwise, retrieve the dependencies of this feature bit,
+		// and check that they are set in turn.
+		var subFeatures []lnwire.FeatureBit
+		for subBit := range deps[bit] {
+			subFeatures = append(subFeatures, subBit)
+		}
+		if err := validateDeps(subFeatures, supported); err != nil {
+			return err
+		}
+
+		// Finally, mark this feature as having been checked.
+		supported[bit] = true
+	}
+
+	return nil
+}
+
+// initSupported sets all bits from the feature vector as supported but not
+// checked. This signals that the validity of their dependencies has not been
+// verified. All required bits are mapped to optional to simplify the DAG.
+func initSupported(features []lnwire.FeatureBit) supportedFeatures {
+	supported := make(supportedFeatures)
+	for _, bit := range features {
+		supported[mapToOptional(bit)] = false
+	}
+
+	return supported
+}
+
+// mapToOptional returns the optional variant of a given feature bit pair. Our
+// dependency graph is described using only optional feature bits, which
+// reduces the number of constraints we need to express in the descriptor.
+func mapToOptional(bit lnwire.FeatureBit) lnwire.FeatureBit {
+	if bit%2 == 0 {
+		bit ^= 1
+	}
+	return bit
+}
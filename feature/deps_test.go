@@ -0,0 +1,106 @@
+package feature
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+type depTest struct {
+	name   string
+	raw    *lnwire.RawFeatureVector
+	expErr error
+}
+
+var depTests = []depTest{
+	{
+		name: "empty",
+		raw:  lnwire.NewRawFeatureVector(),
+	},
+	{
+		name: "no deps optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.GossipQueriesOptional,
+		),
+	},
+	{
+		name: "no deps required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+		),
+	},
+	{
+		name: "one dep optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+		),
+	},
+	{
+		name: "one dep required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+			lnwire.PaymentAddrRequired,
+		),
+	},
+	{
+		name: "one missing optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.PaymentAddrOptional,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.TLVOnionPayloadOptional},
+	},
+	{
+		name: "one missing required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.PaymentAddrRequired,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.TLVOnionPayloadOptional},
+	},
+	{
+		name: "gossip queries ex missing gossip queries",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.GossipQueriesExOptional,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.GossipQueriesOptional},
+	},
+	{
+		name: "two deps",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.MPPOptional,
+		),
+	},
+	{
+		name: "mpp missing payment addr",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.MPPOptional,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.PaymentAddrOptional},
+	},
+}
+
+// TestValidateDeps tests that ValidateDeps correctly asserts whether or not
+// the set features constitute a valid feature chain.
+func TestValidateDeps(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range depTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testValidateDeps(t, test)
+		})
+	}
+}
+
+func testValidateDeps(t *testing.T, test depTest) {
+	fv := lnwire.NewFeatureVector(test.raw, lnwire.Features)
+	err := ValidateDeps(fv)
+	if !reflect.DeepEqual(err, test.expErr) {
+		t.Fatalf("validation mismatch, want: %v, got: %v",
+			test.expErr, err)
+	}
+}
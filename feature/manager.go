@@ -0,0 +1,140 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+// Config houses any runtime modifications to the default set descriptors. For
+// our purposes, this typically means disabling certain features to test
+// specific behavior or work around incompatibilities with other
+// implementations.
+type Config struct {
+	// NoTLVOnion unsets any optional or required TLVOnionPayload bits
+	// from all feature sets.
+	NoTLVOnion bool
+
+	// NoPaymentAddr unsets any optional or required PaymentAddr bits from
+	// all feature sets.
+	NoPaymentAddr bool
+
+	// NoMPP unsets any optional or required MPP bits from all feature
+	// sets.
+	NoMPP bool
+
+	// NoDualFund unsets any optional or required DualFund bits from all
+	// feature sets.
+	NoDualFund bool
+
+	// NoGossipQueriesEx unsets any optional or required GossipQueriesEx
+	// bits from all feature sets.
+	NoGossipQueriesEx bool
+}
+
+// Manager is responsible for generating feature vectors for different
+// requested feature sets.
+type Manager struct {
+	// fsets is a static map of feature set to raw feature vectors.
+	// Requests are fulfilled by cloning these internal feature vectors.
+	fsets map[Set]*lnwire.RawFeatureVector
+}
+
+// NewManager creates a new feature Manager, applying any custom modifications
+// to its feature sets.
+func NewManager(cfg Config) (*Manager, error) {
+	return newManager(cfg, defaultSetDesc)
+}
+
+// newManager creates a new feature Manager, applying any custom modifications
+// to its feature sets. The dependencies of the resulting feature sets are
+// validated, such that a feature can't be disabled without also disabling the
+// features depending on it.
+func newManager(cfg Config, desc setDesc) (*Manager, error) {
+	// First build the default feature vector for all known sets.
+	fsets := make(map[Set]*lnwire.RawFeatureVector)
+	for bit, sets := range desc {
+		for set := range sets {
+			// Fetch the feature vector for this set, allocating a
+			// new one if it doesn't exist.
+			fv, ok := fsets[set]
+			if !ok {
+				fv = lnwire.NewRawFeatureVector()
+			}
+
+			// Set the configured bit on the feature vector,
+			// ensuring that we don't set two feature bits for the
+			// same pair.
+			if fv.IsSet(bit ^ 1) {
+				return nil, fmt.Errorf("feature %v already "+
+					"set in %v", bit^1, set)
+			}
+			fv.Set(bit)
+
+			// Write the updated feature vector under its set.
+			fsets[set] = fv
+		}
+	}
+
+	// Now, remove any features as directed by the config, and validate
+	// that the dependencies of the remaining ones are still satisfied.
+	for set, raw := range fsets {
+		if cfg.NoTLVOnion {
+			raw.Unset(lnwire.TLVOnionPayloadOptional)
+			raw.Unset(lnwire.TLVOnionPayloadRequired)
+		}
+		if cfg.NoPaymentAddr {
+			raw.Unset(lnwire.PaymentAddrOptional)
+			raw.Unset(lnwire.PaymentAddrRequired)
+		}
+		if cfg.NoMPP {
+			raw.Unset(lnwire.MPPOptional)
+			raw.Unset(lnwire.MPPRequired)
+		}
+		if cfg.NoDualFund {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+		}
+		if cfg.NoGossipQueriesEx {
+			raw.Unset(lnwire.GossipQueriesExOptional)
+			raw.Unset(lnwire.GossipQueriesExRequired)
+		}
+
+		fv := lnwire.NewFeatureVector(raw, lnwire.Features)
+		if err := ValidateDeps(fv); err != nil {
+			return nil, fmt.Errorf("invalid feature set %v: %v",
+				set, err)
+		}
+	}
+
+	return &Manager{
+		fsets: fsets,
+	}, nil
+}
+
+// GetRaw returns a raw feature vector for the passed set. If no set is known,
+// an empty raw feature vector is returned.
+func (m *Manager) GetRaw(set Set) *lnwire.RawFeatureVector {
+	if fv, ok := m.fsets[set]; ok {
+		return fv.Clone()
+	}
+
+	return lnwire.NewRawFeatureVector()
+}
+
+// Get returns a feature vector for the passed set. If no set is known, an
+// empty feature vector is returned.
+func (m *Manager) Get(set Set) *lnwire.FeatureVector {
+	raw := m.GetRaw(set)
+	return lnwire.NewFeatureVector(raw, lnwire.Features)
+}
+
+// ListSets returns a list of the feature sets that our node supports.
+func (m *Manager) ListSets() []Set {
+	var sets []Set
+	for set := range m.fsets {
+		sets = append(sets, set)
+	}
+
+	return sets
+}
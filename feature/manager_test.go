@@ -0,0 +1,207 @@
+package feature
+
+import (
+	"testing"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+type managerTest struct {
+	name string
+	cfg  Config
+}
+
+const unknownFeature lnwire.FeatureBit = 30
+
+var testSetDesc = setDesc{
+	lnwire.DataLossProtectRequired: {
+		SetInit: {}, // I
+	},
+	lnwire.GossipQueriesOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.GossipQueriesExOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.TLVOnionPayloadOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+		SetInvoice: {}, // 9
+	},
+	lnwire.PaymentAddrOptional: {
+		SetInvoice: {}, // 9
+	},
+	lnwire.MPPOptional: {
+		SetInvoice: {}, // 9
+	},
+	lnwire.DualFundOptional: {
+		SetInit: {}, // I
+	},
+	unknownFeature: {
+		SetInit: {},
+	},
+}
+
+var managerTests = []managerTest{
+	{
+		name: "default",
+		cfg:  Config{},
+	},
+	{
+		name: "no mpp",
+		cfg: Config{
+			NoMPP: true,
+		},
+	},
+	{
+		name: "no payment addr and mpp",
+		cfg: Config{
+			NoPaymentAddr: true,
+			NoMPP:         true,
+		},
+	},
+	{
+		name: "no tlv, payment addr and mpp",
+		cfg: Config{
+			NoTLVOnion:    true,
+			NoPaymentAddr: true,
+			NoMPP:         true,
+		},
+	},
+	{
+		name: "no dual fund",
+		cfg: Config{
+			NoDualFund: true,
+		},
+	},
+	{
+		name: "no gossip queries ex",
+		cfg: Config{
+			NoGossipQueriesEx: true,
+		},
+	},
+}
+
+// TestManager asserts basic initialization and operation of a feature
+// manager, including that the proper features are removed in response to
+// config changes.
+func TestManager(t *testing.T) {
+	for _, test := range managerTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testManager(t, test)
+		})
+	}
+}
+
+func testManager(t *testing.T, test managerTest) {
+	m, err := newManager(test.cfg, testSetDesc)
+	if err != nil {
+		t.Fatalf("unable to create feature manager: %v", err)
+	}
+
+	sets := []Set{
+		SetInit,
+		SetLegacyGlobal,
+		SetNodeAnn,
+		SetInvoice,
+	}
+
+	for _, set := range sets {
+		raw := m.GetRaw(set)
+		fv := m.Get(set)
+
+		fv2 := lnwire.NewFeatureVector(raw, lnwire.Features)
+
+		for _, bit := range fv2.Features() {
+			if !fv.IsSet(bit) {
+				t.Fatalf("expected fv to contain bit %d", bit)
+			}
+		}
+
+		// Assert that the features we expect to be removed are
+		// indeed removed.
+		assertUnset := func(bit lnwire.FeatureBit) {
+			hasBit := fv.HasFeature(bit)
+			if hasBit {
+				t.Fatalf("bit %d or %d should not be set",
+					bit, bit^1)
+			}
+		}
+		if test.cfg.NoTLVOnion {
+			assertUnset(lnwire.TLVOnionPayloadOptional)
+		}
+		if test.cfg.NoPaymentAddr {
+			assertUnset(lnwire.PaymentAddrOptional)
+		}
+		if test.cfg.NoMPP {
+			assertUnset(lnwire.MPPOptional)
+		}
+		if test.cfg.NoDualFund {
+			assertUnset(lnwire.DualFundOptional)
+		}
+		if test.cfg.NoGossipQueriesEx {
+			assertUnset(lnwire.GossipQueriesExOptional)
+		}
+
+		// Mutating the returned vector shouldn't affect the vectors
+		// handed out by the manager later on.
+		raw.Set(unknownFeature + 2)
+		if m.GetRaw(set).IsSet(unknownFeature + 2) {
+			t.Fatalf("manager's %v feature set was mutated", set)
+		}
+
+		// Finally, assert that all features from the descriptor
+		// that weren't removed by the config are present.
+		for bit, sets := range testSetDesc {
+			if _, ok := sets[set]; !ok {
+				continue
+			}
+
+			if test.cfg.NoTLVOnion &&
+				bit == lnwire.TLVOnionPayloadOptional {
+
+				continue
+			}
+			if test.cfg.NoPaymentAddr &&
+				bit == lnwire.PaymentAddrOptional {
+
+				continue
+			}
+			if test.cfg.NoMPP && bit == lnwire.MPPOptional {
+				continue
+			}
+			if test.cfg.NoDualFund &&
+				bit == lnwire.DualFundOptional {
+
+				continue
+			}
+			if test.cfg.NoGossipQueriesEx &&
+				bit == lnwire.GossipQueriesExOptional {
+
+				continue
+			}
+
+			if !fv.IsSet(bit) {
+				t.Fatalf("expected feature bit %d to be set in "+
+					"%v", bit, set)
+			}
+		}
+	}
+}
+
+// TestManagerInvalidDeps asserts that a feature can't be disabled through the
+// config while features depending on it remain enabled.
+func TestManagerInvalidDeps(t *testing.T) {
+	t.Parallel()
+
+	_, err := newManager(Config{NoTLVOnion: true}, testSetDesc)
+	if err == nil {
+		t.Fatalf("expected feature manager creation to fail")
+	}
+
+	_, err = newManager(Config{NoPaymentAddr: true}, testSetDesc)
+	if err == nil {
+		t.Fatalf("expected feature manager creation to fail")
+	}
+}
@@ -0,0 +1,41 @@
+package feature
+
+// Set is an enum identifying various feature sets, which separates the single
+// feature namespace into distinct categorizations.
+type Set uint8
+
+const (
+	// SetInit identifies features that should be sent in the local
+	// features of an Init message. These features are only relevant to the
+	// connection with a direct peer.
+	SetInit Set = iota
+
+	// SetLegacyGlobal identifies features that should be sent in the
+	// global features of an Init message. These features are also
+	// advertised to the entire network within our node announcement.
+	SetLegacyGlobal
+
+	// SetNodeAnn identifies features that should be advertised on node
+	// announcements.
+	SetNodeAnn
+
+	// SetInvoice identifies features that should be advertised on
+	// invoices generated by the daemon.
+	SetInvoice
+)
+
+// String returns a human-readable description of a Set.
+func (s Set) String() string {
+	switch s {
+	case SetInit:
+		return "SetInit"
+	case SetLegacyGlobal:
+		return "SetLegacyGlobal"
+	case SetNodeAnn:
+		return "SetNodeAnn"
+	case SetInvoice:
+		return "SetInvoice"
+	default:
+		return "SetUnknown"
+	}
+}
@@ -0,0 +1,141 @@
+package htlcswitch
+
+import (
+	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/lnwallet"
+)
+
+const (
+	// DefaultCommitFeeConfTarget is the default confirmation target, in
+	// blocks, of the commitment fee rate we propose for our channels.
+	DefaultCommitFeeConfTarget = 3
+
+	// DefaultCommitFeeHysteresis is the default percentage by which the
+	// target fee rate must deviate from the current commitment fee rate
+	// before we propose a fee update.
+	DefaultCommitFeeHysteresis = 10
+)
+
+// CommitFeePolicy governs the commitment fee rate that a link proposes for a
+// channel that we initiated. The target fee rate is sampled from the fee
+// estimator and bounded by the policy. To avoid frequent fee updates that our
+// peers may disagree with, a new fee rate is only proposed once it deviates
+// far enough from the current one.
+type CommitFeePolicy struct {
+	// ConfTarget is the confirmation target, in blocks, the fee estimator
+	// is queried with. If zero, DefaultCommitFeeConfTarget is used.
+	ConfTarget uint32
+
+	// MinFeeRate is the lowest fee rate we'll propose. If zero, the fee
+	// rate floor is used.
+	MinFeeRate lnwallet.SatPerKWeight
+
+	// MaxFeeRate is the highest fee rate we'll propose. If zero, the fee
+	// rate isn't bounded from above.
+	MaxFeeRate lnwallet.SatPerKWeight
+
+	// Hysteresis is the percentage by which the target fee rate must
+	// deviate from the current commitment fee rate before we propose it.
+	// If zero, every change of the target fee rate is proposed.
+	Hysteresis uint32
+}
+
+// Merge updates the policy with the fields set in the passed override. Fields
+// the override sets to zero reset the ones of the policy to their default,
+// while unset fields don't override the current ones.
+func (p *CommitFeePolicy) Merge(override *channeldb.CommitFeePolicyOverride) {
+	if override.ConfTarget != nil {
+		p.ConfTarget = *override.ConfTarget
+	}
+	if override.MinFeePerKw != nil {
+		p.MinFeeRate = lnwallet.SatPerKWeight(*override.MinFeePerKw)
+	}
+	if override.MaxFeePerKw != nil {
+		p.MaxFeeRate = lnwallet.SatPerKWeight(*override.MaxFeePerKw)
+	}
+	if override.Hysteresis != nil {
+		p.Hysteresis = *override.Hysteresis
+	}
+}
+
+// confTarget returns the confirmation target of the policy.
+func (p *CommitFeePolicy) confTarget() uint32 {
+	if p.ConfTarget == 0 {
+		return DefaultCommitFeeConfTarget
+	}
+
+	return p.ConfTarget
+}
+
+// minFeeRate returns the lower bound of the fee rates of the policy.
+func (p *CommitFeePolicy) minFeeRate() lnwallet.SatPerKWeight {
+	if p.MinFeeRate < lnwallet.FeePerKwFloor {
+		return lnwallet.FeePerKwFloor
+	}
+
+	return p.MinFeeRate
+}
+
+// clamp bounds the passed fee rate by the min and max fee rate of the policy.
+func (p *CommitFeePolicy) clamp(
+	feePerKw lnwallet.SatPerKWeight) lnwallet.SatPerKWeight {
+
+	if p.MaxFeeRate != 0 && feePerKw > p.MaxFeeRate {
+		feePerKw = p.MaxFeeRate
+	}
+	if feePerKw < p.minFeeRate() {
+		feePerKw = p.minFeeRate()
+	}
+
+	return feePerKw
+}
+
+// targetFeeRate queries the passed fee estimator for the fee rate to get into
+// the chain within the confirmation target of the policy, bounded by its min
+// and max fee rate.
+func (p *CommitFeePolicy) targetFeeRate(
+	estimator lnwallet.FeeEstimator) (lnwallet.SatPerKWeight, error) {
+
+	feePerKw, err := estimator.EstimateFeePerKW(p.confTarget())
+	if err != nil {
+		return 0, err
+	}
+
+	return p.clamp(feePerKw), nil
+}
+
+// shouldUpdate returns true if we should update the commitment fee rate of a
+// channel to the passed target fee rate. We always update if the current fee
+// rate is out of the bounds of the policy. Otherwise, we only update if the
+// target fee rate deviates from the current fee rate by at least the
+// hysteresis of the policy.
+func (p *CommitFeePolicy) shouldUpdate(targetFee,
+	chanFee lnwallet.SatPerKWeight) bool {
+
+	band := (chanFee * lnwallet.SatPerKWeight(p.Hysteresis)) / 100
+
+	switch {
+	// Our fees match exactly, so there's nothing to update.
+	case targetFee == chanFee:
+		return false
+
+	// The current fee rate is out of bounds, so we'll move it back into
+	// them regardless of the hysteresis.
+	case chanFee != p.clamp(chanFee):
+		return true
+
+	// If the target fee is greater than the commitment fee, then we'll
+	// switch to it if it's above the hysteresis band.
+	case targetFee > chanFee && targetFee >= chanFee+band:
+		return true
+
+	// If the target fee is less than our commitment fee, then we'll
+	// switch to it if it's below the hysteresis band.
+	case targetFee < chanFee && targetFee <= chanFee-band:
+		return true
+
+	// Otherwise, we won't modify our fee.
+	default:
+		return false
+	}
+}
@@ -2,10 +2,13 @@ package htlcswitch
 
 import (
 	"errors"
+	"sync"
+	"sync/atomic"
 
 	"github.com/coreos/bbolt"
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/queue"
 )
 
 var (
@@ -41,6 +44,13 @@ type ControlTower interface {
 	// atomically transitions the status for this payment hash as InFlight.
 	ClearForTakeoff(htlc *lnwire.UpdateAddHTLC) error
 
+	// ClearShardForTakeoff is the multi-path counterpart of
+	// ClearForTakeoff. Besides transitioning a grounded payment to
+	// InFlight, it also permits an additional shard for a payment that is
+	// InFlight because of shards sent through this method. A payment is
+	// only transitioned back to Grounded once all of its shards failed.
+	ClearShardForTakeoff(htlc *lnwire.UpdateAddHTLC) error
+
 	// Success transitions an InFlight payment into a Completed payment.
 	// After invoking this method, ClearForTakeoff should always return an
 	// error to prevent us from making duplicate payments to the same
@@ -52,6 +62,31 @@ type ControlTower interface {
 	// call for this payment hash, allowing the switch to make a subsequent
 	// payment.
 	Fail(paymentHash [32]byte) error
+
+	// RegisterAttempt persists a new attempt to send the payment along a
+	// route, assigning it its attempt ID.
+	RegisterAttempt(paymentHash [32]byte,
+		attempt *channeldb.PaymentAttempt) error
+
+	// SettleAttempt records that the HTLC of the given attempt to send the
+	// payment was settled.
+	SettleAttempt(paymentHash [32]byte, attemptID uint64) error
+
+	// FailAttempt records that the HTLC of the given attempt to send the
+	// payment failed, along with the index of the node that reported the
+	// failure within the route and the code of its failure message.
+	FailAttempt(paymentHash [32]byte, attemptID uint64,
+		sourceIndex uint32, failureCode lnwire.FailCode) error
+
+	// FailPayment transitions a Grounded payment, which has nothing left
+	// in flight, into a Failed payment, recording the reason it was given
+	// up on. Like a Grounded payment, it may be attempted again.
+	FailPayment(paymentHash [32]byte, reason channeldb.FailureReason) error
+
+	// SubscribePayment returns a subscription that delivers the history of
+	// the payment, starting with its current one, each time it changes.
+	// ErrPaymentNotInitiated is returned if the payment is unknown.
+	SubscribePayment(paymentHash [32]byte) (*PaymentSubscription, error)
 }
 
 // paymentControl is persistent implementation of ControlTower to restrict
@@ -60,6 +95,18 @@ type paymentControl struct {
 	strict bool
 
 	db *channeldb.DB
+
+	// inFlightShards tracks the number of shards of multi-path payments
+	// that are currently in flight, indexed by payment hash. As shards
+	// can't survive a restart, this doesn't need to be persisted.
+	inFlightShards map[[32]byte]uint32
+	shardMtx       sync.Mutex
+
+	// subscriptions holds the active subscriptions to the updates of
+	// each payment, indexed by payment hash and subscription ID.
+	subscriptions      map[[32]byte]map[uint64]*PaymentSubscription
+	nextSubscriptionID uint64
+	subscriptionMtx    sync.Mutex
 }
 
 // NewPaymentControl creates a new instance of the paymentControl. The strict
@@ -72,8 +119,12 @@ type paymentControl struct {
 // hash from being added.
 func NewPaymentControl(strict bool, db *channeldb.DB) ControlTower {
 	return &paymentControl{
-		strict: strict,
-		db:     db,
+		strict:         strict,
+		db:             db,
+		inFlightShards: make(map[[32]byte]uint32),
+		subscriptions: make(
+			map[[32]byte]map[uint64]*PaymentSubscription,
+		),
 	}
 }
 
@@ -96,11 +147,10 @@ func (p *paymentControl) ClearForTakeoff(htlc *lnwire.UpdateAddHTLC) error {
 
 		switch paymentStatus {
 
-		case channeldb.StatusGrounded:
-			// It is safe to reattempt a payment if we know that we
-			// haven't left one in flight. Since this one is
-			// grounded, Transition the payment status to InFlight
-			// to prevent others.
+		// It is safe to reattempt a payment if we know that we haven't
+		// left one in flight. Since this one is grounded or failed,
+		// Transition the payment status to InFlight to prevent others.
+		case channeldb.StatusGrounded, channeldb.StatusFailed:
 			return channeldb.UpdatePaymentStatusTx(
 				tx, htlc.PaymentHash, channeldb.StatusInFlight,
 			)
@@ -124,14 +174,69 @@ func (p *paymentControl) ClearForTakeoff(htlc *lnwire.UpdateAddHTLC) error {
 	if err != nil {
 		return err
 	}
+	if takeoffErr != nil {
+		return takeoffErr
+	}
+
+	p.notifySubscribers(htlc.PaymentHash)
+
+	return nil
+}
+
+// ClearShardForTakeoff checks that we don't already have a Completed payment
+// identified by the same payment hash, or an InFlight one that isn't made up
+// of shards.
+func (p *paymentControl) ClearShardForTakeoff(htlc *lnwire.UpdateAddHTLC) error {
+	p.shardMtx.Lock()
+	defer p.shardMtx.Unlock()
+
+	// If other shards of this payment are already in flight, then the
+	// payment must already be InFlight, so we only need to account for
+	// the additional shard.
+	if p.inFlightShards[htlc.PaymentHash] > 0 {
+		p.inFlightShards[htlc.PaymentHash]++
+		return nil
+	}
+
+	if err := p.ClearForTakeoff(htlc); err != nil {
+		return err
+	}
+
+	p.inFlightShards[htlc.PaymentHash] = 1
+	return nil
+}
 
-	return takeoffErr
+// settleShard accounts for a shard of the payment identified by the payment
+// hash that has been resolved. It returns true if other shards of the payment
+// are still in flight.
+//
+// NOTE: The shardMtx MUST be held when calling this method.
+func (p *paymentControl) settleShard(paymentHash [32]byte) bool {
+	switch p.inFlightShards[paymentHash] {
+	case 0:
+		return false
+
+	case 1:
+		delete(p.inFlightShards, paymentHash)
+		return false
+
+	default:
+		p.inFlightShards[paymentHash]--
+		return true
+	}
 }
 
 // Success transitions an InFlight payment to Completed, otherwise it returns an
 // error. After calling Success, ClearForTakeoff should prevent any further
 // attempts for the same payment hash.
 func (p *paymentControl) Success(paymentHash [32]byte) error {
+	// We'll hold the shard mutex throughout, to ensure no new shard is
+	// cleared for takeoff while the payment status is updated.
+	p.shardMtx.Lock()
+	defer p.shardMtx.Unlock()
+
+	p.settleShard(paymentHash)
+
 	var updateErr error
 	err := p.db.Batch(func(tx *bbolt.Tx) error {
 		paymentStatus, err := channeldb.FetchPaymentStatusTx(
@@ -163,6 +268,13 @@ func (p *paymentControl) Success(paymentHash [32]byte) error {
 			// A successful response was received for an InFlight
 			// payment, mark it as completed to prevent sending to
 			// this payment hash again.
+			fallthrough
+
+		case paymentStatus == channeldb.StatusFailed:
+			// The payment was marked as failed when the HTLC of
+			// one of its attempts failed after a restart, while
+			// another one was still in flight. As it settled, the
+			// payment did succeed after all.
 			return channeldb.UpdatePaymentStatusTx(
 				tx, paymentHash, channeldb.StatusCompleted,
 			)
@@ -181,14 +293,27 @@ func (p *paymentControl) Success(paymentHash [32]byte) error {
 	if err != nil {
 		return err
 	}
+	if updateErr != nil {
+		return updateErr
+	}
+
+	p.notifySubscribers(paymentHash)
 
-	return updateErr
+	return nil
 }
 
 // Fail transitions an InFlight payment to Grounded, otherwise it returns an
 // error. After calling Fail, ClearForTakeoff should fail any further attempts
-// for the same payment hash.
+// for the same payment hash. If other shards of a multi-path payment are still
+// in flight, the payment remains InFlight.
 func (p *paymentControl) Fail(paymentHash [32]byte) error {
+	p.shardMtx.Lock()
+	defer p.shardMtx.Unlock()
+
+	if p.settleShard(paymentHash) {
+		return nil
+	}
+
 	var updateErr error
 	err := p.db.Batch(func(tx *bbolt.Tx) error {
 		paymentStatus, err := channeldb.FetchPaymentStatusTx(
@@ -224,6 +349,11 @@ func (p *paymentControl) Fail(paymentHash [32]byte) error {
 				tx, paymentHash, channeldb.StatusGrounded,
 			)
 
+		case paymentStatus == channeldb.StatusFailed:
+			// The payment was already given up on, which permits
+			// subsequent attempts as well, so we'll leave it
+			// failed along with its reason.
+
 		case paymentStatus == channeldb.StatusCompleted:
 			// The payment was completed previously, and we are now
 			// reporting that it has failed. Leave the status as
@@ -240,6 +370,259 @@ func (p *paymentControl) Fail(paymentHash [32]byte) error {
 	if err != nil {
 		return err
 	}
+	if updateErr != nil {
+		return updateErr
+	}
+
+	p.notifySubscribers(paymentHash)
+
+	return nil
+}
+
+// RegisterAttempt persists a new attempt to send the payment along a route,
+// assigning it its attempt ID.
+func (p *paymentControl) RegisterAttempt(paymentHash [32]byte,
+	attempt *channeldb.PaymentAttempt) error {
+
+	err := p.db.RegisterPaymentAttempt(paymentHash, attempt)
+	if err != nil {
+		return err
+	}
+
+	p.notifySubscribers(paymentHash)
+
+	return nil
+}
+
+// SettleAttempt records that the HTLC of the given attempt to send the payment
+// was settled.
+func (p *paymentControl) SettleAttempt(paymentHash [32]byte,
+	attemptID uint64) error {
+
+	err := p.db.SettlePaymentAttempt(paymentHash, attemptID)
+	if err != nil {
+		return err
+	}
+
+	p.notifySubscribers(paymentHash)
+
+	return nil
+}
+
+// FailAttempt records that the HTLC of the given attempt to send the payment
+// failed, along with the index of the node that reported the failure within
+// the route and the code of its failure message.
+func (p *paymentControl) FailAttempt(paymentHash [32]byte, attemptID uint64,
+	sourceIndex uint32, failureCode lnwire.FailCode) error {
+
+	err := p.db.FailPaymentAttempt(
+		paymentHash, attemptID, sourceIndex, failureCode,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.notifySubscribers(paymentHash)
+
+	return nil
+}
+
+// FailPayment transitions a Grounded payment to Failed, recording the reason
+// it was given up on, otherwise it returns an error. A payment that is still
+// InFlight can't be failed, as one of its HTLCs may yet settle.
+func (p *paymentControl) FailPayment(paymentHash [32]byte,
+	reason channeldb.FailureReason) error {
+
+	// We'll hold the shard mutex throughout, to ensure no new shard is
+	// cleared for takeoff while the payment status is updated.
+	p.shardMtx.Lock()
+	defer p.shardMtx.Unlock()
+
+	var updateErr error
+	err := p.db.Batch(func(tx *bbolt.Tx) error {
+		paymentStatus, err := channeldb.FetchPaymentStatusTx(
+			tx, paymentHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Reset the update error, to avoid carrying over an error
+		// from a previous execution of the batched db transaction.
+		updateErr = nil
+
+		switch paymentStatus {
+
+		case channeldb.StatusGrounded, channeldb.StatusFailed:
+			// Nothing is left in flight for this payment, so it
+			// can be marked as failed.
+			return channeldb.FailPaymentTx(tx, paymentHash, reason)
+
+		case channeldb.StatusInFlight:
+			// An HTLC of this payment is still in flight, so we
+			// can't tell whether it failed yet.
+			updateErr = ErrPaymentInFlight
+
+		case channeldb.StatusCompleted:
+			// The payment was completed, so it can't fail
+			// anymore.
+			updateErr = ErrAlreadyPaid
+
+		default:
+			updateErr = ErrUnknownPaymentStatus
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if updateErr != nil {
+		return updateErr
+	}
+
+	p.notifySubscribers(paymentHash)
+
+	return nil
+}
+
+// PaymentSubscription delivers the history of a payment each time it changes,
+// until it's canceled.
+type PaymentSubscription struct {
+	cancelled uint32 // To be used atomically.
+
+	// Updates is the channel over which the current history of the
+	// payment is delivered, first when subscribing and then after each of
+	// its changes.
+	Updates chan *channeldb.PaymentHistory
+
+	id          uint64
+	paymentHash [32]byte
+
+	ntfnQueue *queue.ConcurrentQueue
+
+	control *paymentControl
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// Cancel unregisters the PaymentSubscription, freeing any previously allocated
+// resources.
+func (s *PaymentSubscription) Cancel() {
+	if !atomic.CompareAndSwapUint32(&s.cancelled, 0, 1) {
+		return
+	}
+
+	close(s.cancelChan)
+
+	s.control.subscriptionMtx.Lock()
+	subscriptions := s.control.subscriptions[s.paymentHash]
+	delete(subscriptions, s.id)
+	if len(subscriptions) == 0 {
+		delete(s.control.subscriptions, s.paymentHash)
+	}
+	s.control.subscriptionMtx.Unlock()
+
+	s.ntfnQueue.Stop()
+
+	s.wg.Wait()
+}
+
+// SubscribePayment returns a subscription that delivers the history of the
+// payment, starting with its current one, each time it changes. As the
+// history is persisted, this also applies to payments sent before a restart.
+// Payments that were never attempted can't be subscribed to, and
+// ErrPaymentNotInitiated is returned for them.
+func (p *paymentControl) SubscribePayment(
+	paymentHash [32]byte) (*PaymentSubscription, error) {
+
+	// We'll register the subscription after fetching the current history,
+	// while holding the mutex, such that no change made in the meantime
+	// can be missed.
+	p.subscriptionMtx.Lock()
+	defer p.subscriptionMtx.Unlock()
+
+	history, err := p.db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if history.Status == channeldb.StatusGrounded &&
+		len(history.Attempts) == 0 {
+
+		return nil, ErrPaymentNotInitiated
+	}
 
-	return updateErr
+	client := &PaymentSubscription{
+		Updates:     make(chan *channeldb.PaymentHistory),
+		paymentHash: paymentHash,
+		ntfnQueue:   queue.NewConcurrentQueue(20),
+		control:     p,
+		cancelChan:  make(chan struct{}),
+	}
+	client.ntfnQueue.Start()
+
+	client.id = p.nextSubscriptionID
+	p.nextSubscriptionID++
+
+	subscriptions, ok := p.subscriptions[paymentHash]
+	if !ok {
+		subscriptions = make(map[uint64]*PaymentSubscription)
+		p.subscriptions[paymentHash] = subscriptions
+	}
+	subscriptions[client.id] = client
+
+	client.ntfnQueue.ChanIn() <- history
+
+	// We'll launch a goroutine that proxies all histories appended to the
+	// end of the concurrent queue to the client-side channel.
+	client.wg.Add(1)
+	go func() {
+		defer client.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-client.ntfnQueue.ChanOut():
+				history := ntfn.(*channeldb.PaymentHistory)
+
+				select {
+				case client.Updates <- history:
+				case <-client.cancelChan:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+			}
+		}
+	}()
+
+	return client, nil
+}
+
+// notifySubscribers delivers the current history of the payment to all of
+// its subscribers.
+func (p *paymentControl) notifySubscribers(paymentHash [32]byte) {
+	p.subscriptionMtx.Lock()
+	defer p.subscriptionMtx.Unlock()
+
+	subscriptions := p.subscriptions[paymentHash]
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	history, err := p.db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		log.Errorf("Unable to fetch history of payment %x: %v",
+			paymentHash, err)
+		return
+	}
+
+	for _, client := range subscriptions {
+		select {
+		case client.ntfnQueue.ChanIn() <- history:
+		case <-client.cancelChan:
+		}
+	}
 }
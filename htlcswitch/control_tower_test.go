@@ -3,6 +3,7 @@ package htlcswitch
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/fastsha256"
 	"github.com/breez/lightninglib/channeldb"
@@ -46,6 +47,11 @@ var paymentControlTests = []struct {
 		strict:   true,
 		testcase: testPaymentControlSwitchDoublePay,
 	},
+	{
+		name:     "shards-strict",
+		strict:   true,
+		testcase: testPaymentControlSwitchShards,
+	},
 	{
 		name:     "fail-not-strict",
 		strict:   false,
@@ -61,6 +67,11 @@ var paymentControlTests = []struct {
 		strict:   false,
 		testcase: testPaymentControlSwitchDoublePay,
 	},
+	{
+		name:     "shards-not-strict",
+		strict:   false,
+		testcase: testPaymentControlSwitchShards,
+	},
 }
 
 // TestPaymentControls runs a set of common tests against both the strict and
@@ -204,6 +215,73 @@ func testPaymentControlSwitchDoublePay(t *testing.T, strict bool) {
 	}
 }
 
+// testPaymentControlSwitchShards checks that several shards of a payment can be
+// in flight at once, that the payment stays InFlight until all of them failed,
+// and that no further shards are permitted once one of them succeeded.
+func testPaymentControlSwitchShards(t *testing.T, strict bool) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(strict, db)
+
+	htlc, err := genHtlc()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	// Send two shards of the same payment, both should be permitted.
+	for i := 0; i < 2; i++ {
+		if err := pControl.ClearShardForTakeoff(htlc); err != nil {
+			t.Fatalf("unable to send shard: %v", err)
+		}
+	}
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusInFlight)
+
+	// A regular payment to the same hash must still be refused.
+	if err := pControl.ClearForTakeoff(htlc); err != ErrPaymentInFlight {
+		t.Fatalf("payment control wrong behaviour: sending while " +
+			"shards are in flight must trigger ErrPaymentInFlight")
+	}
+
+	// Failing one of the shards must leave the payment InFlight, while
+	// failing the last one grounds it.
+	if err := pControl.Fail(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to fail shard: %v", err)
+	}
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusInFlight)
+
+	if err := pControl.Fail(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to fail shard: %v", err)
+	}
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusGrounded)
+
+	// Retry with two new shards, and let both of them succeed.
+	for i := 0; i < 2; i++ {
+		if err := pControl.ClearShardForTakeoff(htlc); err != nil {
+			t.Fatalf("unable to send shard: %v", err)
+		}
+	}
+	if err := pControl.Success(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to settle shard: %v", err)
+	}
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusCompleted)
+
+	err = pControl.Success(htlc.PaymentHash)
+	if err != ErrPaymentAlreadyCompleted {
+		t.Fatalf("expected ErrPaymentAlreadyCompleted, got: %v", err)
+	}
+
+	// Now that the payment completed, no further shards may be sent.
+	if err := pControl.ClearShardForTakeoff(htlc); err != ErrAlreadyPaid {
+		t.Fatalf("payment control wrong behaviour: " +
+			"shard after completion must trigger ErrAlreadyPaid")
+	}
+}
+
 // TestPaymentControlNonStrictSuccessesWithoutInFlight checks that a non-strict
 // payment control will allow calls to Success when no payment is in flight. This
 // is necessary to gracefully handle the case in which the switch already sent
@@ -334,6 +412,172 @@ func TestPaymentControlStrictFailsWithoutInFlight(t *testing.T) {
 	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusGrounded)
 }
 
+// TestPaymentControlFailPayment checks that only a payment without any HTLC in
+// flight can be failed, and that a failed payment may be attempted again.
+func TestPaymentControlFailPayment(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(true, db)
+
+	htlc, err := genHtlc()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	if err := pControl.ClearForTakeoff(htlc); err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	// The payment can't be failed while its HTLC is in flight.
+	err = pControl.FailPayment(
+		htlc.PaymentHash, channeldb.FailureReasonNoRoute,
+	)
+	if err != ErrPaymentInFlight {
+		t.Fatalf("expected ErrPaymentInFlight, got %v", err)
+	}
+
+	if err := pControl.Fail(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to fail payment hash: %v", err)
+	}
+
+	err = pControl.FailPayment(
+		htlc.PaymentHash, channeldb.FailureReasonNoRoute,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusFailed)
+
+	// A failed payment may be attempted again, and once it succeeded, it
+	// can't be failed anymore.
+	if err := pControl.ClearForTakeoff(htlc); err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+	if err := pControl.Success(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to mark payment hash success: %v", err)
+	}
+
+	err = pControl.FailPayment(
+		htlc.PaymentHash, channeldb.FailureReasonNoRoute,
+	)
+	if err != ErrAlreadyPaid {
+		t.Fatalf("expected ErrAlreadyPaid, got %v", err)
+	}
+
+	assertPaymentStatus(t, db, htlc.PaymentHash, channeldb.StatusCompleted)
+}
+
+// TestPaymentControlSubscribePayment checks that a payment subscription
+// delivers the current history of the payment, followed by each change to its
+// status and attempts.
+func TestPaymentControlSubscribePayment(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewPaymentControl(false, db)
+
+	htlc, err := genHtlc()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	// A payment that was never initiated can't be subscribed to.
+	_, err = pControl.SubscribePayment(htlc.PaymentHash)
+	if err != ErrPaymentNotInitiated {
+		t.Fatalf("expected ErrPaymentNotInitiated, got %v", err)
+	}
+
+	if err := pControl.ClearForTakeoff(htlc); err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	subscription, err := pControl.SubscribePayment(htlc.PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to subscribe to payment: %v", err)
+	}
+	defer subscription.Cancel()
+
+	assertUpdate := func(status channeldb.PaymentStatus,
+		numAttempts int) *channeldb.PaymentHistory {
+
+		t.Helper()
+
+		select {
+		case history := <-subscription.Updates:
+			if history.Status != status {
+				t.Fatalf("expected status %v, got %v", status,
+					history.Status)
+			}
+			if len(history.Attempts) != numAttempts {
+				t.Fatalf("expected %v attempts, got %v",
+					numAttempts, len(history.Attempts))
+			}
+			return history
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no payment update received")
+		}
+
+		return nil
+	}
+
+	// The current history of the payment is delivered first.
+	assertUpdate(channeldb.StatusInFlight, 0)
+
+	attempt := &channeldb.PaymentAttempt{
+		Hops: []channeldb.AttemptHop{
+			{ChannelID: 1, AmtToForward: htlc.Amount},
+		},
+		TotalAmount: htlc.Amount,
+		AttemptTime: time.Now(),
+	}
+	err = pControl.RegisterAttempt(htlc.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	assertUpdate(channeldb.StatusInFlight, 1)
+
+	err = pControl.FailAttempt(
+		htlc.PaymentHash, attempt.AttemptID, 1,
+		lnwire.CodeTemporaryChannelFailure,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+	history := assertUpdate(channeldb.StatusInFlight, 1)
+	if history.Attempts[0].State != channeldb.AttemptFailed {
+		t.Fatalf("expected attempt state %v, got %v",
+			channeldb.AttemptFailed, history.Attempts[0].State)
+	}
+
+	if err := pControl.Fail(htlc.PaymentHash); err != nil {
+		t.Fatalf("unable to fail payment hash: %v", err)
+	}
+	assertUpdate(channeldb.StatusGrounded, 1)
+
+	err = pControl.FailPayment(
+		htlc.PaymentHash, channeldb.FailureReasonTimeout,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+	history = assertUpdate(channeldb.StatusFailed, 1)
+	if history.FailureReason != channeldb.FailureReasonTimeout {
+		t.Fatalf("expected failure reason %v, got %v",
+			channeldb.FailureReasonTimeout, history.FailureReason)
+	}
+}
+
 func assertPaymentStatus(t *testing.T, db *channeldb.DB,
 	hash [32]byte, expStatus channeldb.PaymentStatus) {
 
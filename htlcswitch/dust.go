@@ -0,0 +1,130 @@
+package htlcswitch
+
+import (
+	"errors"
+
+	"github.com/breez/lightninglib/lnwallet"
+	"github.com/breez/lightninglib/lnwire"
+)
+
+const (
+	// DefaultMaxDustHTLCExposure is the default maximum total value of the
+	// dust HTLCs on either commitment of a channel.
+	DefaultMaxDustHTLCExposure = lnwire.MilliSatoshi(500000000)
+)
+
+var (
+	// ErrDustExposureExceeded is returned when an HTLC or a fee update
+	// would push the total value of the dust HTLCs on either commitment of
+	// a channel past the max dust HTLC exposure of the link.
+	ErrDustExposureExceeded = errors.New("max dust htlc exposure exceeded")
+)
+
+// dustExposure tracks the total value of the dust HTLCs on both commitments of
+// a channel.
+type dustExposure struct {
+	ours   lnwire.MilliSatoshi
+	theirs lnwire.MilliSatoshi
+}
+
+// currentDustExposure returns the dust exposure of the channel at the fee rate
+// of its latest commitments, or at the passed fee rate if it's non-zero.
+func (l *channelLink) currentDustExposure(
+	feePerKw lnwallet.SatPerKWeight) dustExposure {
+
+	ours, theirs := l.channel.DustExposure(feePerKw)
+	return dustExposure{
+		ours:   ours,
+		theirs: theirs,
+	}
+}
+
+// exceeds returns whether the dust exposure is past the passed limit on either
+// commitment. A zero limit is never exceeded.
+func (d dustExposure) exceeds(limit lnwire.MilliSatoshi) bool {
+	if limit == 0 {
+		return false
+	}
+
+	return d.ours > limit || d.theirs > limit
+}
+
+// checkHtlcDustExposure returns ErrDustExposureExceeded if adding a new HTLC
+// of the passed amount would push the dust exposure of the channel past the
+// max dust HTLC exposure of the link.
+func (l *channelLink) checkHtlcDustExposure(amt lnwire.MilliSatoshi,
+	incoming bool) error {
+
+	if l.cfg.MaxDustHTLCExposure == 0 {
+		return nil
+	}
+
+	exposure := l.currentDustExposure(0)
+	ourDust, theirDust := l.channel.IsDustHTLC(amt, incoming)
+	if ourDust {
+		exposure.ours += amt
+	}
+	if theirDust {
+		exposure.theirs += amt
+	}
+
+	if exposure.exceeds(l.cfg.MaxDustHTLCExposure) {
+		return ErrDustExposureExceeded
+	}
+
+	return nil
+}
+
+// checkFeeDustExposure returns ErrDustExposureExceeded if a fee update to the
+// passed fee rate would push the dust exposure of the channel past the max
+// dust HTLC exposure of the link. As a higher fee rate raises the cost of the
+// second level HTLC transactions, it may turn HTLCs into dust. Fee updates
+// that don't raise the fee rate are always accepted, as they can only lower
+// the dust exposure.
+func (l *channelLink) checkFeeDustExposure(
+	feePerKw lnwallet.SatPerKWeight) error {
+
+	if l.cfg.MaxDustHTLCExposure == 0 {
+		return nil
+	}
+
+	if feePerKw <= l.channel.CommitFeeRate() {
+		return nil
+	}
+
+	exposure := l.currentDustExposure(feePerKw)
+	if exposure.exceeds(l.cfg.MaxDustHTLCExposure) {
+		return ErrDustExposureExceeded
+	}
+
+	return nil
+}
+
+// rejectIncomingDust returns whether the passed locked in incoming HTLC must be
+// failed back, as it's dust on a commitment whose dust exposure is past the
+// max dust HTLC exposure of the link. If so, its value is deducted from the
+// passed exposure, since the HTLC will be removed from both commitments.
+func (l *channelLink) rejectIncomingDust(pd *lnwallet.PaymentDescriptor,
+	exposure *dustExposure) bool {
+
+	limit := l.cfg.MaxDustHTLCExposure
+	if limit == 0 {
+		return false
+	}
+
+	ourDust, theirDust := l.channel.IsDustHTLC(pd.Amount, true)
+	if !(ourDust && exposure.ours > limit) &&
+		!(theirDust && exposure.theirs > limit) {
+
+		return false
+	}
+
+	if ourDust {
+		exposure.ours -= pd.Amount
+	}
+	if theirDust {
+		exposure.theirs -= pd.Amount
+	}
+
+	return true
+}
@@ -238,19 +238,19 @@ type SphinxErrorDecrypter struct {
 // NOTE: Part of the ErrorDecrypter interface.
 func (s *SphinxErrorDecrypter) DecryptError(reason lnwire.OpaqueReason) (*ForwardingError, error) {
 
-	source, failureData, err := s.OnionErrorDecrypter.DecryptError(reason)
+	failure, err := s.OnionErrorDecrypter.DecryptError(reason)
 	if err != nil {
 		return nil, err
 	}
 
-	r := bytes.NewReader(failureData)
+	r := bytes.NewReader(failure.Message)
 	failureMsg, err := lnwire.DecodeFailure(r, 0)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ForwardingError{
-		ErrorSource:    source,
+		ErrorSource:    failure.Sender,
 		FailureMessage: failureMsg,
 	}, nil
 }
@@ -0,0 +1,221 @@
+package htlcswitch
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+var (
+	// ErrPeerForwardsExceeded is the failure detail of forwards that are
+	// rejected because their incoming peer already has the maximum number
+	// of forwards in flight.
+	ErrPeerForwardsExceeded = errors.New("incoming peer exceeded max " +
+		"concurrent forwards")
+
+	// ErrPeerForwardValueExceeded is the failure detail of forwards that
+	// are rejected because they would exceed the maximum value in flight
+	// of their incoming peer.
+	ErrPeerForwardValueExceeded = errors.New("incoming peer exceeded max " +
+		"forward value in flight")
+
+	// ErrForwardHoldTimeExceeded is the failure detail of forwards that
+	// are failed back early because their outgoing link didn't pick them
+	// up within the max hold time.
+	ErrForwardHoldTimeExceeded = errors.New("forward exceeded max hold " +
+		"time")
+)
+
+// ForwardLimits holds the limits that protect our HTLC slots and liquidity
+// from being jammed by the forwards of a single incoming peer. A zero value
+// disables the respective limit.
+type ForwardLimits struct {
+	// MaxPeerForwards is the maximum number of forwarded HTLCs that an
+	// incoming peer may have in flight at the same time.
+	MaxPeerForwards uint32
+
+	// MaxPeerForwardValue is the maximum total value of the forwarded
+	// HTLCs that an incoming peer may have in flight at the same time.
+	MaxPeerForwardValue lnwire.MilliSatoshi
+
+	// MaxForwardHoldTime is the maximum duration that a forward may wait
+	// to be added to its outgoing channel. If the outgoing link doesn't
+	// pick the HTLC up in time, e.g. because its commitment is full or its
+	// peer is unresponsive, the forward is failed back early.
+	MaxForwardHoldTime time.Duration
+}
+
+// peerForwardUsage is the amount of forwards an incoming peer has in flight.
+type peerForwardUsage struct {
+	numForwards uint32
+	value       lnwire.MilliSatoshi
+}
+
+// trackedForward is a forward in flight that counts towards the limits of its
+// incoming peer.
+type trackedForward struct {
+	peer   [33]byte
+	amount lnwire.MilliSatoshi
+	timer  *time.Timer
+}
+
+// forwardTracker keeps track of the forwards in flight per incoming peer in
+// order to enforce the ForwardLimits.
+type forwardTracker struct {
+	limits *ForwardLimits
+
+	// peers holds the usage of all incoming peers with forwards in flight.
+	peers map[[33]byte]*peerForwardUsage
+
+	// forwards holds all tracked forwards, keyed by their incoming
+	// circuit.
+	forwards map[CircuitKey]*trackedForward
+
+	mtx sync.Mutex
+}
+
+// newForwardTracker creates a new forwardTracker enforcing the passed limits.
+func newForwardTracker(limits *ForwardLimits) *forwardTracker {
+	return &forwardTracker{
+		limits:   limits,
+		peers:    make(map[[33]byte]*peerForwardUsage),
+		forwards: make(map[CircuitKey]*trackedForward),
+	}
+}
+
+// add tracks the forward with the passed incoming circuit as part of the usage
+// of its incoming peer. An error is returned instead if the forward would
+// exceed the limits of the peer. If a max hold time is set, onExpiry is called
+// once it has passed, unless the forward is released before.
+func (t *forwardTracker) add(inKey CircuitKey, peer [33]byte,
+	amount lnwire.MilliSatoshi, onExpiry func()) error {
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if _, ok := t.forwards[inKey]; ok {
+		return nil
+	}
+
+	usage, ok := t.peers[peer]
+	if !ok {
+		usage = &peerForwardUsage{}
+	}
+
+	maxForwards := t.limits.MaxPeerForwards
+	if maxForwards != 0 && usage.numForwards >= maxForwards {
+		return ErrPeerForwardsExceeded
+	}
+
+	maxValue := t.limits.MaxPeerForwardValue
+	if maxValue != 0 && usage.value+amount > maxValue {
+		return ErrPeerForwardValueExceeded
+	}
+
+	usage.numForwards++
+	usage.value += amount
+	t.peers[peer] = usage
+
+	fwd := &trackedForward{
+		peer:   peer,
+		amount: amount,
+	}
+	if t.limits.MaxForwardHoldTime != 0 {
+		fwd.timer = time.AfterFunc(t.limits.MaxForwardHoldTime, onExpiry)
+	}
+	t.forwards[inKey] = fwd
+
+	return nil
+}
+
+// release removes the forward with the passed incoming circuit from the usage
+// of its incoming peer, if it's tracked.
+func (t *forwardTracker) release(inKey CircuitKey) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	fwd, ok := t.forwards[inKey]
+	if !ok {
+		return
+	}
+	delete(t.forwards, inKey)
+
+	if fwd.timer != nil {
+		fwd.timer.Stop()
+	}
+
+	usage := t.peers[fwd.peer]
+	usage.numForwards--
+	usage.value -= fwd.amount
+	if usage.numForwards == 0 {
+		delete(t.peers, fwd.peer)
+	}
+}
+
+// stop stops the hold timers of all tracked forwards.
+func (t *forwardTracker) stop() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	for _, fwd := range t.forwards {
+		if fwd.timer != nil {
+			fwd.timer.Stop()
+		}
+	}
+}
+
+// trackForward tracks the passed Add packet as part of the forwards in flight
+// of its incoming peer. An error is returned if the forward would exceed the
+// limits of the peer, in which case it must be failed back.
+func (s *Switch) trackForward(packet *htlcPacket) error {
+	if s.cfg.ForwardLimits == (ForwardLimits{}) {
+		return nil
+	}
+
+	// If the incoming link is gone, there is no peer we can attribute the
+	// forward to, so we'll let it through.
+	s.indexMtx.RLock()
+	incomingLink, err := s.getLinkByShortID(packet.incomingChanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return nil
+	}
+	peer := incomingLink.Peer().PubKey()
+
+	err = s.fwdTracker.add(packet.inKey(), peer, packet.amount, func() {
+		s.expireForward(packet)
+	})
+	if err != nil {
+		log.Warnf("Rejecting forward with incoming circuit %v from "+
+			"peer %x: %v", packet.inKey(), peer, err)
+		return err
+	}
+
+	return nil
+}
+
+// expireForward fails the passed Add packet back once it has exceeded the max
+// hold time, unless its outgoing link has already picked it up.
+func (s *Switch) expireForward(packet *htlcPacket) {
+	if !packet.expireForward() {
+		return
+	}
+
+	log.Infof("Failing back forward with incoming circuit %v, as "+
+		"outgoing link %v didn't pick it up within %v", packet.inKey(),
+		packet.outgoingChanID, s.cfg.ForwardLimits.MaxForwardHoldTime)
+
+	s.fwdTracker.release(packet.inKey())
+
+	var failure lnwire.FailureMessage
+	update, err := s.cfg.FetchLastChannelUpdate(packet.outgoingChanID)
+	if err != nil {
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	} else {
+		failure = lnwire.NewTemporaryChannelFailure(update)
+	}
+
+	s.failAddPacket(packet, failure, ErrForwardHoldTimeExceeded)
+}
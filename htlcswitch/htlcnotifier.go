@@ -0,0 +1,309 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/subscribe"
+)
+
+// HtlcEventType represents the type of the htlc an event refers to.
+type HtlcEventType int
+
+const (
+	// HtlcEventTypeSend represents an htlc that was sent by our node, as
+	// part of a payment we initiated.
+	HtlcEventTypeSend HtlcEventType = iota
+
+	// HtlcEventTypeReceive represents an htlc that was received by our
+	// node, as the final hop of a payment.
+	HtlcEventTypeReceive
+
+	// HtlcEventTypeForward represents an htlc that was forwarded through
+	// our node.
+	HtlcEventTypeForward
+)
+
+// String returns a human readable representation of the htlc event type.
+func (h HtlcEventType) String() string {
+	switch h {
+	case HtlcEventTypeSend:
+		return "send"
+
+	case HtlcEventTypeReceive:
+		return "receive"
+
+	case HtlcEventTypeForward:
+		return "forward"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HtlcKey identifies an htlc by its incoming and outgoing circuits. The
+// incoming circuit of an htlc we sent has a zero channel ID, and the outgoing
+// circuit of an htlc we received is left blank. If an htlc couldn't be added
+// to its outgoing channel, only the channel ID of its outgoing circuit is set.
+type HtlcKey struct {
+	// IncomingCircuit is the channel and htlc ID of the incoming htlc.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit is the channel and htlc ID of the outgoing htlc.
+	OutgoingCircuit CircuitKey
+}
+
+// String returns a human readable representation of the htlc key.
+func (k HtlcKey) String() string {
+	return fmt.Sprintf("%v -> %v", k.IncomingCircuit, k.OutgoingCircuit)
+}
+
+// HtlcInfo holds the amounts and time locks of an htlc.
+type HtlcInfo struct {
+	// IncomingTimeLock is the time lock of the incoming htlc.
+	IncomingTimeLock uint32
+
+	// OutgoingTimeLock is the time lock of the outgoing htlc.
+	OutgoingTimeLock uint32
+
+	// IncomingAmt is the amount of the incoming htlc.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount of the outgoing htlc.
+	OutgoingAmt lnwire.MilliSatoshi
+}
+
+// ForwardingEvent is sent once an htlc has been added to the commitment of
+// its outgoing channel.
+type ForwardingEvent struct {
+	HtlcKey
+	HtlcInfo
+
+	// HtlcEventType is the type of the forwarded htlc.
+	HtlcEventType HtlcEventType
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// LinkFailEvent is sent when one of our links fails an htlc, either because
+// it couldn't be added to the outgoing channel, or because it was rejected on
+// the incoming channel.
+type LinkFailEvent struct {
+	HtlcKey
+	HtlcInfo
+
+	// HtlcEventType is the type of the failed htlc.
+	HtlcEventType HtlcEventType
+
+	// FailureMessage is the failure that was sent back to the sender.
+	FailureMessage lnwire.FailureMessage
+
+	// FailureDetail describes the local reason of the failure. It may be
+	// empty if no reason beyond the failure message is known.
+	FailureDetail string
+
+	// Incoming is true if the htlc was failed on its incoming link, and
+	// false if it was failed by its outgoing link.
+	Incoming bool
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// ForwardingFailEvent is sent when an htlc we added to an outgoing channel is
+// failed back by the downstream peer. The failure reason is encrypted for the
+// sender, so it isn't available.
+type ForwardingFailEvent struct {
+	HtlcKey
+
+	// HtlcEventType is the type of the failed htlc.
+	HtlcEventType HtlcEventType
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// SettleEvent is sent when an htlc is settled.
+type SettleEvent struct {
+	HtlcKey
+
+	// HtlcEventType is the type of the settled htlc.
+	HtlcEventType HtlcEventType
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+}
+
+// HtlcNotifier dispatches the htlc events of the switch and its links to its
+// subscribers.
+type HtlcNotifier struct {
+	started uint32
+	stopped uint32
+
+	// now returns the current time, it is set in the notifier to allow for
+	// timestamp mocking in tests.
+	now func() time.Time
+
+	ntfnServer *subscribe.Server
+}
+
+// NewHtlcNotifier creates a new HtlcNotifier.
+func NewHtlcNotifier() *HtlcNotifier {
+	return &HtlcNotifier{
+		now:        time.Now,
+		ntfnServer: subscribe.NewServer(),
+	}
+}
+
+// Start starts the HtlcNotifier and all goroutines it needs to carry out its
+// task.
+func (h *HtlcNotifier) Start() error {
+	if !atomic.CompareAndSwapUint32(&h.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("HtlcNotifier %v starting", h)
+
+	return h.ntfnServer.Start()
+}
+
+// Stop signals the notifier for a graceful shutdown.
+func (h *HtlcNotifier) Stop() error {
+	if !atomic.CompareAndSwapUint32(&h.stopped, 0, 1) {
+		return nil
+	}
+
+	return h.ntfnServer.Stop()
+}
+
+// SubscribeHtlcEvents returns a subscribe.Client that will receive a
+// ForwardingEvent, LinkFailEvent, ForwardingFailEvent or SettleEvent for every
+// htlc event that occurs.
+func (h *HtlcNotifier) SubscribeHtlcEvents() (*subscribe.Client, error) {
+	return h.ntfnServer.Subscribe()
+}
+
+// NotifyForwardingEvent notifies the subscribers that an htlc was added to
+// the commitment of its outgoing channel.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+
+	event := &ForwardingEvent{
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying forward event: %v over %v, %v", eventType, key,
+		info)
+
+	h.sendUpdate(event)
+}
+
+// NotifyLinkFailEvent notifies the subscribers that a link failed an htlc.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage,
+	failureDetail string, incoming bool) {
+
+	event := &LinkFailEvent{
+		HtlcKey:        key,
+		HtlcInfo:       info,
+		HtlcEventType:  eventType,
+		FailureMessage: failure,
+		FailureDetail:  failureDetail,
+		Incoming:       incoming,
+		Timestamp:      h.now(),
+	}
+
+	log.Tracef("Notifying link failure event: %v over %v, %v", eventType,
+		key, info)
+
+	h.sendUpdate(event)
+}
+
+// NotifyForwardingFailEvent notifies the subscribers that an htlc was failed
+// back by the downstream peer.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType) {
+
+	event := &ForwardingFailEvent{
+		HtlcKey:       key,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying forwarding failure event: %v over %v",
+		eventType, key)
+
+	h.sendUpdate(event)
+}
+
+// NotifySettleEvent notifies the subscribers that an htlc was settled.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+
+	event := &SettleEvent{
+		HtlcKey:       key,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying settle event: %v over %v", eventType, key)
+
+	h.sendUpdate(event)
+}
+
+// sendUpdate dispatches the passed event to all subscribers.
+func (h *HtlcNotifier) sendUpdate(event interface{}) {
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send htlc event update: %v", err)
+	}
+}
+
+// newHtlcKey returns the htlc key of the passed packet.
+func newHtlcKey(pkt *htlcPacket) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: pkt.inKey(),
+		OutgoingCircuit: pkt.outKey(),
+	}
+}
+
+// newCircuitHtlcKey returns the htlc key of the passed open circuit.
+func newCircuitHtlcKey(circuit *PaymentCircuit) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: circuit.Incoming,
+		OutgoingCircuit: *circuit.Outgoing,
+	}
+}
+
+// newHtlcInfo returns the htlc info of the passed add packet.
+func newHtlcInfo(pkt *htlcPacket) HtlcInfo {
+	return HtlcInfo{
+		IncomingTimeLock: pkt.incomingTimeout,
+		OutgoingTimeLock: pkt.outgoingTimeout,
+		IncomingAmt:      pkt.incomingAmount,
+		OutgoingAmt:      pkt.amount,
+	}
+}
+
+// getEventType returns the htlc event type of the passed packet, which is
+// either a send for locally initiated payments or a forward.
+func getEventType(pkt *htlcPacket) HtlcEventType {
+	if pkt.incomingChanID == sourceHop {
+		return HtlcEventTypeSend
+	}
+
+	return HtlcEventTypeForward
+}
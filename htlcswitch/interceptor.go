@@ -0,0 +1,314 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+)
+
+const (
+	// DefaultInterceptTimeout is the default duration that an intercepted
+	// forward may be held by an interceptor before the switch resumes it
+	// on its own.
+	DefaultInterceptTimeout = time.Minute
+)
+
+var (
+	// ErrFwdAlreadyResolved is returned when an intercepted forward is
+	// resolved more than once, or after it has timed out.
+	ErrFwdAlreadyResolved = errors.New("intercepted forward already " +
+		"resolved")
+
+	// ErrInvalidInterceptPreimage is returned when an intercepted forward
+	// is settled with a preimage that doesn't match its payment hash.
+	ErrInvalidInterceptPreimage = errors.New("preimage doesn't match " +
+		"payment hash")
+)
+
+// InterceptedPacket holds the information about an intercepted forward that's
+// exposed to the interceptor.
+type InterceptedPacket struct {
+	// IncomingCircuit identifies the incoming HTLC of the forward.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the short channel ID of the channel the HTLC is
+	// headed for. This channel may not exist.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Hash is the payment hash of the HTLC.
+	Hash [32]byte
+
+	// IncomingAmount is the amount of the incoming HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// OutgoingAmount is the amount of the outgoing HTLC.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute expiry height of the incoming HTLC.
+	IncomingExpiry uint32
+
+	// OutgoingExpiry is the absolute expiry height of the outgoing HTLC.
+	OutgoingExpiry uint32
+}
+
+// InterceptedForward is a forward that has been handed over to an interceptor.
+// Exactly one of its methods that resolve it must eventually be called, unless
+// the switch resumes the forward on its own once the intercept timeout has
+// passed.
+type InterceptedForward interface {
+	// Packet returns the information about the intercepted forward.
+	Packet() InterceptedPacket
+
+	// Resume hands the forward back to the switch, which then forwards it
+	// as usual.
+	Resume() error
+
+	// ResumeModified hands the forward back to the switch, which then
+	// forwards it over the passed outgoing channel instead of the one the
+	// HTLC was originally headed for.
+	ResumeModified(outgoingChanID lnwire.ShortChannelID) error
+
+	// Settle settles the incoming HTLC using the passed preimage.
+	Settle(preimage [32]byte) error
+
+	// Fail fails the incoming HTLC back with the passed failure code.
+	Fail(code lnwire.FailCode) error
+}
+
+// ForwardInterceptor is a function that the switch calls for each HTLC that's
+// about to be forwarded, if one is set. It returns true if the interceptor
+// holds the forward and will resolve it later on, or false if the switch
+// should forward it as usual.
+type ForwardInterceptor func(InterceptedForward) bool
+
+// interceptedForward is the implementation of the InterceptedForward
+// interface backed by the switch.
+type interceptedForward struct {
+	// resolved is set to 1 once the forward has been resolved.
+	//
+	// NOTE: This MUST be used atomically.
+	resolved uint32
+
+	packet     *htlcPacket
+	htlcSwitch *Switch
+	timer      *time.Timer
+}
+
+// newInterceptedForward creates a new intercepted forward for the passed
+// packet.
+func newInterceptedForward(packet *htlcPacket,
+	htlcSwitch *Switch) *interceptedForward {
+
+	return &interceptedForward{
+		packet:     packet,
+		htlcSwitch: htlcSwitch,
+	}
+}
+
+// Packet returns the information about the intercepted forward.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Packet() InterceptedPacket {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+
+	return InterceptedPacket{
+		IncomingCircuit: f.packet.inKey(),
+		OutgoingChanID:  f.packet.outgoingChanID,
+		Hash:            htlc.PaymentHash,
+		IncomingAmount:  f.packet.incomingAmount,
+		OutgoingAmount:  f.packet.amount,
+		IncomingExpiry:  f.packet.incomingTimeout,
+		OutgoingExpiry:  f.packet.outgoingTimeout,
+	}
+}
+
+// Resume hands the forward back to the switch, which then forwards it as
+// usual.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Resume() error {
+	if !f.markResolved() {
+		return ErrFwdAlreadyResolved
+	}
+
+	return f.htlcSwitch.route(f.packet)
+}
+
+// ResumeModified hands the forward back to the switch, which then forwards it
+// over the passed outgoing channel.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) ResumeModified(
+	outgoingChanID lnwire.ShortChannelID) error {
+
+	if !f.markResolved() {
+		return ErrFwdAlreadyResolved
+	}
+
+	f.packet.outgoingChanID = outgoingChanID
+	return f.htlcSwitch.route(f.packet)
+}
+
+// Settle settles the incoming HTLC using the passed preimage.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Settle(preimage [32]byte) error {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+	if sha256.Sum256(preimage[:]) != htlc.PaymentHash {
+		return ErrInvalidInterceptPreimage
+	}
+
+	if !f.markResolved() {
+		return ErrFwdAlreadyResolved
+	}
+
+	return f.resolve(&lnwire.UpdateFulfillHTLC{
+		PaymentPreimage: preimage,
+	})
+}
+
+// Fail fails the incoming HTLC back with the passed failure code.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Fail(code lnwire.FailCode) error {
+	failure, err := f.failureMessage(code)
+	if err != nil {
+		return err
+	}
+
+	if !f.markResolved() {
+		return ErrFwdAlreadyResolved
+	}
+
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
+	if err != nil {
+		return fmt.Errorf("unable to obfuscate error: %v", err)
+	}
+
+	return f.resolve(&lnwire.UpdateFailHTLC{
+		Reason: reason,
+	})
+}
+
+// failureMessage creates the failure message of the passed failure code. Only
+// the failure codes that don't carry any data beyond what the switch knows of
+// are supported.
+func (f *interceptedForward) failureMessage(
+	code lnwire.FailCode) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			f.packet.outgoingChanID,
+		)
+		if err != nil {
+			return &lnwire.FailTemporaryNodeFailure{}, nil
+		}
+		return lnwire.NewTemporaryChannelFailure(update), nil
+
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnwire.CodeUnknownPaymentHash:
+		return lnwire.NewFailUnknownPaymentHash(
+			f.packet.incomingAmount,
+		), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported failure code: %v", code)
+	}
+}
+
+// resolve delivers the passed settle or fail message for the incoming HTLC
+// back to the incoming link.
+func (f *interceptedForward) resolve(htlc lnwire.Message) error {
+	pkt := &htlcPacket{
+		sourceRef:      f.packet.sourceRef,
+		incomingChanID: f.packet.incomingChanID,
+		incomingHTLCID: f.packet.incomingHTLCID,
+		circuit:        f.packet.circuit,
+		htlc:           htlc,
+	}
+
+	return f.htlcSwitch.mailOrchestrator.Deliver(pkt.incomingChanID, pkt)
+}
+
+// markResolved marks the forward as resolved, returning false if it already
+// had been resolved.
+func (f *interceptedForward) markResolved() bool {
+	if !atomic.CompareAndSwapUint32(&f.resolved, 0, 1) {
+		return false
+	}
+
+	if f.timer != nil {
+		f.timer.Stop()
+	}
+
+	return true
+}
+
+// SetInterceptor sets the interceptor that is handed all HTLCs that are about
+// to be forwarded. Passing nil removes the current interceptor, in which case
+// the switch forwards all HTLCs as usual again. Forwards that are held by a
+// removed interceptor are resumed once they time out.
+func (s *Switch) SetInterceptor(interceptor ForwardInterceptor) {
+	s.interceptorMtx.Lock()
+	s.interceptor = interceptor
+	s.interceptorMtx.Unlock()
+}
+
+// interceptForward hands the passed add packet to the interceptor, if one is
+// set. It returns true if the interceptor holds the forward, in which case the
+// switch must not forward it.
+func (s *Switch) interceptForward(packet *htlcPacket) bool {
+	s.interceptorMtx.RLock()
+	interceptor := s.interceptor
+	s.interceptorMtx.RUnlock()
+
+	if interceptor == nil {
+		return false
+	}
+
+	fwd := newInterceptedForward(packet, s)
+	if !interceptor(fwd) {
+		return false
+	}
+
+	log.Debugf("Forward of htlc with incoming circuit %v intercepted",
+		packet.inKey())
+
+	// Should the interceptor fail to resolve the forward in time, we'll
+	// resume it ourselves, as we can't hold onto the incoming HTLC
+	// indefinitely.
+	fwd.timer = time.AfterFunc(s.cfg.InterceptTimeout, func() {
+		err := fwd.Resume()
+		switch {
+		case err == ErrFwdAlreadyResolved:
+			return
+
+		case err != nil:
+			log.Errorf("Unable to resume timed out forward of "+
+				"htlc with incoming circuit %v: %v",
+				packet.inKey(), err)
+			return
+		}
+
+		log.Infof("Resumed timed out forward of htlc with incoming "+
+			"circuit %v", packet.inKey())
+	})
+
+	return true
+}
@@ -3,6 +3,7 @@ package htlcswitch
 import (
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/lnpeer"
+	"github.com/breez/lightninglib/lnwallet"
 	"github.com/breez/lightninglib/lnwire"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
@@ -16,9 +17,42 @@ type InvoiceDatabase interface {
 	// extended to us gives us enough time to settle as we prescribe.
 	LookupInvoice(chainhash.Hash) (channeldb.Invoice, uint32, error)
 
-	// SettleInvoice attempts to mark an invoice corresponding to the
-	// passed payment hash as fully settled.
-	SettleInvoice(payHash chainhash.Hash, paidAmount lnwire.MilliSatoshi) error
+	// AddKeysendInvoice adds an invoice for a keysend payment of the passed
+	// amount that doesn't pay to an existing invoice, using the preimage
+	// chosen by its sender. The invoice is returned along with its min
+	// final CLTV delta. An error is returned if the preimage doesn't match
+	// the payment hash.
+	AddKeysendInvoice(payHash chainhash.Hash, preimage [32]byte,
+		amt lnwire.MilliSatoshi) (channeldb.Invoice, uint32, error)
+
+	// NotifyExitHopHtlc adds the htlc identified by the circuit key to the
+	// set of htlcs paying to the invoice with the passed payment hash. If
+	// the htlc can be resolved right away, e.g. because it completes the
+	// set, its resolution is returned. Otherwise, the htlc is held and nil
+	// is returned, and its resolution is later delivered on the passed
+	// subscriber channel. The total amount is the amount the sender
+	// intends to pay with the set, or zero if the sender didn't pass one.
+	// The expiry of the htlc is used to cancel hold invoices before their
+	// htlcs time out.
+	NotifyExitHopHtlc(payHash chainhash.Hash, paidAmount,
+		totalAmount lnwire.MilliSatoshi, expiry uint32,
+		circuitKey channeldb.CircuitKey,
+		subscriber chan<- interface{}) (*HtlcResolution, error)
+
+	// UnsubscribeHtlcResolutions removes the passed subscriber channel from
+	// all htlcs it awaits the resolution of.
+	UnsubscribeHtlcResolutions(subscriber chan<- interface{})
+}
+
+// HtlcResolution notifies a link of the final outcome of an exit hop htlc
+// that is held by the invoice registry.
+type HtlcResolution struct {
+	// CircuitKey identifies the htlc that was resolved.
+	CircuitKey channeldb.CircuitKey
+
+	// Preimage is the preimage the htlc is to be settled with. If nil, the
+	// htlc is to be failed back.
+	Preimage *[32]byte
 }
 
 // ChannelLink is an interface which represents the subsystem for managing the
@@ -78,6 +112,16 @@ type ChannelLink interface {
 	// policy to govern if it an incoming HTLC should be forwarded or not.
 	UpdateForwardingPolicy(ForwardingPolicy)
 
+	// UpdateCommitFeePolicy updates the commitment fee policy for the
+	// target ChannelLink. Once updated, the link will use the new policy
+	// to determine the commitment fee rate it proposes.
+	UpdateCommitFeePolicy(*channeldb.CommitFeePolicyOverride)
+
+	// CommitFeeProposals returns the commitment fee rate our commitment
+	// fee policy last targeted, and the commitment fee rate last proposed
+	// by the remote party.
+	CommitFeeProposals() (lnwallet.SatPerKWeight, lnwallet.SatPerKWeight)
+
 	// HtlcSatifiesPolicy should return a nil error if the passed HTLC
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a valid protocol failure message should be returned in
@@ -131,3 +175,25 @@ type ForwardingLog interface {
 	// visualizations, etc.
 	AddForwardingEvents([]channeldb.ForwardingEvent) error
 }
+
+// htlcNotifier is an interface which represents the subsystem that notifies
+// subscribers of the htlc events of the switch and its links.
+type htlcNotifier interface {
+	// NotifyForwardingEvent notifies that an htlc was added to the
+	// commitment of its outgoing channel.
+	NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType)
+
+	// NotifyLinkFailEvent notifies that a link failed an htlc, either on
+	// its incoming or its outgoing channel.
+	NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType, failure lnwire.FailureMessage,
+		failureDetail string, incoming bool)
+
+	// NotifyForwardingFailEvent notifies that an htlc was failed back by
+	// the downstream peer.
+	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType)
+
+	// NotifySettleEvent notifies that an htlc was settled.
+	NotifySettleEvent(key HtlcKey, eventType HtlcEventType)
+}
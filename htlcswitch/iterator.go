@@ -2,6 +2,7 @@ package htlcswitch
 
 import (
 	"encoding/binary"
+	"fmt"
 	"io"
 
 	"github.com/breez/lightninglib/lnwire"
@@ -70,8 +71,28 @@ type ForwardingInfo struct {
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
 
-	// TODO(roasbeef): modify sphinx logic to not just discard the
-	// remaining bytes, instead should include the rest as excess
+	// KeysendPreimage is the preimage of a keysend payment, chosen by its
+	// sender and passed to us within the final hop's payload. It's only
+	// set if we're the exit hop of a keysend payment.
+	KeysendPreimage *[32]byte
+
+	// PaymentAddr is the payment address of the invoice being paid, passed
+	// to us by the sender within the final hop's payload. It's only set if
+	// we're the exit hop of a payment to an invoice with a payment
+	// address.
+	PaymentAddr *[32]byte
+
+	// TotalAmount is the total amount of the payment the HTLC is part of,
+	// passed to us by the sender along with the payment address. The
+	// payment may be split across several HTLCs, which are to be held
+	// until their sum reaches this amount. It's zero if no payment
+	// address was passed.
+	TotalAmount lnwire.MilliSatoshi
+
+	// CustomRecords are the records the sender passed to the final hop
+	// within its payload for use by applications, keyed by their type.
+	// Only TLV payloads are able to carry them.
+	CustomRecords map[uint64][]byte
 }
 
 // HopIterator is an interface that abstracts away the routing information
@@ -84,8 +105,9 @@ type HopIterator interface {
 	// _how_ this hop should forward the HTLC to the next hop.
 	// Additionally, the information encoded within the returned
 	// ForwardingInfo is to be used by each hop to authenticate the
-	// information given to it by the prior hop.
-	ForwardingInstructions() ForwardingInfo
+	// information given to it by the prior hop. An error is returned if
+	// the hop's payload is invalid.
+	ForwardingInstructions() (ForwardingInfo, error)
 
 	// EncodeNextHop encodes the onion packet destined for the next hop
 	// into the passed io.Writer.
@@ -136,26 +158,38 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 // ForwardingInstructions returns the set of fields that detail exactly _how_
 // this hop should forward the HTLC to the next hop.  Additionally, the
 // information encoded within the returned ForwardingInfo is to be used by each
-// hop to authenticate the information given to it by the prior hop.
+// hop to authenticate the information given to it by the prior hop. Both the
+// legacy fixed-size payload and the variable-size TLV payload are understood.
 //
 // NOTE: Part of the HopIterator interface.
-func (r *sphinxHopIterator) ForwardingInstructions() ForwardingInfo {
-	fwdInst := r.processedPacket.ForwardingInstructions
-
-	var nextHop lnwire.ShortChannelID
-	switch r.processedPacket.Action {
-	case sphinx.ExitNode:
-		nextHop = exitHop
-	case sphinx.MoreHops:
-		s := binary.BigEndian.Uint64(fwdInst.NextAddress[:])
-		nextHop = lnwire.NewShortChanIDFromInt(s)
-	}
+func (r *sphinxHopIterator) ForwardingInstructions() (ForwardingInfo, error) {
+	finalHop := r.processedPacket.Action == sphinx.ExitNode
+
+	switch r.processedPacket.Payload.Type {
+	case sphinx.PayloadLegacy:
+		fwdInst := r.processedPacket.ForwardingInstructions
+
+		var nextHop lnwire.ShortChannelID
+		if !finalHop {
+			s := binary.BigEndian.Uint64(fwdInst.NextAddress[:])
+			nextHop = lnwire.NewShortChanIDFromInt(s)
+		}
 
-	return ForwardingInfo{
-		Network:         BitcoinHop,
-		NextHop:         nextHop,
-		AmountToForward: lnwire.MilliSatoshi(fwdInst.ForwardAmount),
-		OutgoingCTLV:    fwdInst.OutgoingCltv,
+		return ForwardingInfo{
+			Network:         BitcoinHop,
+			NextHop:         nextHop,
+			AmountToForward: lnwire.MilliSatoshi(fwdInst.ForwardAmount),
+			OutgoingCTLV:    fwdInst.OutgoingCltv,
+		}, nil
+
+	case sphinx.PayloadTLV:
+		return parseTLVPayload(
+			r.processedPacket.Payload.Payload, finalHop,
+		)
+
+	default:
+		return ForwardingInfo{}, fmt.Errorf("unknown sphinx payload "+
+			"type: %v", r.processedPacket.Payload.Type)
 	}
 }
 
@@ -0,0 +1,109 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/onion"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+)
+
+// TestSphinxHopIteratorForwardingInstructions tests that we're able to
+// properly decode the forwarding instructions of both legacy and TLV
+// payloads, for intermediate as well as final hops.
+func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
+	t.Parallel()
+
+	nextHop := lnwire.NewShortChanIDFromInt(1)
+	preimage := [32]byte{1, 2, 3}
+
+	// The legacy payload of an intermediate hop carries the next hop
+	// within its next address.
+	hopData := sphinx.HopData{
+		ForwardAmount: 100000,
+		OutgoingCltv:  4343,
+	}
+	binary.BigEndian.PutUint64(hopData.NextAddress[:], nextHop.ToUint64())
+	legacyPayload, err := sphinx.NewHopPayload(&hopData, nil)
+	if err != nil {
+		t.Fatalf("unable to create legacy payload: %v", err)
+	}
+
+	// The TLV payload of the final hop omits the next hop, and carries the
+	// preimage of a keysend payment along with a custom record.
+	finalFwdInfo := ForwardingInfo{
+		Network:         BitcoinHop,
+		NextHop:         exitHop,
+		AmountToForward: 100000,
+		OutgoingCTLV:    4343,
+		KeysendPreimage: &preimage,
+		CustomRecords: map[uint64][]byte{
+			onion.MinCustomRecordsTlvType + 1: {4, 5, 6},
+		},
+	}
+	var b bytes.Buffer
+	err = onion.EncodePayload(&b, &onion.Payload{
+		AmountToForward: finalFwdInfo.AmountToForward,
+		OutgoingCTLV:    finalFwdInfo.OutgoingCTLV,
+		KeysendPreimage: finalFwdInfo.KeysendPreimage,
+		CustomRecords:   finalFwdInfo.CustomRecords,
+	})
+	if err != nil {
+		t.Fatalf("unable to encode tlv payload: %v", err)
+	}
+	tlvPayload, err := sphinx.NewHopPayload(nil, b.Bytes())
+	if err != nil {
+		t.Fatalf("unable to create tlv payload: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		action          sphinx.ProcessCode
+		payload         sphinx.HopPayload
+		expectedFwdInfo ForwardingInfo
+	}{
+		{
+			name:    "legacy intermediate hop",
+			action:  sphinx.MoreHops,
+			payload: legacyPayload,
+			expectedFwdInfo: ForwardingInfo{
+				Network:         BitcoinHop,
+				NextHop:         nextHop,
+				AmountToForward: 100000,
+				OutgoingCTLV:    4343,
+			},
+		},
+		{
+			name:            "tlv final hop",
+			action:          sphinx.ExitNode,
+			payload:         tlvPayload,
+			expectedFwdInfo: finalFwdInfo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		packet := &sphinx.ProcessedPacket{
+			Action:  testCase.action,
+			Payload: testCase.payload,
+		}
+		if testCase.payload.Type == sphinx.PayloadLegacy {
+			packet.ForwardingInstructions = &hopData
+		}
+
+		iterator := makeSphinxHopIterator(nil, packet)
+		fwdInfo, err := iterator.ForwardingInstructions()
+		if err != nil {
+			t.Fatalf("%v: unable to decode forwarding "+
+				"instructions: %v", testCase.name, err)
+		}
+
+		if !reflect.DeepEqual(fwdInfo, testCase.expectedFwdInfo) {
+			t.Fatalf("%v: wrong forwarding info: expected %v, "+
+				"got %v", testCase.name,
+				testCase.expectedFwdInfo, fwdInfo)
+		}
+	}
+}
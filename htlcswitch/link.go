@@ -15,6 +15,7 @@ import (
 	"github.com/breez/lightninglib/lnpeer"
 	"github.com/breez/lightninglib/lnwallet"
 	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/queue"
 	"github.com/breez/lightninglib/ticker"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/davecgh/go-spew/spew"
@@ -192,11 +193,21 @@ type ChannelLinkConfig struct {
 	// transaction to ensure timely confirmation.
 	FeeEstimator lnwallet.FeeEstimator
 
+	// CommitFeePolicy governs the commitment fee rate that we propose if
+	// we're the initiator of the channel. It can be updated with
+	// subsequent calls to UpdateCommitFeePolicy.
+	CommitFeePolicy CommitFeePolicy
+
 	// DebugHTLC should be turned on if you want all HTLCs sent to a node
 	// with the debug htlc R-Hash are immediately settled in the next
 	// available state transition.
 	DebugHTLC bool
 
+	// AcceptKeysend indicates whether we accept keysend payments that
+	// don't pay to an existing invoice. If set, an invoice is created on
+	// the fly from the preimage passed by the sender of such a payment.
+	AcceptKeysend bool
+
 	// hodl.Mask is a bitvector composed of hodl.Flags, specifying breakpoints
 	// for HTLC forwarding internal to the switch.
 	//
@@ -239,6 +250,17 @@ type ChannelLinkConfig struct {
 	MaxFeeUpdateTimeout time.Duration
 
 	OnCommitmentRevoked func()
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
+
+	// MaxDustHTLCExposure is the maximum total value of the dust HTLCs on
+	// either commitment of the channel. Dust HTLCs don't get an output, so
+	// their value goes to the miners if the channel is force closed. New
+	// HTLCs and fee updates that would exceed this value are rejected. A
+	// zero value disables the limit.
+	MaxDustHTLCExposure lnwire.MilliSatoshi
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -333,6 +355,20 @@ type channelLink struct {
 	// commitment fee every time it fires.
 	updateFeeTimer *time.Timer
 
+	// localFeeProposal is the commitment fee rate our commitment fee
+	// policy last targeted, and remoteFeeProposal is the commitment fee
+	// rate last proposed by the remote party.
+	localFeeProposal  lnwallet.SatPerKWeight
+	remoteFeeProposal lnwallet.SatPerKWeight
+
+	// heldHtlcs contains the exit hop htlcs that are held by the invoice
+	// registry until the rest of their set arrives.
+	heldHtlcs map[channeldb.CircuitKey]heldHtlc
+
+	// htlcResolutions is the queue the invoice registry delivers the
+	// resolutions of held htlcs on.
+	htlcResolutions *queue.ConcurrentQueue
+
 	sync.RWMutex
 
 	wg   sync.WaitGroup
@@ -352,10 +388,21 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		logCommitTimer: time.NewTimer(300 * time.Millisecond),
 		overflowQueue:  newPacketQueue(lnwallet.MaxHTLCNumber / 2),
 		htlcUpdates:    make(chan []channeldb.HTLC),
-		quit:           make(chan struct{}),
+		heldHtlcs:      make(map[channeldb.CircuitKey]heldHtlc),
+		htlcResolutions: queue.NewConcurrentQueue(
+			lnwallet.MaxHTLCNumber / 2,
+		),
+		quit: make(chan struct{}),
 	}
 }
 
+// heldHtlc is an exit hop htlc awaiting its resolution by the invoice
+// registry, along with the obfuscator needed to fail it back.
+type heldHtlc struct {
+	pd         *lnwallet.PaymentDescriptor
+	obfuscator ErrorEncrypter
+}
+
 // A compile time check to ensure channelLink implements the ChannelLink
 // interface.
 var _ ChannelLink = (*channelLink)(nil)
@@ -375,6 +422,7 @@ func (l *channelLink) Start() error {
 
 	l.mailBox.ResetMessages()
 	l.overflowQueue.Start()
+	l.htlcResolutions.Start()
 
 	// Before launching the htlcManager messages, revert any circuits that
 	// were marked open in the switch's circuit map, but did not make it
@@ -447,6 +495,12 @@ func (l *channelLink) Stop() {
 	l.updateFeeTimer.Stop()
 	l.overflowQueue.Stop()
 
+	// The registry must no longer deliver resolutions to this link once
+	// its queue is stopped. Held htlcs will be handed to the registry again
+	// when their forwarding packages are replayed by the next link.
+	l.cfg.Registry.UnsubscribeHtlcResolutions(l.htlcResolutions.ChanIn())
+	l.htlcResolutions.Stop()
+
 	close(l.quit)
 	l.wg.Wait()
 }
@@ -468,42 +522,26 @@ func (l *channelLink) EligibleToForward() bool {
 }
 
 // sampleNetworkFee samples the current fee rate on the network to get into the
-// chain in a timely manner. The returned value is expressed in fee-per-kw, as
+// chain within the confirmation target of the passed commitment fee policy,
+// bounded by the policy. The returned value is expressed in fee-per-kw, as
 // this is the native rate used when computing the fee for commitment
 // transactions, and the second-level HTLC transactions.
-func (l *channelLink) sampleNetworkFee() (lnwallet.SatPerKWeight, error) {
-	// We'll first query for the sat/kw recommended to be confirmed within 3
-	// blocks.
-	feePerKw, err := l.cfg.FeeEstimator.EstimateFeePerKW(3)
+func (l *channelLink) sampleNetworkFee(
+	policy CommitFeePolicy) (lnwallet.SatPerKWeight, error) {
+
+	feePerKw, err := policy.targetFeeRate(l.cfg.FeeEstimator)
 	if err != nil {
 		return 0, err
 	}
 
-	log.Debugf("ChannelLink(%v): sampled fee rate for 3 block conf: %v "+
-		"sat/kw", l, int64(feePerKw))
-
-	return feePerKw, nil
-}
-
-// shouldAdjustCommitFee returns true if we should update our commitment fee to
-// match that of the network fee. We'll only update our commitment fee if the
-// network fee is +/- 10% to our network fee.
-func shouldAdjustCommitFee(netFee, chanFee lnwallet.SatPerKWeight) bool {
-	switch {
-	// If the network fee is greater than the commitment fee, then we'll
-	// switch to it if it's at least 10% greater than the commit fee.
-	case netFee > chanFee && netFee >= (chanFee+(chanFee*10)/100):
-		return true
+	log.Debugf("ChannelLink(%v): sampled fee rate for %v block conf: %v "+
+		"sat/kw", l, policy.confTarget(), int64(feePerKw))
 
-	// If the network fee is less than our commitment fee, then we'll
-	// switch to it if it's at least 10% less than the commitment fee.
-	case netFee < chanFee && netFee <= (chanFee-(chanFee*10)/100):
-		return true
+	l.Lock()
+	l.localFeeProposal = feePerKw
+	l.Unlock()
 
-	// Otherwise, we won't modify our fee.
-	default:
-		return false
-	}
+	return feePerKw, nil
 }
 
 // syncChanState attempts to synchronize channel states with the remote party.
@@ -899,25 +937,30 @@ out:
 		case <-l.updateFeeTimer.C:
 			l.updateFeeTimer.Reset(l.randomFeeUpdateTimeout())
 
-			// If we're not the initiator of the channel, don't we
-			// don't control the fees, so we can ignore this.
-			if !l.channel.IsInitiator() {
-				continue
-			}
+			// We'll sample the fee rate our commitment fee policy
+			// targets. Even if we're not the initiator of the
+			// channel, we keep track of it so that it can be
+			// compared to the fee rates the remote party proposes.
+			l.RLock()
+			policy := l.cfg.CommitFeePolicy
+			l.RUnlock()
 
-			// If we are the initiator, then we'll sample the
-			// current fee rate to get into the chain within 3
-			// blocks.
-			feePerKw, err := l.sampleNetworkFee()
+			feePerKw, err := l.sampleNetworkFee(policy)
 			if err != nil {
 				log.Errorf("unable to sample network fee: %v", err)
 				continue
 			}
 
+			// If we're not the initiator of the channel, we don't
+			// control the fees, so we're done.
+			if !l.channel.IsInitiator() {
+				continue
+			}
+
 			// We'll check to see if we should update the fee rate
 			// based on our current set fee rate.
 			commitFee := l.channel.CommitFeeRate()
-			if !shouldAdjustCommitFee(feePerKw, commitFee) {
+			if !policy.shouldUpdate(feePerKw, commitFee) {
 				continue
 			}
 
@@ -1037,6 +1080,20 @@ out:
 		case msg := <-l.upstream:
 			l.handleUpstreamMsg(msg)
 
+		// The invoice registry has resolved an exit hop htlc we're
+		// holding, so we'll settle or fail it and lock in the update.
+		case msg := <-l.htlcResolutions.ChanOut():
+			resolution := msg.(*HtlcResolution)
+			if !l.processHtlcResolution(resolution) {
+				continue
+			}
+
+			if err := l.updateCommitTx(); err != nil {
+				l.fail(LinkFailureError{code: ErrInternalError},
+					"unable to update commitment: %v", err)
+				break out
+			}
+
 		case <-l.quit:
 			break out
 		}
@@ -1071,12 +1128,29 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			return
 		}
 
+		// If the switch already failed the forward back because we
+		// didn't pick it up within its max hold time, we'll drop it.
+		if !pkt.claimForward() {
+			l.infof("Dropping downstream htlc add update with "+
+				"payment hash(%x), as it exceeded its max hold "+
+				"time", htlc.PaymentHash[:])
+			l.mailBox.AckPacket(pkt.inKey())
+			return
+		}
+
 		// A new payment has been initiated via the downstream channel,
 		// so we add the new HTLC to our local log, then update the
 		// commitment chains.
 		htlc.ChanID = l.ChanID()
 		openCircuitRef := pkt.inKey()
-		index, err := l.channel.AddHTLC(htlc, &openCircuitRef)
+
+		// Before adding the HTLC, we'll make sure it doesn't push the
+		// value of the dust HTLCs on either commitment past our limit.
+		var index uint64
+		err := l.checkHtlcDustExposure(htlc.Amount, false)
+		if err == nil {
+			index, err = l.channel.AddHTLC(htlc, &openCircuitRef)
+		}
 		if err != nil {
 			switch err {
 
@@ -1089,6 +1163,9 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					htlc.PaymentHash[:],
 					l.batchCounter)
 
+				// While the packet waits for a free slot, the
+				// switch may still fail it back.
+				pkt.unclaimForward()
 				l.overflowQueue.AddPkt(pkt)
 				return
 
@@ -1098,6 +1175,16 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
 
+				// The htlc never made it into the channel, so
+				// only the outgoing channel of its key is known.
+				htlcKey := HtlcKey{
+					IncomingCircuit: pkt.inKey(),
+					OutgoingCircuit: CircuitKey{
+						ChanID: l.ShortChanID(),
+					},
+				}
+				failureDetail := err.Error()
+
 				var (
 					localFailure = false
 					reason       lnwire.OpaqueReason
@@ -1137,6 +1224,12 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					}
 				}
 
+				l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+					htlcKey, newHtlcInfo(pkt),
+					getEventType(pkt), failure,
+					failureDetail, false,
+				)
+
 				failPkt := &htlcPacket{
 					incomingChanID: pkt.incomingChanID,
 					incomingHTLCID: pkt.incomingHTLCID,
@@ -1182,6 +1275,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		l.cfg.HtlcNotifier.NotifyForwardingEvent(
+			newHtlcKey(pkt), newHtlcInfo(pkt), getEventType(pkt),
+		)
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -1577,7 +1674,10 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		}
 		l.cfg.OnCommitmentRevoked()
 
-		if needUpdate {
+		// The revocation opens up our revocation window, so we'll
+		// also sign the updates we couldn't commit while it was
+		// exhausted, such as the settles of held exit hop htlcs.
+		if needUpdate || l.channel.OweCommitment() {
 			if err := l.updateCommitTx(); err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
 					"unable to update commitment: %v", err)
@@ -1589,11 +1689,30 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// We received fee update from peer. If we are the initiator we
 		// will fail the channel, if not we will apply the update.
 		fee := lnwallet.SatPerKWeight(msg.FeePerKw)
+
+		// A higher fee rate may turn HTLCs into dust, so we'll refuse
+		// the update if it pushes our dust exposure past the limit.
+		if err := l.checkFeeDustExposure(fee); err != nil {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"unable to accept fee update to %v sat/kw: %v",
+				int64(fee), err)
+			return
+		}
+
 		if err := l.channel.ReceiveUpdateFee(fee); err != nil {
 			l.fail(LinkFailureError{code: ErrInvalidUpdate},
 				"error receiving fee update: %v", err)
 			return
 		}
+
+		l.Lock()
+		l.remoteFeeProposal = fee
+		localFee := l.localFeeProposal
+		l.Unlock()
+
+		log.Infof("ChannelPoint(%v): remote party proposed commit fee "+
+			"of %v sat/kw, our last target was %v sat/kw", l,
+			int64(fee), int64(localFee))
 	case *lnwire.Error:
 		// Error received from remote, MUST fail channel, but should
 		// only print the contents of the error message if all
@@ -1880,6 +1999,35 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 	}
 }
 
+// UpdateCommitFeePolicy updates the commitment fee policy of the target
+// ChannelLink with the fields set in the passed override. Once updated, the
+// link will use the new policy the next time it considers updating the
+// commitment fee rate.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) UpdateCommitFeePolicy(
+	override *channeldb.CommitFeePolicyOverride) {
+
+	l.Lock()
+	defer l.Unlock()
+
+	l.cfg.CommitFeePolicy.Merge(override)
+}
+
+// CommitFeeProposals returns the commitment fee rate our commitment fee policy
+// last targeted, and the commitment fee rate last proposed by the remote
+// party. A zero value means that no fee rate has been sampled or proposed yet.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) CommitFeeProposals() (lnwallet.SatPerKWeight,
+	lnwallet.SatPerKWeight) {
+
+	l.RLock()
+	defer l.RUnlock()
+
+	return l.localFeeProposal, l.remoteFeeProposal
+}
+
 // HtlcSatifiesPolicy should return a nil error if the passed HTLC details
 // satisfy the current forwarding policy fo the target link.  Otherwise, a
 // valid protocol failure message should be returned in order to signal to the
@@ -2061,6 +2209,14 @@ func (l *channelLink) updateChannelFee(feePerKw lnwallet.SatPerKWeight) error {
 		return nil
 	}
 
+	// We won't propose a fee rate that would push the value of the dust
+	// HTLCs on either commitment past our limit.
+	if err := l.checkFeeDustExposure(feePerKw); err != nil {
+		log.Warnf("ChannelPoint(%v): skipping fee update to %v "+
+			"sat/kw: %v", l, int64(feePerKw), err)
+		return nil
+	}
+
 	// First, we'll update the local fee on our commitment.
 	if err := l.channel.UpdateFee(feePerKw); err != nil {
 		return err
@@ -2221,6 +2377,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		switchPackets []*htlcPacket
 	)
 
+	// Grab the current dust exposure of the channel, which already
+	// includes the dust HTLCs among the locked in adds.
+	var exposure dustExposure
+	if l.cfg.MaxDustHTLCExposure != 0 {
+		exposure = l.currentDustExposure(0)
+	}
+
 	for i, pd := range lockedInHtlcs {
 		idx := uint16(i)
 
@@ -2254,8 +2417,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(
+				pd, failureCode, onionBlob[:], false,
+			)
 			needUpdate = true
 
 			log.Errorf("unable to decode onion hop "+
@@ -2272,8 +2436,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(
+				pd, failureCode, onionBlob[:], false,
+			)
 			needUpdate = true
 
 			log.Errorf("unable to decode onion "+
@@ -2283,7 +2448,46 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 
 		heightNow := l.cfg.Switch.BestHeight()
 
-		fwdInfo := chanIterator.ForwardingInstructions()
+		fwdInfo, err := chanIterator.ForwardingInstructions()
+		if err != nil {
+			// If we're unable to understand the hop's payload, we
+			// fail the htlc as if it used an unknown realm, which
+			// signals to the sender that the payment can't succeed.
+			log.Errorf("unable to parse onion payload of "+
+				"htlc(%x): %v", pd.RHash[:], err)
+
+			failure := lnwire.FailInvalidRealm{}
+			l.sendHTLCError(pd, &failure, obfuscator, false)
+			needUpdate = true
+			continue
+		}
+
+		// If the HTLC is dust on a commitment that is already past
+		// our max dust exposure, we'll fail it back, as its value
+		// would go to the miners if the channel is force closed.
+		if l.rejectIncomingDust(pd, &exposure) {
+			log.Warnf("ChannelPoint(%v): failing incoming dust "+
+				"htlc(%x): %v", l, pd.RHash[:],
+				ErrDustExposureExceeded)
+
+			var failure lnwire.FailureMessage
+			update, err := l.cfg.FetchLastChannelUpdate(
+				l.ShortChanID(),
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(
+					update,
+				)
+			}
+
+			isReceive := fwdInfo.NextHop == exitHop
+			l.sendHTLCError(pd, failure, obfuscator, isReceive)
+			needUpdate = true
+			continue
+		}
+
 		switch fwdInfo.NextHop {
 		case exitHop:
 			// If hodl.ExitSettle is requested, we will not validate
@@ -2295,20 +2499,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
-			// First, we'll check the expiry of the HTLC itself
-			// against, the current block height. If the timeout is
-			// too soon, then we'll reject the HTLC.
-			if pd.Timeout-expiryGraceDelta <= heightNow {
-				log.Errorf("htlc(%x) has an expiry that's too "+
-					"soon: expiry=%v, best_height=%v",
-					pd.RHash[:], pd.Timeout, heightNow)
-
-				failure := lnwire.FailFinalExpiryTooSoon{}
-				l.sendHTLCError(
-					pd.HtlcIndex, &failure, obfuscator, pd.SourceRef,
-				)
-				needUpdate = true
-				continue
+			if len(fwdInfo.CustomRecords) > 0 {
+				log.Debugf("Incoming htlc(%x) carries %d custom "+
+					"records", pd.RHash[:],
+					len(fwdInfo.CustomRecords))
 			}
 
 			// We're the designated payment destination.  Therefore
@@ -2318,178 +2512,88 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			invoice, minCltvDelta, err := l.cfg.Registry.LookupInvoice(
 				invoiceHash,
 			)
-			if err != nil {
-				log.Errorf("unable to query invoice registry: "+
-					" %v", err)
-				failure := lnwire.NewFailUnknownPaymentHash(
-					pd.Amount,
-				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
 
-				needUpdate = true
-				continue
-			}
-
-			// If the invoice is already settled, we choose to
-			// accept the payment to simplify failure recovery.
-			//
-			// NOTE: Though our recovery and forwarding logic is
-			// predominately batched, settling invoices happens
-			// iteratively. We may reject one of two payments
-			// for the same rhash at first, but then restart and
-			// reject both after seeing that the invoice has been
-			// settled. Without any record of which one settles
-			// first, it is ambiguous as to which one actually
-			// settled the invoice. Thus, by accepting all
-			// payments, we eliminate the race condition that can
-			// lead to this inconsistency.
-			//
-			// TODO(conner): track ownership of settlements to
-			// properly recover from failures? or add batch invoice
-			// settlement
-			if invoice.Terms.Settled {
-				log.Warnf("Accepting duplicate payment for "+
-					"hash=%x", pd.RHash[:])
-			}
+			// If there's no invoice for a keysend payment, we'll
+			// create one on the fly if we accept those.
+			if err == channeldb.ErrInvoiceNotFound &&
+				fwdInfo.KeysendPreimage != nil &&
+				l.cfg.AcceptKeysend {
 
-			// If we're not currently in debug mode, and the
-			// extended htlc doesn't meet the value requested, then
-			// we'll fail the htlc.  Otherwise, we settle this htlc
-			// within our local state update log, then send the
-			// update entry to the remote party.
-			//
-			// NOTE: We make an exception when the value requested
-			// by the invoice is zero. This means the invoice
-			// allows the payee to specify the amount of satoshis
-			// they wish to send.  So since we expect the htlc to
-			// have a different amount, we should not fail.
-			if !l.cfg.DebugHTLC && invoice.Terms.Value > 0 &&
-				pd.Amount < invoice.Terms.Value {
-
-				log.Errorf("rejecting htlc due to incorrect "+
-					"amount: expected %v, received %v",
-					invoice.Terms.Value, pd.Amount)
-
-				failure := lnwire.NewFailUnknownPaymentHash(
+				invoice, minCltvDelta, err = l.cfg.Registry.AddKeysendInvoice(
+					invoiceHash, *fwdInfo.KeysendPreimage,
 					pd.Amount,
 				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
-
-				needUpdate = true
-				continue
 			}
-
-			// As we're the exit hop, we'll double check the
-			// hop-payload included in the HTLC to ensure that it
-			// was crafted correctly by the sender and matches the
-			// HTLC we were extended.
-			//
-			// NOTE: We make an exception when the value requested
-			// by the invoice is zero. This means the invoice
-			// allows the payee to specify the amount of satoshis
-			// they wish to send.  So since we expect the htlc to
-			// have a different amount, we should not fail.
-			if !l.cfg.DebugHTLC && invoice.Terms.Value > 0 &&
-				fwdInfo.AmountToForward < invoice.Terms.Value {
-
-				log.Errorf("Onion payload of incoming htlc(%x) "+
-					"has incorrect value: expected %v, "+
-					"got %v", pd.RHash, invoice.Terms.Value,
-					fwdInfo.AmountToForward)
-
+			if err != nil {
+				log.Errorf("unable to query invoice registry: "+
+					" %v", err)
 				failure := lnwire.NewFailUnknownPaymentHash(
 					pd.Amount,
 				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
+				l.sendHTLCError(pd, failure, obfuscator, true)
 
 				needUpdate = true
 				continue
 			}
 
-			// We'll also ensure that our time-lock value has been
-			// computed correctly.
-			expectedHeight := heightNow + minCltvDelta
-			switch {
-			case !l.cfg.DebugHTLC && pd.Timeout < expectedHeight:
-				log.Errorf("Incoming htlc(%x) has an "+
-					"expiration that is too soon: "+
-					"expected at least %v, got %v",
-					pd.RHash[:], expectedHeight, pd.Timeout)
-
-				failure := lnwire.FailFinalExpiryTooSoon{}
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator,
-					pd.SourceRef,
-				)
-
-				needUpdate = true
-				continue
-
-			case !l.cfg.DebugHTLC && pd.Timeout != fwdInfo.OutgoingCTLV:
-				log.Errorf("HTLC(%x) has incorrect "+
-					"time-lock: expected %v, got %v",
-					pd.RHash[:], pd.Timeout,
-					fwdInfo.OutgoingCTLV)
-
-				failure := lnwire.NewFinalIncorrectCltvExpiry(
-					fwdInfo.OutgoingCTLV,
-				)
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
+			// An htlc that is already part of the set paying to
+			// the invoice is replayed after a restart. It passed
+			// our checks when it arrived, and may have been held
+			// since then, so its expiry may now be too soon. We'll
+			// leave it to the invoice registry to resolve it
+			// instead.
+			circuitKey := channeldb.CircuitKey{
+				ChanID: l.ShortChanID(),
+				HtlcID: pd.HtlcIndex,
+			}
+			_, replayed := invoice.Htlcs[circuitKey]
+			if !replayed {
+				failure := l.checkExitHopHtlc(
+					pd, &fwdInfo, &invoice, minCltvDelta,
+					heightNow,
 				)
+				if failure != nil {
+					l.sendHTLCError(
+						pd, failure, obfuscator, true,
+					)
 
-				needUpdate = true
-				continue
+					needUpdate = true
+					continue
+				}
 			}
 
-			preimage := invoice.Terms.PaymentPreimage
-			err = l.channel.SettleHTLC(
-				preimage, pd.HtlcIndex, pd.SourceRef, nil, nil,
-			)
-			if err != nil {
-				l.fail(LinkFailureError{code: ErrInternalError},
-					"unable to settle htlc: %v", err)
-				return false
+			// Notify the invoiceRegistry of the htlc, which adds
+			// it to the set of htlcs paying to the invoice. If the
+			// set isn't complete yet, we'll hold on to the htlc
+			// until the registry resolves it.
+			l.heldHtlcs[circuitKey] = heldHtlc{
+				pd:         pd,
+				obfuscator: obfuscator,
 			}
-
-			// Notify the invoiceRegistry of the invoices we just
-			// settled (with the amount accepted at settle time)
-			// with this latest commitment update.
-			err = l.cfg.Registry.SettleInvoice(
-				invoiceHash, pd.Amount,
+			resolution, err := l.cfg.Registry.NotifyExitHopHtlc(
+				invoiceHash, pd.Amount, fwdInfo.TotalAmount,
+				pd.Timeout, circuitKey,
+				l.htlcResolutions.ChanIn(),
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
-					"unable to settle invoice: %v", err)
+					"unable to notify invoice registry: %v",
+					err)
 				return false
 			}
 
-			l.infof("settling %x as exit hop", pd.RHash)
-
-			// If the link is in hodl.BogusSettle mode, replace the
-			// preimage with a fake one before sending it to the
-			// peer.
-			if l.cfg.DebugHTLC &&
-				l.cfg.HodlMask.Active(hodl.BogusSettle) {
-				l.warnf(hodl.BogusSettle.Warning())
-				preimage = [32]byte{}
-				copy(preimage[:], bytes.Repeat([]byte{2}, 32))
+			if resolution == nil {
+				l.infof("holding htlc %x as exit hop until "+
+					"its set completes", pd.RHash)
+				continue
 			}
 
-			// HTLC was successfully settled locally send
-			// notification about it remote peer.
-			l.cfg.Peer.SendMessage(false, &lnwire.UpdateFulfillHTLC{
-				ChanID:          l.ChanID(),
-				ID:              pd.HtlcIndex,
-				PaymentPreimage: preimage,
-			})
-			needUpdate = true
+			if l.processHtlcResolution(resolution) {
+				needUpdate = true
+			}
+			if l.failed {
+				return false
+			}
 
 		// There are additional channels left within this route. So
 		// we'll simply do some forwarding package book-keeping.
@@ -2585,9 +2689,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					)
 				}
 
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
+				l.sendHTLCError(pd, failure, obfuscator, false)
 				needUpdate = true
 				continue
 			}
@@ -2689,9 +2791,10 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 }
 
 // sendHTLCError functions cancels HTLC and send cancel message back to the
-// peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e ErrorEncrypter, sourceRef *channeldb.AddRef) {
+// peer from which HTLC was received. The isReceive flag indicates whether we
+// are the final hop of the HTLC.
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, e ErrorEncrypter, isReceive bool) {
 
 	reason, err := e.EncryptFirstHop(failure)
 	if err != nil {
@@ -2699,7 +2802,7 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 		return
 	}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
+	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2707,18 +2810,178 @@ func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMess
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
 		ChanID: l.ChanID(),
-		ID:     htlcIndex,
+		ID:     pd.HtlcIndex,
 		Reason: reason,
 	})
+
+	l.notifyIncomingFail(pd, failure, isReceive)
+}
+
+// checkExitHopHtlc validates an htlc arriving at the exit hop against the
+// invoice it pays to. It returns the failure to send back to the htlc's sender
+// if the htlc can't pay to the invoice, or nil otherwise.
+func (l *channelLink) checkExitHopHtlc(pd *lnwallet.PaymentDescriptor,
+	fwdInfo *ForwardingInfo, invoice *channeldb.Invoice,
+	minCltvDelta, heightNow uint32) lnwire.FailureMessage {
+
+	// First, we'll check the expiry of the HTLC itself against, the
+	// current block height. If the timeout is too soon, then we'll reject
+	// the HTLC.
+	if pd.Timeout-expiryGraceDelta <= heightNow {
+		log.Errorf("htlc(%x) has an expiry that's too soon: "+
+			"expiry=%v, best_height=%v", pd.RHash[:], pd.Timeout,
+			heightNow)
+
+		return &lnwire.FailFinalExpiryTooSoon{}
+	}
+
+	// If the invoice has a payment address, the sender may only omit it if
+	// the invoice signals the payment address feature as optional, and may
+	// never pass an incorrect one. Otherwise, the htlc may be probing for
+	// the invoice by its payment hash, so we'll fail it as if the invoice
+	// were unknown.
+	var zeroAddr [32]byte
+	badPaymentAddr := false
+	switch {
+	case invoice.PaymentAddr == zeroAddr:
+	case fwdInfo.PaymentAddr == nil:
+		badPaymentAddr = invoice.PaymentAddrRequired
+	default:
+		badPaymentAddr = *fwdInfo.PaymentAddr != invoice.PaymentAddr
+	}
+	if badPaymentAddr {
+		log.Errorf("Incoming htlc(%x) has an incorrect payment "+
+			"address", pd.RHash[:])
+
+		return lnwire.NewFailUnknownPaymentHash(pd.Amount)
+	}
+
+	// If the invoice is already settled, we choose to accept the payment
+	// to simplify failure recovery.
+	//
+	// NOTE: Though our recovery and forwarding logic is predominately
+	// batched, settling invoices happens iteratively. We may reject one of
+	// two payments for the same rhash at first, but then restart and
+	// reject both after seeing that the invoice has been settled. Without
+	// any record of which one settles first, it is ambiguous as to which
+	// one actually settled the invoice. Thus, by accepting all payments,
+	// we eliminate the race condition that can lead to this inconsistency.
+	//
+	// TODO(conner): track ownership of settlements to properly recover
+	// from failures? or add batch invoice settlement
+	if invoice.Terms.State == channeldb.ContractSettled {
+		log.Warnf("Accepting duplicate payment for hash=%x",
+			pd.RHash[:])
+	}
+
+	// As we're the exit hop, we'll double check the hop-payload included
+	// in the HTLC to ensure that it was crafted correctly by the sender
+	// and matches the HTLC we were extended. As the invoice may be paid by
+	// a set of several HTLCs, each of them must carry the exact amount the
+	// sender intended for it. Whether the set pays the full value
+	// requested by the invoice is checked by the invoice registry.
+	//
+	// NOTE: We make an exception when the value requested by the invoice
+	// is zero. This means the invoice allows the payee to specify the
+	// amount of satoshis they wish to send.  So since we expect the htlc
+	// to have a different amount, we should not fail.
+	if !l.cfg.DebugHTLC && invoice.Terms.Value > 0 &&
+		fwdInfo.AmountToForward != pd.Amount {
+
+		log.Errorf("Onion payload of incoming htlc(%x) has incorrect "+
+			"value: expected %v, got %v", pd.RHash, pd.Amount,
+			fwdInfo.AmountToForward)
+
+		return lnwire.NewFailUnknownPaymentHash(pd.Amount)
+	}
+
+	// We'll also ensure that our time-lock value has been computed
+	// correctly.
+	expectedHeight := heightNow + minCltvDelta
+	switch {
+	case !l.cfg.DebugHTLC && pd.Timeout < expectedHeight:
+		log.Errorf("Incoming htlc(%x) has an expiration that is too "+
+			"soon: expected at least %v, got %v", pd.RHash[:],
+			expectedHeight, pd.Timeout)
+
+		return &lnwire.FailFinalExpiryTooSoon{}
+
+	case !l.cfg.DebugHTLC && pd.Timeout != fwdInfo.OutgoingCTLV:
+		log.Errorf("HTLC(%x) has incorrect time-lock: expected %v, "+
+			"got %v", pd.RHash[:], pd.Timeout, fwdInfo.OutgoingCTLV)
+
+		return lnwire.NewFinalIncorrectCltvExpiry(fwdInfo.OutgoingCTLV)
+	}
+
+	return nil
+}
+
+// processHtlcResolution settles or fails the held exit hop htlc according to
+// the resolution received from the invoice registry. It returns true if the
+// htlc was resolved and the commitment needs to be updated.
+func (l *channelLink) processHtlcResolution(resolution *HtlcResolution) bool {
+	htlc, ok := l.heldHtlcs[resolution.CircuitKey]
+	if !ok {
+		log.Warnf("received resolution for unknown htlc %v",
+			resolution.CircuitKey)
+		return false
+	}
+	delete(l.heldHtlcs, resolution.CircuitKey)
+
+	pd := htlc.pd
+	if resolution.Preimage == nil {
+		l.infof("failing %x as exit hop", pd.RHash)
+
+		failure := lnwire.NewFailUnknownPaymentHash(pd.Amount)
+		l.sendHTLCError(pd, failure, htlc.obfuscator, true)
+		return true
+	}
+
+	preimage := *resolution.Preimage
+	err := l.channel.SettleHTLC(
+		preimage, pd.HtlcIndex, pd.SourceRef, nil, nil,
+	)
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to settle htlc: %v", err)
+		return false
+	}
+
+	l.infof("settling %x as exit hop", pd.RHash)
+
+	// If the link is in hodl.BogusSettle mode, replace the preimage with
+	// a fake one before sending it to the peer.
+	if l.cfg.DebugHTLC && l.cfg.HodlMask.Active(hodl.BogusSettle) {
+		l.warnf(hodl.BogusSettle.Warning())
+		preimage = [32]byte{}
+		copy(preimage[:], bytes.Repeat([]byte{2}, 32))
+	}
+
+	// HTLC was successfully settled locally send notification about it
+	// remote peer.
+	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFulfillHTLC{
+		ChanID:          l.ChanID(),
+		ID:              pd.HtlcIndex,
+		PaymentPreimage: preimage,
+	})
+
+	l.cfg.HtlcNotifier.NotifySettleEvent(
+		HtlcKey{IncomingCircuit: resolution.CircuitKey},
+		HtlcEventTypeReceive,
+	)
+
+	return true
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
 // to the payment sender.
-func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
-	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
+func (l *channelLink) sendMalformedHTLCError(pd *lnwallet.PaymentDescriptor,
+	code lnwire.FailCode, onionBlob []byte, isReceive bool) {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
+	err := l.channel.MalformedFailHTLC(
+		pd.HtlcIndex, code, shaOnionBlob, pd.SourceRef,
+	)
 	if err != nil {
 		log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -2726,10 +2989,48 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 
 	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
 		ChanID:       l.ChanID(),
-		ID:           htlcIndex,
+		ID:           pd.HtlcIndex,
 		ShaOnionBlob: shaOnionBlob,
 		FailureCode:  code,
 	})
+
+	var failure lnwire.FailureMessage
+	switch code {
+	case lnwire.CodeInvalidOnionVersion:
+		failure = lnwire.NewInvalidOnionVersion(onionBlob)
+	case lnwire.CodeInvalidOnionHmac:
+		failure = lnwire.NewInvalidOnionHmac(onionBlob)
+	case lnwire.CodeInvalidOnionKey:
+		failure = lnwire.NewInvalidOnionKey(onionBlob)
+	default:
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	}
+	l.notifyIncomingFail(pd, failure, isReceive)
+}
+
+// notifyIncomingFail notifies the htlc notifier that we failed the passed
+// incoming HTLC back to our peer.
+func (l *channelLink) notifyIncomingFail(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, isReceive bool) {
+
+	eventType := HtlcEventTypeForward
+	if isReceive {
+		eventType = HtlcEventTypeReceive
+	}
+
+	l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		HtlcKey{
+			IncomingCircuit: CircuitKey{
+				ChanID: l.ShortChanID(),
+				HtlcID: pd.HtlcIndex,
+			},
+		},
+		HtlcInfo{
+			IncomingTimeLock: pd.Timeout,
+			IncomingAmt:      pd.Amount,
+		},
+		eventType, failure, "", true,
+	)
 }
 
 // fail is a function which is used to encapsulate the action necessary for
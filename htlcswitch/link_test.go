@@ -12,6 +12,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/fastsha256"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
@@ -235,7 +237,7 @@ func TestChannelLinkSingleHopPayment(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if !invoice.Terms.Settled {
+	if invoice.Terms.State != channeldb.ContractSettled {
 		t.Fatal("alice invoice wasn't settled")
 	}
 
@@ -251,6 +253,440 @@ func TestChannelLinkSingleHopPayment(t *testing.T) {
 	}
 }
 
+// TestChannelLinkExitHopPaymentAddr tests that the exit hop only accepts htlcs
+// without a payment address if the invoice doesn't require one, and rejects
+// htlcs with an incorrect payment address.
+func TestChannelLinkExitHopPaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+
+	invoiceAddr := [32]byte{1}
+	wrongAddr := [32]byte{2}
+
+	tests := []struct {
+		name        string
+		required    bool
+		paymentAddr *[32]byte
+		settled     bool
+	}{
+		{
+			name:        "optional, no address",
+			required:    false,
+			paymentAddr: nil,
+			settled:     true,
+		},
+		{
+			name:        "optional, correct address",
+			required:    false,
+			paymentAddr: &invoiceAddr,
+			settled:     true,
+		},
+		{
+			name:        "optional, incorrect address",
+			required:    false,
+			paymentAddr: &wrongAddr,
+			settled:     false,
+		},
+		{
+			name:        "required, no address",
+			required:    true,
+			paymentAddr: nil,
+			settled:     false,
+		},
+		{
+			name:        "required, correct address",
+			required:    true,
+			paymentAddr: &invoiceAddr,
+			settled:     true,
+		},
+	}
+
+	amount := lnwire.NewMSatFromSatoshis(10000)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+	for _, test := range tests {
+		htlcAmt, totalTimelock, hops := generateHops(
+			amount, testStartingHeight, n.firstBobChannelLink,
+		)
+		hops[len(hops)-1].PaymentAddr = test.paymentAddr
+
+		blob, err := generateRoute(hops...)
+		if err != nil {
+			t.Fatalf("%v: unable to generate route: %v",
+				test.name, err)
+		}
+		invoice, htlc, err := generatePayment(
+			amount, htlcAmt, totalTimelock, blob,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to generate payment: %v",
+				test.name, err)
+		}
+		invoice.PaymentAddr = invoiceAddr
+		invoice.PaymentAddrRequired = test.required
+
+		err = n.bobServer.registry.AddInvoice(*invoice)
+		if err != nil {
+			t.Fatalf("%v: unable to add invoice: %v", test.name,
+				err)
+		}
+
+		_, err = n.aliceServer.htlcSwitch.SendHTLC(
+			firstHop, htlc, newMockDeobfuscator(),
+		)
+		if test.settled && err != nil {
+			t.Fatalf("%v: unable to make the payment: %v",
+				test.name, err)
+		}
+		if !test.settled && err == nil {
+			t.Fatalf("%v: payment should have failed", test.name)
+		}
+
+		dbInvoice, _, err := n.bobServer.registry.LookupInvoice(
+			htlc.PaymentHash,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to get invoice: %v", test.name,
+				err)
+		}
+		settled := dbInvoice.Terms.State == channeldb.ContractSettled
+		if settled != test.settled {
+			t.Fatalf("%v: expected invoice settled to be %v, "+
+				"got %v", test.name, test.settled, settled)
+		}
+	}
+}
+
+// waitForInvoiceHtlcs waits for the invoice paid to by the passed hash to have
+// the given number of htlcs in its set, and returns it.
+func waitForInvoiceHtlcs(t *testing.T, registry *mockInvoiceRegistry,
+	rhash chainhash.Hash, numHtlcs int) channeldb.Invoice {
+
+	t.Helper()
+
+	timeout := time.After(15 * time.Second)
+	for {
+		invoice, _, err := registry.LookupInvoice(rhash)
+		if err != nil {
+			t.Fatalf("unable to get invoice: %v", err)
+		}
+		if len(invoice.Htlcs) == numHtlcs {
+			return invoice
+		}
+
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("expected %v htlcs paying to invoice, got %v",
+				numHtlcs, len(invoice.Htlcs))
+		}
+	}
+}
+
+// TestChannelLinkExitHopMpp tests that the exit hop holds the htlcs of a
+// multi-path payment until its set pays the total amount passed by the sender,
+// and fails the htlcs whose total amount doesn't cover the invoice.
+func TestChannelLinkExitHopMpp(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+
+	amount := lnwire.NewMSatFromSatoshis(10000)
+	shardAmt := amount / 2
+	paymentAddr := [32]byte{1}
+	firstHop := n.firstBobChannelLink.ShortChanID()
+
+	// generateShard generates an htlc carrying the passed amount as part
+	// of a payment of the given total amount.
+	var preimage [32]byte
+	generateShard := func(shardAmt,
+		totalAmt lnwire.MilliSatoshi) *lnwire.UpdateAddHTLC {
+
+		htlcAmt, totalTimelock, hops := generateHops(
+			shardAmt, testStartingHeight, n.firstBobChannelLink,
+		)
+		hops[len(hops)-1].PaymentAddr = &paymentAddr
+		hops[len(hops)-1].TotalAmount = totalAmt
+
+		blob, err := generateRoute(hops...)
+		if err != nil {
+			t.Fatalf("unable to generate route: %v", err)
+		}
+
+		invoice, htlc, err := generatePayment(
+			amount, htlcAmt, totalTimelock, blob,
+		)
+		if err != nil {
+			t.Fatalf("unable to generate payment: %v", err)
+		}
+
+		// All shards pay to the same invoice, which is added along
+		// with the first one.
+		if preimage == [32]byte{} {
+			preimage = invoice.Terms.PaymentPreimage
+			invoice.PaymentAddr = paymentAddr
+
+			err := n.bobServer.registry.AddInvoice(*invoice)
+			if err != nil {
+				t.Fatalf("unable to add invoice: %v", err)
+			}
+		}
+		htlc.PaymentHash = fastsha256.Sum256(preimage[:])
+
+		return htlc
+	}
+
+	// A shard whose total amount doesn't cover the invoice is failed.
+	htlc := generateShard(shardAmt, shardAmt)
+	_, err = n.aliceServer.htlcSwitch.SendHTLCShard(
+		firstHop, htlc, newMockDeobfuscator(),
+	)
+	if err == nil {
+		t.Fatalf("shard below the invoice value should have failed")
+	}
+
+	// The first shard of the payment is held until the rest of the set
+	// arrives.
+	errChan := make(chan error, 2)
+	sendShard := func(htlc *lnwire.UpdateAddHTLC) {
+		go func() {
+			_, err := n.aliceServer.htlcSwitch.SendHTLCShard(
+				firstHop, htlc, newMockDeobfuscator(),
+			)
+			errChan <- err
+		}()
+	}
+	rhash := chainhash.Hash(htlc.PaymentHash)
+
+	sendShard(generateShard(shardAmt, amount))
+	invoice := waitForInvoiceHtlcs(t, n.bobServer.registry, rhash, 1)
+	if invoice.Terms.State != channeldb.ContractOpen {
+		t.Fatalf("expected invoice to be open, got %v",
+			invoice.Terms.State)
+	}
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("first shard should be held, got %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// Once the second shard completes the set, both are settled.
+	sendShard(generateShard(shardAmt, amount))
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				t.Fatalf("unable to send shard: %v", err)
+			}
+		case <-time.After(15 * time.Second):
+			t.Fatalf("shard wasn't settled")
+		}
+	}
+
+	invoice = waitForInvoiceHtlcs(t, n.bobServer.registry, rhash, 2)
+	if invoice.Terms.State != channeldb.ContractSettled ||
+		invoice.AmtPaid != amount {
+
+		t.Fatalf("expected invoice to be settled with %v, got state "+
+			"%v with %v", amount, invoice.Terms.State,
+			invoice.AmtPaid)
+	}
+}
+
+// TestChannelLinkExitHopHoldInvoice tests that the exit hop holds the htlc
+// paying to a hold invoice until the invoice is settled.
+func TestChannelLinkExitHopHoldInvoice(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+
+	amount := lnwire.NewMSatFromSatoshis(10000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.firstBobChannelLink,
+	)
+	blob, err := generateRoute(hops...)
+	if err != nil {
+		t.Fatalf("unable to generate route: %v", err)
+	}
+	invoice, htlc, err := generatePayment(
+		amount, htlcAmt, totalTimelock, blob,
+	)
+	if err != nil {
+		t.Fatalf("unable to generate payment: %v", err)
+	}
+
+	// The hold invoice is added with only its payment hash.
+	preimage := invoice.Terms.PaymentPreimage
+	invoice.PaymentHash = htlc.PaymentHash
+	invoice.Terms.PaymentPreimage = [32]byte{}
+	if err := n.bobServer.registry.AddInvoice(*invoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	type sendResult struct {
+		preimage [32]byte
+		err      error
+	}
+	resultChan := make(chan sendResult, 1)
+	go func() {
+		preimage, err := n.aliceServer.htlcSwitch.SendHTLC(
+			n.firstBobChannelLink.ShortChanID(), htlc,
+			newMockDeobfuscator(),
+		)
+		resultChan <- sendResult{preimage, err}
+	}()
+
+	// The htlc is held, and the invoice accepted, until it's settled.
+	rhash := chainhash.Hash(htlc.PaymentHash)
+	dbInvoice := waitForInvoiceHtlcs(t, n.bobServer.registry, rhash, 1)
+	if dbInvoice.Terms.State != channeldb.ContractAccepted {
+		t.Fatalf("expected invoice to be accepted, got %v",
+			dbInvoice.Terms.State)
+	}
+
+	select {
+	case result := <-resultChan:
+		t.Fatalf("htlc should be held, got %v", result.err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	if err := n.bobServer.registry.settleHodlInvoice(preimage); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	select {
+	case result := <-resultChan:
+		if result.err != nil {
+			t.Fatalf("unable to make the payment: %v", result.err)
+		}
+		if result.preimage != preimage {
+			t.Fatalf("expected preimage %x, got %x", preimage,
+				result.preimage)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatalf("htlc wasn't settled")
+	}
+}
+
+// TestChannelLinkExitHopKeysend tests that the exit hop settles a keysend
+// payment that doesn't pay to an existing invoice only if it accepts keysend
+// payments.
+func TestChannelLinkExitHopKeysend(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+
+	amount := lnwire.NewMSatFromSatoshis(10000)
+	for _, acceptKeysend := range []bool{false, true} {
+		n.firstBobChannelLink.cfg.AcceptKeysend = acceptKeysend
+
+		// The sender chooses the preimage, and passes it to the exit
+		// hop within the onion.
+		htlcAmt, totalTimelock, hops := generateHops(
+			amount, testStartingHeight, n.firstBobChannelLink,
+		)
+		_, htlc, err := generatePayment(
+			amount, htlcAmt, totalTimelock,
+			[lnwire.OnionPacketSize]byte{},
+		)
+		if err != nil {
+			t.Fatalf("unable to generate payment: %v", err)
+		}
+		var preimage [32]byte
+		if _, err := rand.Read(preimage[:]); err != nil {
+			t.Fatalf("unable to generate preimage: %v", err)
+		}
+		htlc.PaymentHash = fastsha256.Sum256(preimage[:])
+		hops[len(hops)-1].KeysendPreimage = &preimage
+
+		htlc.OnionBlob, err = generateRoute(hops...)
+		if err != nil {
+			t.Fatalf("unable to generate route: %v", err)
+		}
+
+		_, err = n.aliceServer.htlcSwitch.SendHTLC(
+			n.firstBobChannelLink.ShortChanID(), htlc,
+			newMockDeobfuscator(),
+		)
+		if acceptKeysend && err != nil {
+			t.Fatalf("unable to make keysend payment: %v", err)
+		}
+		if !acceptKeysend && err == nil {
+			t.Fatalf("keysend payment should have failed")
+		}
+
+		invoice, _, err := n.bobServer.registry.LookupInvoice(
+			htlc.PaymentHash,
+		)
+		switch {
+		case !acceptKeysend && err != channeldb.ErrInvoiceNotFound:
+			t.Fatalf("expected no keysend invoice, got %v", err)
+
+		case acceptKeysend && err != nil:
+			t.Fatalf("unable to get keysend invoice: %v", err)
+
+		case acceptKeysend &&
+			invoice.Terms.State != channeldb.ContractSettled:
+
+			t.Fatalf("expected keysend invoice to be settled, "+
+				"got %v", invoice.Terms.State)
+		}
+	}
+}
+
 // TestChannelLinkBidirectionalOneHopPayments tests the ability of channel
 // link to cope with bigger number of payment updates that commitment
 // transaction may consist.
@@ -466,7 +902,7 @@ func TestChannelLinkMultiHopPayment(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if !invoice.Terms.Settled {
+	if invoice.Terms.State != channeldb.ContractSettled {
 		t.Fatal("carol invoice haven't been settled")
 	}
 
@@ -817,7 +1253,7 @@ func TestUpdateForwardingPolicy(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if !invoice.Terms.Settled {
+	if invoice.Terms.State != channeldb.ContractSettled {
 		t.Fatal("carol invoice haven't been settled")
 	}
 
@@ -936,7 +1372,7 @@ func TestChannelLinkMultiHopInsufficientPayment(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if invoice.Terms.Settled {
+	if invoice.Terms.State == channeldb.ContractSettled {
 		t.Fatal("carol invoice have been settled")
 	}
 
@@ -1026,7 +1462,7 @@ func TestChannelLinkMultiHopUnknownPaymentHash(t *testing.T) {
 
 	// Check that alice invoice wasn't settled and bandwidth of htlc
 	// links hasn't been changed.
-	if invoice.Terms.Settled {
+	if invoice.Terms.State == channeldb.ContractSettled {
 		t.Fatal("alice invoice was settled")
 	}
 
@@ -1112,7 +1548,7 @@ func TestChannelLinkMultiHopUnknownNextHop(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if invoice.Terms.Settled {
+	if invoice.Terms.State == channeldb.ContractSettled {
 		t.Fatal("carol invoice have been settled")
 	}
 
@@ -1227,7 +1663,7 @@ func TestChannelLinkMultiHopDecodeError(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if invoice.Terms.Settled {
+	if invoice.Terms.State == channeldb.ContractSettled {
 		t.Fatal("carol invoice have been settled")
 	}
 
@@ -1560,6 +1996,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
 		},
+		OnCommitmentRevoked: func() {},
 		UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 			return nil
 		},
@@ -1572,6 +2009,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve btcutil.Amount) (
 		BatchSize:           10000,
 		MinFeeUpdateTimeout: 30 * time.Minute,
 		MaxFeeUpdateTimeout: 40 * time.Minute,
+		HtlcNotifier:        aliceSwitch.cfg.HtlcNotifier,
 	}
 
 	const startingHeight = 100
@@ -3325,7 +3763,7 @@ func TestChannelRetransmission(t *testing.T) {
 				err = errors.Errorf("unable to get invoice: %v", err)
 				continue
 			}
-			if !invoice.Terms.Settled {
+			if invoice.Terms.State != channeldb.ContractSettled {
 				err = errors.Errorf("alice invoice haven't been settled")
 				continue
 			}
@@ -3364,10 +3802,16 @@ func TestChannelRetransmission(t *testing.T) {
 
 }
 
-// TestShouldAdjustCommitFee tests the shouldAdjustCommitFee pivot function to
-// ensure that ie behaves properly. We should only update the fee if it
-// deviates from our current fee by more 10% or more.
-func TestShouldAdjustCommitFee(t *testing.T) {
+// TestCommitFeePolicyShouldUpdate tests the shouldUpdate pivot function of the
+// commitment fee policy to ensure that ie behaves properly. With the default
+// hysteresis, we should only update the fee if it deviates from our current
+// fee by more 10% or more.
+func TestCommitFeePolicyShouldUpdate(t *testing.T) {
+	policy := CommitFeePolicy{
+		Hysteresis: DefaultCommitFeeHysteresis,
+	}
+
+
 	tests := []struct {
 		netFee       lnwallet.SatPerKWeight
 		chanFee      lnwallet.SatPerKWeight
@@ -3449,9 +3893,7 @@ func TestShouldAdjustCommitFee(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		adjustedFee := shouldAdjustCommitFee(
-			test.netFee, test.chanFee,
-		)
+		adjustedFee := policy.shouldUpdate(test.netFee, test.chanFee)
 
 		if adjustedFee && !test.shouldAdjust {
 			t.Fatalf("test #%v failed: net_fee=%v, "+
@@ -3462,6 +3904,90 @@ func TestShouldAdjustCommitFee(t *testing.T) {
 	}
 }
 
+// TestCommitFeePolicyBounds tests that the commitment fee policy bounds the
+// target fee rate, and that it always moves a commitment fee rate that is out
+// of bounds back into them.
+func TestCommitFeePolicyBounds(t *testing.T) {
+	t.Parallel()
+
+	policy := CommitFeePolicy{
+		ConfTarget: 6,
+		MinFeeRate: 1000,
+		MaxFeeRate: 5000,
+		Hysteresis: 50,
+	}
+
+	estimator := &mockFeeEstimator{
+		byteFeeIn: make(chan lnwallet.SatPerKWeight, 1),
+		quit:      make(chan struct{}),
+	}
+
+	tests := []struct {
+		netFee    lnwallet.SatPerKWeight
+		targetFee lnwallet.SatPerKWeight
+	}{
+		{netFee: 500, targetFee: 1000},
+		{netFee: 3000, targetFee: 3000},
+		{netFee: 9000, targetFee: 5000},
+	}
+
+	for i, test := range tests {
+		estimator.byteFeeIn <- test.netFee
+		targetFee, err := policy.targetFeeRate(estimator)
+		if err != nil {
+			t.Fatalf("test #%v: unable to get target fee: %v", i, err)
+		}
+		if targetFee != test.targetFee {
+			t.Fatalf("test #%v: expected target fee %v, got %v", i,
+				test.targetFee, targetFee)
+		}
+	}
+
+	// Within the bounds, the hysteresis prevents small updates.
+	if policy.shouldUpdate(1400, 1000) {
+		t.Fatalf("expected no update within the hysteresis band")
+	}
+	if !policy.shouldUpdate(1500, 1000) {
+		t.Fatalf("expected update outside of the hysteresis band")
+	}
+
+	// A current fee rate out of bounds is always updated, even if the
+	// change is within the hysteresis band.
+	if !policy.shouldUpdate(5000, 5200) {
+		t.Fatalf("expected update of fee rate above max fee rate")
+	}
+	if !policy.shouldUpdate(1000, 900) {
+		t.Fatalf("expected update of fee rate below min fee rate")
+	}
+
+	// Merging an override only overrides the fields it sets.
+	maxFeePerKw := uint64(8000)
+	policy.Merge(&channeldb.CommitFeePolicyOverride{
+		MaxFeePerKw: &maxFeePerKw,
+	})
+	if policy.MaxFeeRate != 8000 || policy.MinFeeRate != 1000 ||
+		policy.ConfTarget != 6 || policy.Hysteresis != 50 {
+
+		t.Fatalf("unexpected policy after merge: %v",
+			spew.Sdump(policy))
+	}
+
+	// Fields set to zero are reset, which removes the max fee rate and
+	// disables the hysteresis.
+	var zeroFee uint64
+	var zeroPercent uint32
+	policy.Merge(&channeldb.CommitFeePolicyOverride{
+		MaxFeePerKw: &zeroFee,
+		Hysteresis:  &zeroPercent,
+	})
+	if policy.MaxFeeRate != 0 || policy.MinFeeRate != 1000 ||
+		policy.ConfTarget != 6 || policy.Hysteresis != 0 {
+
+		t.Fatalf("unexpected policy after reset: %v",
+			spew.Sdump(policy))
+	}
+}
+
 // TestChannelLinkShutdownDuringForward asserts that a link can be fully
 // stopped when it is trying to send synchronously through the switch. The
 // specific case this can occur is when a link forwards incoming Adds. We test
@@ -3696,6 +4222,19 @@ func TestChannelLinkUpdateCommitFee(t *testing.T) {
 		t.Fatalf("bob's fee rate didn't change: expected %v, got %v",
 			newFeeRate, aliceFeeRate)
 	}
+
+	// Alice's link should have recorded the fee rate it targeted, and
+	// Bob's link the fee rate that Alice proposed.
+	aliceTarget, _ := n.aliceChannelLink.CommitFeeProposals()
+	if aliceTarget != newFeeRate {
+		t.Fatalf("alice's target fee rate wasn't recorded: expected "+
+			"%v, got %v", newFeeRate, aliceTarget)
+	}
+	_, bobRemote := n.firstBobChannelLink.CommitFeeProposals()
+	if bobRemote != newFeeRate {
+		t.Fatalf("alice's proposed fee rate wasn't recorded by bob: "+
+			"expected %v, got %v", newFeeRate, bobRemote)
+	}
 }
 
 // TestChannelLinkAcceptDuplicatePayment tests that if a link receives an
@@ -3821,7 +4360,7 @@ func TestChannelLinkAcceptOverpay(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to get invoice: %v", err)
 	}
-	if !invoice.Terms.Settled {
+	if invoice.Terms.State != channeldb.ContractSettled {
 		t.Fatal("carol invoice haven't been settled")
 	}
 
@@ -3935,10 +4474,12 @@ func (h *persistentLinkHarness) restart(restartSwitch bool,
 
 	// Now, restart the link using the channel state. This will take care of
 	// adding the link to an existing switch, or creating a new one using
-	// the database owned by the link.
+	// the database owned by the link. The invoice registry is carried
+	// over, as its state is persisted along with the channel's.
 	var cleanUp func()
 	h.link, h.batchTicker, cleanUp, err = restartLink(
-		h.channel, htlcSwitch, hodlFlags,
+		h.channel, htlcSwitch,
+		h.coreLink.cfg.Registry.(*mockInvoiceRegistry), hodlFlags,
 	)
 	if err != nil {
 		h.t.Fatalf("unable to restart alicelink: %v", err)
@@ -4011,11 +4552,12 @@ func (h *persistentLinkHarness) trySignNextCommitment() {
 	}
 }
 
-// restartLink creates a new channel link from the given channel state, and adds
-// to an htlcswitch. If none is provided by the caller, a new one will be
-// created using Alice's database.
+// restartLink creates a new channel link from the given channel state and
+// invoice registry, and adds to an htlcswitch. If none is provided by the
+// caller, a new one will be created using Alice's database.
 func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
-	hodlFlags []hodl.Flag) (ChannelLink, chan time.Time, func(), error) {
+	invoiceRegistry *mockInvoiceRegistry, hodlFlags []hodl.Flag) (
+	ChannelLink, chan time.Time, func(), error) {
 
 	var (
 		decoder    = newMockIteratorDecoder()
@@ -4031,8 +4573,6 @@ func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
 			TimeLockDelta: 6,
 		}
 
-		invoiceRegistry = newMockRegistry(globalPolicy.TimeLockDelta)
-
 		pCache = &mockPreimageCache{
 			// hash -> preimage
 			preimageMap: make(map[[32]byte][]byte),
@@ -4068,6 +4608,7 @@ func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
 		OnChannelFailure: func(lnwire.ChannelID,
 			lnwire.ShortChannelID, LinkFailureError) {
 		},
+		OnCommitmentRevoked: func() {},
 		UpdateContractSignals: func(*contractcourt.ContractSignals) error {
 			return nil
 		},
@@ -4080,6 +4621,7 @@ func restartLink(aliceChannel *lnwallet.LightningChannel, aliceSwitch *Switch,
 		BatchSize:           10000,
 		MinFeeUpdateTimeout: 30 * time.Minute,
 		MaxFeeUpdateTimeout: 40 * time.Minute,
+		HtlcNotifier:        aliceSwitch.cfg.HtlcNotifier,
 		// Set any hodl flags requested for the new link.
 		HodlMask:  hodl.MaskFromFlags(hodlFlags...),
 		DebugHTLC: len(hodlFlags) > 0,
@@ -4289,6 +4831,88 @@ func receiveFailAliceToBob(t *testing.T, aliceMsgs chan lnwire.Message,
 	}
 }
 
+// TestChannelLinkHoldInvoiceRestart tests that an htlc held for a hold invoice
+// is held again when the link replays it after a restart, even though its
+// expiry became too soon to accept it meanwhile, and is settled along with the
+// invoice.
+func TestChannelLinkHoldInvoiceRestart(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+
+	aliceLink, bobChannel, batchTicker, start, cleanUp, restore, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	if err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	defer cleanUp()
+
+	if err := start(); err != nil {
+		t.Fatalf("unable to start test harness: %v", err)
+	}
+
+	alice := newPersistentLinkHarness(t, aliceLink, batchTicker, restore)
+	registry := alice.coreLink.cfg.Registry.(*mockInvoiceRegistry)
+
+	// Add a hold invoice to Alice's registry, which only knows its payment
+	// hash.
+	htlcAmt := lnwire.NewMSatFromSatoshis(10000)
+	blob, err := generateRoute(ForwardingInfo{
+		Network:         BitcoinHop,
+		NextHop:         exitHop,
+		AmountToForward: htlcAmt,
+		OutgoingCTLV:    144,
+	})
+	if err != nil {
+		t.Fatalf("unable to generate route: %v", err)
+	}
+	invoice, htlc, err := generatePayment(htlcAmt, htlcAmt, 144, blob)
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+	preimage := invoice.Terms.PaymentPreimage
+	invoice.PaymentHash = htlc.PaymentHash
+	invoice.Terms.PaymentPreimage = [32]byte{}
+	if err := registry.AddInvoice(*invoice); err != nil {
+		t.Fatalf("unable to add invoice to registry: %v", err)
+	}
+
+	// Bob sends the htlc paying to the invoice, and locks it in.
+	sendHtlcBobToAlice(t, alice.link, bobChannel, htlc)
+	sendCommitSigBobToAlice(t, alice.link, bobChannel, 1)
+	receiveRevAndAckAliceToBob(t, alice.msgs, alice.link, bobChannel)
+	receiveCommitSigAliceToBob(t, alice.msgs, alice.link, bobChannel, 1)
+	sendRevAndAckBobToAlice(t, alice.link, bobChannel)
+
+	// Alice holds the htlc, and accepts the invoice.
+	rhash := chainhash.Hash(htlc.PaymentHash)
+	dbInvoice := waitForInvoiceHtlcs(t, registry, rhash, 1)
+	if dbInvoice.Terms.State != channeldb.ContractAccepted {
+		t.Fatalf("expected invoice to be accepted, got %v",
+			dbInvoice.Terms.State)
+	}
+
+	// The chain advances close to the expiry of the htlc while it's held,
+	// so that it would now be rejected if it arrived. After the restart,
+	// Alice replays the htlc, which must not fail it.
+	atomic.StoreUint32(&alice.coreLink.cfg.Switch.bestHeight, htlc.Expiry-1)
+
+	cleanUp = alice.restart(false)
+	defer cleanUp()
+
+	select {
+	case msg := <-alice.msgs:
+		t.Fatalf("expected htlc to be held, got %T", msg)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// Settling the invoice settles the htlc held by the restarted link.
+	if err := registry.settleHodlInvoice(preimage); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	receiveSettleAliceToBob(t, alice.msgs, alice.link, bobChannel)
+}
+
 // TestChannelLinkNoMoreUpdates tests that we won't send a new commitment
 // when there are no new updates to sign.
 func TestChannelLinkNoMoreUpdates(t *testing.T) {
@@ -5192,3 +5816,84 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 		}
 	})
 }
+
+// TestChannelLinkDustExposure asserts that the link refuses to add downstream
+// HTLCs that would push the value of the dust HTLCs on the commitments past
+// its max dust HTLC exposure.
+func TestChannelLinkDustExposure(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	aliceLink, _, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	if err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	defer cleanUp()
+
+	var (
+		mockBlob  [lnwire.OnionPacketSize]byte
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+		dustAmt   = lnwire.NewMSatFromSatoshis(1000)
+	)
+
+	// Allow a single dust HTLC on the commitments.
+	coreLink.cfg.MaxDustHTLCExposure = dustAmt + dustAmt/2
+
+	if err := start(); err != nil {
+		t.Fatalf("unable to start test harness: %v", err)
+	}
+
+	sendDust := func() {
+		_, htlc, err := generatePayment(dustAmt, dustAmt, 5, mockBlob)
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+
+		addPkt := &htlcPacket{
+			htlc:       htlc,
+			obfuscator: NewMockObfuscator(),
+		}
+		circuit := makePaymentCircuit(&htlc.PaymentHash, addPkt)
+		_, err = coreLink.cfg.Switch.commitCircuits(&circuit)
+		if err != nil {
+			t.Fatalf("unable to commit circuit: %v", err)
+		}
+
+		aliceLink.HandleSwitchPacket(addPkt)
+	}
+
+	// The first dust HTLC is within the limit, so Alice should send it to
+	// Bob.
+	sendDust()
+	select {
+	case msg := <-aliceMsgs:
+		if _, ok := msg.(*lnwire.UpdateAddHTLC); !ok {
+			t.Fatalf("expected UpdateAddHTLC, got %T", msg)
+		}
+	case <-time.After(15 * time.Second):
+		t.Fatalf("did not receive message")
+	}
+
+	// A second dust HTLC would exceed the limit, so it must be rejected.
+	err = coreLink.checkHtlcDustExposure(dustAmt, false)
+	if err != ErrDustExposureExceeded {
+		t.Fatalf("expected %v, got %v", ErrDustExposureExceeded, err)
+	}
+
+	sendDust()
+	select {
+	case msg := <-aliceMsgs:
+		t.Fatalf("expected no message, got %T", msg)
+	case <-time.After(time.Second):
+	}
+
+	// HTLCs that aren't dust aren't affected by the limit.
+	err = coreLink.checkHtlcDustExposure(
+		lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin), false,
+	)
+	if err != nil {
+		t.Fatalf("unable to add non-dust htlc: %v", err)
+	}
+}
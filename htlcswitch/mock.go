@@ -102,6 +102,25 @@ func (m *mockForwardingLog) AddForwardingEvents(events []channeldb.ForwardingEve
 	return nil
 }
 
+type mockHTLCNotifier struct{}
+
+func (h *mockHTLCNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage,
+	failureDetail string, incoming bool) {
+}
+
+func (h *mockHTLCNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
+
 type mockServer struct {
 	started  int32 // To be used atomically.
 	shutdown int32 // To be used atomically.
@@ -160,6 +179,7 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		Notifier:       &mockNotifier{},
 		FwdEventTicker: ticker.MockNew(DefaultFwdEventInterval),
 		LogEventTicker: ticker.MockNew(DefaultLogInterval),
+		HtlcNotifier:   &mockHTLCNotifier{},
 	}
 
 	return New(cfg, startingHeight)
@@ -252,10 +272,10 @@ func newMockHopIterator(hops ...ForwardingInfo) HopIterator {
 	return &mockHopIterator{hops: hops}
 }
 
-func (r *mockHopIterator) ForwardingInstructions() ForwardingInfo {
+func (r *mockHopIterator) ForwardingInstructions() (ForwardingInfo, error) {
 	h := r.hops[0]
 	r.hops = r.hops[1:]
-	return h
+	return h, nil
 }
 
 func (r *mockHopIterator) ExtractErrorEncrypter(
@@ -298,6 +318,31 @@ func (f *ForwardingInfo) encode(w io.Writer) error {
 		return err
 	}
 
+	hasPaymentAddr := f.PaymentAddr != nil
+	if err := binary.Write(w, binary.BigEndian, hasPaymentAddr); err != nil {
+		return err
+	}
+	if hasPaymentAddr {
+		if _, err := w.Write(f.PaymentAddr[:]); err != nil {
+			return err
+		}
+		err := binary.Write(w, binary.BigEndian, f.TotalAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	hasKeysendPreimage := f.KeysendPreimage != nil
+	err := binary.Write(w, binary.BigEndian, hasKeysendPreimage)
+	if err != nil {
+		return err
+	}
+	if hasKeysendPreimage {
+		if _, err := w.Write(f.KeysendPreimage[:]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -462,6 +507,36 @@ func (f *ForwardingInfo) decode(r io.Reader) error {
 		return err
 	}
 
+	var hasPaymentAddr bool
+	if err := binary.Read(r, binary.BigEndian, &hasPaymentAddr); err != nil {
+		return err
+	}
+	if hasPaymentAddr {
+		var paymentAddr [32]byte
+		if _, err := io.ReadFull(r, paymentAddr[:]); err != nil {
+			return err
+		}
+		f.PaymentAddr = &paymentAddr
+
+		err := binary.Read(r, binary.BigEndian, &f.TotalAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	var hasKeysendPreimage bool
+	err := binary.Read(r, binary.BigEndian, &hasKeysendPreimage)
+	if err != nil {
+		return err
+	}
+	if hasKeysendPreimage {
+		var preimage [32]byte
+		if _, err := io.ReadFull(r, preimage[:]); err != nil {
+			return err
+		}
+		f.KeysendPreimage = &preimage
+	}
+
 	return nil
 }
 
@@ -644,6 +719,14 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
+func (f *mockChannelLink) UpdateCommitFeePolicy(
+	_ *channeldb.CommitFeePolicyOverride) {
+
+}
+func (f *mockChannelLink) CommitFeeProposals() (lnwallet.SatPerKWeight,
+	lnwallet.SatPerKWeight) {
+	return 0, 0
+}
 func (f *mockChannelLink) HtlcSatifiesPolicy([32]byte, lnwire.MilliSatoshi,
 	lnwire.MilliSatoshi, uint32, uint32, uint32) lnwire.FailureMessage {
 	return nil
@@ -681,14 +764,16 @@ var _ ChannelLink = (*mockChannelLink)(nil)
 type mockInvoiceRegistry struct {
 	sync.Mutex
 
-	invoices   map[chainhash.Hash]channeldb.Invoice
-	finalDelta uint32
+	invoices    map[chainhash.Hash]channeldb.Invoice
+	subscribers map[channeldb.CircuitKey]chan<- interface{}
+	finalDelta  uint32
 }
 
 func newMockRegistry(minDelta uint32) *mockInvoiceRegistry {
 	return &mockInvoiceRegistry{
-		finalDelta: minDelta,
-		invoices:   make(map[chainhash.Hash]channeldb.Invoice),
+		finalDelta:  minDelta,
+		invoices:    make(map[chainhash.Hash]channeldb.Invoice),
+		subscribers: make(map[channeldb.CircuitKey]chan<- interface{}),
 	}
 }
 
@@ -698,41 +783,208 @@ func (i *mockInvoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Inv
 
 	invoice, ok := i.invoices[rHash]
 	if !ok {
-		return channeldb.Invoice{}, 0, fmt.Errorf("can't find mock "+
-			"invoice: %x", rHash[:])
+		return channeldb.Invoice{}, 0, channeldb.ErrInvoiceNotFound
+	}
+
+	// Copy the set of htlcs, as the registry keeps updating it.
+	htlcs := make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+	for circuitKey, htlc := range invoice.Htlcs {
+		htlcCopy := *htlc
+		htlcs[circuitKey] = &htlcCopy
 	}
+	invoice.Htlcs = htlcs
 
 	return invoice, i.finalDelta, nil
 }
 
-func (i *mockInvoiceRegistry) SettleInvoice(rhash chainhash.Hash,
-	amt lnwire.MilliSatoshi) error {
+func (i *mockInvoiceRegistry) AddKeysendInvoice(rhash chainhash.Hash,
+	preimage [32]byte, amt lnwire.MilliSatoshi) (channeldb.Invoice, uint32,
+	error) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	if chainhash.Hash(fastsha256.Sum256(preimage[:])) != rhash {
+		return channeldb.Invoice{}, 0, fmt.Errorf("preimage doesn't "+
+			"match hash %x", rhash[:])
+	}
+
+	invoice := channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           amt,
+		},
+		Htlcs:       make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC),
+		PaymentHash: rhash,
+	}
+	i.invoices[rhash] = invoice
+
+	return invoice, i.finalDelta, nil
+}
+
+// NotifyExitHopHtlc adds the htlc to the set paying to the invoice, and holds
+// it until the set pays the total amount passed by the sender, or the value of
+// the invoice if none was passed. The htlcs paying to a hold invoice are held
+// until it's settled. As the mock doesn't time out incomplete sets, an htlc
+// that doesn't pass a total amount is failed unless it pays the invoice on its
+// own.
+func (i *mockInvoiceRegistry) NotifyExitHopHtlc(rhash chainhash.Hash,
+	amt, totalAmt lnwire.MilliSatoshi, expiry uint32,
+	circuitKey channeldb.CircuitKey,
+	subscriber chan<- interface{}) (*HtlcResolution, error) {
 
 	i.Lock()
 	defer i.Unlock()
 
 	invoice, ok := i.invoices[rhash]
 	if !ok {
-		return fmt.Errorf("can't find mock invoice: %x", rhash[:])
+		return nil, channeldb.ErrInvoiceNotFound
+	}
+	settleResolution := &HtlcResolution{
+		CircuitKey: circuitKey,
+		Preimage:   &invoice.Terms.PaymentPreimage,
+	}
+	failResolution := &HtlcResolution{
+		CircuitKey: circuitKey,
 	}
 
-	if invoice.Terms.Settled {
-		return nil
+	// An htlc replayed after a restart gets its recorded outcome, or is
+	// held again.
+	if htlc, ok := invoice.Htlcs[circuitKey]; ok {
+		switch htlc.State {
+		case channeldb.HtlcStateSettled:
+			return settleResolution, nil
+
+		case channeldb.HtlcStateCanceled:
+			return failResolution, nil
+		}
+
+		i.subscribers[circuitKey] = subscriber
+		return nil, nil
 	}
 
-	invoice.Terms.Settled = true
-	invoice.AmtPaid = amt
-	i.invoices[rhash] = invoice
+	if invoice.Terms.State == channeldb.ContractSettled {
+		return settleResolution, nil
+	}
+
+	setTotal := invoice.Terms.Value
+	switch {
+	case totalAmt != 0 && totalAmt < invoice.Terms.Value:
+		return failResolution, nil
+
+	case totalAmt != 0:
+		setTotal = totalAmt
+
+	case amt < invoice.Terms.Value:
+		return failResolution, nil
+	}
+
+	invoice.Htlcs[circuitKey] = &channeldb.InvoiceHTLC{
+		Amt:      amt,
+		MppTotal: totalAmt,
+		State:    channeldb.HtlcStateAccepted,
+	}
+
+	var amtAccepted lnwire.MilliSatoshi
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State == channeldb.HtlcStateAccepted {
+			amtAccepted += htlc.Amt
+		}
+	}
+
+	if amtAccepted < setTotal || invoice.IsHold() {
+		if amtAccepted >= setTotal {
+			invoice.Terms.State = channeldb.ContractAccepted
+			i.invoices[rhash] = invoice
+		}
+
+		i.subscribers[circuitKey] = subscriber
+		return nil, nil
+	}
+
+	i.settleHtlcSet(rhash, invoice)
+
+	return settleResolution, nil
+}
+
+// settleHodlInvoice settles the accepted hold invoice paid to by the passed
+// preimage, along with the htlcs held as part of its set.
+func (i *mockInvoiceRegistry) settleHodlInvoice(preimage [32]byte) error {
+	i.Lock()
+	defer i.Unlock()
+
+	rhash := chainhash.Hash(fastsha256.Sum256(preimage[:]))
+	invoice, ok := i.invoices[rhash]
+	if !ok {
+		return channeldb.ErrInvoiceNotFound
+	}
+	if invoice.Terms.State != channeldb.ContractAccepted {
+		return fmt.Errorf("invoice %x isn't accepted", rhash[:])
+	}
+
+	invoice.Terms.PaymentPreimage = preimage
+	i.settleHtlcSet(rhash, invoice)
 
 	return nil
 }
 
+// settleHtlcSet settles the invoice and the htlcs accepted as part of its set,
+// delivering the resolutions of the held htlcs to their subscribers.
+//
+// NOTE: The mock's lock MUST be held when calling this method.
+func (i *mockInvoiceRegistry) settleHtlcSet(rhash chainhash.Hash,
+	invoice channeldb.Invoice) {
+
+	invoice.Terms.State = channeldb.ContractSettled
+	for circuitKey, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		htlc.State = channeldb.HtlcStateSettled
+		invoice.AmtPaid += htlc.Amt
+
+		subscriber, ok := i.subscribers[circuitKey]
+		if !ok {
+			continue
+		}
+		delete(i.subscribers, circuitKey)
+
+		subscriber <- &HtlcResolution{
+			CircuitKey: circuitKey,
+			Preimage:   &invoice.Terms.PaymentPreimage,
+		}
+	}
+	i.invoices[rhash] = invoice
+}
+
+func (i *mockInvoiceRegistry) UnsubscribeHtlcResolutions(
+	subscriber chan<- interface{}) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	for circuitKey, htlcSubscriber := range i.subscribers {
+		if htlcSubscriber == subscriber {
+			delete(i.subscribers, circuitKey)
+		}
+	}
+}
+
 func (i *mockInvoiceRegistry) AddInvoice(invoice channeldb.Invoice) error {
 	i.Lock()
 	defer i.Unlock()
 
-	rhash := fastsha256.Sum256(invoice.Terms.PaymentPreimage[:])
-	i.invoices[chainhash.Hash(rhash)] = invoice
+	// Hold invoices are added with only a payment hash, while the hash of
+	// other invoices is the one of their preimage.
+	var zeroHash [32]byte
+	if invoice.PaymentHash == zeroHash {
+		invoice.PaymentHash = fastsha256.Sum256(
+			invoice.Terms.PaymentPreimage[:],
+		)
+	}
+	invoice.Htlcs = make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+	i.invoices[chainhash.Hash(invoice.PaymentHash)] = invoice
 
 	return nil
 }
@@ -1,6 +1,8 @@
 package htlcswitch
 
 import (
+	"sync/atomic"
+
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/lnwire"
 )
@@ -85,6 +87,58 @@ type htlcPacket struct {
 	// will be extraced from the hop payload recevived by the incoming
 	// link.
 	outgoingTimeout uint32
+
+	// fwdState tracks whether an Add packet has been claimed by its
+	// outgoing link, or failed back by the switch because it was held for
+	// too long. It ensures that exactly one of both happens.
+	//
+	// NOTE: This MUST be used atomically.
+	fwdState uint32
+}
+
+const (
+	// fwdStatePending indicates that an Add packet hasn't been claimed by
+	// its outgoing link yet.
+	fwdStatePending uint32 = iota
+
+	// fwdStateClaimed indicates that the outgoing link is adding the Add
+	// packet to its channel.
+	fwdStateClaimed
+
+	// fwdStateExpired indicates that the switch failed the Add packet back
+	// before it was claimed by its outgoing link.
+	fwdStateExpired
+)
+
+// claimForward claims the Add packet for its outgoing link before the HTLC is
+// added to the channel. It returns false if the switch has already failed the
+// packet back, in which case it must be dropped. A packet that is redelivered
+// after it was claimed may be claimed again.
+func (p *htlcPacket) claimForward() bool {
+	if atomic.CompareAndSwapUint32(
+		&p.fwdState, fwdStatePending, fwdStateClaimed,
+	) {
+		return true
+	}
+
+	return atomic.LoadUint32(&p.fwdState) == fwdStateClaimed
+}
+
+// unclaimForward releases the claim of the outgoing link on the Add packet,
+// which is used when the HTLC couldn't be added to the channel yet.
+func (p *htlcPacket) unclaimForward() {
+	atomic.CompareAndSwapUint32(
+		&p.fwdState, fwdStateClaimed, fwdStatePending,
+	)
+}
+
+// expireForward marks the Add packet as failed back by the switch. It returns
+// false if the packet has already been claimed by its outgoing link, in which
+// case it must not be failed back.
+func (p *htlcPacket) expireForward() bool {
+	return atomic.CompareAndSwapUint32(
+		&p.fwdState, fwdStatePending, fwdStateExpired,
+	)
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
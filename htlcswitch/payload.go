@@ -0,0 +1,26 @@
+package htlcswitch
+
+import (
+	"github.com/breez/lightninglib/onion"
+)
+
+// parseTLVPayload decodes the forwarding info from the TLV hop payload of a
+// hop. An onion.ErrInvalidPayload is returned if the payload omits a record
+// that's required for the hop or includes one that isn't allowed for it.
+func parseTLVPayload(payload []byte, finalHop bool) (ForwardingInfo, error) {
+	p, err := onion.DecodePayload(payload, finalHop)
+	if err != nil {
+		return ForwardingInfo{}, err
+	}
+
+	return ForwardingInfo{
+		Network:         BitcoinHop,
+		NextHop:         p.NextHop,
+		AmountToForward: p.AmountToForward,
+		OutgoingCTLV:    p.OutgoingCTLV,
+		PaymentAddr:     p.PaymentAddr,
+		TotalAmount:     p.TotalAmount,
+		KeysendPreimage: p.KeysendPreimage,
+		CustomRecords:   p.CustomRecords,
+	}, nil
+}
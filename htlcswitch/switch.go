@@ -117,6 +117,17 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// MaxFeePerKw is the highest fee rate the closure transaction may pay
+	// during fee negotiation. If zero, no limit other than the fee of the
+	// current commitment transaction is imposed. This value is only
+	// utilized if the closure type is CloseRegular.
+	MaxFeePerKw lnwallet.SatPerKWeight
+
+	// DeliveryScript is the script the closed out funds of the channel
+	// should be paid to. If nil, a fresh address of the wallet is used.
+	// This value is only utilized if the closure type is CloseRegular.
+	DeliveryScript []byte
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan *lnrpc.CloseStatusUpdate
@@ -148,6 +159,12 @@ type Config struct {
 	// persistent circuit map.
 	DB *channeldb.DB
 
+	// Control tracks the outgoing payments sent through the switch. It's
+	// shared with the router, which records the attempts to send each
+	// payment through it. If nil, a non-strict payment control backed by
+	// DB is used.
+	Control ControlTower
+
 	// SwitchPackager provides access to the forwarding packages of all
 	// active channels. This gives the switch the ability to read arbitrary
 	// forwarding packages, and ack settles and fails contained within them.
@@ -176,6 +193,19 @@ type Config struct {
 	// LogEventTicker is a signal instructing the htlcswitch to log
 	// aggregate stats about it's forwarding during the last interval.
 	LogEventTicker ticker.Ticker
+
+	// InterceptTimeout is the duration that a forward may be held by the
+	// forward interceptor before the switch resumes it on its own. If
+	// zero, DefaultInterceptTimeout is used.
+	InterceptTimeout time.Duration
+
+	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
+	// events through.
+	HtlcNotifier htlcNotifier
+
+	// ForwardLimits are the limits that are enforced on the forwards of
+	// each incoming peer.
+	ForwardLimits ForwardLimits
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -277,6 +307,15 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// interceptor is the forward interceptor that is handed all HTLCs
+	// that are about to be forwarded, if set.
+	interceptor    ForwardInterceptor
+	interceptorMtx sync.RWMutex
+
+	// fwdTracker tracks the forwards in flight of each incoming peer in
+	// order to enforce the forward limits.
+	fwdTracker *forwardTracker
 }
 
 // New creates the new instance of htlc switch.
@@ -294,12 +333,21 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		return nil, err
 	}
 
+	control := cfg.Control
+	if control == nil {
+		control = NewPaymentControl(false, cfg.DB)
+	}
+
+	if cfg.InterceptTimeout == 0 {
+		cfg.InterceptTimeout = DefaultInterceptTimeout
+	}
+
 	return &Switch{
 		bestHeight:        currentHeight,
 		cfg:               &cfg,
 		circuits:          circuitMap,
 		paymentSequencer:  sequencer,
-		control:           NewPaymentControl(false, cfg.DB),
+		control:           control,
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
@@ -309,6 +357,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
+		fwdTracker:        newForwardTracker(&cfg.ForwardLimits),
 		quit:              make(chan struct{}),
 	}, nil
 }
@@ -362,6 +411,32 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 		return zeroPreimage, err
 	}
 
+	return s.sendHTLC(firstHop, htlc, deobfuscator)
+}
+
+// SendHTLCShard is used by other subsystems to send one of the several htlc
+// updates a multi-path payment is split into. Unlike SendHTLC, it permits
+// other shards of the same payment to be in flight.
+func (s *Switch) SendHTLCShard(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC,
+	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
+
+	// Before sending, double check that we don't already have 1) an
+	// in-flight payment to this payment hash that isn't made up of shards,
+	// or 2) a complete payment for the same hash.
+	if err := s.control.ClearShardForTakeoff(htlc); err != nil {
+		return zeroPreimage, err
+	}
+
+	return s.sendHTLC(firstHop, htlc, deobfuscator)
+}
+
+// sendHTLC forwards an htlc update that has been cleared for takeoff to the
+// first hop, and waits for its settlement or failure.
+func (s *Switch) sendHTLC(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC,
+	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
+
 	// Create payment and add to the map of payment in order later to be
 	// able to retrieve it and return response to the user.
 	payment := &pendingPayment{
@@ -639,8 +714,13 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 	}
 
 	// Now, forward any packets for circuits that were successfully added to
-	// the switch's circuit map.
+	// the switch's circuit map, unless the forward interceptor holds onto
+	// them.
 	for _, packet := range addedPackets {
+		if s.interceptForward(packet) {
+			continue
+		}
+
 		err := s.routeAsync(packet, fwdChan, linkQuit)
 		if err != nil {
 			return errChan
@@ -903,6 +983,23 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 			return
 		}
 
+		// If no one is waiting for the payment, the daemon has been
+		// restarted since sending it, so there's no router left to
+		// retry it. We'll mark it as failed, so its outcome can still
+		// be reported.
+		if payment == nil {
+			err := s.control.FailPayment(
+				pkt.circuit.PaymentHash,
+				channeldb.FailureReasonError,
+			)
+			if err != nil && err != ErrPaymentInFlight &&
+				err != ErrAlreadyPaid {
+
+				log.Warnf("Unable to fail payment %x: %v",
+					pkt.circuit.PaymentHash, err)
+			}
+		}
+
 		paymentErr = s.parseFailedPayment(payment, pkt, htlc)
 
 	default:
@@ -1134,10 +1231,30 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.failAddPacket(packet, linkErr, addErr)
 		}
 
+		// Before handing the packet to its outgoing link, we'll make
+		// sure that the incoming peer stays within its forward limits.
+		packet.outgoingChanID = destination.ShortChanID()
+		if err := s.trackForward(packet); err != nil {
+			var failure lnwire.FailureMessage
+			update, fetchErr := s.cfg.FetchLastChannelUpdate(
+				packet.outgoingChanID,
+			)
+			if fetchErr != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(update)
+			}
+
+			return s.failAddPacket(packet, failure, err)
+		}
+
 		// Send the packet to the destination channel link which
 		// manages the channel.
-		packet.outgoingChanID = destination.ShortChanID()
-		return destination.HandleSwitchPacket(packet)
+		err = destination.HandleSwitchPacket(packet)
+		if err != nil {
+			s.fwdTracker.release(packet.inKey())
+		}
+		return err
 
 	case *lnwire.UpdateFailHTLC, *lnwire.UpdateFulfillHTLC:
 		// If the source of this packet has not been set, use the
@@ -1147,6 +1264,10 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return err
 		}
 
+		// The forward is no longer in flight, so it doesn't count
+		// towards the limits of its incoming peer anymore.
+		s.fwdTracker.release(circuit.Incoming)
+
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
 		if isFail && !packet.hasSource {
 			switch {
@@ -1176,7 +1297,21 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 					fail.Reason,
 				)
 			}
+
+			// Failures that neither originate from our own outgoing
+			// link nor from an on-chain resolution were sent back by
+			// the downstream peer.
+			if circuit.Outgoing != nil && !packet.isResolution {
+				s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+					newCircuitHtlcKey(circuit),
+					getEventType(packet),
+				)
+			}
 		} else if !isFail && circuit.Outgoing != nil {
+			s.cfg.HtlcNotifier.NotifySettleEvent(
+				newCircuitHtlcKey(circuit), getEventType(packet),
+			)
+
 			// If this is an HTLC settle, and it wasn't from a
 			// locally initiated HTLC, then we'll log a forwarding
 			// event so we can flush it to disk later.
@@ -1234,6 +1369,19 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	// The htlc never made it into an outgoing channel, so only the
+	// requested outgoing channel of its key is known.
+	htlcKey := HtlcKey{
+		IncomingCircuit: packet.inKey(),
+		OutgoingCircuit: CircuitKey{
+			ChanID: packet.outgoingChanID,
+		},
+	}
+	s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		htlcKey, newHtlcInfo(packet), getEventType(packet), failure,
+		failErr.Error(), false,
+	)
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -1412,11 +1560,13 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then the fee parameters should be the ideal fee-per-kw that will be used as
+// a starting point for close negotiation and the maximum fee-per-kw we're
+// willing to pay, and the delivery script may optionally specify where our
+// funds should be paid to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
-	chan error) {
+	targetFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	deliveryScript []byte) (chan *lnrpc.CloseStatusUpdate, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
 	updateChan := make(chan *lnrpc.CloseStatusUpdate, 2)
@@ -1427,6 +1577,8 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		MaxFeePerKw:    maxFeePerKw,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1887,6 +2039,8 @@ func (s *Switch) Stop() error {
 
 	s.wg.Wait()
 
+	s.fwdTracker.stop()
+
 	// Wait until all active goroutines have finished exiting before
 	// stopping the mailboxes, otherwise the mailbox map could still be
 	// accessed and modified.
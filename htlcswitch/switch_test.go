@@ -1983,3 +1983,612 @@ func TestMultiHopPaymentForwardingEvents(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchForwardInterceptor tests that forwards held by the forward
+// interceptor are only forwarded once resumed, and that they can be settled
+// or failed back by the interceptor instead.
+func TestSwitchForwardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.InterceptTimeout = time.Second
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// We'll set an interceptor that holds all forwards.
+	forwards := make(chan InterceptedForward, 1)
+	s.SetInterceptor(func(fwd InterceptedForward) bool {
+		forwards <- fwd
+		return true
+	})
+
+	// unknownChanID is the channel that all HTLCs will be headed for,
+	// which doesn't exist yet.
+	unknownChanID := lnwire.NewShortChanIDFromInt(1 << 40)
+
+	var htlcID uint64
+	forwardHtlc := func(preimage [32]byte) InterceptedForward {
+		packet := &htlcPacket{
+			incomingChanID:  aliceChannelLink.ShortChanID(),
+			incomingHTLCID:  htlcID,
+			outgoingChanID:  unknownChanID,
+			incomingAmount:  2,
+			amount:          1,
+			incomingTimeout: 100,
+			outgoingTimeout: 50,
+			obfuscator:      NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: fastsha256.Sum256(preimage[:]),
+				Amount:      1,
+			},
+		}
+		htlcID++
+
+		errChan := s.ForwardPackets(nil, packet)
+		for err := range errChan {
+			if err != nil {
+				t.Fatalf("unable to forward packet: %v", err)
+			}
+		}
+
+		var fwd InterceptedForward
+		select {
+		case fwd = <-forwards:
+		case <-time.After(time.Second):
+			t.Fatalf("forward was not intercepted")
+		}
+
+		expectedPacket := InterceptedPacket{
+			IncomingCircuit: packet.inKey(),
+			OutgoingChanID:  unknownChanID,
+			Hash:            packet.htlc.(*lnwire.UpdateAddHTLC).PaymentHash,
+			IncomingAmount:  2,
+			OutgoingAmount:  1,
+			IncomingExpiry:  100,
+			OutgoingExpiry:  50,
+		}
+		if fwd.Packet() != expectedPacket {
+			t.Fatalf("wrong intercepted packet: expected %v, got %v",
+				spew.Sdump(expectedPacket),
+				spew.Sdump(fwd.Packet()))
+		}
+
+		// The held forward shouldn't have made it to any link.
+		select {
+		case <-bobChannelLink.packets:
+			t.Fatalf("held forward was forwarded")
+		case <-aliceChannelLink.packets:
+			t.Fatalf("held forward was resolved")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		return fwd
+	}
+
+	// First, we'll resume a forward over bob's channel, which should
+	// forward the HTLC to bob.
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	fwd := forwardHtlc(preimage)
+	if err := fwd.ResumeModified(bobChanID); err != nil {
+		t.Fatalf("unable to resume forward: %v", err)
+	}
+	select {
+	case pkt := <-bobChannelLink.packets:
+		if pkt.outgoingChanID != bobChanID {
+			t.Fatalf("wrong outgoing channel: expected %v, got %v",
+				bobChanID, pkt.outgoingChanID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("resumed forward was not forwarded")
+	}
+
+	// The forward can't be resolved again.
+	if err := fwd.Resume(); err != ErrFwdAlreadyResolved {
+		t.Fatalf("expected ErrFwdAlreadyResolved, got %v", err)
+	}
+
+	// Next, we'll settle a forward, which should settle the HTLC back to
+	// alice, but only using the correct preimage.
+	preimage, err = genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	fwd = forwardHtlc(preimage)
+	if err := fwd.Settle([32]byte{}); err != ErrInvalidInterceptPreimage {
+		t.Fatalf("expected ErrInvalidInterceptPreimage, got %v", err)
+	}
+	if err := fwd.Settle(preimage); err != nil {
+		t.Fatalf("unable to settle forward: %v", err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		settle, ok := pkt.htlc.(*lnwire.UpdateFulfillHTLC)
+		if !ok {
+			t.Fatalf("expected settle, got %T", pkt.htlc)
+		}
+		if settle.PaymentPreimage != preimage {
+			t.Fatalf("wrong preimage")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("settle was not delivered")
+	}
+
+	// Then, we'll fail a forward, which should fail the HTLC back to
+	// alice.
+	fwd = forwardHtlc(preimage)
+	if err := fwd.Fail(lnwire.CodeUnknownNextPeer); err != nil {
+		t.Fatalf("unable to fail forward: %v", err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail, got %T", pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("fail was not delivered")
+	}
+
+	// Finally, a forward that isn't resolved in time should be resumed by
+	// the switch, which fails it back as the outgoing channel is unknown.
+	forwardHtlc(preimage)
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail, got %T", pkt.htlc)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("timed out forward was not resumed")
+	}
+}
+
+// TestSwitchHtlcNotifier tests that the switch notifies the htlc notifier of
+// the htlcs it fails to forward, and of the settles and fails of the htlcs it
+// forwarded.
+func TestSwitchHtlcNotifier(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	notifier := NewHtlcNotifier()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start htlc notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	client, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe to htlc events: %v", err)
+	}
+	defer client.Cancel()
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.HtlcNotifier = notifier
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	receiveEvent := func() interface{} {
+		select {
+		case event := <-client.Updates():
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("htlc event not received")
+		}
+		return nil
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	newAddPacket := func(htlcID uint64,
+		outgoingChanID lnwire.ShortChannelID) *htlcPacket {
+
+		return &htlcPacket{
+			incomingChanID:  aliceChannelLink.ShortChanID(),
+			incomingHTLCID:  htlcID,
+			outgoingChanID:  outgoingChanID,
+			incomingAmount:  2,
+			amount:          1,
+			incomingTimeout: 150,
+			outgoingTimeout: 140,
+			obfuscator:      NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+	}
+
+	// An htlc that's headed for an unknown channel should be failed by the
+	// switch, which should result in a link failure on the outgoing side.
+	unknownChanID := lnwire.NewShortChanIDFromInt(42)
+	if err := s.forward(newAddPacket(0, unknownChanID)); err == nil {
+		t.Fatalf("expected forward to unknown channel to fail")
+	}
+
+	linkFail, ok := receiveEvent().(*LinkFailEvent)
+	if !ok {
+		t.Fatalf("expected link fail event")
+	}
+	expectedKey := HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: aliceChannelLink.ShortChanID(),
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: unknownChanID,
+		},
+	}
+	expectedInfo := HtlcInfo{
+		IncomingTimeLock: 150,
+		OutgoingTimeLock: 140,
+		IncomingAmt:      2,
+		OutgoingAmt:      1,
+	}
+	switch {
+	case linkFail.HtlcKey != expectedKey:
+		t.Fatalf("expected key %v, got %v", expectedKey,
+			linkFail.HtlcKey)
+
+	case linkFail.HtlcInfo != expectedInfo:
+		t.Fatalf("expected info %v, got %v", expectedInfo,
+			linkFail.HtlcInfo)
+
+	case linkFail.HtlcEventType != HtlcEventTypeForward:
+		t.Fatalf("expected forward event, got %v",
+			linkFail.HtlcEventType)
+
+	case linkFail.Incoming:
+		t.Fatalf("expected outgoing link failure")
+
+	case linkFail.FailureDetail == "":
+		t.Fatalf("expected failure detail")
+	}
+	if _, ok := linkFail.FailureMessage.(*lnwire.FailUnknownNextPeer); !ok {
+		t.Fatalf("expected unknown next peer failure, got %T",
+			linkFail.FailureMessage)
+	}
+
+	// forwardToBob forwards an htlc from Alice to Bob and completes its
+	// circuit.
+	forwardToBob := func(htlcID uint64) {
+		if err := s.forward(newAddPacket(htlcID, bobChanID)); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case pkt := <-bobChannelLink.packets:
+			if err := bobChannelLink.completeCircuit(pkt); err != nil {
+				t.Fatalf("unable to complete payment circuit: %v",
+					err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("request was not propagated to destination")
+		}
+	}
+
+	// resolveFromBob sends the passed settle or fail back from Bob, and
+	// returns the resulting htlc event.
+	resolveFromBob := func(outgoingHTLCID uint64,
+		htlc lnwire.Message) interface{} {
+
+		packet := &htlcPacket{
+			outgoingChanID: bobChanID,
+			outgoingHTLCID: outgoingHTLCID,
+			amount:         1,
+			htlc:           htlc,
+		}
+		if err := s.forward(packet); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case pkt := <-aliceChannelLink.packets:
+			if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+				t.Fatalf("unable to remove circuit: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("response was not propagated to source")
+		}
+
+		return receiveEvent()
+	}
+
+	// A settle of a forwarded htlc should be notified along with both of
+	// its circuits.
+	forwardToBob(1)
+	settle, ok := resolveFromBob(0, &lnwire.UpdateFulfillHTLC{
+		PaymentPreimage: preimage,
+	}).(*SettleEvent)
+	if !ok {
+		t.Fatalf("expected settle event")
+	}
+	expectedKey = HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: aliceChannelLink.ShortChanID(),
+			HtlcID: 1,
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: bobChanID,
+			HtlcID: 0,
+		},
+	}
+	if settle.HtlcKey != expectedKey {
+		t.Fatalf("expected key %v, got %v", expectedKey,
+			settle.HtlcKey)
+	}
+	if settle.HtlcEventType != HtlcEventTypeForward {
+		t.Fatalf("expected forward event, got %v",
+			settle.HtlcEventType)
+	}
+
+	// Likewise, a failure sent back by Bob should result in a forwarding
+	// failure.
+	forwardToBob(2)
+	fwdFail, ok := resolveFromBob(1, &lnwire.UpdateFailHTLC{
+		Reason: []byte{1, 2, 3},
+	}).(*ForwardingFailEvent)
+	if !ok {
+		t.Fatalf("expected forwarding fail event")
+	}
+	expectedKey = HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: aliceChannelLink.ShortChanID(),
+			HtlcID: 2,
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: bobChanID,
+			HtlcID: 1,
+		},
+	}
+	if fwdFail.HtlcKey != expectedKey {
+		t.Fatalf("expected key %v, got %v", expectedKey,
+			fwdFail.HtlcKey)
+	}
+	if fwdFail.HtlcEventType != HtlcEventTypeForward {
+		t.Fatalf("expected forward event, got %v",
+			fwdFail.HtlcEventType)
+	}
+}
+
+// TestSwitchForwardLimits tests that the switch fails back the forwards that
+// exceed the limits of their incoming peer, and that forwards that aren't
+// picked up by their outgoing link within the max hold time are failed back
+// early.
+func TestSwitchForwardLimits(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.ForwardLimits = ForwardLimits{
+		MaxPeerForwards:     2,
+		MaxPeerForwardValue: 1500,
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// forward forwards an htlc of the passed amount from Alice to Bob. If
+	// the forward is expected to be rejected, it asserts that the passed
+	// error is returned and that the htlc is failed back to Alice.
+	forward := func(htlcID uint64, amount lnwire.MilliSatoshi,
+		expectedErr error) *htlcPacket {
+
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChanID,
+			incomingAmount: amount,
+			amount:         amount,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      amount,
+			},
+		}
+		if err := s.forward(packet); err != expectedErr {
+			t.Fatalf("expected error %v, got %v", expectedErr, err)
+		}
+
+		if expectedErr == nil {
+			return packet
+		}
+
+		select {
+		case pkt := <-aliceChannelLink.packets:
+			if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+				t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("fail was not propagated to source")
+		}
+
+		return nil
+	}
+
+	// receiveAtBob asserts that Bob receives the next forward and
+	// completes its circuit.
+	receiveAtBob := func() *htlcPacket {
+		select {
+		case pkt := <-bobChannelLink.packets:
+			if err := bobChannelLink.completeCircuit(pkt); err != nil {
+				t.Fatalf("unable to complete payment circuit: %v",
+					err)
+			}
+			return pkt
+		case <-time.After(time.Second):
+			t.Fatal("request was not propagated to destination")
+		}
+		return nil
+	}
+
+	// The first forward is within all limits.
+	forward(0, 1000, nil)
+	receiveAtBob()
+
+	// The second one would exceed the max value in flight of Alice, while
+	// a smaller one still fits.
+	forward(1, 1000, ErrPeerForwardValueExceeded)
+	forward(2, 500, nil)
+	receiveAtBob()
+
+	// With two forwards in flight, Alice has reached the max number of
+	// forwards.
+	forward(3, 1, ErrPeerForwardsExceeded)
+
+	// Once Bob settles the first forward, its slot becomes available
+	// again.
+	settle := &htlcPacket{
+		outgoingChanID: bobChanID,
+		outgoingHTLCID: 0,
+		amount:         1000,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	if err := s.forward(settle); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+			t.Fatalf("unable to remove circuit: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settle was not propagated to source")
+	}
+
+	forward(4, 1, nil)
+	receiveAtBob()
+
+	// Finally, we'll release the previous forwards and set a max hold
+	// time, so that the next forward that Bob doesn't pick up in time is
+	// failed back early.
+	s.fwdTracker.release(CircuitKey{ChanID: aliceChanID, HtlcID: 2})
+	s.fwdTracker.release(CircuitKey{ChanID: aliceChanID, HtlcID: 4})
+	s.cfg.ForwardLimits.MaxForwardHoldTime = 50 * time.Millisecond
+
+	// We'll claim the first forward on behalf of Bob's link, as if it had
+	// picked it up, while the second one is left waiting.
+	claimed := forward(5, 1, nil)
+	held := forward(6, 1, nil)
+	if !claimed.claimForward() {
+		t.Fatalf("unable to claim forward")
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail htlc, got %T", pkt.htlc)
+		}
+		if pkt.inKey() != held.inKey() {
+			t.Fatalf("expected fail of %v, got %v", held.inKey(),
+				pkt.inKey())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("held forward was not failed back")
+	}
+
+	// The expired forward must no longer be claimable by Bob's link, while
+	// the claimed one must not have been failed back.
+	if held.claimForward() {
+		t.Fatalf("expired forward must not be claimable")
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		t.Fatalf("unexpected packet at alice: %v", pkt.inKey())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
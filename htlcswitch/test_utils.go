@@ -931,6 +931,8 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			MinFeeUpdateTimeout: minFeeUpdateTimeout,
 			MaxFeeUpdateTimeout: maxFeeUpdateTimeout,
 			OnChannelFailure:    func(lnwire.ChannelID, lnwire.ShortChannelID, LinkFailureError) {},
+			OnCommitmentRevoked: func() {},
+			HtlcNotifier:        &mockHTLCNotifier{},
 		},
 		aliceChannel,
 	)
@@ -974,6 +976,8 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			MinFeeUpdateTimeout: minFeeUpdateTimeout,
 			MaxFeeUpdateTimeout: maxFeeUpdateTimeout,
 			OnChannelFailure:    func(lnwire.ChannelID, lnwire.ShortChannelID, LinkFailureError) {},
+			OnCommitmentRevoked: func() {},
+			HtlcNotifier:        &mockHTLCNotifier{},
 		},
 		firstBobChannel,
 	)
@@ -1017,6 +1021,8 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			MinFeeUpdateTimeout: minFeeUpdateTimeout,
 			MaxFeeUpdateTimeout: maxFeeUpdateTimeout,
 			OnChannelFailure:    func(lnwire.ChannelID, lnwire.ShortChannelID, LinkFailureError) {},
+			OnCommitmentRevoked: func() {},
+			HtlcNotifier:        &mockHTLCNotifier{},
 		},
 		secondBobChannel,
 	)
@@ -1060,6 +1066,8 @@ func newThreeHopNetwork(t testing.TB, aliceChannel, firstBobChannel,
 			MinFeeUpdateTimeout: minFeeUpdateTimeout,
 			MaxFeeUpdateTimeout: maxFeeUpdateTimeout,
 			OnChannelFailure:    func(lnwire.ChannelID, lnwire.ShortChannelID, LinkFailureError) {},
+			OnCommitmentRevoked: func() {},
+			HtlcNotifier:        &mockHTLCNotifier{},
 		},
 		carolChannel,
 	)
@@ -24,6 +24,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ForwardHtlcInterceptResponse_ResolveAction int32
+
+const (
+	ForwardHtlcInterceptResponse_SETTLE          ForwardHtlcInterceptResponse_ResolveAction = 0
+	ForwardHtlcInterceptResponse_FAIL            ForwardHtlcInterceptResponse_ResolveAction = 1
+	ForwardHtlcInterceptResponse_RESUME          ForwardHtlcInterceptResponse_ResolveAction = 2
+	ForwardHtlcInterceptResponse_RESUME_MODIFIED ForwardHtlcInterceptResponse_ResolveAction = 3
+)
+
+var ForwardHtlcInterceptResponse_ResolveAction_name = map[int32]string{
+	0: "SETTLE",
+	1: "FAIL",
+	2: "RESUME",
+	3: "RESUME_MODIFIED",
+}
+var ForwardHtlcInterceptResponse_ResolveAction_value = map[string]int32{
+	"SETTLE":          0,
+	"FAIL":            1,
+	"RESUME":          2,
+	"RESUME_MODIFIED": 3,
+}
+
+func (x ForwardHtlcInterceptResponse_ResolveAction) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_ResolveAction_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_ResolveAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{20, 0}
+}
+
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_UNKNOWN HtlcEvent_EventType = 0
+	HtlcEvent_SEND    HtlcEvent_EventType = 1
+	HtlcEvent_RECEIVE HtlcEvent_EventType = 2
+	HtlcEvent_FORWARD HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "RECEIVE",
+	3: "FORWARD",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SEND":    1,
+	"RECEIVE": 2,
+	"FORWARD": 3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{22, 0}
+}
+
 type NewAddressRequest_AddressType int32
 
 const (
@@ -44,7 +102,7 @@ func (x NewAddressRequest_AddressType) String() string {
 	return proto.EnumName(NewAddressRequest_AddressType_name, int32(x))
 }
 func (NewAddressRequest_AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{22, 0}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{34, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -79,7 +137,123 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{48, 0}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{60, 0}
+}
+
+type Invoice_InvoiceState int32
+
+const (
+	Invoice_OPEN     Invoice_InvoiceState = 0
+	Invoice_SETTLED  Invoice_InvoiceState = 1
+	Invoice_CANCELED Invoice_InvoiceState = 2
+	Invoice_ACCEPTED Invoice_InvoiceState = 3
+)
+
+var Invoice_InvoiceState_name = map[int32]string{
+	0: "OPEN",
+	1: "SETTLED",
+	2: "CANCELED",
+	3: "ACCEPTED",
+}
+var Invoice_InvoiceState_value = map[string]int32{
+	"OPEN":     0,
+	"SETTLED":  1,
+	"CANCELED": 2,
+	"ACCEPTED": 3,
+}
+
+func (x Invoice_InvoiceState) String() string {
+	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
+}
+func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{138, 0}
+}
+
+type PaymentAttempt_AttemptState int32
+
+const (
+	PaymentAttempt_IN_FLIGHT PaymentAttempt_AttemptState = 0
+	PaymentAttempt_SUCCEEDED PaymentAttempt_AttemptState = 1
+	PaymentAttempt_FAILED    PaymentAttempt_AttemptState = 2
+)
+
+var PaymentAttempt_AttemptState_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var PaymentAttempt_AttemptState_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x PaymentAttempt_AttemptState) String() string {
+	return proto.EnumName(PaymentAttempt_AttemptState_name, int32(x))
+}
+func (PaymentAttempt_AttemptState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{153, 0}
+}
+
+type PaymentUpdate_PaymentStatus int32
+
+const (
+	PaymentUpdate_GROUNDED  PaymentUpdate_PaymentStatus = 0
+	PaymentUpdate_IN_FLIGHT PaymentUpdate_PaymentStatus = 1
+	PaymentUpdate_SUCCEEDED PaymentUpdate_PaymentStatus = 2
+	PaymentUpdate_FAILED    PaymentUpdate_PaymentStatus = 3
+)
+
+var PaymentUpdate_PaymentStatus_name = map[int32]string{
+	0: "GROUNDED",
+	1: "IN_FLIGHT",
+	2: "SUCCEEDED",
+	3: "FAILED",
+}
+var PaymentUpdate_PaymentStatus_value = map[string]int32{
+	"GROUNDED":  0,
+	"IN_FLIGHT": 1,
+	"SUCCEEDED": 2,
+	"FAILED":    3,
+}
+
+func (x PaymentUpdate_PaymentStatus) String() string {
+	return proto.EnumName(PaymentUpdate_PaymentStatus_name, int32(x))
+}
+func (PaymentUpdate_PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{154, 0}
+}
+
+type PaymentUpdate_FailureReason int32
+
+const (
+	PaymentUpdate_FAILURE_REASON_NONE                      PaymentUpdate_FailureReason = 0
+	PaymentUpdate_FAILURE_REASON_TIMEOUT                   PaymentUpdate_FailureReason = 1
+	PaymentUpdate_FAILURE_REASON_NO_ROUTE                  PaymentUpdate_FailureReason = 2
+	PaymentUpdate_FAILURE_REASON_ERROR                     PaymentUpdate_FailureReason = 3
+	PaymentUpdate_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS PaymentUpdate_FailureReason = 4
+)
+
+var PaymentUpdate_FailureReason_name = map[int32]string{
+	0: "FAILURE_REASON_NONE",
+	1: "FAILURE_REASON_TIMEOUT",
+	2: "FAILURE_REASON_NO_ROUTE",
+	3: "FAILURE_REASON_ERROR",
+	4: "FAILURE_REASON_INCORRECT_PAYMENT_DETAILS",
+}
+var PaymentUpdate_FailureReason_value = map[string]int32{
+	"FAILURE_REASON_NONE":                      0,
+	"FAILURE_REASON_TIMEOUT":                   1,
+	"FAILURE_REASON_NO_ROUTE":                  2,
+	"FAILURE_REASON_ERROR":                     3,
+	"FAILURE_REASON_INCORRECT_PAYMENT_DETAILS": 4,
+}
+
+func (x PaymentUpdate_FailureReason) String() string {
+	return proto.EnumName(PaymentUpdate_FailureReason_name, int32(x))
+}
+func (PaymentUpdate_FailureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{154, 1}
 }
 
 type GenSeedRequest struct {
@@ -100,7 +274,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{0}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -155,7 +329,7 @@ func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{1}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{1}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -220,7 +394,7 @@ func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{2}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{2}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -278,7 +452,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{3}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{3}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -320,7 +494,7 @@ func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{4}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{4}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -364,7 +538,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{5}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{5}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -402,7 +576,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{6}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{6}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -446,7 +620,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{7}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{7}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -492,7 +666,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{8}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{8}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -578,7 +752,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{9}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{9}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -610,7 +784,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{10}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{10}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -651,7 +825,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{11}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{11}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -797,17 +971,36 @@ type SendRequest struct {
 	// This value can be represented either as a percentage of the amount being
 	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
 	// send the payment.
-	FeeLimit             *FeeLimit `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	FeeLimit *FeeLimit `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// *
+	// The maximum number of HTLCs the payment may be split into, in case no
+	// single route can carry the full amount. A value of zero or one sends the
+	// payment as a single HTLC. Payments are only split if the payment request
+	// signals support for multi-path payments.
+	MaxShards uint32 `protobuf:"varint,9,opt,name=max_shards,json=maxShards,proto3" json:"max_shards,omitempty"`
+	// *
+	// If set, a keysend payment is sent to dest without an invoice. A random
+	// preimage is generated and passed to the destination within the final hop's
+	// onion payload, so the payment hash must not be specified. The destination
+	// must accept keysend payments.
+	Keysend bool `protobuf:"varint,10,opt,name=keysend,proto3" json:"keysend,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used. This also applies to each shard of the payment.
+	OutgoingChanId uint64 `protobuf:"varint,11,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any hop may be used.
+	LastHopPubkey        []byte   `protobuf:"bytes,12,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{12}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{12}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -883,6 +1076,34 @@ func (m *SendRequest) GetFeeLimit() *FeeLimit {
 	return nil
 }
 
+func (m *SendRequest) GetMaxShards() uint32 {
+	if m != nil {
+		return m.MaxShards
+	}
+	return 0
+}
+
+func (m *SendRequest) GetKeysend() bool {
+	if m != nil {
+		return m.Keysend
+	}
+	return false
+}
+
+func (m *SendRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *SendRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
 type RouteError struct {
 	PaymentRoute         *Route   `protobuf:"bytes,1,opt,name=payment_route,json=paymentRoute,proto3" json:"payment_route,omitempty"`
 	PaymentError         string   `protobuf:"bytes,2,opt,name=payment_error,json=paymentError,proto3" json:"payment_error,omitempty"`
@@ -895,7 +1116,7 @@ func (m *RouteError) Reset()         { *m = RouteError{} }
 func (m *RouteError) String() string { return proto.CompactTextString(m) }
 func (*RouteError) ProtoMessage()    {}
 func (*RouteError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{13}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{13}
 }
 func (m *RouteError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteError.Unmarshal(m, b)
@@ -930,20 +1151,22 @@ func (m *RouteError) GetPaymentError() string {
 }
 
 type SendResponse struct {
-	PaymentError         string        `protobuf:"bytes,1,opt,name=payment_error,proto3" json:"payment_error,omitempty"`
-	PaymentPreimage      []byte        `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
-	PaymentRoute         *Route        `protobuf:"bytes,3,opt,name=payment_route,proto3" json:"payment_route,omitempty"`
-	FailedRoutes         []*RouteError `protobuf:"bytes,4,rep,name=failed_routes,proto3" json:"failed_routes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	PaymentError    string        `protobuf:"bytes,1,opt,name=payment_error,proto3" json:"payment_error,omitempty"`
+	PaymentPreimage []byte        `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	PaymentRoute    *Route        `protobuf:"bytes,3,opt,name=payment_route,proto3" json:"payment_route,omitempty"`
+	FailedRoutes    []*RouteError `protobuf:"bytes,4,rep,name=failed_routes,proto3" json:"failed_routes,omitempty"`
+	// / The routes of all shards of the payment, only set if it was split into several HTLCs.
+	ShardRoutes          []*Route `protobuf:"bytes,5,rep,name=shard_routes,proto3" json:"shard_routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{14}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{14}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -991,6 +1214,147 @@ func (m *SendResponse) GetFailedRoutes() []*RouteError {
 	return nil
 }
 
+func (m *SendResponse) GetShardRoutes() []*Route {
+	if m != nil {
+		return m.ShardRoutes
+	}
+	return nil
+}
+
+type RebalanceRequest struct {
+	// / The channel to shift balance away from.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
+	// / The channel to shift balance to.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,proto3" json:"incoming_chan_id,omitempty"`
+	// / The amount of satoshis to shift.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	// *
+	// The maximum amount of fees to pay for the rebalance. If not set, the
+	// amount to shift is used as the limit.
+	FeeLimit             *FeeLimit `protobuf:"bytes,4,opt,name=fee_limit,proto3" json:"fee_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RebalanceRequest) Reset()         { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()    {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{15}
+}
+func (m *RebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRequest.Unmarshal(m, b)
+}
+func (m *RebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRequest.Merge(dst, src)
+}
+func (m *RebalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRequest.Size(m)
+}
+func (m *RebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceRequest proto.InternalMessageInfo
+
+func (m *RebalanceRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+type RebalanceResponse struct {
+	// / The payment hash of the self-invoice that was paid.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The preimage of the self-invoice that was paid.
+	PaymentPreimage []byte `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	// / The circular route the payment took.
+	Route *Route `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// / The total fee paid for the rebalance, in millisatoshis.
+	FeeMsat              int64    `protobuf:"varint,4,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceResponse) Reset()         { *m = RebalanceResponse{} }
+func (m *RebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceResponse) ProtoMessage()    {}
+func (*RebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{16}
+}
+func (m *RebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceResponse.Unmarshal(m, b)
+}
+func (m *RebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceResponse.Merge(dst, src)
+}
+func (m *RebalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceResponse.Size(m)
+}
+func (m *RebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceResponse proto.InternalMessageInfo
+
+func (m *RebalanceResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *RebalanceResponse) GetPaymentPreimage() []byte {
+	if m != nil {
+		return m.PaymentPreimage
+	}
+	return nil
+}
+
+func (m *RebalanceResponse) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *RebalanceResponse) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
 type SendToRouteRequest struct {
 	// / The payment hash to use for the HTLC.
 	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -1007,7 +1371,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{15}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{17}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1048,4556 +1412,6644 @@ func (m *SendToRouteRequest) GetRoutes() []*Route {
 	return nil
 }
 
-type ChannelPoint struct {
-	// Types that are valid to be assigned to FundingTxid:
-	//	*ChannelPoint_FundingTxidBytes
-	//	*ChannelPoint_FundingTxidStr
-	FundingTxid isChannelPoint_FundingTxid `protobuf_oneof:"funding_txid"`
-	// / The index of the output of the funding transaction
-	OutputIndex          uint32   `protobuf:"varint,3,opt,name=output_index,proto3" json:"output_index,omitempty"`
+type CircuitKey struct {
+	// / The id of the channel that is part of this circuit.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The index of the htlc within the channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,proto3" json:"htlc_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
-func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
-func (*ChannelPoint) ProtoMessage()    {}
-func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{16}
+func (m *CircuitKey) Reset()         { *m = CircuitKey{} }
+func (m *CircuitKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()    {}
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{18}
 }
-func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
+func (m *CircuitKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitKey.Unmarshal(m, b)
 }
-func (m *ChannelPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelPoint.Marshal(b, m, deterministic)
+func (m *CircuitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitKey.Marshal(b, m, deterministic)
 }
-func (dst *ChannelPoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelPoint.Merge(dst, src)
+func (dst *CircuitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitKey.Merge(dst, src)
 }
-func (m *ChannelPoint) XXX_Size() int {
-	return xxx_messageInfo_ChannelPoint.Size(m)
+func (m *CircuitKey) XXX_Size() int {
+	return xxx_messageInfo_CircuitKey.Size(m)
 }
-func (m *ChannelPoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelPoint.DiscardUnknown(m)
+func (m *CircuitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelPoint proto.InternalMessageInfo
+var xxx_messageInfo_CircuitKey proto.InternalMessageInfo
 
-type isChannelPoint_FundingTxid interface {
-	isChannelPoint_FundingTxid()
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
 
-type ChannelPoint_FundingTxidBytes struct {
-	FundingTxidBytes []byte `protobuf:"bytes,1,opt,name=funding_txid_bytes,proto3,oneof"`
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
 }
 
-type ChannelPoint_FundingTxidStr struct {
-	FundingTxidStr string `protobuf:"bytes,2,opt,name=funding_txid_str,proto3,oneof"`
+type ForwardHtlcInterceptRequest struct {
+	// / The key of the incoming htlc.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,proto3" json:"incoming_circuit_key,omitempty"`
+	// / The amount of the incoming htlc in millisatoshis.
+	IncomingAmountMsat uint64 `protobuf:"varint,2,opt,name=incoming_amount_msat,proto3" json:"incoming_amount_msat,omitempty"`
+	// / The absolute expiry height of the incoming htlc.
+	IncomingExpiry uint32 `protobuf:"varint,3,opt,name=incoming_expiry,proto3" json:"incoming_expiry,omitempty"`
+	// / The payment hash of the htlc.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The short channel id of the channel the htlc is headed for, which may not
+	// exist.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id,proto3" json:"outgoing_requested_chan_id,omitempty"`
+	// / The amount of the outgoing htlc in millisatoshis.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat,proto3" json:"outgoing_amount_msat,omitempty"`
+	// / The absolute expiry height of the outgoing htlc.
+	OutgoingExpiry       uint32   `protobuf:"varint,7,opt,name=outgoing_expiry,proto3" json:"outgoing_expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*ChannelPoint_FundingTxidBytes) isChannelPoint_FundingTxid() {}
+func (m *ForwardHtlcInterceptRequest) Reset()         { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()    {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{19}
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Unmarshal(m, b)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Marshal(b, m, deterministic)
+}
+func (dst *ForwardHtlcInterceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.Merge(dst, src)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Size(m)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.DiscardUnknown(m)
+}
 
-func (*ChannelPoint_FundingTxidStr) isChannelPoint_FundingTxid() {}
+var xxx_messageInfo_ForwardHtlcInterceptRequest proto.InternalMessageInfo
 
-func (m *ChannelPoint) GetFundingTxid() isChannelPoint_FundingTxid {
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
 	if m != nil {
-		return m.FundingTxid
+		return m.IncomingCircuitKey
 	}
 	return nil
 }
 
-func (m *ChannelPoint) GetFundingTxidBytes() []byte {
-	if x, ok := m.GetFundingTxid().(*ChannelPoint_FundingTxidBytes); ok {
-		return x.FundingTxidBytes
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
 	}
 	return nil
 }
 
-func (m *ChannelPoint) GetFundingTxidStr() string {
-	if x, ok := m.GetFundingTxid().(*ChannelPoint_FundingTxidStr); ok {
-		return x.FundingTxidStr
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
 	}
-	return ""
+	return 0
 }
 
-func (m *ChannelPoint) GetOutputIndex() uint32 {
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
 	if m != nil {
-		return m.OutputIndex
+		return m.OutgoingAmountMsat
 	}
 	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*ChannelPoint) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _ChannelPoint_OneofMarshaler, _ChannelPoint_OneofUnmarshaler, _ChannelPoint_OneofSizer, []interface{}{
-		(*ChannelPoint_FundingTxidBytes)(nil),
-		(*ChannelPoint_FundingTxidStr)(nil),
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
+	if m != nil {
+		return m.OutgoingExpiry
 	}
+	return 0
 }
 
-func _ChannelPoint_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*ChannelPoint)
-	// funding_txid
-	switch x := m.FundingTxid.(type) {
-	case *ChannelPoint_FundingTxidBytes:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		b.EncodeRawBytes(x.FundingTxidBytes)
-	case *ChannelPoint_FundingTxidStr:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.FundingTxidStr)
-	case nil:
-	default:
-		return fmt.Errorf("ChannelPoint.FundingTxid has unexpected type %T", x)
-	}
-	return nil
-}
-
-func _ChannelPoint_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*ChannelPoint)
-	switch tag {
-	case 1: // funding_txid.funding_txid_bytes
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeRawBytes(true)
-		m.FundingTxid = &ChannelPoint_FundingTxidBytes{x}
-		return true, err
-	case 2: // funding_txid.funding_txid_str
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.FundingTxid = &ChannelPoint_FundingTxidStr{x}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ChannelPoint_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ChannelPoint)
-	// funding_txid
-	switch x := m.FundingTxid.(type) {
-	case *ChannelPoint_FundingTxidBytes:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.FundingTxidBytes)))
-		n += len(x.FundingTxidBytes)
-	case *ChannelPoint_FundingTxidStr:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.FundingTxidStr)))
-		n += len(x.FundingTxidStr)
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type LightningAddress struct {
-	// / The identity pubkey of the Lightning node
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	// / The network location of the lightning node, e.g. `69.69.69.69:1337` or `localhost:10011`
-	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+type ForwardHtlcInterceptResponse struct {
+	// / The key of the incoming htlc to resolve.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,proto3" json:"incoming_circuit_key,omitempty"`
+	// / How to resolve the htlc.
+	Action ForwardHtlcInterceptResponse_ResolveAction `protobuf:"varint,2,opt,name=action,proto3,enum=lnrpc.ForwardHtlcInterceptResponse_ResolveAction" json:"action,omitempty"`
+	// / The preimage to settle the htlc with, if the action is SETTLE.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The BOLT #4 failure code to fail the htlc back with, if the action is
+	// FAIL. Only failures that don't carry any data beyond what's known to the
+	// switch are supported.
+	FailureCode uint32 `protobuf:"varint,4,opt,name=failure_code,proto3" json:"failure_code,omitempty"`
+	// *
+	// The short channel id of the channel to forward the htlc over instead, if
+	// the action is RESUME_MODIFIED.
+	OutgoingChanId       uint64   `protobuf:"varint,5,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
-func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
-func (*LightningAddress) ProtoMessage()    {}
-func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{17}
+func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()    {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{20}
 }
-func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
+func (m *ForwardHtlcInterceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Unmarshal(m, b)
 }
-func (m *LightningAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightningAddress.Marshal(b, m, deterministic)
+func (m *ForwardHtlcInterceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Marshal(b, m, deterministic)
 }
-func (dst *LightningAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightningAddress.Merge(dst, src)
+func (dst *ForwardHtlcInterceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.Merge(dst, src)
 }
-func (m *LightningAddress) XXX_Size() int {
-	return xxx_messageInfo_LightningAddress.Size(m)
+func (m *ForwardHtlcInterceptResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Size(m)
 }
-func (m *LightningAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightningAddress.DiscardUnknown(m)
+func (m *ForwardHtlcInterceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LightningAddress proto.InternalMessageInfo
+var xxx_messageInfo_ForwardHtlcInterceptResponse proto.InternalMessageInfo
 
-func (m *LightningAddress) GetPubkey() string {
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
 	if m != nil {
-		return m.Pubkey
+		return m.IncomingCircuitKey
 	}
-	return ""
+	return nil
 }
 
-func (m *LightningAddress) GetHost() string {
+func (m *ForwardHtlcInterceptResponse) GetAction() ForwardHtlcInterceptResponse_ResolveAction {
 	if m != nil {
-		return m.Host
+		return m.Action
 	}
-	return ""
+	return ForwardHtlcInterceptResponse_SETTLE
 }
 
-type SendManyRequest struct {
-	// / The map from addresses to amounts
-	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// / The target number of blocks that this transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptResponse) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+type SubscribeHtlcEventsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
-func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
-func (*SendManyRequest) ProtoMessage()    {}
-func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{18}
+func (m *SubscribeHtlcEventsRequest) Reset()         { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()    {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{21}
 }
-func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
+func (m *SubscribeHtlcEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Unmarshal(m, b)
 }
-func (m *SendManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendManyRequest.Marshal(b, m, deterministic)
+func (m *SubscribeHtlcEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Marshal(b, m, deterministic)
 }
-func (dst *SendManyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendManyRequest.Merge(dst, src)
+func (dst *SubscribeHtlcEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.Merge(dst, src)
 }
-func (m *SendManyRequest) XXX_Size() int {
-	return xxx_messageInfo_SendManyRequest.Size(m)
+func (m *SubscribeHtlcEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Size(m)
 }
-func (m *SendManyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendManyRequest.DiscardUnknown(m)
+func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendManyRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
 
-func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
+type HtlcEvent struct {
+	// *
+	// The short channel id of the incoming channel. It is zero for htlcs that
+	// we sent.
+	IncomingChannelId uint64 `protobuf:"varint,1,opt,name=incoming_channel_id,proto3" json:"incoming_channel_id,omitempty"`
+	// *
+	// The short channel id of the outgoing channel. It is zero for htlcs that
+	// we received.
+	OutgoingChannelId uint64 `protobuf:"varint,2,opt,name=outgoing_channel_id,proto3" json:"outgoing_channel_id,omitempty"`
+	// / The index of the htlc within the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id,proto3" json:"incoming_htlc_id,omitempty"`
+	// / The index of the htlc within the outgoing channel.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id,proto3" json:"outgoing_htlc_id,omitempty"`
+	// / The time the event occurred, in nanoseconds since the unix epoch.
+	TimestampNs uint64 `protobuf:"varint,5,opt,name=timestamp_ns,proto3" json:"timestamp_ns,omitempty"`
+	// / Whether the htlc was sent, received or forwarded by the node.
+	EventType HtlcEvent_EventType `protobuf:"varint,6,opt,name=event_type,proto3,enum=lnrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// / The htlc was added to the commitment of its outgoing channel.
+	ForwardEvent *ForwardEvent `protobuf:"bytes,7,opt,name=forward_event,proto3" json:"forward_event,omitempty"`
+	// / The htlc was failed back by the downstream peer.
+	ForwardFailEvent *ForwardFailEvent `protobuf:"bytes,8,opt,name=forward_fail_event,proto3" json:"forward_fail_event,omitempty"`
+	// / The htlc was settled.
+	SettleEvent *SettleEvent `protobuf:"bytes,9,opt,name=settle_event,proto3" json:"settle_event,omitempty"`
+	// / The htlc was failed by one of our links.
+	LinkFailEvent        *LinkFailEvent `protobuf:"bytes,10,opt,name=link_fail_event,proto3" json:"link_fail_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()    {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{22}
+}
+func (m *HtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcEvent.Unmarshal(m, b)
+}
+func (m *HtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcEvent.Marshal(b, m, deterministic)
+}
+func (dst *HtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcEvent.Merge(dst, src)
+}
+func (m *HtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_HtlcEvent.Size(m)
+}
+func (m *HtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcEvent proto.InternalMessageInfo
+
+func (m *HtlcEvent) GetIncomingChannelId() uint64 {
 	if m != nil {
-		return m.AddrToAmount
+		return m.IncomingChannelId
 	}
-	return nil
+	return 0
 }
 
-func (m *SendManyRequest) GetTargetConf() int32 {
+func (m *HtlcEvent) GetOutgoingChannelId() uint64 {
 	if m != nil {
-		return m.TargetConf
+		return m.OutgoingChannelId
 	}
 	return 0
 }
 
-func (m *SendManyRequest) GetSatPerByte() int64 {
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.IncomingHtlcId
 	}
 	return 0
 }
 
-type SendManyResponse struct {
-	// / The id of the transaction
-	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
 }
 
-func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
-func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
-func (*SendManyResponse) ProtoMessage()    {}
-func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{19}
-}
-func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
-}
-func (m *SendManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendManyResponse.Marshal(b, m, deterministic)
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
 }
-func (dst *SendManyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendManyResponse.Merge(dst, src)
+
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return HtlcEvent_UNKNOWN
 }
-func (m *SendManyResponse) XXX_Size() int {
-	return xxx_messageInfo_SendManyResponse.Size(m)
+
+func (m *HtlcEvent) GetForwardEvent() *ForwardEvent {
+	if m != nil {
+		return m.ForwardEvent
+	}
+	return nil
 }
-func (m *SendManyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendManyResponse.DiscardUnknown(m)
+
+func (m *HtlcEvent) GetForwardFailEvent() *ForwardFailEvent {
+	if m != nil {
+		return m.ForwardFailEvent
+	}
+	return nil
 }
 
-var xxx_messageInfo_SendManyResponse proto.InternalMessageInfo
+func (m *HtlcEvent) GetSettleEvent() *SettleEvent {
+	if m != nil {
+		return m.SettleEvent
+	}
+	return nil
+}
 
-func (m *SendManyResponse) GetTxid() string {
+func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
 	if m != nil {
-		return m.Txid
+		return m.LinkFailEvent
 	}
-	return ""
+	return nil
 }
 
-type SendCoinsRequest struct {
-	// / The address to send coins to
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	// / The amount in satoshis to send
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// / The target number of blocks that this transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+type HtlcInfo struct {
+	// / The absolute expiry height of the incoming htlc.
+	IncomingTimelock uint32 `protobuf:"varint,1,opt,name=incoming_timelock,proto3" json:"incoming_timelock,omitempty"`
+	// / The absolute expiry height of the outgoing htlc.
+	OutgoingTimelock uint32 `protobuf:"varint,2,opt,name=outgoing_timelock,proto3" json:"outgoing_timelock,omitempty"`
+	// / The amount of the incoming htlc in millisatoshis.
+	IncomingAmtMsat uint64 `protobuf:"varint,3,opt,name=incoming_amt_msat,proto3" json:"incoming_amt_msat,omitempty"`
+	// / The amount of the outgoing htlc in millisatoshis.
+	OutgoingAmtMsat      uint64   `protobuf:"varint,4,opt,name=outgoing_amt_msat,proto3" json:"outgoing_amt_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
-func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
-func (*SendCoinsRequest) ProtoMessage()    {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{20}
+func (m *HtlcInfo) Reset()         { *m = HtlcInfo{} }
+func (m *HtlcInfo) String() string { return proto.CompactTextString(m) }
+func (*HtlcInfo) ProtoMessage()    {}
+func (*HtlcInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{23}
 }
-func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
+func (m *HtlcInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcInfo.Unmarshal(m, b)
 }
-func (m *SendCoinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendCoinsRequest.Marshal(b, m, deterministic)
+func (m *HtlcInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcInfo.Marshal(b, m, deterministic)
 }
-func (dst *SendCoinsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendCoinsRequest.Merge(dst, src)
+func (dst *HtlcInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcInfo.Merge(dst, src)
 }
-func (m *SendCoinsRequest) XXX_Size() int {
-	return xxx_messageInfo_SendCoinsRequest.Size(m)
+func (m *HtlcInfo) XXX_Size() int {
+	return xxx_messageInfo_HtlcInfo.Size(m)
 }
-func (m *SendCoinsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendCoinsRequest.DiscardUnknown(m)
+func (m *HtlcInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendCoinsRequest proto.InternalMessageInfo
+var xxx_messageInfo_HtlcInfo proto.InternalMessageInfo
 
-func (m *SendCoinsRequest) GetAddr() string {
+func (m *HtlcInfo) GetIncomingTimelock() uint32 {
 	if m != nil {
-		return m.Addr
+		return m.IncomingTimelock
 	}
-	return ""
+	return 0
 }
 
-func (m *SendCoinsRequest) GetAmount() int64 {
+func (m *HtlcInfo) GetOutgoingTimelock() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.OutgoingTimelock
 	}
 	return 0
 }
 
-func (m *SendCoinsRequest) GetTargetConf() int32 {
+func (m *HtlcInfo) GetIncomingAmtMsat() uint64 {
 	if m != nil {
-		return m.TargetConf
+		return m.IncomingAmtMsat
 	}
 	return 0
 }
 
-func (m *SendCoinsRequest) GetSatPerByte() int64 {
+func (m *HtlcInfo) GetOutgoingAmtMsat() uint64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.OutgoingAmtMsat
 	}
 	return 0
 }
 
-type SendCoinsResponse struct {
-	// / The transaction ID of the transaction
-	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ForwardEvent struct {
+	// / The amounts and expiries of the htlc.
+	Info                 *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
-func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
-func (*SendCoinsResponse) ProtoMessage()    {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{21}
+func (m *ForwardEvent) Reset()         { *m = ForwardEvent{} }
+func (m *ForwardEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardEvent) ProtoMessage()    {}
+func (*ForwardEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{24}
 }
-func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
+func (m *ForwardEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardEvent.Unmarshal(m, b)
 }
-func (m *SendCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendCoinsResponse.Marshal(b, m, deterministic)
+func (m *ForwardEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardEvent.Marshal(b, m, deterministic)
 }
-func (dst *SendCoinsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendCoinsResponse.Merge(dst, src)
+func (dst *ForwardEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardEvent.Merge(dst, src)
 }
-func (m *SendCoinsResponse) XXX_Size() int {
-	return xxx_messageInfo_SendCoinsResponse.Size(m)
+func (m *ForwardEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardEvent.Size(m)
 }
-func (m *SendCoinsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendCoinsResponse.DiscardUnknown(m)
+func (m *ForwardEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendCoinsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ForwardEvent proto.InternalMessageInfo
 
-func (m *SendCoinsResponse) GetTxid() string {
+func (m *ForwardEvent) GetInfo() *HtlcInfo {
 	if m != nil {
-		return m.Txid
+		return m.Info
 	}
-	return ""
+	return nil
 }
 
-// *
-// `AddressType` has to be one of:
-//
-// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
-// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
-type NewAddressRequest struct {
-	// / The address type
-	Type                 NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
-}
-
-func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
-func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*NewAddressRequest) ProtoMessage()    {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{22}
-}
-func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
-}
-func (m *NewAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NewAddressRequest.Marshal(b, m, deterministic)
-}
-func (dst *NewAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewAddressRequest.Merge(dst, src)
-}
-func (m *NewAddressRequest) XXX_Size() int {
-	return xxx_messageInfo_NewAddressRequest.Size(m)
-}
-func (m *NewAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewAddressRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_NewAddressRequest proto.InternalMessageInfo
-
-func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
-	if m != nil {
-		return m.Type
-	}
-	return NewAddressRequest_WITNESS_PUBKEY_HASH
-}
-
-type NewAddressResponse struct {
-	// / The newly generated wallet address
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type ForwardFailEvent struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
-func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*NewAddressResponse) ProtoMessage()    {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{23}
+func (m *ForwardFailEvent) Reset()         { *m = ForwardFailEvent{} }
+func (m *ForwardFailEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardFailEvent) ProtoMessage()    {}
+func (*ForwardFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{25}
 }
-func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
+func (m *ForwardFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardFailEvent.Unmarshal(m, b)
 }
-func (m *NewAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NewAddressResponse.Marshal(b, m, deterministic)
+func (m *ForwardFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardFailEvent.Marshal(b, m, deterministic)
 }
-func (dst *NewAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewAddressResponse.Merge(dst, src)
+func (dst *ForwardFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardFailEvent.Merge(dst, src)
 }
-func (m *NewAddressResponse) XXX_Size() int {
-	return xxx_messageInfo_NewAddressResponse.Size(m)
+func (m *ForwardFailEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardFailEvent.Size(m)
 }
-func (m *NewAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewAddressResponse.DiscardUnknown(m)
+func (m *ForwardFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardFailEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewAddressResponse proto.InternalMessageInfo
-
-func (m *NewAddressResponse) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_ForwardFailEvent proto.InternalMessageInfo
 
-type SubSwapClientInitRequest struct {
+type SettleEvent struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapClientInitRequest) Reset()         { *m = SubSwapClientInitRequest{} }
-func (m *SubSwapClientInitRequest) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientInitRequest) ProtoMessage()    {}
-func (*SubSwapClientInitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{24}
+func (m *SettleEvent) Reset()         { *m = SettleEvent{} }
+func (m *SettleEvent) String() string { return proto.CompactTextString(m) }
+func (*SettleEvent) ProtoMessage()    {}
+func (*SettleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{26}
 }
-func (m *SubSwapClientInitRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientInitRequest.Unmarshal(m, b)
+func (m *SettleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleEvent.Unmarshal(m, b)
 }
-func (m *SubSwapClientInitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientInitRequest.Marshal(b, m, deterministic)
+func (m *SettleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleEvent.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapClientInitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientInitRequest.Merge(dst, src)
+func (dst *SettleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleEvent.Merge(dst, src)
 }
-func (m *SubSwapClientInitRequest) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientInitRequest.Size(m)
+func (m *SettleEvent) XXX_Size() int {
+	return xxx_messageInfo_SettleEvent.Size(m)
 }
-func (m *SubSwapClientInitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientInitRequest.DiscardUnknown(m)
+func (m *SettleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapClientInitRequest proto.InternalMessageInfo
+var xxx_messageInfo_SettleEvent proto.InternalMessageInfo
 
-type SubSwapClientInitResponse struct {
-	Preimage             []byte   `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	Pubkey               []byte   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+type LinkFailEvent struct {
+	// / The amounts and expiries of the htlc.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// / The BOLT #4 code of the failure sent back to the sender.
+	FailureCode uint32 `protobuf:"varint,2,opt,name=failure_code,proto3" json:"failure_code,omitempty"`
+	// / A human readable representation of the failure sent back.
+	FailureString string `protobuf:"bytes,3,opt,name=failure_string,proto3" json:"failure_string,omitempty"`
+	// / The local reason of the failure, which may be empty.
+	FailureDetail string `protobuf:"bytes,4,opt,name=failure_detail,proto3" json:"failure_detail,omitempty"`
+	// *
+	// Whether the htlc was failed on its incoming channel, rather than by its
+	// outgoing channel.
+	Incoming             bool     `protobuf:"varint,5,opt,name=incoming,proto3" json:"incoming,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapClientInitResponse) Reset()         { *m = SubSwapClientInitResponse{} }
-func (m *SubSwapClientInitResponse) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientInitResponse) ProtoMessage()    {}
-func (*SubSwapClientInitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{25}
+func (m *LinkFailEvent) Reset()         { *m = LinkFailEvent{} }
+func (m *LinkFailEvent) String() string { return proto.CompactTextString(m) }
+func (*LinkFailEvent) ProtoMessage()    {}
+func (*LinkFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{27}
 }
-func (m *SubSwapClientInitResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientInitResponse.Unmarshal(m, b)
+func (m *LinkFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkFailEvent.Unmarshal(m, b)
 }
-func (m *SubSwapClientInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientInitResponse.Marshal(b, m, deterministic)
+func (m *LinkFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkFailEvent.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapClientInitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientInitResponse.Merge(dst, src)
+func (dst *LinkFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkFailEvent.Merge(dst, src)
 }
-func (m *SubSwapClientInitResponse) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientInitResponse.Size(m)
+func (m *LinkFailEvent) XXX_Size() int {
+	return xxx_messageInfo_LinkFailEvent.Size(m)
 }
-func (m *SubSwapClientInitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientInitResponse.DiscardUnknown(m)
+func (m *LinkFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkFailEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapClientInitResponse proto.InternalMessageInfo
+var xxx_messageInfo_LinkFailEvent proto.InternalMessageInfo
 
-func (m *SubSwapClientInitResponse) GetPreimage() []byte {
+func (m *LinkFailEvent) GetInfo() *HtlcInfo {
 	if m != nil {
-		return m.Preimage
+		return m.Info
 	}
 	return nil
 }
 
-func (m *SubSwapClientInitResponse) GetHash() []byte {
+func (m *LinkFailEvent) GetFailureCode() uint32 {
 	if m != nil {
-		return m.Hash
+		return m.FailureCode
 	}
-	return nil
+	return 0
 }
 
-func (m *SubSwapClientInitResponse) GetKey() []byte {
+func (m *LinkFailEvent) GetFailureString() string {
 	if m != nil {
-		return m.Key
+		return m.FailureString
 	}
-	return nil
+	return ""
 }
 
-func (m *SubSwapClientInitResponse) GetPubkey() []byte {
+func (m *LinkFailEvent) GetFailureDetail() string {
 	if m != nil {
-		return m.Pubkey
+		return m.FailureDetail
 	}
-	return nil
+	return ""
 }
 
-type SubSwapServiceInitRequest struct {
-	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+func (m *LinkFailEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+type ChannelPoint struct {
+	// Types that are valid to be assigned to FundingTxid:
+	//	*ChannelPoint_FundingTxidBytes
+	//	*ChannelPoint_FundingTxidStr
+	FundingTxid isChannelPoint_FundingTxid `protobuf_oneof:"funding_txid"`
+	// / The index of the output of the funding transaction
+	OutputIndex          uint32   `protobuf:"varint,3,opt,name=output_index,proto3" json:"output_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapServiceInitRequest) Reset()         { *m = SubSwapServiceInitRequest{} }
-func (m *SubSwapServiceInitRequest) String() string { return proto.CompactTextString(m) }
-func (*SubSwapServiceInitRequest) ProtoMessage()    {}
-func (*SubSwapServiceInitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{26}
+func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
+func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
+func (*ChannelPoint) ProtoMessage()    {}
+func (*ChannelPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{28}
 }
-func (m *SubSwapServiceInitRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapServiceInitRequest.Unmarshal(m, b)
+func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
 }
-func (m *SubSwapServiceInitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapServiceInitRequest.Marshal(b, m, deterministic)
+func (m *ChannelPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelPoint.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapServiceInitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapServiceInitRequest.Merge(dst, src)
+func (dst *ChannelPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPoint.Merge(dst, src)
 }
-func (m *SubSwapServiceInitRequest) XXX_Size() int {
-	return xxx_messageInfo_SubSwapServiceInitRequest.Size(m)
+func (m *ChannelPoint) XXX_Size() int {
+	return xxx_messageInfo_ChannelPoint.Size(m)
 }
-func (m *SubSwapServiceInitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapServiceInitRequest.DiscardUnknown(m)
+func (m *ChannelPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPoint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapServiceInitRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChannelPoint proto.InternalMessageInfo
 
-func (m *SubSwapServiceInitRequest) GetHash() []byte {
-	if m != nil {
-		return m.Hash
-	}
-	return nil
+type isChannelPoint_FundingTxid interface {
+	isChannelPoint_FundingTxid()
 }
 
-func (m *SubSwapServiceInitRequest) GetPubkey() []byte {
-	if m != nil {
-		return m.Pubkey
-	}
-	return nil
+type ChannelPoint_FundingTxidBytes struct {
+	FundingTxidBytes []byte `protobuf:"bytes,1,opt,name=funding_txid_bytes,proto3,oneof"`
 }
 
-type SubSwapServiceInitResponse struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	LockHeight           int64    `protobuf:"varint,3,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ChannelPoint_FundingTxidStr struct {
+	FundingTxidStr string `protobuf:"bytes,2,opt,name=funding_txid_str,proto3,oneof"`
 }
 
-func (m *SubSwapServiceInitResponse) Reset()         { *m = SubSwapServiceInitResponse{} }
-func (m *SubSwapServiceInitResponse) String() string { return proto.CompactTextString(m) }
-func (*SubSwapServiceInitResponse) ProtoMessage()    {}
-func (*SubSwapServiceInitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{27}
-}
-func (m *SubSwapServiceInitResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapServiceInitResponse.Unmarshal(m, b)
-}
-func (m *SubSwapServiceInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapServiceInitResponse.Marshal(b, m, deterministic)
-}
-func (dst *SubSwapServiceInitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapServiceInitResponse.Merge(dst, src)
-}
-func (m *SubSwapServiceInitResponse) XXX_Size() int {
-	return xxx_messageInfo_SubSwapServiceInitResponse.Size(m)
-}
-func (m *SubSwapServiceInitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapServiceInitResponse.DiscardUnknown(m)
-}
+func (*ChannelPoint_FundingTxidBytes) isChannelPoint_FundingTxid() {}
 
-var xxx_messageInfo_SubSwapServiceInitResponse proto.InternalMessageInfo
+func (*ChannelPoint_FundingTxidStr) isChannelPoint_FundingTxid() {}
 
-func (m *SubSwapServiceInitResponse) GetAddress() string {
+func (m *ChannelPoint) GetFundingTxid() isChannelPoint_FundingTxid {
 	if m != nil {
-		return m.Address
+		return m.FundingTxid
 	}
-	return ""
+	return nil
 }
 
-func (m *SubSwapServiceInitResponse) GetPubkey() []byte {
-	if m != nil {
-		return m.Pubkey
+func (m *ChannelPoint) GetFundingTxidBytes() []byte {
+	if x, ok := m.GetFundingTxid().(*ChannelPoint_FundingTxidBytes); ok {
+		return x.FundingTxidBytes
 	}
 	return nil
 }
 
-func (m *SubSwapServiceInitResponse) GetLockHeight() int64 {
+func (m *ChannelPoint) GetFundingTxidStr() string {
+	if x, ok := m.GetFundingTxid().(*ChannelPoint_FundingTxidStr); ok {
+		return x.FundingTxidStr
+	}
+	return ""
+}
+
+func (m *ChannelPoint) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.LockHeight
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type SubSwapClientWatchRequest struct {
-	Preimage             []byte   `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	ServicePubkey        []byte   `protobuf:"bytes,3,opt,name=service_pubkey,proto3" json:"service_pubkey,omitempty"`
-	LockHeight           int64    `protobuf:"varint,4,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ChannelPoint) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ChannelPoint_OneofMarshaler, _ChannelPoint_OneofUnmarshaler, _ChannelPoint_OneofSizer, []interface{}{
+		(*ChannelPoint_FundingTxidBytes)(nil),
+		(*ChannelPoint_FundingTxidStr)(nil),
+	}
 }
 
-func (m *SubSwapClientWatchRequest) Reset()         { *m = SubSwapClientWatchRequest{} }
-func (m *SubSwapClientWatchRequest) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientWatchRequest) ProtoMessage()    {}
-func (*SubSwapClientWatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{28}
-}
-func (m *SubSwapClientWatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientWatchRequest.Unmarshal(m, b)
-}
-func (m *SubSwapClientWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientWatchRequest.Marshal(b, m, deterministic)
-}
-func (dst *SubSwapClientWatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientWatchRequest.Merge(dst, src)
-}
-func (m *SubSwapClientWatchRequest) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientWatchRequest.Size(m)
-}
-func (m *SubSwapClientWatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientWatchRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SubSwapClientWatchRequest proto.InternalMessageInfo
-
-func (m *SubSwapClientWatchRequest) GetPreimage() []byte {
-	if m != nil {
-		return m.Preimage
-	}
-	return nil
-}
-
-func (m *SubSwapClientWatchRequest) GetKey() []byte {
-	if m != nil {
-		return m.Key
+func _ChannelPoint_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ChannelPoint)
+	// funding_txid
+	switch x := m.FundingTxid.(type) {
+	case *ChannelPoint_FundingTxidBytes:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.FundingTxidBytes)
+	case *ChannelPoint_FundingTxidStr:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.FundingTxidStr)
+	case nil:
+	default:
+		return fmt.Errorf("ChannelPoint.FundingTxid has unexpected type %T", x)
 	}
 	return nil
 }
 
-func (m *SubSwapClientWatchRequest) GetServicePubkey() []byte {
-	if m != nil {
-		return m.ServicePubkey
+func _ChannelPoint_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ChannelPoint)
+	switch tag {
+	case 1: // funding_txid.funding_txid_bytes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.FundingTxid = &ChannelPoint_FundingTxidBytes{x}
+		return true, err
+	case 2: // funding_txid.funding_txid_str
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.FundingTxid = &ChannelPoint_FundingTxidStr{x}
+		return true, err
+	default:
+		return false, nil
 	}
-	return nil
 }
 
-func (m *SubSwapClientWatchRequest) GetLockHeight() int64 {
-	if m != nil {
-		return m.LockHeight
+func _ChannelPoint_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ChannelPoint)
+	// funding_txid
+	switch x := m.FundingTxid.(type) {
+	case *ChannelPoint_FundingTxidBytes:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.FundingTxidBytes)))
+		n += len(x.FundingTxidBytes)
+	case *ChannelPoint_FundingTxidStr:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.FundingTxidStr)))
+		n += len(x.FundingTxidStr)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return 0
+	return n
 }
 
-type SubSwapClientWatchResponse struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Script               []byte   `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+type LightningAddress struct {
+	// / The identity pubkey of the Lightning node
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / The network location of the lightning node, e.g. `69.69.69.69:1337` or `localhost:10011`
+	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapClientWatchResponse) Reset()         { *m = SubSwapClientWatchResponse{} }
-func (m *SubSwapClientWatchResponse) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientWatchResponse) ProtoMessage()    {}
-func (*SubSwapClientWatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{29}
+func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
+func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
+func (*LightningAddress) ProtoMessage()    {}
+func (*LightningAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{29}
 }
-func (m *SubSwapClientWatchResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientWatchResponse.Unmarshal(m, b)
+func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
 }
-func (m *SubSwapClientWatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientWatchResponse.Marshal(b, m, deterministic)
+func (m *LightningAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightningAddress.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapClientWatchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientWatchResponse.Merge(dst, src)
+func (dst *LightningAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightningAddress.Merge(dst, src)
 }
-func (m *SubSwapClientWatchResponse) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientWatchResponse.Size(m)
+func (m *LightningAddress) XXX_Size() int {
+	return xxx_messageInfo_LightningAddress.Size(m)
 }
-func (m *SubSwapClientWatchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientWatchResponse.DiscardUnknown(m)
+func (m *LightningAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightningAddress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapClientWatchResponse proto.InternalMessageInfo
+var xxx_messageInfo_LightningAddress proto.InternalMessageInfo
 
-func (m *SubSwapClientWatchResponse) GetAddress() string {
+func (m *LightningAddress) GetPubkey() string {
 	if m != nil {
-		return m.Address
+		return m.Pubkey
 	}
 	return ""
 }
 
-func (m *SubSwapClientWatchResponse) GetScript() []byte {
+func (m *LightningAddress) GetHost() string {
 	if m != nil {
-		return m.Script
+		return m.Host
 	}
-	return nil
+	return ""
 }
 
-type UnspentAmountRequest struct {
-	// / The address (to be used by a client using swap service)
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// / The hash (to be used by a swapper service)
-	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+type SendManyRequest struct {
+	// / The map from addresses to amounts
+	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// / The target number of blocks that this transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UnspentAmountRequest) Reset()         { *m = UnspentAmountRequest{} }
-func (m *UnspentAmountRequest) String() string { return proto.CompactTextString(m) }
-func (*UnspentAmountRequest) ProtoMessage()    {}
-func (*UnspentAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{30}
+func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
+func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
+func (*SendManyRequest) ProtoMessage()    {}
+func (*SendManyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{30}
 }
-func (m *UnspentAmountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnspentAmountRequest.Unmarshal(m, b)
+func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
 }
-func (m *UnspentAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnspentAmountRequest.Marshal(b, m, deterministic)
+func (m *SendManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendManyRequest.Marshal(b, m, deterministic)
 }
-func (dst *UnspentAmountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnspentAmountRequest.Merge(dst, src)
+func (dst *SendManyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendManyRequest.Merge(dst, src)
 }
-func (m *UnspentAmountRequest) XXX_Size() int {
-	return xxx_messageInfo_UnspentAmountRequest.Size(m)
+func (m *SendManyRequest) XXX_Size() int {
+	return xxx_messageInfo_SendManyRequest.Size(m)
 }
-func (m *UnspentAmountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnspentAmountRequest.DiscardUnknown(m)
+func (m *SendManyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendManyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnspentAmountRequest proto.InternalMessageInfo
+var xxx_messageInfo_SendManyRequest proto.InternalMessageInfo
 
-func (m *UnspentAmountRequest) GetAddress() string {
+func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
-		return m.Address
+		return m.AddrToAmount
 	}
-	return ""
+	return nil
 }
 
-func (m *UnspentAmountRequest) GetHash() []byte {
+func (m *SendManyRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Hash
+		return m.TargetConf
 	}
-	return nil
+	return 0
 }
 
-type UnspentAmountResponse struct {
-	// / The total amount
-	Amount               int64                         `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	LockHeight           int32                         `protobuf:"varint,2,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
-	Utxos                []*UnspentAmountResponse_Utxo `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *SendManyRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
 }
 
-func (m *UnspentAmountResponse) Reset()         { *m = UnspentAmountResponse{} }
-func (m *UnspentAmountResponse) String() string { return proto.CompactTextString(m) }
-func (*UnspentAmountResponse) ProtoMessage()    {}
-func (*UnspentAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{31}
+type SendManyResponse struct {
+	// / The id of the transaction
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *UnspentAmountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnspentAmountResponse.Unmarshal(m, b)
+
+func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
+func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
+func (*SendManyResponse) ProtoMessage()    {}
+func (*SendManyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{31}
 }
-func (m *UnspentAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnspentAmountResponse.Marshal(b, m, deterministic)
+func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
 }
-func (dst *UnspentAmountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnspentAmountResponse.Merge(dst, src)
+func (m *SendManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendManyResponse.Marshal(b, m, deterministic)
 }
-func (m *UnspentAmountResponse) XXX_Size() int {
-	return xxx_messageInfo_UnspentAmountResponse.Size(m)
+func (dst *SendManyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendManyResponse.Merge(dst, src)
 }
-func (m *UnspentAmountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnspentAmountResponse.DiscardUnknown(m)
+func (m *SendManyResponse) XXX_Size() int {
+	return xxx_messageInfo_SendManyResponse.Size(m)
+}
+func (m *SendManyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendManyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnspentAmountResponse proto.InternalMessageInfo
+var xxx_messageInfo_SendManyResponse proto.InternalMessageInfo
 
-func (m *UnspentAmountResponse) GetAmount() int64 {
+func (m *SendManyResponse) GetTxid() string {
 	if m != nil {
-		return m.Amount
+		return m.Txid
 	}
-	return 0
+	return ""
 }
 
-func (m *UnspentAmountResponse) GetLockHeight() int32 {
-	if m != nil {
-		return m.LockHeight
-	}
-	return 0
+type SendCoinsRequest struct {
+	// / The address to send coins to
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// / The amount in satoshis to send
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The target number of blocks that this transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UnspentAmountResponse) GetUtxos() []*UnspentAmountResponse_Utxo {
-	if m != nil {
-		return m.Utxos
-	}
-	return nil
-}
-
-type UnspentAmountResponse_Utxo struct {
-	BlockHeight          int32    `protobuf:"varint,1,opt,name=block_height,proto3" json:"block_height,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Txid                 string   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
-	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UnspentAmountResponse_Utxo) Reset()         { *m = UnspentAmountResponse_Utxo{} }
-func (m *UnspentAmountResponse_Utxo) String() string { return proto.CompactTextString(m) }
-func (*UnspentAmountResponse_Utxo) ProtoMessage()    {}
-func (*UnspentAmountResponse_Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{31, 0}
+func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
+func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
+func (*SendCoinsRequest) ProtoMessage()    {}
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{32}
 }
-func (m *UnspentAmountResponse_Utxo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnspentAmountResponse_Utxo.Unmarshal(m, b)
+func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
 }
-func (m *UnspentAmountResponse_Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnspentAmountResponse_Utxo.Marshal(b, m, deterministic)
+func (m *SendCoinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCoinsRequest.Marshal(b, m, deterministic)
 }
-func (dst *UnspentAmountResponse_Utxo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnspentAmountResponse_Utxo.Merge(dst, src)
+func (dst *SendCoinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCoinsRequest.Merge(dst, src)
 }
-func (m *UnspentAmountResponse_Utxo) XXX_Size() int {
-	return xxx_messageInfo_UnspentAmountResponse_Utxo.Size(m)
+func (m *SendCoinsRequest) XXX_Size() int {
+	return xxx_messageInfo_SendCoinsRequest.Size(m)
 }
-func (m *UnspentAmountResponse_Utxo) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnspentAmountResponse_Utxo.DiscardUnknown(m)
+func (m *SendCoinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCoinsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnspentAmountResponse_Utxo proto.InternalMessageInfo
+var xxx_messageInfo_SendCoinsRequest proto.InternalMessageInfo
 
-func (m *UnspentAmountResponse_Utxo) GetBlockHeight() int32 {
+func (m *SendCoinsRequest) GetAddr() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *UnspentAmountResponse_Utxo) GetAmount() int64 {
+func (m *SendCoinsRequest) GetAmount() int64 {
 	if m != nil {
 		return m.Amount
 	}
 	return 0
 }
 
-func (m *UnspentAmountResponse_Utxo) GetTxid() string {
+func (m *SendCoinsRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.Txid
+		return m.TargetConf
 	}
-	return ""
+	return 0
 }
 
-func (m *UnspentAmountResponse_Utxo) GetIndex() uint32 {
+func (m *SendCoinsRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.Index
+		return m.SatPerByte
 	}
 	return 0
 }
 
-type SubSwapServiceRedeemRequest struct {
-	Preimage []byte `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte           int64    `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+type SendCoinsResponse struct {
+	// / The transaction ID of the transaction
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapServiceRedeemRequest) Reset()         { *m = SubSwapServiceRedeemRequest{} }
-func (m *SubSwapServiceRedeemRequest) String() string { return proto.CompactTextString(m) }
-func (*SubSwapServiceRedeemRequest) ProtoMessage()    {}
-func (*SubSwapServiceRedeemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{32}
-}
-func (m *SubSwapServiceRedeemRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapServiceRedeemRequest.Unmarshal(m, b)
+func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
+func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*SendCoinsResponse) ProtoMessage()    {}
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{33}
 }
-func (m *SubSwapServiceRedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapServiceRedeemRequest.Marshal(b, m, deterministic)
+func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
 }
-func (dst *SubSwapServiceRedeemRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapServiceRedeemRequest.Merge(dst, src)
+func (m *SendCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCoinsResponse.Marshal(b, m, deterministic)
 }
-func (m *SubSwapServiceRedeemRequest) XXX_Size() int {
-	return xxx_messageInfo_SubSwapServiceRedeemRequest.Size(m)
+func (dst *SendCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCoinsResponse.Merge(dst, src)
 }
-func (m *SubSwapServiceRedeemRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapServiceRedeemRequest.DiscardUnknown(m)
+func (m *SendCoinsResponse) XXX_Size() int {
+	return xxx_messageInfo_SendCoinsResponse.Size(m)
 }
-
-var xxx_messageInfo_SubSwapServiceRedeemRequest proto.InternalMessageInfo
-
-func (m *SubSwapServiceRedeemRequest) GetPreimage() []byte {
-	if m != nil {
-		return m.Preimage
-	}
-	return nil
+func (m *SendCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCoinsResponse.DiscardUnknown(m)
 }
 
-func (m *SubSwapServiceRedeemRequest) GetTargetConf() int32 {
-	if m != nil {
-		return m.TargetConf
-	}
-	return 0
-}
+var xxx_messageInfo_SendCoinsResponse proto.InternalMessageInfo
 
-func (m *SubSwapServiceRedeemRequest) GetSatPerByte() int64 {
+func (m *SendCoinsResponse) GetTxid() string {
 	if m != nil {
-		return m.SatPerByte
+		return m.Txid
 	}
-	return 0
+	return ""
 }
 
-type SubSwapServiceRedeemResponse struct {
-	// / The txid of the transaction.
-	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// *
+// `AddressType` has to be one of:
+//
+// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
+// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
+type NewAddressRequest struct {
+	// / The address type
+	Type                 NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *SubSwapServiceRedeemResponse) Reset()         { *m = SubSwapServiceRedeemResponse{} }
-func (m *SubSwapServiceRedeemResponse) String() string { return proto.CompactTextString(m) }
-func (*SubSwapServiceRedeemResponse) ProtoMessage()    {}
-func (*SubSwapServiceRedeemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{33}
+func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
+func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*NewAddressRequest) ProtoMessage()    {}
+func (*NewAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{34}
 }
-func (m *SubSwapServiceRedeemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapServiceRedeemResponse.Unmarshal(m, b)
+func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
 }
-func (m *SubSwapServiceRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapServiceRedeemResponse.Marshal(b, m, deterministic)
+func (m *NewAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewAddressRequest.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapServiceRedeemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapServiceRedeemResponse.Merge(dst, src)
+func (dst *NewAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAddressRequest.Merge(dst, src)
 }
-func (m *SubSwapServiceRedeemResponse) XXX_Size() int {
-	return xxx_messageInfo_SubSwapServiceRedeemResponse.Size(m)
+func (m *NewAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_NewAddressRequest.Size(m)
 }
-func (m *SubSwapServiceRedeemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapServiceRedeemResponse.DiscardUnknown(m)
+func (m *NewAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapServiceRedeemResponse proto.InternalMessageInfo
+var xxx_messageInfo_NewAddressRequest proto.InternalMessageInfo
 
-func (m *SubSwapServiceRedeemResponse) GetTxid() string {
+func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
 	if m != nil {
-		return m.Txid
+		return m.Type
 	}
-	return ""
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
 }
 
-type SubSwapClientRefundRequest struct {
-	// / The address used for the swap.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// / The address to receive the refund.
-	RefundAddress string `protobuf:"bytes,2,opt,name=refundAddress,json=refund_address,proto3" json:"refundAddress,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+type NewAddressResponse struct {
+	// / The newly generated wallet address
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapClientRefundRequest) Reset()         { *m = SubSwapClientRefundRequest{} }
-func (m *SubSwapClientRefundRequest) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientRefundRequest) ProtoMessage()    {}
-func (*SubSwapClientRefundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{34}
+func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
+func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*NewAddressResponse) ProtoMessage()    {}
+func (*NewAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{35}
 }
-func (m *SubSwapClientRefundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientRefundRequest.Unmarshal(m, b)
+func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
 }
-func (m *SubSwapClientRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientRefundRequest.Marshal(b, m, deterministic)
+func (m *NewAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewAddressResponse.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapClientRefundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientRefundRequest.Merge(dst, src)
+func (dst *NewAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAddressResponse.Merge(dst, src)
 }
-func (m *SubSwapClientRefundRequest) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientRefundRequest.Size(m)
+func (m *NewAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_NewAddressResponse.Size(m)
 }
-func (m *SubSwapClientRefundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientRefundRequest.DiscardUnknown(m)
+func (m *NewAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapClientRefundRequest proto.InternalMessageInfo
+var xxx_messageInfo_NewAddressResponse proto.InternalMessageInfo
 
-func (m *SubSwapClientRefundRequest) GetAddress() string {
+func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *SubSwapClientRefundRequest) GetRefundAddress() string {
-	if m != nil {
-		return m.RefundAddress
-	}
-	return ""
-}
-
-func (m *SubSwapClientRefundRequest) GetTargetConf() int32 {
-	if m != nil {
-		return m.TargetConf
-	}
-	return 0
-}
-
-func (m *SubSwapClientRefundRequest) GetSatPerByte() int64 {
-	if m != nil {
-		return m.SatPerByte
-	}
-	return 0
-}
-
-type SubSwapClientRefundResponse struct {
-	// / The txid of the transaction.
-	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+type SubSwapClientInitRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubSwapClientRefundResponse) Reset()         { *m = SubSwapClientRefundResponse{} }
-func (m *SubSwapClientRefundResponse) String() string { return proto.CompactTextString(m) }
-func (*SubSwapClientRefundResponse) ProtoMessage()    {}
-func (*SubSwapClientRefundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{35}
+func (m *SubSwapClientInitRequest) Reset()         { *m = SubSwapClientInitRequest{} }
+func (m *SubSwapClientInitRequest) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientInitRequest) ProtoMessage()    {}
+func (*SubSwapClientInitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{36}
 }
-func (m *SubSwapClientRefundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubSwapClientRefundResponse.Unmarshal(m, b)
+func (m *SubSwapClientInitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientInitRequest.Unmarshal(m, b)
 }
-func (m *SubSwapClientRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubSwapClientRefundResponse.Marshal(b, m, deterministic)
+func (m *SubSwapClientInitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientInitRequest.Marshal(b, m, deterministic)
 }
-func (dst *SubSwapClientRefundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubSwapClientRefundResponse.Merge(dst, src)
+func (dst *SubSwapClientInitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientInitRequest.Merge(dst, src)
 }
-func (m *SubSwapClientRefundResponse) XXX_Size() int {
-	return xxx_messageInfo_SubSwapClientRefundResponse.Size(m)
+func (m *SubSwapClientInitRequest) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientInitRequest.Size(m)
 }
-func (m *SubSwapClientRefundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubSwapClientRefundResponse.DiscardUnknown(m)
+func (m *SubSwapClientInitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientInitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubSwapClientRefundResponse proto.InternalMessageInfo
-
-func (m *SubSwapClientRefundResponse) GetTxid() string {
-	if m != nil {
-		return m.Txid
-	}
-	return ""
-}
+var xxx_messageInfo_SubSwapClientInitRequest proto.InternalMessageInfo
 
-type SignMessageRequest struct {
-	// / The message to be signed
-	Msg                  []byte   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+type SubSwapClientInitResponse struct {
+	Preimage             []byte   `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
-func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
-func (*SignMessageRequest) ProtoMessage()    {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{36}
+func (m *SubSwapClientInitResponse) Reset()         { *m = SubSwapClientInitResponse{} }
+func (m *SubSwapClientInitResponse) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientInitResponse) ProtoMessage()    {}
+func (*SubSwapClientInitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{37}
 }
-func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
+func (m *SubSwapClientInitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientInitResponse.Unmarshal(m, b)
 }
-func (m *SignMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignMessageRequest.Marshal(b, m, deterministic)
+func (m *SubSwapClientInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientInitResponse.Marshal(b, m, deterministic)
 }
-func (dst *SignMessageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignMessageRequest.Merge(dst, src)
+func (dst *SubSwapClientInitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientInitResponse.Merge(dst, src)
 }
-func (m *SignMessageRequest) XXX_Size() int {
-	return xxx_messageInfo_SignMessageRequest.Size(m)
+func (m *SubSwapClientInitResponse) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientInitResponse.Size(m)
 }
-func (m *SignMessageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignMessageRequest.DiscardUnknown(m)
+func (m *SubSwapClientInitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientInitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignMessageRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubSwapClientInitResponse proto.InternalMessageInfo
 
-func (m *SignMessageRequest) GetMsg() []byte {
+func (m *SubSwapClientInitResponse) GetPreimage() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Preimage
 	}
 	return nil
 }
 
-type SignMessageResponse struct {
-	// / The signature for the given message
-	Signature            string   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SubSwapClientInitResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
 }
 
-func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
-func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
-func (*SignMessageResponse) ProtoMessage()    {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{37}
-}
-func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
-}
-func (m *SignMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignMessageResponse.Marshal(b, m, deterministic)
-}
-func (dst *SignMessageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignMessageResponse.Merge(dst, src)
-}
-func (m *SignMessageResponse) XXX_Size() int {
-	return xxx_messageInfo_SignMessageResponse.Size(m)
-}
-func (m *SignMessageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignMessageResponse.DiscardUnknown(m)
+func (m *SubSwapClientInitResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-var xxx_messageInfo_SignMessageResponse proto.InternalMessageInfo
-
-func (m *SignMessageResponse) GetSignature() string {
+func (m *SubSwapClientInitResponse) GetPubkey() []byte {
 	if m != nil {
-		return m.Signature
+		return m.Pubkey
 	}
-	return ""
+	return nil
 }
 
-type VerifyMessageRequest struct {
-	// / The message over which the signature is to be verified
-	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// / The signature to be verified over the given message
-	Signature            string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+type SubSwapServiceInitRequest struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
-func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
-func (*VerifyMessageRequest) ProtoMessage()    {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{38}
+func (m *SubSwapServiceInitRequest) Reset()         { *m = SubSwapServiceInitRequest{} }
+func (m *SubSwapServiceInitRequest) String() string { return proto.CompactTextString(m) }
+func (*SubSwapServiceInitRequest) ProtoMessage()    {}
+func (*SubSwapServiceInitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{38}
 }
-func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
+func (m *SubSwapServiceInitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapServiceInitRequest.Unmarshal(m, b)
 }
-func (m *VerifyMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyMessageRequest.Marshal(b, m, deterministic)
+func (m *SubSwapServiceInitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapServiceInitRequest.Marshal(b, m, deterministic)
 }
-func (dst *VerifyMessageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyMessageRequest.Merge(dst, src)
+func (dst *SubSwapServiceInitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapServiceInitRequest.Merge(dst, src)
 }
-func (m *VerifyMessageRequest) XXX_Size() int {
-	return xxx_messageInfo_VerifyMessageRequest.Size(m)
+func (m *SubSwapServiceInitRequest) XXX_Size() int {
+	return xxx_messageInfo_SubSwapServiceInitRequest.Size(m)
 }
-func (m *VerifyMessageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyMessageRequest.DiscardUnknown(m)
+func (m *SubSwapServiceInitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapServiceInitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyMessageRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubSwapServiceInitRequest proto.InternalMessageInfo
 
-func (m *VerifyMessageRequest) GetMsg() []byte {
+func (m *SubSwapServiceInitRequest) GetHash() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Hash
 	}
 	return nil
 }
 
-func (m *VerifyMessageRequest) GetSignature() string {
+func (m *SubSwapServiceInitRequest) GetPubkey() []byte {
 	if m != nil {
-		return m.Signature
+		return m.Pubkey
 	}
-	return ""
+	return nil
 }
 
-type VerifyMessageResponse struct {
-	// / Whether the signature was valid over the given message
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	// / The pubkey recovered from the signature
-	Pubkey               string   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+type SubSwapServiceInitResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	LockHeight           int64    `protobuf:"varint,3,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
-func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
-func (*VerifyMessageResponse) ProtoMessage()    {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{39}
+func (m *SubSwapServiceInitResponse) Reset()         { *m = SubSwapServiceInitResponse{} }
+func (m *SubSwapServiceInitResponse) String() string { return proto.CompactTextString(m) }
+func (*SubSwapServiceInitResponse) ProtoMessage()    {}
+func (*SubSwapServiceInitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{39}
 }
-func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
+func (m *SubSwapServiceInitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapServiceInitResponse.Unmarshal(m, b)
 }
-func (m *VerifyMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifyMessageResponse.Marshal(b, m, deterministic)
+func (m *SubSwapServiceInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapServiceInitResponse.Marshal(b, m, deterministic)
 }
-func (dst *VerifyMessageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifyMessageResponse.Merge(dst, src)
+func (dst *SubSwapServiceInitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapServiceInitResponse.Merge(dst, src)
 }
-func (m *VerifyMessageResponse) XXX_Size() int {
-	return xxx_messageInfo_VerifyMessageResponse.Size(m)
+func (m *SubSwapServiceInitResponse) XXX_Size() int {
+	return xxx_messageInfo_SubSwapServiceInitResponse.Size(m)
 }
-func (m *VerifyMessageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VerifyMessageResponse.DiscardUnknown(m)
+func (m *SubSwapServiceInitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapServiceInitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VerifyMessageResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubSwapServiceInitResponse proto.InternalMessageInfo
 
-func (m *VerifyMessageResponse) GetValid() bool {
+func (m *SubSwapServiceInitResponse) GetAddress() string {
 	if m != nil {
-		return m.Valid
+		return m.Address
 	}
-	return false
+	return ""
 }
 
-func (m *VerifyMessageResponse) GetPubkey() string {
+func (m *SubSwapServiceInitResponse) GetPubkey() []byte {
 	if m != nil {
 		return m.Pubkey
 	}
-	return ""
+	return nil
 }
 
-type ConnectPeerRequest struct {
-	// / Lightning address of the peer, in the format `<pubkey>@host`
-	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	// * If set, the daemon will attempt to persistently connect to the target
-	// peer.  Otherwise, the call will be synchronous.
-	Perm                 bool     `protobuf:"varint,2,opt,name=perm,proto3" json:"perm,omitempty"`
+func (m *SubSwapServiceInitResponse) GetLockHeight() int64 {
+	if m != nil {
+		return m.LockHeight
+	}
+	return 0
+}
+
+type SubSwapClientWatchRequest struct {
+	Preimage             []byte   `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ServicePubkey        []byte   `protobuf:"bytes,3,opt,name=service_pubkey,proto3" json:"service_pubkey,omitempty"`
+	LockHeight           int64    `protobuf:"varint,4,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
-func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*ConnectPeerRequest) ProtoMessage()    {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{40}
+func (m *SubSwapClientWatchRequest) Reset()         { *m = SubSwapClientWatchRequest{} }
+func (m *SubSwapClientWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientWatchRequest) ProtoMessage()    {}
+func (*SubSwapClientWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{40}
 }
-func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
+func (m *SubSwapClientWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientWatchRequest.Unmarshal(m, b)
 }
-func (m *ConnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConnectPeerRequest.Marshal(b, m, deterministic)
+func (m *SubSwapClientWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientWatchRequest.Marshal(b, m, deterministic)
 }
-func (dst *ConnectPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectPeerRequest.Merge(dst, src)
+func (dst *SubSwapClientWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientWatchRequest.Merge(dst, src)
 }
-func (m *ConnectPeerRequest) XXX_Size() int {
-	return xxx_messageInfo_ConnectPeerRequest.Size(m)
+func (m *SubSwapClientWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientWatchRequest.Size(m)
 }
-func (m *ConnectPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectPeerRequest.DiscardUnknown(m)
+func (m *SubSwapClientWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientWatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectPeerRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubSwapClientWatchRequest proto.InternalMessageInfo
 
-func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
+func (m *SubSwapClientWatchRequest) GetPreimage() []byte {
 	if m != nil {
-		return m.Addr
+		return m.Preimage
 	}
 	return nil
 }
 
-func (m *ConnectPeerRequest) GetPerm() bool {
+func (m *SubSwapClientWatchRequest) GetKey() []byte {
 	if m != nil {
-		return m.Perm
+		return m.Key
 	}
-	return false
+	return nil
 }
 
-type ConnectPeerResponse struct {
+func (m *SubSwapClientWatchRequest) GetServicePubkey() []byte {
+	if m != nil {
+		return m.ServicePubkey
+	}
+	return nil
+}
+
+func (m *SubSwapClientWatchRequest) GetLockHeight() int64 {
+	if m != nil {
+		return m.LockHeight
+	}
+	return 0
+}
+
+type SubSwapClientWatchResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Script               []byte   `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
-func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*ConnectPeerResponse) ProtoMessage()    {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{41}
+func (m *SubSwapClientWatchResponse) Reset()         { *m = SubSwapClientWatchResponse{} }
+func (m *SubSwapClientWatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientWatchResponse) ProtoMessage()    {}
+func (*SubSwapClientWatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{41}
 }
-func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
+func (m *SubSwapClientWatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientWatchResponse.Unmarshal(m, b)
 }
-func (m *ConnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConnectPeerResponse.Marshal(b, m, deterministic)
+func (m *SubSwapClientWatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientWatchResponse.Marshal(b, m, deterministic)
 }
-func (dst *ConnectPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConnectPeerResponse.Merge(dst, src)
+func (dst *SubSwapClientWatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientWatchResponse.Merge(dst, src)
 }
-func (m *ConnectPeerResponse) XXX_Size() int {
-	return xxx_messageInfo_ConnectPeerResponse.Size(m)
+func (m *SubSwapClientWatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientWatchResponse.Size(m)
 }
-func (m *ConnectPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConnectPeerResponse.DiscardUnknown(m)
+func (m *SubSwapClientWatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientWatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConnectPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubSwapClientWatchResponse proto.InternalMessageInfo
 
-type DisconnectPeerRequest struct {
-	// / The pubkey of the node to disconnect from
-	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+func (m *SubSwapClientWatchResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SubSwapClientWatchResponse) GetScript() []byte {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type UnspentAmountRequest struct {
+	// / The address (to be used by a client using swap service)
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// / The hash (to be used by a swapper service)
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
-func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
-func (*DisconnectPeerRequest) ProtoMessage()    {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{42}
+func (m *UnspentAmountRequest) Reset()         { *m = UnspentAmountRequest{} }
+func (m *UnspentAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*UnspentAmountRequest) ProtoMessage()    {}
+func (*UnspentAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{42}
 }
-func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
+func (m *UnspentAmountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnspentAmountRequest.Unmarshal(m, b)
 }
-func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+func (m *UnspentAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnspentAmountRequest.Marshal(b, m, deterministic)
 }
-func (dst *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectPeerRequest.Merge(dst, src)
+func (dst *UnspentAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnspentAmountRequest.Merge(dst, src)
 }
-func (m *DisconnectPeerRequest) XXX_Size() int {
-	return xxx_messageInfo_DisconnectPeerRequest.Size(m)
+func (m *UnspentAmountRequest) XXX_Size() int {
+	return xxx_messageInfo_UnspentAmountRequest.Size(m)
 }
-func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
+func (m *UnspentAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnspentAmountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnspentAmountRequest proto.InternalMessageInfo
 
-func (m *DisconnectPeerRequest) GetPubKey() string {
+func (m *UnspentAmountRequest) GetAddress() string {
 	if m != nil {
-		return m.PubKey
+		return m.Address
 	}
 	return ""
 }
 
-type DisconnectPeerResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UnspentAmountRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
 }
 
-func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{} }
-func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*DisconnectPeerResponse) ProtoMessage()    {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{43}
+type UnspentAmountResponse struct {
+	// / The total amount
+	Amount               int64                         `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	LockHeight           int32                         `protobuf:"varint,2,opt,name=lock_height,proto3" json:"lock_height,omitempty"`
+	Utxos                []*UnspentAmountResponse_Utxo `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
-func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
+
+func (m *UnspentAmountResponse) Reset()         { *m = UnspentAmountResponse{} }
+func (m *UnspentAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*UnspentAmountResponse) ProtoMessage()    {}
+func (*UnspentAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{43}
 }
-func (m *DisconnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DisconnectPeerResponse.Marshal(b, m, deterministic)
+func (m *UnspentAmountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnspentAmountResponse.Unmarshal(m, b)
 }
-func (dst *DisconnectPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DisconnectPeerResponse.Merge(dst, src)
+func (m *UnspentAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnspentAmountResponse.Marshal(b, m, deterministic)
 }
-func (m *DisconnectPeerResponse) XXX_Size() int {
-	return xxx_messageInfo_DisconnectPeerResponse.Size(m)
+func (dst *UnspentAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnspentAmountResponse.Merge(dst, src)
 }
-func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DisconnectPeerResponse.DiscardUnknown(m)
+func (m *UnspentAmountResponse) XXX_Size() int {
+	return xxx_messageInfo_UnspentAmountResponse.Size(m)
+}
+func (m *UnspentAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnspentAmountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnspentAmountResponse proto.InternalMessageInfo
 
-type HTLC struct {
-	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+func (m *UnspentAmountResponse) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *UnspentAmountResponse) GetLockHeight() int32 {
+	if m != nil {
+		return m.LockHeight
+	}
+	return 0
+}
+
+func (m *UnspentAmountResponse) GetUtxos() []*UnspentAmountResponse_Utxo {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+type UnspentAmountResponse_Utxo struct {
+	BlockHeight          int32    `protobuf:"varint,1,opt,name=block_height,proto3" json:"block_height,omitempty"`
 	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
-	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+	Txid                 string   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HTLC) Reset()         { *m = HTLC{} }
-func (m *HTLC) String() string { return proto.CompactTextString(m) }
-func (*HTLC) ProtoMessage()    {}
-func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{44}
+func (m *UnspentAmountResponse_Utxo) Reset()         { *m = UnspentAmountResponse_Utxo{} }
+func (m *UnspentAmountResponse_Utxo) String() string { return proto.CompactTextString(m) }
+func (*UnspentAmountResponse_Utxo) ProtoMessage()    {}
+func (*UnspentAmountResponse_Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{43, 0}
 }
-func (m *HTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+func (m *UnspentAmountResponse_Utxo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnspentAmountResponse_Utxo.Unmarshal(m, b)
 }
-func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+func (m *UnspentAmountResponse_Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnspentAmountResponse_Utxo.Marshal(b, m, deterministic)
 }
-func (dst *HTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HTLC.Merge(dst, src)
+func (dst *UnspentAmountResponse_Utxo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnspentAmountResponse_Utxo.Merge(dst, src)
 }
-func (m *HTLC) XXX_Size() int {
-	return xxx_messageInfo_HTLC.Size(m)
+func (m *UnspentAmountResponse_Utxo) XXX_Size() int {
+	return xxx_messageInfo_UnspentAmountResponse_Utxo.Size(m)
 }
-func (m *HTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_HTLC.DiscardUnknown(m)
+func (m *UnspentAmountResponse_Utxo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnspentAmountResponse_Utxo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HTLC proto.InternalMessageInfo
+var xxx_messageInfo_UnspentAmountResponse_Utxo proto.InternalMessageInfo
 
-func (m *HTLC) GetIncoming() bool {
+func (m *UnspentAmountResponse_Utxo) GetBlockHeight() int32 {
 	if m != nil {
-		return m.Incoming
+		return m.BlockHeight
 	}
-	return false
+	return 0
 }
 
-func (m *HTLC) GetAmount() int64 {
+func (m *UnspentAmountResponse_Utxo) GetAmount() int64 {
 	if m != nil {
 		return m.Amount
 	}
 	return 0
 }
 
-func (m *HTLC) GetHashLock() []byte {
+func (m *UnspentAmountResponse_Utxo) GetTxid() string {
 	if m != nil {
-		return m.HashLock
+		return m.Txid
 	}
-	return nil
+	return ""
 }
 
-func (m *HTLC) GetExpirationHeight() uint32 {
+func (m *UnspentAmountResponse_Utxo) GetIndex() uint32 {
 	if m != nil {
-		return m.ExpirationHeight
+		return m.Index
 	}
 	return 0
 }
 
-type Channel struct {
-	// / Whether this channel is active or not
-	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	// / The identity pubkey of the remote node
-	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	// / The total amount of funds held in this channel
-	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	// / This node's current balance in this channel
-	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	// / The counterparty's current balance in this channel
-	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of commitment
-	// transactions. The fee amount is persisted with the channel in order to
-	// allow the fee amount to be removed and recalculated with each channel state
-	// update, including updates that happen after a system restart.
-	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will pay
-	// at all times, for both the funding transaction and commitment transaction.
-	// This value can later be updated once the channel is open.
-	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
-	// / The unsettled balance in this channel
-	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance,proto3" json:"unsettled_balance,omitempty"`
-	// *
-	// The total number of satoshis we've sent within this channel.
-	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent,proto3" json:"total_satoshis_sent,omitempty"`
-	// *
-	// The total number of satoshis we've received within this channel.
-	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received,proto3" json:"total_satoshis_received,omitempty"`
-	// *
-	// The total number of updates conducted within this channel.
-	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates,proto3" json:"num_updates,omitempty"`
-	// *
-	// The list of active, uncleared HTLCs currently pending within the channel.
-	PendingHtlcs []*HTLC `protobuf:"bytes,15,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	// *
-	// The CSV delay expressed in relative blocks. If the channel is force
-	// closed, we'll need to wait for this many blocks before we can regain our
-	// funds.
-	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
-	// / Whether this channel is advertised to the network or not
-	Private bool `protobuf:"varint,17,opt,name=private,proto3" json:"private,omitempty"`
-	// / This minimum satoshies this node is required to reseve in
-	// / its balance
-	LocalChanReserve int64 `protobuf:"varint,18,opt,name=local_chan_reserve,proto3" json:"local_chan_reserve,omitempty"`
-	// / This minimum satoshies the other node is required to reseve in
-	// / its balance
-	RemoteChanReserve    int64    `protobuf:"varint,19,opt,name=remote_chan_reserve,proto3" json:"remote_chan_reserve,omitempty"`
+type SubSwapServiceRedeemRequest struct {
+	Preimage []byte `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte           int64    `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Channel) Reset()         { *m = Channel{} }
-func (m *Channel) String() string { return proto.CompactTextString(m) }
-func (*Channel) ProtoMessage()    {}
-func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{45}
-}
-func (m *Channel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Channel.Unmarshal(m, b)
+func (m *SubSwapServiceRedeemRequest) Reset()         { *m = SubSwapServiceRedeemRequest{} }
+func (m *SubSwapServiceRedeemRequest) String() string { return proto.CompactTextString(m) }
+func (*SubSwapServiceRedeemRequest) ProtoMessage()    {}
+func (*SubSwapServiceRedeemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{44}
 }
-func (m *Channel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Channel.Marshal(b, m, deterministic)
+func (m *SubSwapServiceRedeemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapServiceRedeemRequest.Unmarshal(m, b)
 }
-func (dst *Channel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Channel.Merge(dst, src)
+func (m *SubSwapServiceRedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapServiceRedeemRequest.Marshal(b, m, deterministic)
 }
-func (m *Channel) XXX_Size() int {
-	return xxx_messageInfo_Channel.Size(m)
+func (dst *SubSwapServiceRedeemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapServiceRedeemRequest.Merge(dst, src)
 }
-func (m *Channel) XXX_DiscardUnknown() {
-	xxx_messageInfo_Channel.DiscardUnknown(m)
+func (m *SubSwapServiceRedeemRequest) XXX_Size() int {
+	return xxx_messageInfo_SubSwapServiceRedeemRequest.Size(m)
 }
-
-var xxx_messageInfo_Channel proto.InternalMessageInfo
-
-func (m *Channel) GetActive() bool {
-	if m != nil {
-		return m.Active
-	}
-	return false
+func (m *SubSwapServiceRedeemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapServiceRedeemRequest.DiscardUnknown(m)
 }
 
-func (m *Channel) GetRemotePubkey() string {
-	if m != nil {
-		return m.RemotePubkey
-	}
-	return ""
-}
+var xxx_messageInfo_SubSwapServiceRedeemRequest proto.InternalMessageInfo
 
-func (m *Channel) GetChannelPoint() string {
+func (m *SubSwapServiceRedeemRequest) GetPreimage() []byte {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Preimage
 	}
-	return ""
+	return nil
 }
 
-func (m *Channel) GetChanId() uint64 {
+func (m *SubSwapServiceRedeemRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.ChanId
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *Channel) GetCapacity() int64 {
+func (m *SubSwapServiceRedeemRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.SatPerByte
 	}
 	return 0
 }
 
-func (m *Channel) GetLocalBalance() int64 {
-	if m != nil {
-		return m.LocalBalance
-	}
-	return 0
+type SubSwapServiceRedeemResponse struct {
+	// / The txid of the transaction.
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Channel) GetRemoteBalance() int64 {
-	if m != nil {
-		return m.RemoteBalance
-	}
-	return 0
+func (m *SubSwapServiceRedeemResponse) Reset()         { *m = SubSwapServiceRedeemResponse{} }
+func (m *SubSwapServiceRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*SubSwapServiceRedeemResponse) ProtoMessage()    {}
+func (*SubSwapServiceRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{45}
 }
-
-func (m *Channel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
-	}
-	return 0
+func (m *SubSwapServiceRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapServiceRedeemResponse.Unmarshal(m, b)
 }
-
-func (m *Channel) GetCommitWeight() int64 {
-	if m != nil {
-		return m.CommitWeight
-	}
-	return 0
+func (m *SubSwapServiceRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapServiceRedeemResponse.Marshal(b, m, deterministic)
 }
-
-func (m *Channel) GetFeePerKw() int64 {
-	if m != nil {
-		return m.FeePerKw
-	}
-	return 0
+func (dst *SubSwapServiceRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapServiceRedeemResponse.Merge(dst, src)
 }
-
-func (m *Channel) GetUnsettledBalance() int64 {
-	if m != nil {
-		return m.UnsettledBalance
-	}
-	return 0
+func (m *SubSwapServiceRedeemResponse) XXX_Size() int {
+	return xxx_messageInfo_SubSwapServiceRedeemResponse.Size(m)
 }
-
-func (m *Channel) GetTotalSatoshisSent() int64 {
-	if m != nil {
-		return m.TotalSatoshisSent
-	}
-	return 0
+func (m *SubSwapServiceRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapServiceRedeemResponse.DiscardUnknown(m)
 }
 
-func (m *Channel) GetTotalSatoshisReceived() int64 {
+var xxx_messageInfo_SubSwapServiceRedeemResponse proto.InternalMessageInfo
+
+func (m *SubSwapServiceRedeemResponse) GetTxid() string {
 	if m != nil {
-		return m.TotalSatoshisReceived
+		return m.Txid
 	}
-	return 0
+	return ""
 }
 
-func (m *Channel) GetNumUpdates() uint64 {
-	if m != nil {
-		return m.NumUpdates
-	}
-	return 0
+type SubSwapClientRefundRequest struct {
+	// / The address used for the swap.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// / The address to receive the refund.
+	RefundAddress string `protobuf:"bytes,2,opt,name=refundAddress,json=refund_address,proto3" json:"refundAddress,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Channel) GetPendingHtlcs() []*HTLC {
-	if m != nil {
-		return m.PendingHtlcs
-	}
-	return nil
+func (m *SubSwapClientRefundRequest) Reset()         { *m = SubSwapClientRefundRequest{} }
+func (m *SubSwapClientRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientRefundRequest) ProtoMessage()    {}
+func (*SubSwapClientRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{46}
+}
+func (m *SubSwapClientRefundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientRefundRequest.Unmarshal(m, b)
+}
+func (m *SubSwapClientRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientRefundRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubSwapClientRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientRefundRequest.Merge(dst, src)
+}
+func (m *SubSwapClientRefundRequest) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientRefundRequest.Size(m)
+}
+func (m *SubSwapClientRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientRefundRequest.DiscardUnknown(m)
 }
 
-func (m *Channel) GetCsvDelay() uint32 {
+var xxx_messageInfo_SubSwapClientRefundRequest proto.InternalMessageInfo
+
+func (m *SubSwapClientRefundRequest) GetAddress() string {
 	if m != nil {
-		return m.CsvDelay
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *Channel) GetPrivate() bool {
+func (m *SubSwapClientRefundRequest) GetRefundAddress() string {
 	if m != nil {
-		return m.Private
+		return m.RefundAddress
 	}
-	return false
+	return ""
 }
 
-func (m *Channel) GetLocalChanReserve() int64 {
+func (m *SubSwapClientRefundRequest) GetTargetConf() int32 {
 	if m != nil {
-		return m.LocalChanReserve
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *Channel) GetRemoteChanReserve() int64 {
+func (m *SubSwapClientRefundRequest) GetSatPerByte() int64 {
 	if m != nil {
-		return m.RemoteChanReserve
+		return m.SatPerByte
 	}
 	return 0
 }
 
-type ListChannelsRequest struct {
-	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
-	PublicOnly           bool     `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
-	PrivateOnly          bool     `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+type SubSwapClientRefundResponse struct {
+	// / The txid of the transaction.
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
-func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListChannelsRequest) ProtoMessage()    {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{46}
+func (m *SubSwapClientRefundResponse) Reset()         { *m = SubSwapClientRefundResponse{} }
+func (m *SubSwapClientRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*SubSwapClientRefundResponse) ProtoMessage()    {}
+func (*SubSwapClientRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{47}
 }
-func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
-}
-func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
-}
-func (dst *ListChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChannelsRequest.Merge(dst, src)
+func (m *SubSwapClientRefundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubSwapClientRefundResponse.Unmarshal(m, b)
 }
-func (m *ListChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListChannelsRequest.Size(m)
+func (m *SubSwapClientRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubSwapClientRefundResponse.Marshal(b, m, deterministic)
 }
-func (m *ListChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
+func (dst *SubSwapClientRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubSwapClientRefundResponse.Merge(dst, src)
 }
-
-var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
-
-func (m *ListChannelsRequest) GetActiveOnly() bool {
-	if m != nil {
-		return m.ActiveOnly
-	}
-	return false
+func (m *SubSwapClientRefundResponse) XXX_Size() int {
+	return xxx_messageInfo_SubSwapClientRefundResponse.Size(m)
 }
-
-func (m *ListChannelsRequest) GetInactiveOnly() bool {
-	if m != nil {
-		return m.InactiveOnly
-	}
-	return false
+func (m *SubSwapClientRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubSwapClientRefundResponse.DiscardUnknown(m)
 }
 
-func (m *ListChannelsRequest) GetPublicOnly() bool {
-	if m != nil {
-		return m.PublicOnly
-	}
-	return false
-}
+var xxx_messageInfo_SubSwapClientRefundResponse proto.InternalMessageInfo
 
-func (m *ListChannelsRequest) GetPrivateOnly() bool {
+func (m *SubSwapClientRefundResponse) GetTxid() string {
 	if m != nil {
-		return m.PrivateOnly
+		return m.Txid
 	}
-	return false
+	return ""
 }
 
-type ListChannelsResponse struct {
-	// / The list of active channels
-	Channels             []*Channel `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type SignMessageRequest struct {
+	// / The message to be signed
+	Msg                  []byte   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
-func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListChannelsResponse) ProtoMessage()    {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{47}
+func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SignMessageRequest) ProtoMessage()    {}
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{48}
 }
-func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
+func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
 }
-func (m *ListChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListChannelsResponse.Marshal(b, m, deterministic)
+func (m *SignMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChannelsResponse.Merge(dst, src)
+func (dst *SignMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageRequest.Merge(dst, src)
 }
-func (m *ListChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListChannelsResponse.Size(m)
+func (m *SignMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SignMessageRequest.Size(m)
 }
-func (m *ListChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChannelsResponse.DiscardUnknown(m)
+func (m *SignMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListChannelsResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignMessageRequest proto.InternalMessageInfo
 
-func (m *ListChannelsResponse) GetChannels() []*Channel {
+func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
-		return m.Channels
+		return m.Msg
 	}
 	return nil
 }
 
-type ChannelCloseSummary struct {
-	// / The outpoint (txid:index) of the funding transaction.
-	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	// /  The unique channel ID for the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	// / The hash of the genesis block that this channel resides within.
-	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
-	// / The txid of the transaction which ultimately closed this channel.
-	ClosingTxHash string `protobuf:"bytes,4,opt,name=closing_tx_hash,proto3" json:"closing_tx_hash,omitempty"`
-	// / Public key of the remote peer that we formerly had a channel with.
-	RemotePubkey string `protobuf:"bytes,5,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
-	// / Total capacity of the channel.
-	Capacity int64 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	// / Height at which the funding transaction was spent.
-	CloseHeight uint32 `protobuf:"varint,7,opt,name=close_height,proto3" json:"close_height,omitempty"`
-	// / Settled balance at the time of channel closure
-	SettledBalance int64 `protobuf:"varint,8,opt,name=settled_balance,proto3" json:"settled_balance,omitempty"`
-	// / The sum of all the time-locked outputs at the time of channel closure
-	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
-	// / Details on how the channel was closed.
-	CloseType            ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type SignMessageResponse struct {
+	// / The signature for the given message
+	Signature            string   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
-func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
-func (*ChannelCloseSummary) ProtoMessage()    {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{48}
+func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SignMessageResponse) ProtoMessage()    {}
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{49}
 }
-func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
+func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
 }
-func (m *ChannelCloseSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelCloseSummary.Marshal(b, m, deterministic)
+func (m *SignMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChannelCloseSummary) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelCloseSummary.Merge(dst, src)
+func (dst *SignMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageResponse.Merge(dst, src)
 }
-func (m *ChannelCloseSummary) XXX_Size() int {
-	return xxx_messageInfo_ChannelCloseSummary.Size(m)
+func (m *SignMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SignMessageResponse.Size(m)
 }
-func (m *ChannelCloseSummary) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelCloseSummary.DiscardUnknown(m)
+func (m *SignMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelCloseSummary proto.InternalMessageInfo
+var xxx_messageInfo_SignMessageResponse proto.InternalMessageInfo
 
-func (m *ChannelCloseSummary) GetChannelPoint() string {
+func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *ChannelCloseSummary) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
-	}
-	return 0
+type VerifyMessageRequest struct {
+	// / The message over which the signature is to be verified
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// / The signature to be verified over the given message
+	Signature            string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelCloseSummary) GetChainHash() string {
-	if m != nil {
-		return m.ChainHash
-	}
-	return ""
+func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
+func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageRequest) ProtoMessage()    {}
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{50}
 }
-
-func (m *ChannelCloseSummary) GetClosingTxHash() string {
-	if m != nil {
-		return m.ClosingTxHash
-	}
-	return ""
+func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
 }
-
-func (m *ChannelCloseSummary) GetRemotePubkey() string {
-	if m != nil {
-		return m.RemotePubkey
-	}
-	return ""
+func (m *VerifyMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageRequest.Marshal(b, m, deterministic)
 }
-
-func (m *ChannelCloseSummary) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+func (dst *VerifyMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageRequest.Merge(dst, src)
 }
-
-func (m *ChannelCloseSummary) GetCloseHeight() uint32 {
-	if m != nil {
-		return m.CloseHeight
-	}
-	return 0
+func (m *VerifyMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageRequest.Size(m)
 }
-
-func (m *ChannelCloseSummary) GetSettledBalance() int64 {
-	if m != nil {
-		return m.SettledBalance
-	}
-	return 0
+func (m *VerifyMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageRequest.DiscardUnknown(m)
 }
 
-func (m *ChannelCloseSummary) GetTimeLockedBalance() int64 {
+var xxx_messageInfo_VerifyMessageRequest proto.InternalMessageInfo
+
+func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
-		return m.TimeLockedBalance
+		return m.Msg
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
+func (m *VerifyMessageRequest) GetSignature() string {
 	if m != nil {
-		return m.CloseType
+		return m.Signature
 	}
-	return ChannelCloseSummary_COOPERATIVE_CLOSE
+	return ""
 }
 
-type PrunedChannelsRequest struct {
-	BeginHeight          uint32   `protobuf:"varint,1,opt,name=begin_height,proto3" json:"begin_height,omitempty"`
-	EndHeight            uint32   `protobuf:"varint,2,opt,name=end_height,proto3" json:"end_height,omitempty"`
+type VerifyMessageResponse struct {
+	// / Whether the signature was valid over the given message
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// / The pubkey recovered from the signature
+	Pubkey               string   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrunedChannelsRequest) Reset()         { *m = PrunedChannelsRequest{} }
-func (m *PrunedChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*PrunedChannelsRequest) ProtoMessage()    {}
-func (*PrunedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{49}
+func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
+func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageResponse) ProtoMessage()    {}
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{51}
 }
-func (m *PrunedChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrunedChannelsRequest.Unmarshal(m, b)
+func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
 }
-func (m *PrunedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrunedChannelsRequest.Marshal(b, m, deterministic)
+func (m *VerifyMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageResponse.Marshal(b, m, deterministic)
 }
-func (dst *PrunedChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrunedChannelsRequest.Merge(dst, src)
+func (dst *VerifyMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageResponse.Merge(dst, src)
 }
-func (m *PrunedChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_PrunedChannelsRequest.Size(m)
+func (m *VerifyMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageResponse.Size(m)
 }
-func (m *PrunedChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrunedChannelsRequest.DiscardUnknown(m)
+func (m *VerifyMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrunedChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifyMessageResponse proto.InternalMessageInfo
 
-func (m *PrunedChannelsRequest) GetBeginHeight() uint32 {
+func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
-		return m.BeginHeight
+		return m.Valid
 	}
-	return 0
+	return false
 }
 
-func (m *PrunedChannelsRequest) GetEndHeight() uint32 {
+func (m *VerifyMessageResponse) GetPubkey() string {
 	if m != nil {
-		return m.EndHeight
+		return m.Pubkey
 	}
-	return 0
+	return ""
 }
 
-type PrunedChannel struct {
-	ClosedHeight         uint32   `protobuf:"varint,1,opt,name=closed_height,proto3" json:"closed_height,omitempty"`
-	ChanId               uint64   `protobuf:"varint,2,opt,name=chan_id,json=channel_id,proto3" json:"chan_id,omitempty"`
-	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	ChannelPoint         string   `protobuf:"bytes,4,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+type ConnectPeerRequest struct {
+	// / Lightning address of the peer, in the format `<pubkey>@host`
+	Addr *LightningAddress `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// * If set, the daemon will attempt to persistently connect to the target
+	// peer.  Otherwise, the call will be synchronous.
+	Perm                 bool     `protobuf:"varint,2,opt,name=perm,proto3" json:"perm,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrunedChannel) Reset()         { *m = PrunedChannel{} }
-func (m *PrunedChannel) String() string { return proto.CompactTextString(m) }
-func (*PrunedChannel) ProtoMessage()    {}
-func (*PrunedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{50}
+func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
+func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*ConnectPeerRequest) ProtoMessage()    {}
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{52}
 }
-func (m *PrunedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrunedChannel.Unmarshal(m, b)
+func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
 }
-func (m *PrunedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrunedChannel.Marshal(b, m, deterministic)
+func (m *ConnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectPeerRequest.Marshal(b, m, deterministic)
 }
-func (dst *PrunedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrunedChannel.Merge(dst, src)
+func (dst *ConnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectPeerRequest.Merge(dst, src)
 }
-func (m *PrunedChannel) XXX_Size() int {
-	return xxx_messageInfo_PrunedChannel.Size(m)
+func (m *ConnectPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_ConnectPeerRequest.Size(m)
 }
-func (m *PrunedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrunedChannel.DiscardUnknown(m)
+func (m *ConnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectPeerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrunedChannel proto.InternalMessageInfo
+var xxx_messageInfo_ConnectPeerRequest proto.InternalMessageInfo
 
-func (m *PrunedChannel) GetClosedHeight() uint32 {
+func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
-		return m.ClosedHeight
+		return m.Addr
 	}
-	return 0
+	return nil
 }
 
-func (m *PrunedChannel) GetChanId() uint64 {
+func (m *ConnectPeerRequest) GetPerm() bool {
 	if m != nil {
-		return m.ChanId
+		return m.Perm
 	}
-	return 0
+	return false
 }
 
-func (m *PrunedChannel) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+type ConnectPeerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrunedChannel) GetChannelPoint() string {
-	if m != nil {
-		return m.ChannelPoint
-	}
-	return ""
+func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
+func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*ConnectPeerResponse) ProtoMessage()    {}
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{53}
+}
+func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
+}
+func (m *ConnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectPeerResponse.Marshal(b, m, deterministic)
+}
+func (dst *ConnectPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectPeerResponse.Merge(dst, src)
+}
+func (m *ConnectPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_ConnectPeerResponse.Size(m)
+}
+func (m *ConnectPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectPeerResponse.DiscardUnknown(m)
 }
 
-type PrunedChannelsResponse struct {
-	Channels             []*PrunedChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+var xxx_messageInfo_ConnectPeerResponse proto.InternalMessageInfo
+
+type DisconnectPeerRequest struct {
+	// / The pubkey of the node to disconnect from
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrunedChannelsResponse) Reset()         { *m = PrunedChannelsResponse{} }
-func (m *PrunedChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*PrunedChannelsResponse) ProtoMessage()    {}
-func (*PrunedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{51}
+func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()    {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{54}
 }
-func (m *PrunedChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrunedChannelsResponse.Unmarshal(m, b)
+func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
 }
-func (m *PrunedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrunedChannelsResponse.Marshal(b, m, deterministic)
+func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
 }
-func (dst *PrunedChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrunedChannelsResponse.Merge(dst, src)
+func (dst *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerRequest.Merge(dst, src)
 }
-func (m *PrunedChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_PrunedChannelsResponse.Size(m)
+func (m *DisconnectPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_DisconnectPeerRequest.Size(m)
 }
-func (m *PrunedChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrunedChannelsResponse.DiscardUnknown(m)
+func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrunedChannelsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
 
-func (m *PrunedChannelsResponse) GetChannels() []*PrunedChannel {
+func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
-		return m.Channels
+		return m.PubKey
 	}
-	return nil
+	return ""
 }
 
-type ClosedChannelsRequest struct {
-	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
-	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
-	RemoteForce          bool     `protobuf:"varint,3,opt,name=remote_force,json=remoteForce,proto3" json:"remote_force,omitempty"`
-	Breach               bool     `protobuf:"varint,4,opt,name=breach,proto3" json:"breach,omitempty"`
-	FundingCanceled      bool     `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled,proto3" json:"funding_canceled,omitempty"`
-	Abandoned            bool     `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
+type DisconnectPeerResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
-func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelsRequest) ProtoMessage()    {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{52}
+func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{} }
+func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerResponse) ProtoMessage()    {}
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{55}
 }
-func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
+func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
 }
-func (m *ClosedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelsRequest.Marshal(b, m, deterministic)
+func (m *DisconnectPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectPeerResponse.Marshal(b, m, deterministic)
 }
-func (dst *ClosedChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelsRequest.Merge(dst, src)
+func (dst *DisconnectPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerResponse.Merge(dst, src)
 }
-func (m *ClosedChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelsRequest.Size(m)
+func (m *DisconnectPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_DisconnectPeerResponse.Size(m)
 }
-func (m *ClosedChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelsRequest.DiscardUnknown(m)
+func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClosedChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
 
-func (m *ClosedChannelsRequest) GetCooperative() bool {
-	if m != nil {
-		return m.Cooperative
-	}
-	return false
+type HTLC struct {
+	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
+	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClosedChannelsRequest) GetLocalForce() bool {
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{56}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+}
+func (dst *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(dst, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return xxx_messageInfo_HTLC.Size(m)
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetIncoming() bool {
 	if m != nil {
-		return m.LocalForce
+		return m.Incoming
 	}
 	return false
 }
 
-func (m *ClosedChannelsRequest) GetRemoteForce() bool {
+func (m *HTLC) GetAmount() int64 {
 	if m != nil {
-		return m.RemoteForce
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetBreach() bool {
+func (m *HTLC) GetHashLock() []byte {
 	if m != nil {
-		return m.Breach
+		return m.HashLock
 	}
-	return false
+	return nil
 }
 
-func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
+func (m *HTLC) GetExpirationHeight() uint32 {
 	if m != nil {
-		return m.FundingCanceled
-	}
-	return false
-}
-
-func (m *ClosedChannelsRequest) GetAbandoned() bool {
-	if m != nil {
-		return m.Abandoned
+		return m.ExpirationHeight
 	}
-	return false
+	return 0
 }
 
-type ClosedChannelsResponse struct {
-	Channels             []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type Channel struct {
+	// / Whether this channel is active or not
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// / The identity pubkey of the remote node
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The total amount of funds held in this channel
+	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / This node's current balance in this channel
+	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	// / The counterparty's current balance in this channel
+	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of commitment
+	// transactions. The fee amount is persisted with the channel in order to
+	// allow the fee amount to be removed and recalculated with each channel state
+	// update, including updates that happen after a system restart.
+	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will pay
+	// at all times, for both the funding transaction and commitment transaction.
+	// This value can later be updated once the channel is open.
+	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	// / The unsettled balance in this channel
+	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance,proto3" json:"unsettled_balance,omitempty"`
+	// *
+	// The total number of satoshis we've sent within this channel.
+	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent,proto3" json:"total_satoshis_sent,omitempty"`
+	// *
+	// The total number of satoshis we've received within this channel.
+	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received,proto3" json:"total_satoshis_received,omitempty"`
+	// *
+	// The total number of updates conducted within this channel.
+	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates,proto3" json:"num_updates,omitempty"`
+	// *
+	// The list of active, uncleared HTLCs currently pending within the channel.
+	PendingHtlcs []*HTLC `protobuf:"bytes,15,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	// *
+	// The CSV delay expressed in relative blocks. If the channel is force
+	// closed, we'll need to wait for this many blocks before we can regain our
+	// funds.
+	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
+	// / Whether this channel is advertised to the network or not
+	Private bool `protobuf:"varint,17,opt,name=private,proto3" json:"private,omitempty"`
+	// / This minimum satoshies this node is required to reseve in
+	// / its balance
+	LocalChanReserve int64 `protobuf:"varint,18,opt,name=local_chan_reserve,proto3" json:"local_chan_reserve,omitempty"`
+	// / This minimum satoshies the other node is required to reseve in
+	// / its balance
+	RemoteChanReserve int64 `protobuf:"varint,19,opt,name=remote_chan_reserve,proto3" json:"remote_chan_reserve,omitempty"`
+	// *
+	// The commitment fee rate (in sat/kw) our commitment fee policy last
+	// targeted for this channel, or zero if it wasn't sampled yet
+	LocalProposedFeePerKw int64 `protobuf:"varint,20,opt,name=local_proposed_fee_per_kw,proto3" json:"local_proposed_fee_per_kw,omitempty"`
+	// *
+	// The commitment fee rate (in sat/kw) last proposed by the remote party, or
+	// zero if it didn't propose one since the channel became active
+	RemoteProposedFeePerKw int64    `protobuf:"varint,21,opt,name=remote_proposed_fee_per_kw,proto3" json:"remote_proposed_fee_per_kw,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{} }
-func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelsResponse) ProtoMessage()    {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{53}
+func (m *Channel) Reset()         { *m = Channel{} }
+func (m *Channel) String() string { return proto.CompactTextString(m) }
+func (*Channel) ProtoMessage()    {}
+func (*Channel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{57}
 }
-func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
+func (m *Channel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Channel.Unmarshal(m, b)
 }
-func (m *ClosedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelsResponse.Marshal(b, m, deterministic)
+func (m *Channel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Channel.Marshal(b, m, deterministic)
 }
-func (dst *ClosedChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelsResponse.Merge(dst, src)
+func (dst *Channel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Channel.Merge(dst, src)
 }
-func (m *ClosedChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelsResponse.Size(m)
+func (m *Channel) XXX_Size() int {
+	return xxx_messageInfo_Channel.Size(m)
 }
-func (m *ClosedChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelsResponse.DiscardUnknown(m)
+func (m *Channel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Channel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClosedChannelsResponse proto.InternalMessageInfo
+var xxx_messageInfo_Channel proto.InternalMessageInfo
 
-func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
+func (m *Channel) GetActive() bool {
 	if m != nil {
-		return m.Channels
+		return m.Active
 	}
-	return nil
+	return false
 }
 
-type Peer struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// / Bytes of data transmitted to this peer
-	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
-	// / Bytes of data transmitted from this peer
-	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
-	// / Satoshis sent to this peer
-	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
-	// / Satoshis received from this peer
-	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
-	// / A channel is inbound if the counterparty initiated the channel
-	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
-	// / Ping time to this peer
-	PingTime             int64    `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Channel) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
 }
 
-func (m *Peer) Reset()         { *m = Peer{} }
-func (m *Peer) String() string { return proto.CompactTextString(m) }
-func (*Peer) ProtoMessage()    {}
-func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{54}
+func (m *Channel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
 }
-func (m *Peer) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Peer.Unmarshal(m, b)
+
+func (m *Channel) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
-func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+
+func (m *Channel) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
-func (dst *Peer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Peer.Merge(dst, src)
+
+func (m *Channel) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
+	}
+	return 0
 }
-func (m *Peer) XXX_Size() int {
-	return xxx_messageInfo_Peer.Size(m)
+
+func (m *Channel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return 0
 }
-func (m *Peer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Peer.DiscardUnknown(m)
+
+func (m *Channel) GetCommitFee() int64 {
+	if m != nil {
+		return m.CommitFee
+	}
+	return 0
 }
 
-var xxx_messageInfo_Peer proto.InternalMessageInfo
+func (m *Channel) GetCommitWeight() int64 {
+	if m != nil {
+		return m.CommitWeight
+	}
+	return 0
+}
 
-func (m *Peer) GetPubKey() string {
+func (m *Channel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.PubKey
+		return m.FeePerKw
 	}
-	return ""
+	return 0
 }
 
-func (m *Peer) GetAddress() string {
+func (m *Channel) GetUnsettledBalance() int64 {
 	if m != nil {
-		return m.Address
+		return m.UnsettledBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Peer) GetBytesSent() uint64 {
+func (m *Channel) GetTotalSatoshisSent() int64 {
 	if m != nil {
-		return m.BytesSent
+		return m.TotalSatoshisSent
 	}
 	return 0
 }
 
-func (m *Peer) GetBytesRecv() uint64 {
+func (m *Channel) GetTotalSatoshisReceived() int64 {
 	if m != nil {
-		return m.BytesRecv
+		return m.TotalSatoshisReceived
 	}
 	return 0
 }
 
-func (m *Peer) GetSatSent() int64 {
+func (m *Channel) GetNumUpdates() uint64 {
 	if m != nil {
-		return m.SatSent
+		return m.NumUpdates
 	}
 	return 0
 }
 
-func (m *Peer) GetSatRecv() int64 {
+func (m *Channel) GetPendingHtlcs() []*HTLC {
 	if m != nil {
-		return m.SatRecv
+		return m.PendingHtlcs
+	}
+	return nil
+}
+
+func (m *Channel) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
 	}
 	return 0
 }
 
-func (m *Peer) GetInbound() bool {
+func (m *Channel) GetPrivate() bool {
 	if m != nil {
-		return m.Inbound
+		return m.Private
 	}
 	return false
 }
 
-func (m *Peer) GetPingTime() int64 {
+func (m *Channel) GetLocalChanReserve() int64 {
 	if m != nil {
-		return m.PingTime
+		return m.LocalChanReserve
 	}
 	return 0
 }
 
-type ListPeersRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Channel) GetRemoteChanReserve() int64 {
+	if m != nil {
+		return m.RemoteChanReserve
+	}
+	return 0
 }
 
-func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
-func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPeersRequest) ProtoMessage()    {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{55}
-}
-func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
-}
-func (m *ListPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPeersRequest.Marshal(b, m, deterministic)
-}
-func (dst *ListPeersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPeersRequest.Merge(dst, src)
-}
-func (m *ListPeersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPeersRequest.Size(m)
-}
-func (m *ListPeersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPeersRequest.DiscardUnknown(m)
+func (m *Channel) GetLocalProposedFeePerKw() int64 {
+	if m != nil {
+		return m.LocalProposedFeePerKw
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListPeersRequest proto.InternalMessageInfo
+func (m *Channel) GetRemoteProposedFeePerKw() int64 {
+	if m != nil {
+		return m.RemoteProposedFeePerKw
+	}
+	return 0
+}
 
-type ListPeersResponse struct {
-	// / The list of currently connected peers
-	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+type ListChannelsRequest struct {
+	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
+	PublicOnly           bool     `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
+	PrivateOnly          bool     `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
-func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListPeersResponse) ProtoMessage()    {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{56}
+func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
+func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsRequest) ProtoMessage()    {}
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{58}
 }
-func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
+func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
 }
-func (m *ListPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPeersResponse.Marshal(b, m, deterministic)
+func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListPeersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPeersResponse.Merge(dst, src)
+func (dst *ListChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsRequest.Merge(dst, src)
 }
-func (m *ListPeersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListPeersResponse.Size(m)
+func (m *ListChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsRequest.Size(m)
 }
-func (m *ListPeersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPeersResponse.DiscardUnknown(m)
+func (m *ListChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPeersResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
 
-func (m *ListPeersResponse) GetPeers() []*Peer {
+func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
-		return m.Peers
+		return m.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-type PeerSubscription struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListChannelsRequest) GetInactiveOnly() bool {
+	if m != nil {
+		return m.InactiveOnly
+	}
+	return false
 }
 
-func (m *PeerSubscription) Reset()         { *m = PeerSubscription{} }
-func (m *PeerSubscription) String() string { return proto.CompactTextString(m) }
-func (*PeerSubscription) ProtoMessage()    {}
-func (*PeerSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{57}
+func (m *ListChannelsRequest) GetPublicOnly() bool {
+	if m != nil {
+		return m.PublicOnly
+	}
+	return false
 }
-func (m *PeerSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PeerSubscription.Unmarshal(m, b)
+
+func (m *ListChannelsRequest) GetPrivateOnly() bool {
+	if m != nil {
+		return m.PrivateOnly
+	}
+	return false
 }
-func (m *PeerSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PeerSubscription.Marshal(b, m, deterministic)
+
+type ListChannelsResponse struct {
+	// / The list of active channels
+	Channels             []*Channel `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (dst *PeerSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PeerSubscription.Merge(dst, src)
+
+func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
+func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsResponse) ProtoMessage()    {}
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{59}
 }
-func (m *PeerSubscription) XXX_Size() int {
-	return xxx_messageInfo_PeerSubscription.Size(m)
+func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
 }
-func (m *PeerSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_PeerSubscription.DiscardUnknown(m)
+func (m *ListChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsResponse.Merge(dst, src)
+}
+func (m *ListChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsResponse.Size(m)
+}
+func (m *ListChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PeerSubscription proto.InternalMessageInfo
+var xxx_messageInfo_ListChannelsResponse proto.InternalMessageInfo
 
-type PeerNotification struct {
-	// / The identity pubkey of the peer
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	// / Network address of the peer; eg `127.0.0.1:10011`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// / The flag reflects if this is a connected or disconnected notification
-	Connected            bool     `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListChannelsResponse) GetChannels() []*Channel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (m *PeerNotification) Reset()         { *m = PeerNotification{} }
-func (m *PeerNotification) String() string { return proto.CompactTextString(m) }
-func (*PeerNotification) ProtoMessage()    {}
-func (*PeerNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{58}
+type ChannelCloseSummary struct {
+	// / The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// /  The unique channel ID for the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The hash of the genesis block that this channel resides within.
+	ChainHash string `protobuf:"bytes,3,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The txid of the transaction which ultimately closed this channel.
+	ClosingTxHash string `protobuf:"bytes,4,opt,name=closing_tx_hash,proto3" json:"closing_tx_hash,omitempty"`
+	// / Public key of the remote peer that we formerly had a channel with.
+	RemotePubkey string `protobuf:"bytes,5,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
+	// / Total capacity of the channel.
+	Capacity int64 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / Height at which the funding transaction was spent.
+	CloseHeight uint32 `protobuf:"varint,7,opt,name=close_height,proto3" json:"close_height,omitempty"`
+	// / Settled balance at the time of channel closure
+	SettledBalance int64 `protobuf:"varint,8,opt,name=settled_balance,proto3" json:"settled_balance,omitempty"`
+	// / The sum of all the time-locked outputs at the time of channel closure
+	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
+	// / Details on how the channel was closed.
+	CloseType            ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
-func (m *PeerNotification) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PeerNotification.Unmarshal(m, b)
+
+func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
+func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseSummary) ProtoMessage()    {}
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{60}
 }
-func (m *PeerNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PeerNotification.Marshal(b, m, deterministic)
+func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
 }
-func (dst *PeerNotification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PeerNotification.Merge(dst, src)
+func (m *ChannelCloseSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseSummary.Marshal(b, m, deterministic)
 }
-func (m *PeerNotification) XXX_Size() int {
-	return xxx_messageInfo_PeerNotification.Size(m)
+func (dst *ChannelCloseSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseSummary.Merge(dst, src)
 }
-func (m *PeerNotification) XXX_DiscardUnknown() {
-	xxx_messageInfo_PeerNotification.DiscardUnknown(m)
+func (m *ChannelCloseSummary) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseSummary.Size(m)
+}
+func (m *ChannelCloseSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PeerNotification proto.InternalMessageInfo
+var xxx_messageInfo_ChannelCloseSummary proto.InternalMessageInfo
 
-func (m *PeerNotification) GetPubKey() string {
+func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
-		return m.PubKey
+		return m.ChannelPoint
 	}
 	return ""
 }
 
-func (m *PeerNotification) GetAddress() string {
+func (m *ChannelCloseSummary) GetChanId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelCloseSummary) GetChainHash() string {
+	if m != nil {
+		return m.ChainHash
 	}
 	return ""
 }
 
-func (m *PeerNotification) GetConnected() bool {
+func (m *ChannelCloseSummary) GetClosingTxHash() string {
 	if m != nil {
-		return m.Connected
+		return m.ClosingTxHash
 	}
-	return false
+	return ""
 }
 
-type GetInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelCloseSummary) GetRemotePubkey() string {
+	if m != nil {
+		return m.RemotePubkey
+	}
+	return ""
 }
 
-func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()    {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{59}
+func (m *ChannelCloseSummary) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
-func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
+
+func (m *ChannelCloseSummary) GetCloseHeight() uint32 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
 }
-func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+
+func (m *ChannelCloseSummary) GetSettledBalance() int64 {
+	if m != nil {
+		return m.SettledBalance
+	}
+	return 0
 }
-func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
-}
-func (m *GetInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetInfoRequest.Size(m)
-}
-func (m *GetInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+
+func (m *ChannelCloseSummary) GetTimeLockedBalance() int64 {
+	if m != nil {
+		return m.TimeLockedBalance
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
+	if m != nil {
+		return m.CloseType
+	}
+	return ChannelCloseSummary_COOPERATIVE_CLOSE
+}
 
-type GetInfoResponse struct {
-	// / The identity pubkey of the current node.
-	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey,proto3" json:"identity_pubkey,omitempty"`
-	// / If applicable, the alias of the current node, e.g. "bob"
-	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	// / Number of pending channels
-	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
-	// / Number of active channels
-	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels,proto3" json:"num_active_channels,omitempty"`
-	// / Number of peers
-	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers,proto3" json:"num_peers,omitempty"`
-	// / The node's current view of the height of the best block
-	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,proto3" json:"block_height,omitempty"`
-	// / The node's current view of the hash of the best block
-	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	// / Whether the wallet's view is synced to the main chain
-	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain,proto3" json:"synced_to_chain,omitempty"`
-	// / Whether the current node is connected to testnet
-	Testnet bool `protobuf:"varint,10,opt,name=testnet,proto3" json:"testnet,omitempty"`
-	// / A list of active chains the node is connected to
-	Chains []string `protobuf:"bytes,11,rep,name=chains,proto3" json:"chains,omitempty"`
-	// / The URIs of the current node.
-	Uris []string `protobuf:"bytes,12,rep,name=uris,proto3" json:"uris,omitempty"`
-	// / Timestamp of the block best known to the wallet
-	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp,proto3" json:"best_header_timestamp,omitempty"`
-	// / The version of the LND software that the node is running.
-	Version string `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`
-	// / Number of inactive channels
-	NumInactiveChannels  uint32   `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
+type PrunedChannelsRequest struct {
+	BeginHeight          uint32   `protobuf:"varint,1,opt,name=begin_height,proto3" json:"begin_height,omitempty"`
+	EndHeight            uint32   `protobuf:"varint,2,opt,name=end_height,proto3" json:"end_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
-func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetInfoResponse) ProtoMessage()    {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{60}
+func (m *PrunedChannelsRequest) Reset()         { *m = PrunedChannelsRequest{} }
+func (m *PrunedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PrunedChannelsRequest) ProtoMessage()    {}
+func (*PrunedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{61}
 }
-func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
+func (m *PrunedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunedChannelsRequest.Unmarshal(m, b)
 }
-func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
+func (m *PrunedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunedChannelsRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfoResponse.Merge(dst, src)
+func (dst *PrunedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedChannelsRequest.Merge(dst, src)
 }
-func (m *GetInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetInfoResponse.Size(m)
+func (m *PrunedChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PrunedChannelsRequest.Size(m)
 }
-func (m *GetInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
+func (m *PrunedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_PrunedChannelsRequest proto.InternalMessageInfo
 
-func (m *GetInfoResponse) GetIdentityPubkey() string {
+func (m *PrunedChannelsRequest) GetBeginHeight() uint32 {
 	if m != nil {
-		return m.IdentityPubkey
+		return m.BeginHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *GetInfoResponse) GetAlias() string {
+func (m *PrunedChannelsRequest) GetEndHeight() uint32 {
 	if m != nil {
-		return m.Alias
+		return m.EndHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
-	if m != nil {
-		return m.NumPendingChannels
-	}
-	return 0
+type PrunedChannel struct {
+	ClosedHeight         uint32   `protobuf:"varint,1,opt,name=closed_height,proto3" json:"closed_height,omitempty"`
+	ChanId               uint64   `protobuf:"varint,2,opt,name=chan_id,json=channel_id,proto3" json:"chan_id,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ChannelPoint         string   `protobuf:"bytes,4,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+func (m *PrunedChannel) Reset()         { *m = PrunedChannel{} }
+func (m *PrunedChannel) String() string { return proto.CompactTextString(m) }
+func (*PrunedChannel) ProtoMessage()    {}
+func (*PrunedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{62}
+}
+func (m *PrunedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunedChannel.Unmarshal(m, b)
+}
+func (m *PrunedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunedChannel.Marshal(b, m, deterministic)
+}
+func (dst *PrunedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedChannel.Merge(dst, src)
+}
+func (m *PrunedChannel) XXX_Size() int {
+	return xxx_messageInfo_PrunedChannel.Size(m)
+}
+func (m *PrunedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunedChannel proto.InternalMessageInfo
+
+func (m *PrunedChannel) GetClosedHeight() uint32 {
 	if m != nil {
-		return m.NumActiveChannels
+		return m.ClosedHeight
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumPeers() uint32 {
+func (m *PrunedChannel) GetChanId() uint64 {
 	if m != nil {
-		return m.NumPeers
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHeight() uint32 {
+func (m *PrunedChannel) GetCapacity() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHash() string {
+func (m *PrunedChannel) GetChannelPoint() string {
 	if m != nil {
-		return m.BlockHash
+		return m.ChannelPoint
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetSyncedToChain() bool {
+type PrunedChannelsResponse struct {
+	Channels             []*PrunedChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PrunedChannelsResponse) Reset()         { *m = PrunedChannelsResponse{} }
+func (m *PrunedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PrunedChannelsResponse) ProtoMessage()    {}
+func (*PrunedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{63}
+}
+func (m *PrunedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunedChannelsResponse.Unmarshal(m, b)
+}
+func (m *PrunedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunedChannelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PrunedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedChannelsResponse.Merge(dst, src)
+}
+func (m *PrunedChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PrunedChannelsResponse.Size(m)
+}
+func (m *PrunedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunedChannelsResponse proto.InternalMessageInfo
+
+func (m *PrunedChannelsResponse) GetChannels() []*PrunedChannel {
 	if m != nil {
-		return m.SyncedToChain
+		return m.Channels
 	}
-	return false
+	return nil
 }
 
-func (m *GetInfoResponse) GetTestnet() bool {
+type ClosedChannelsRequest struct {
+	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
+	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
+	RemoteForce          bool     `protobuf:"varint,3,opt,name=remote_force,json=remoteForce,proto3" json:"remote_force,omitempty"`
+	Breach               bool     `protobuf:"varint,4,opt,name=breach,proto3" json:"breach,omitempty"`
+	FundingCanceled      bool     `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled,proto3" json:"funding_canceled,omitempty"`
+	Abandoned            bool     `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
+func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelsRequest) ProtoMessage()    {}
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{64}
+}
+func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
+}
+func (m *ClosedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClosedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelsRequest.Merge(dst, src)
+}
+func (m *ClosedChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelsRequest.Size(m)
+}
+func (m *ClosedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedChannelsRequest proto.InternalMessageInfo
+
+func (m *ClosedChannelsRequest) GetCooperative() bool {
 	if m != nil {
-		return m.Testnet
+		return m.Cooperative
 	}
 	return false
 }
 
-func (m *GetInfoResponse) GetChains() []string {
+func (m *ClosedChannelsRequest) GetLocalForce() bool {
 	if m != nil {
-		return m.Chains
+		return m.LocalForce
 	}
-	return nil
+	return false
 }
 
-func (m *GetInfoResponse) GetUris() []string {
+func (m *ClosedChannelsRequest) GetRemoteForce() bool {
 	if m != nil {
-		return m.Uris
+		return m.RemoteForce
 	}
-	return nil
+	return false
 }
 
-func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
+func (m *ClosedChannelsRequest) GetBreach() bool {
 	if m != nil {
-		return m.BestHeaderTimestamp
+		return m.Breach
 	}
-	return 0
+	return false
 }
 
-func (m *GetInfoResponse) GetVersion() string {
+func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
 	if m != nil {
-		return m.Version
+		return m.FundingCanceled
 	}
-	return ""
+	return false
 }
 
-func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+func (m *ClosedChannelsRequest) GetAbandoned() bool {
 	if m != nil {
-		return m.NumInactiveChannels
+		return m.Abandoned
 	}
-	return 0
+	return false
 }
 
-type GetBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ClosedChannelsResponse struct {
+	Channels             []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *GetBackupRequest) Reset()         { *m = GetBackupRequest{} }
-func (m *GetBackupRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBackupRequest) ProtoMessage()    {}
-func (*GetBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{61}
+func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{} }
+func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelsResponse) ProtoMessage()    {}
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{65}
 }
-func (m *GetBackupRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBackupRequest.Unmarshal(m, b)
+func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
 }
-func (m *GetBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBackupRequest.Marshal(b, m, deterministic)
+func (m *ClosedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelsResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetBackupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBackupRequest.Merge(dst, src)
+func (dst *ClosedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelsResponse.Merge(dst, src)
 }
-func (m *GetBackupRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBackupRequest.Size(m)
+func (m *ClosedChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelsResponse.Size(m)
 }
-func (m *GetBackupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBackupRequest.DiscardUnknown(m)
+func (m *ClosedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBackupRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClosedChannelsResponse proto.InternalMessageInfo
 
-type GetBackupResponse struct {
-	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (m *GetBackupResponse) Reset()         { *m = GetBackupResponse{} }
-func (m *GetBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBackupResponse) ProtoMessage()    {}
-func (*GetBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{62}
+type Peer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// / Bytes of data transmitted to this peer
+	BytesSent uint64 `protobuf:"varint,4,opt,name=bytes_sent,proto3" json:"bytes_sent,omitempty"`
+	// / Bytes of data transmitted from this peer
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,proto3" json:"bytes_recv,omitempty"`
+	// / Satoshis sent to this peer
+	SatSent int64 `protobuf:"varint,6,opt,name=sat_sent,proto3" json:"sat_sent,omitempty"`
+	// / Satoshis received from this peer
+	SatRecv int64 `protobuf:"varint,7,opt,name=sat_recv,proto3" json:"sat_recv,omitempty"`
+	// / A channel is inbound if the counterparty initiated the channel
+	Inbound bool `protobuf:"varint,8,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// / Ping time to this peer
+	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
+	// / The features advertised by the peer within its init message
+	Features             []*Feature `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *GetBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBackupResponse.Unmarshal(m, b)
+
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{66}
 }
-func (m *GetBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBackupResponse.Marshal(b, m, deterministic)
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Peer.Unmarshal(m, b)
 }
-func (dst *GetBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBackupResponse.Merge(dst, src)
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
 }
-func (m *GetBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_GetBackupResponse.Size(m)
+func (dst *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(dst, src)
 }
-func (m *GetBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBackupResponse.DiscardUnknown(m)
+func (m *Peer) XXX_Size() int {
+	return xxx_messageInfo_Peer.Size(m)
+}
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBackupResponse proto.InternalMessageInfo
+var xxx_messageInfo_Peer proto.InternalMessageInfo
 
-func (m *GetBackupResponse) GetFiles() []string {
+func (m *Peer) GetPubKey() string {
 	if m != nil {
-		return m.Files
+		return m.PubKey
 	}
-	return nil
+	return ""
 }
 
-type ConfirmationUpdate struct {
-	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Peer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()    {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{63}
-}
-func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
-}
-func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
-}
-func (dst *ConfirmationUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfirmationUpdate.Merge(dst, src)
-}
-func (m *ConfirmationUpdate) XXX_Size() int {
-	return xxx_messageInfo_ConfirmationUpdate.Size(m)
-}
-func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
+func (m *Peer) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
 }
 
-var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
-
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
+func (m *Peer) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.BlockSha
+		return m.BytesRecv
 	}
-	return nil
+	return 0
 }
 
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+func (m *Peer) GetSatSent() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.SatSent
 	}
 	return 0
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+func (m *Peer) GetSatRecv() int64 {
 	if m != nil {
-		return m.NumConfsLeft
+		return m.SatRecv
 	}
 	return 0
 }
 
-type ChannelOpenUpdate struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *Peer) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
 }
 
-func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()    {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{64}
-}
-func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
-}
-func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
-}
-func (dst *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelOpenUpdate.Merge(dst, src)
-}
-func (m *ChannelOpenUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
-}
-func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
+func (m *Peer) GetPingTime() int64 {
+	if m != nil {
+		return m.PingTime
+	}
+	return 0
 }
 
-var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
-
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *Peer) GetFeatures() []*Feature {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Features
 	}
 	return nil
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid          []byte   `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+type Feature struct {
+	// / The feature bit
+	Bit uint32 `protobuf:"varint,1,opt,name=bit,proto3" json:"bit,omitempty"`
+	// / The name of the feature, if known
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// / Whether the feature is required, i.e. the bit is even
+	IsRequired bool `protobuf:"varint,3,opt,name=is_required,proto3" json:"is_required,omitempty"`
+	// / Whether the feature bit is known to us
+	IsKnown              bool     `protobuf:"varint,4,opt,name=is_known,proto3" json:"is_known,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()    {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{65}
+func (m *Feature) Reset()         { *m = Feature{} }
+func (m *Feature) String() string { return proto.CompactTextString(m) }
+func (*Feature) ProtoMessage()    {}
+func (*Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{67}
 }
-func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+func (m *Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feature.Unmarshal(m, b)
 }
-func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
 }
-func (dst *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelCloseUpdate.Merge(dst, src)
+func (dst *Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feature.Merge(dst, src)
 }
-func (m *ChannelCloseUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+func (m *Feature) XXX_Size() int {
+	return xxx_messageInfo_Feature.Size(m)
 }
-func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
+func (m *Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feature.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+var xxx_messageInfo_Feature proto.InternalMessageInfo
 
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+func (m *Feature) GetBit() uint32 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Bit
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelCloseUpdate) GetSuccess() bool {
+func (m *Feature) GetName() string {
 	if m != nil {
-		return m.Success
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Feature) GetIsRequired() bool {
+	if m != nil {
+		return m.IsRequired
 	}
 	return false
 }
 
-type CloseChannelRequest struct {
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
-	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+func (m *Feature) GetIsKnown() bool {
+	if m != nil {
+		return m.IsKnown
+	}
+	return false
+}
+
+type ListPeersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
-func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*CloseChannelRequest) ProtoMessage()    {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{66}
+func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
+func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPeersRequest) ProtoMessage()    {}
+func (*ListPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{68}
 }
-func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
+func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
 }
-func (m *CloseChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseChannelRequest.Marshal(b, m, deterministic)
+func (m *ListPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeersRequest.Marshal(b, m, deterministic)
 }
-func (dst *CloseChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseChannelRequest.Merge(dst, src)
+func (dst *ListPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeersRequest.Merge(dst, src)
 }
-func (m *CloseChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_CloseChannelRequest.Size(m)
+func (m *ListPeersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPeersRequest.Size(m)
 }
-func (m *CloseChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseChannelRequest.DiscardUnknown(m)
+func (m *ListPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseChannelRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListPeersRequest proto.InternalMessageInfo
 
-func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
+type ListPeersResponse struct {
+	// / The list of currently connected peers
+	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
+func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPeersResponse) ProtoMessage()    {}
+func (*ListPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{69}
+}
+func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
+}
+func (m *ListPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPeersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPeersResponse.Merge(dst, src)
+}
+func (m *ListPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPeersResponse.Size(m)
+}
+func (m *ListPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPeersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPeersResponse proto.InternalMessageInfo
+
+func (m *ListPeersResponse) GetPeers() []*Peer {
 	if m != nil {
-		return m.ChannelPoint
+		return m.Peers
 	}
 	return nil
 }
 
-func (m *CloseChannelRequest) GetForce() bool {
+type PeerSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerSubscription) Reset()         { *m = PeerSubscription{} }
+func (m *PeerSubscription) String() string { return proto.CompactTextString(m) }
+func (*PeerSubscription) ProtoMessage()    {}
+func (*PeerSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{70}
+}
+func (m *PeerSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerSubscription.Unmarshal(m, b)
+}
+func (m *PeerSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerSubscription.Marshal(b, m, deterministic)
+}
+func (dst *PeerSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerSubscription.Merge(dst, src)
+}
+func (m *PeerSubscription) XXX_Size() int {
+	return xxx_messageInfo_PeerSubscription.Size(m)
+}
+func (m *PeerSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerSubscription proto.InternalMessageInfo
+
+type PeerNotification struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / Network address of the peer; eg `127.0.0.1:10011`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// / The flag reflects if this is a connected or disconnected notification
+	Connected            bool     `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerNotification) Reset()         { *m = PeerNotification{} }
+func (m *PeerNotification) String() string { return proto.CompactTextString(m) }
+func (*PeerNotification) ProtoMessage()    {}
+func (*PeerNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{71}
+}
+func (m *PeerNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerNotification.Unmarshal(m, b)
+}
+func (m *PeerNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerNotification.Marshal(b, m, deterministic)
+}
+func (dst *PeerNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerNotification.Merge(dst, src)
+}
+func (m *PeerNotification) XXX_Size() int {
+	return xxx_messageInfo_PeerNotification.Size(m)
+}
+func (m *PeerNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerNotification proto.InternalMessageInfo
+
+func (m *PeerNotification) GetPubKey() string {
 	if m != nil {
-		return m.Force
+		return m.PubKey
 	}
-	return false
+	return ""
 }
 
-func (m *CloseChannelRequest) GetTargetConf() int32 {
+func (m *PeerNotification) GetAddress() string {
 	if m != nil {
-		return m.TargetConf
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *CloseChannelRequest) GetSatPerByte() int64 {
+func (m *PeerNotification) GetConnected() bool {
 	if m != nil {
-		return m.SatPerByte
+		return m.Connected
 	}
-	return 0
+	return false
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*CloseStatusUpdate_ClosePending
-	//	*CloseStatusUpdate_Confirmation
-	//	*CloseStatusUpdate_ChanClose
-	Update               isCloseStatusUpdate_Update `protobuf_oneof:"update"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type SendCustomMessageRequest struct {
+	// / The 33-byte compressed public key of the peer to send the message to
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type, which must be at least 32768
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The opaque payload of the message
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
-func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
-func (*CloseStatusUpdate) ProtoMessage()    {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{67}
+func (m *SendCustomMessageRequest) Reset()         { *m = SendCustomMessageRequest{} }
+func (m *SendCustomMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageRequest) ProtoMessage()    {}
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{72}
 }
-func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
+func (m *SendCustomMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageRequest.Unmarshal(m, b)
 }
-func (m *CloseStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseStatusUpdate.Marshal(b, m, deterministic)
+func (m *SendCustomMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageRequest.Marshal(b, m, deterministic)
 }
-func (dst *CloseStatusUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseStatusUpdate.Merge(dst, src)
+func (dst *SendCustomMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageRequest.Merge(dst, src)
 }
-func (m *CloseStatusUpdate) XXX_Size() int {
-	return xxx_messageInfo_CloseStatusUpdate.Size(m)
+func (m *SendCustomMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageRequest.Size(m)
 }
-func (m *CloseStatusUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseStatusUpdate.DiscardUnknown(m)
+func (m *SendCustomMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseStatusUpdate proto.InternalMessageInfo
+var xxx_messageInfo_SendCustomMessageRequest proto.InternalMessageInfo
 
-type isCloseStatusUpdate_Update interface {
-	isCloseStatusUpdate_Update()
+func (m *SendCustomMessageRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
 }
 
-type CloseStatusUpdate_ClosePending struct {
-	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,proto3,oneof"`
+func (m *SendCustomMessageRequest) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
 
-type CloseStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
+func (m *SendCustomMessageRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-type CloseStatusUpdate_ChanClose struct {
-	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,proto3,oneof"`
+type SendCustomMessageResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
+func (m *SendCustomMessageResponse) Reset()         { *m = SendCustomMessageResponse{} }
+func (m *SendCustomMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageResponse) ProtoMessage()    {}
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{73}
+}
+func (m *SendCustomMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageResponse.Unmarshal(m, b)
+}
+func (m *SendCustomMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageResponse.Merge(dst, src)
+}
+func (m *SendCustomMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageResponse.Size(m)
+}
+func (m *SendCustomMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageResponse.DiscardUnknown(m)
+}
 
-func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
+var xxx_messageInfo_SendCustomMessageResponse proto.InternalMessageInfo
 
-func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update() {}
+type SubscribeCustomMessagesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
-	if m != nil {
-		return m.Update
-	}
-	return nil
+func (m *SubscribeCustomMessagesRequest) Reset()         { *m = SubscribeCustomMessagesRequest{} }
+func (m *SubscribeCustomMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCustomMessagesRequest) ProtoMessage()    {}
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{74}
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeCustomMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.Merge(dst, src)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Size(m)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
-		return x.ClosePending
-	}
-	return nil
+var xxx_messageInfo_SubscribeCustomMessagesRequest proto.InternalMessageInfo
+
+type CustomMessage struct {
+	// / The 33-byte compressed public key of the peer that sent the message
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The message type
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The opaque payload of the message
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
-		return x.Confirmation
-	}
-	return nil
+func (m *CustomMessage) Reset()         { *m = CustomMessage{} }
+func (m *CustomMessage) String() string { return proto.CompactTextString(m) }
+func (*CustomMessage) ProtoMessage()    {}
+func (*CustomMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{75}
+}
+func (m *CustomMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomMessage.Unmarshal(m, b)
+}
+func (m *CustomMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomMessage.Marshal(b, m, deterministic)
+}
+func (dst *CustomMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomMessage.Merge(dst, src)
+}
+func (m *CustomMessage) XXX_Size() int {
+	return xxx_messageInfo_CustomMessage.Size(m)
+}
+func (m *CustomMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomMessage.DiscardUnknown(m)
 }
 
-func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
-	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
-		return x.ChanClose
+var xxx_messageInfo_CustomMessage proto.InternalMessageInfo
+
+func (m *CustomMessage) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
-		(*CloseStatusUpdate_ClosePending)(nil),
-		(*CloseStatusUpdate_Confirmation)(nil),
-		(*CloseStatusUpdate_ChanClose)(nil),
+func (m *CustomMessage) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
+	return 0
 }
 
-func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClosePending); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *CloseStatusUpdate_ChanClose:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanClose); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+func (m *CustomMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CloseStatusUpdate)
-	switch tag {
-	case 1: // update.close_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ClosePending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_close
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelCloseUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &CloseStatusUpdate_ChanClose{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CloseStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *CloseStatusUpdate_ClosePending:
-		s := proto.Size(x.ClosePending)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *CloseStatusUpdate_ChanClose:
-		s := proto.Size(x.ChanClose)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type PendingUpdate struct {
-	Txid                 []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,proto3" json:"output_index,omitempty"`
+type GetInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
-func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
-func (*PendingUpdate) ProtoMessage()    {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{68}
-}
-func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
+func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()    {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{76}
 }
-func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
+func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
 }
-func (dst *PendingUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingUpdate.Merge(dst, src)
+func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *PendingUpdate) XXX_Size() int {
-	return xxx_messageInfo_PendingUpdate.Size(m)
+func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
 }
-func (m *PendingUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
+func (m *GetInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInfoRequest.Size(m)
 }
-
-var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
-
-func (m *PendingUpdate) GetTxid() []byte {
-	if m != nil {
-		return m.Txid
-	}
-	return nil
+func (m *GetInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
 }
 
-func (m *PendingUpdate) GetOutputIndex() uint32 {
-	if m != nil {
-		return m.OutputIndex
-	}
-	return 0
-}
+var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
 
-type OpenChannelRequest struct {
-	// / The pubkey of the node to open a channel with
-	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
-	// / The hex encoded pubkey of the node to open a channel with
-	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string,proto3" json:"node_pubkey_string,omitempty"`
-	// / The number of satoshis the wallet should commit to the channel
-	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
-	// / The number of satoshis to push to the remote side as part of the initial commitment state
-	PushSat int64 `protobuf:"varint,5,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
-	// / The target number of blocks that the funding transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
-	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
-	// / Whether this channel should be private, not announced to the greater network.
-	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
-	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
-	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
-	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
-	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
-	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
-	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
-	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
-	// / The number of satoshis we require the remote peer to reserve.
-	RemoteChanReserveSat int64    `protobuf:"varint,13,opt,name=remote_chan_reserve_sat,proto3" json:"remote_chan_reserve_sat,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetInfoResponse struct {
+	// / The identity pubkey of the current node.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey,proto3" json:"identity_pubkey,omitempty"`
+	// / If applicable, the alias of the current node, e.g. "bob"
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// / Number of pending channels
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
+	// / Number of active channels
+	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels,proto3" json:"num_active_channels,omitempty"`
+	// / Number of peers
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers,proto3" json:"num_peers,omitempty"`
+	// / The node's current view of the height of the best block
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height,proto3" json:"block_height,omitempty"`
+	// / The node's current view of the hash of the best block
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	// / Whether the wallet's view is synced to the main chain
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain,proto3" json:"synced_to_chain,omitempty"`
+	// / Whether the current node is connected to testnet
+	Testnet bool `protobuf:"varint,10,opt,name=testnet,proto3" json:"testnet,omitempty"`
+	// / A list of active chains the node is connected to
+	Chains []string `protobuf:"bytes,11,rep,name=chains,proto3" json:"chains,omitempty"`
+	// / The URIs of the current node.
+	Uris []string `protobuf:"bytes,12,rep,name=uris,proto3" json:"uris,omitempty"`
+	// / Timestamp of the block best known to the wallet
+	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp,proto3" json:"best_header_timestamp,omitempty"`
+	// / The version of the LND software that the node is running.
+	Version string `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`
+	// / Number of inactive channels
+	NumInactiveChannels uint32 `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
+	// / The features advertised within our node announcement
+	Features             []*Feature `protobuf:"bytes,16,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
-func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*OpenChannelRequest) ProtoMessage()    {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{69}
+func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()    {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{77}
 }
-func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
+func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
 }
-func (m *OpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenChannelRequest.Marshal(b, m, deterministic)
+func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
 }
-func (dst *OpenChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenChannelRequest.Merge(dst, src)
+func (dst *GetInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoResponse.Merge(dst, src)
 }
-func (m *OpenChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_OpenChannelRequest.Size(m)
+func (m *GetInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInfoResponse.Size(m)
 }
-func (m *OpenChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenChannelRequest.DiscardUnknown(m)
+func (m *GetInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenChannelRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
 
-func (m *OpenChannelRequest) GetNodePubkey() []byte {
+func (m *GetInfoResponse) GetIdentityPubkey() string {
 	if m != nil {
-		return m.NodePubkey
+		return m.IdentityPubkey
 	}
-	return nil
+	return ""
 }
 
-func (m *OpenChannelRequest) GetNodePubkeyString() string {
+func (m *GetInfoResponse) GetAlias() string {
 	if m != nil {
-		return m.NodePubkeyString
+		return m.Alias
 	}
 	return ""
 }
 
-func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
 	if m != nil {
-		return m.LocalFundingAmount
+		return m.NumPendingChannels
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPushSat() int64 {
+func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
 	if m != nil {
-		return m.PushSat
+		return m.NumActiveChannels
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetTargetConf() int32 {
+func (m *GetInfoResponse) GetNumPeers() uint32 {
 	if m != nil {
-		return m.TargetConf
+		return m.NumPeers
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetSatPerByte() int64 {
+func (m *GetInfoResponse) GetBlockHeight() uint32 {
 	if m != nil {
-		return m.SatPerByte
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *OpenChannelRequest) GetPrivate() bool {
+func (m *GetInfoResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Private
+		return m.BlockHash
 	}
-	return false
+	return ""
 }
 
-func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+func (m *GetInfoResponse) GetSyncedToChain() bool {
 	if m != nil {
-		return m.MinHtlcMsat
+		return m.SyncedToChain
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+func (m *GetInfoResponse) GetTestnet() bool {
 	if m != nil {
-		return m.RemoteCsvDelay
+		return m.Testnet
 	}
-	return 0
+	return false
 }
 
-func (m *OpenChannelRequest) GetMinConfs() int32 {
+func (m *GetInfoResponse) GetChains() []string {
 	if m != nil {
-		return m.MinConfs
+		return m.Chains
 	}
-	return 0
+	return nil
 }
 
-func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+func (m *GetInfoResponse) GetUris() []string {
 	if m != nil {
-		return m.SpendUnconfirmed
+		return m.Uris
 	}
-	return false
+	return nil
 }
 
-func (m *OpenChannelRequest) GetRemoteChanReserveSat() int64 {
+func (m *GetInfoResponse) GetBestHeaderTimestamp() int64 {
 	if m != nil {
-		return m.RemoteChanReserveSat
+		return m.BestHeaderTimestamp
 	}
 	return 0
 }
 
-type OpenStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
-	//	*OpenStatusUpdate_ChanPending
-	//	*OpenStatusUpdate_Confirmation
-	//	*OpenStatusUpdate_ChanOpen
-	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *GetInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetNumInactiveChannels() uint32 {
+	if m != nil {
+		return m.NumInactiveChannels
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetFeatures() []*Feature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type GetBackupRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBackupRequest) Reset()         { *m = GetBackupRequest{} }
+func (m *GetBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBackupRequest) ProtoMessage()    {}
+func (*GetBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{78}
+}
+func (m *GetBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBackupRequest.Unmarshal(m, b)
+}
+func (m *GetBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBackupRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBackupRequest.Merge(dst, src)
+}
+func (m *GetBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBackupRequest.Size(m)
+}
+func (m *GetBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBackupRequest proto.InternalMessageInfo
+
+type GetBackupResponse struct {
+	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBackupResponse) Reset()         { *m = GetBackupResponse{} }
+func (m *GetBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBackupResponse) ProtoMessage()    {}
+func (*GetBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{79}
+}
+func (m *GetBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBackupResponse.Unmarshal(m, b)
+}
+func (m *GetBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBackupResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBackupResponse.Merge(dst, src)
+}
+func (m *GetBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBackupResponse.Size(m)
+}
+func (m *GetBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBackupResponse proto.InternalMessageInfo
+
+func (m *GetBackupResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type ConfirmationUpdate struct {
+	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()    {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{80}
+}
+func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
+}
+func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmationUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationUpdate.Merge(dst, src)
+}
+func (m *ConfirmationUpdate) XXX_Size() int {
+	return xxx_messageInfo_ConfirmationUpdate.Size(m)
+}
+func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
+
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
+	if m != nil {
+		return m.BlockSha
+	}
+	return nil
+}
+
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+	if m != nil {
+		return m.NumConfsLeft
+	}
+	return 0
+}
+
+type ChannelOpenUpdate struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()    {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{81}
+}
+func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
+}
+func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOpenUpdate.Merge(dst, src)
+}
+func (m *ChannelOpenUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
+}
+func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
+
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type ChannelCloseUpdate struct {
+	ClosingTxid []byte `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success     bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// / The fee paid by the closing transaction in satoshis, only set for cooperative closures.
+	FeeSat               int64    `protobuf:"varint,3,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()    {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{82}
+}
+func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+}
+func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseUpdate.Merge(dst, src)
+}
+func (m *ChannelCloseUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+}
+func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+	if m != nil {
+		return m.ClosingTxid
+	}
+	return nil
+}
+
+func (m *ChannelCloseUpdate) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ChannelCloseUpdate) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+type CloseChannelRequest struct {
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// / The target number of blocks that the closure transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// / An optional address to send our funds to in a cooperative closure. If unset, a fresh address of the wallet is used.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	// / The maximum fee rate in sat/byte the cooperative closure transaction may pay. If unset, no limit is imposed.
+	MaxSatPerByte        int64    `protobuf:"varint,6,opt,name=max_sat_per_byte,json=maxSatPerByte,proto3" json:"max_sat_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()    {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{83}
+}
+func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
+}
+func (m *CloseChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *CloseChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseChannelRequest.Merge(dst, src)
+}
+func (m *CloseChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseChannelRequest.Size(m)
+}
+func (m *CloseChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseChannelRequest proto.InternalMessageInfo
+
+func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *CloseChannelRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CloseChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
+	if m != nil {
+		return m.DeliveryAddress
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetMaxSatPerByte() int64 {
+	if m != nil {
+		return m.MaxSatPerByte
+	}
+	return 0
+}
+
+type CloseStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*CloseStatusUpdate_ClosePending
+	//	*CloseStatusUpdate_Confirmation
+	//	*CloseStatusUpdate_ChanClose
+	Update               isCloseStatusUpdate_Update `protobuf_oneof:"update"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
+func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*CloseStatusUpdate) ProtoMessage()    {}
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{84}
+}
+func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
+}
+func (m *CloseStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseStatusUpdate.Marshal(b, m, deterministic)
+}
+func (dst *CloseStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseStatusUpdate.Merge(dst, src)
+}
+func (m *CloseStatusUpdate) XXX_Size() int {
+	return xxx_messageInfo_CloseStatusUpdate.Size(m)
+}
+func (m *CloseStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseStatusUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseStatusUpdate proto.InternalMessageInfo
+
+type isCloseStatusUpdate_Update interface {
+	isCloseStatusUpdate_Update()
+}
+
+type CloseStatusUpdate_ClosePending struct {
+	ClosePending *PendingUpdate `protobuf:"bytes,1,opt,name=close_pending,proto3,oneof"`
+}
+
+type CloseStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
+}
+
+type CloseStatusUpdate_ChanClose struct {
+	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,proto3,oneof"`
+}
+
+func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
+
+func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
+
+func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update() {}
+
+func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *CloseStatusUpdate) GetClosePending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ClosePending); ok {
+		return x.ClosePending
+	}
+	return nil
+}
+
+func (m *CloseStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_Confirmation); ok {
+		return x.Confirmation
+	}
+	return nil
+}
+
+func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_ChanClose); ok {
+		return x.ChanClose
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
+		(*CloseStatusUpdate_ClosePending)(nil),
+		(*CloseStatusUpdate_Confirmation)(nil),
+		(*CloseStatusUpdate_ChanClose)(nil),
+	}
+}
+
+func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosePending); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *CloseStatusUpdate_ChanClose:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanClose); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CloseStatusUpdate)
+	switch tag {
+	case 1: // update.close_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ClosePending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_close
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_ChanClose{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CloseStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *CloseStatusUpdate_ClosePending:
+		s := proto.Size(x.ClosePending)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CloseStatusUpdate_ChanClose:
+		s := proto.Size(x.ChanClose)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type PendingUpdate struct {
+	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,proto3" json:"output_index,omitempty"`
+	// / The fee paid by the transaction in satoshis, only set for cooperative closures.
+	FeeSat               int64    `protobuf:"varint,3,opt,name=fee_sat,proto3" json:"fee_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()    {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{85}
+}
+func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
+}
+func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
+}
+func (dst *PendingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdate.Merge(dst, src)
+}
+func (m *PendingUpdate) XXX_Size() int {
+	return xxx_messageInfo_PendingUpdate.Size(m)
+}
+func (m *PendingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
+
+func (m *PendingUpdate) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+type OpenChannelRequest struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hex encoded pubkey of the node to open a channel with
+	NodePubkeyString string `protobuf:"bytes,3,opt,name=node_pubkey_string,proto3" json:"node_pubkey_string,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,4,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,5,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,6,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,7,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,9,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// / The number of satoshis we require the remote peer to reserve.
+	RemoteChanReserveSat int64 `protobuf:"varint,13,opt,name=remote_chan_reserve_sat,proto3" json:"remote_chan_reserve_sat,omitempty"`
+	// / Whether the remote peer should be asked to contribute funds to the channel as well. Can't be combined with a push amount.
+	DualFund bool `protobuf:"varint,14,opt,name=dual_fund,proto3" json:"dual_fund,omitempty"`
+	// *
+	// A funding shim instructing the wallet not to fund the channel itself. The
+	// funding transaction is instead provided by the caller through the
+	// FundingStateStep call.
+	FundingShim          *FundingShim `protobuf:"bytes,15,opt,name=funding_shim,proto3" json:"funding_shim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
+func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenChannelRequest) ProtoMessage()    {}
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{86}
+}
+func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
+}
+func (m *OpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *OpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenChannelRequest.Merge(dst, src)
+}
+func (m *OpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenChannelRequest.Size(m)
+}
+func (m *OpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenChannelRequest proto.InternalMessageInfo
+
+func (m *OpenChannelRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *OpenChannelRequest) GetNodePubkeyString() string {
+	if m != nil {
+		return m.NodePubkeyString
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetRemoteChanReserveSat() int64 {
+	if m != nil {
+		return m.RemoteChanReserveSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetDualFund() bool {
+	if m != nil {
+		return m.DualFund
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetFundingShim() *FundingShim {
+	if m != nil {
+		return m.FundingShim
+	}
+	return nil
+}
+
+type OpenStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
+	//	*OpenStatusUpdate_ChanPending
+	//	*OpenStatusUpdate_Confirmation
+	//	*OpenStatusUpdate_ChanOpen
+	//	*OpenStatusUpdate_PsbtFund
+	Update               isOpenStatusUpdate_Update `protobuf_oneof:"update"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{70}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{87}
+}
+func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
+}
+func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
+}
+func (dst *OpenStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenStatusUpdate.Merge(dst, src)
+}
+func (m *OpenStatusUpdate) XXX_Size() int {
+	return xxx_messageInfo_OpenStatusUpdate.Size(m)
+}
+func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
+
+type isOpenStatusUpdate_Update interface {
+	isOpenStatusUpdate_Update()
+}
+
+type OpenStatusUpdate_ChanPending struct {
+	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
+}
+
+type OpenStatusUpdate_Confirmation struct {
+	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
+}
+
+type OpenStatusUpdate_ChanOpen struct {
+	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
+}
+
+type OpenStatusUpdate_PsbtFund struct {
+	PsbtFund *ReadyForPsbtFunding `protobuf:"bytes,4,opt,name=psbt_fund,proto3,oneof"`
+}
+
+func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
+
+func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
+
+func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
+
+func (*OpenStatusUpdate_PsbtFund) isOpenStatusUpdate_Update() {}
+
+func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
+		return x.ChanPending
+	}
+	return nil
+}
+
+func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
+		return x.Confirmation
+	}
+	return nil
+}
+
+func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
+		return x.ChanOpen
+	}
+	return nil
+}
+
+func (m *OpenStatusUpdate) GetPsbtFund() *ReadyForPsbtFunding {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_PsbtFund); ok {
+		return x.PsbtFund
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
+		(*OpenStatusUpdate_ChanPending)(nil),
+		(*OpenStatusUpdate_Confirmation)(nil),
+		(*OpenStatusUpdate_ChanOpen)(nil),
+		(*OpenStatusUpdate_PsbtFund)(nil),
+	}
+}
+
+func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPending); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_Confirmation:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirmation); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_ChanOpen:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanOpen); err != nil {
+			return err
+		}
+	case *OpenStatusUpdate_PsbtFund:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFund); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OpenStatusUpdate)
+	switch tag {
+	case 1: // update.chan_pending
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanPending{msg}
+		return true, err
+	case 2: // update.confirmation
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfirmationUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_Confirmation{msg}
+		return true, err
+	case 3: // update.chan_open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelOpenUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_ChanOpen{msg}
+		return true, err
+	case 4: // update.psbt_fund
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReadyForPsbtFunding)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_PsbtFund{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OpenStatusUpdate)
+	// update
+	switch x := m.Update.(type) {
+	case *OpenStatusUpdate_ChanPending:
+		s := proto.Size(x.ChanPending)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_Confirmation:
+		s := proto.Size(x.Confirmation)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_ChanOpen:
+		s := proto.Size(x.ChanOpen)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OpenStatusUpdate_PsbtFund:
+		s := proto.Size(x.PsbtFund)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ReadyForPsbtFunding struct {
+	// / The address of the 2-of-2 multisig output the funding transaction must pay to.
+	FundingAddress string `protobuf:"bytes,1,opt,name=funding_address,proto3" json:"funding_address,omitempty"`
+	// / The exact amount in satoshis the funding output must carry.
+	FundingAmount int64 `protobuf:"varint,2,opt,name=funding_amount,proto3" json:"funding_amount,omitempty"`
+	// / A raw PSBT containing solely the funding output.
+	Psbt []byte `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// / The pending channel ID to reference the channel with within FundingStateStep calls.
+	PendingChanId        []byte   `protobuf:"bytes,4,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyForPsbtFunding) Reset()         { *m = ReadyForPsbtFunding{} }
+func (m *ReadyForPsbtFunding) String() string { return proto.CompactTextString(m) }
+func (*ReadyForPsbtFunding) ProtoMessage()    {}
+func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{88}
+}
+func (m *ReadyForPsbtFunding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyForPsbtFunding.Unmarshal(m, b)
+}
+func (m *ReadyForPsbtFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyForPsbtFunding.Marshal(b, m, deterministic)
+}
+func (dst *ReadyForPsbtFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyForPsbtFunding.Merge(dst, src)
+}
+func (m *ReadyForPsbtFunding) XXX_Size() int {
+	return xxx_messageInfo_ReadyForPsbtFunding.Size(m)
+}
+func (m *ReadyForPsbtFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyForPsbtFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyForPsbtFunding proto.InternalMessageInfo
+
+func (m *ReadyForPsbtFunding) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
+func (m *ReadyForPsbtFunding) GetFundingAmount() int64 {
+	if m != nil {
+		return m.FundingAmount
+	}
+	return 0
+}
+
+func (m *ReadyForPsbtFunding) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *ReadyForPsbtFunding) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+type PsbtShim struct {
+	// *
+	// An optional 32 byte pending channel ID of the channel to open. It's used to
+	// reference the channel within subsequent FundingStateStep calls. If not set,
+	// a random one is generated, which is returned within the psbt_fund update.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// If set, the funding transaction won't be published by the wallet once the
+	// funding workflow completes. This allows the funding transaction to be
+	// constructed, and published, fully externally.
+	NoPublish            bool     `protobuf:"varint,2,opt,name=no_publish,proto3" json:"no_publish,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PsbtShim) Reset()         { *m = PsbtShim{} }
+func (m *PsbtShim) String() string { return proto.CompactTextString(m) }
+func (*PsbtShim) ProtoMessage()    {}
+func (*PsbtShim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{89}
+}
+func (m *PsbtShim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PsbtShim.Unmarshal(m, b)
+}
+func (m *PsbtShim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PsbtShim.Marshal(b, m, deterministic)
+}
+func (dst *PsbtShim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsbtShim.Merge(dst, src)
+}
+func (m *PsbtShim) XXX_Size() int {
+	return xxx_messageInfo_PsbtShim.Size(m)
+}
+func (m *PsbtShim) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsbtShim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PsbtShim proto.InternalMessageInfo
+
+func (m *PsbtShim) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *PsbtShim) GetNoPublish() bool {
+	if m != nil {
+		return m.NoPublish
+	}
+	return false
+}
+
+type FundingShim struct {
+	// / A shim for funding the channel from an externally signed PSBT.
+	PsbtShim             *PsbtShim `protobuf:"bytes,1,opt,name=psbt_shim,proto3" json:"psbt_shim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FundingShim) Reset()         { *m = FundingShim{} }
+func (m *FundingShim) String() string { return proto.CompactTextString(m) }
+func (*FundingShim) ProtoMessage()    {}
+func (*FundingShim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{90}
+}
+func (m *FundingShim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingShim.Unmarshal(m, b)
+}
+func (m *FundingShim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingShim.Marshal(b, m, deterministic)
+}
+func (dst *FundingShim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingShim.Merge(dst, src)
+}
+func (m *FundingShim) XXX_Size() int {
+	return xxx_messageInfo_FundingShim.Size(m)
+}
+func (m *FundingShim) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingShim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingShim proto.InternalMessageInfo
+
+func (m *FundingShim) GetPsbtShim() *PsbtShim {
+	if m != nil {
+		return m.PsbtShim
+	}
+	return nil
+}
+
+type FundingShimCancel struct {
+	// / The pending channel ID of the channel to cancel the funding workflow of.
+	PendingChanId        []byte   `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingShimCancel) Reset()         { *m = FundingShimCancel{} }
+func (m *FundingShimCancel) String() string { return proto.CompactTextString(m) }
+func (*FundingShimCancel) ProtoMessage()    {}
+func (*FundingShimCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{91}
+}
+func (m *FundingShimCancel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingShimCancel.Unmarshal(m, b)
+}
+func (m *FundingShimCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingShimCancel.Marshal(b, m, deterministic)
+}
+func (dst *FundingShimCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingShimCancel.Merge(dst, src)
+}
+func (m *FundingShimCancel) XXX_Size() int {
+	return xxx_messageInfo_FundingShimCancel.Size(m)
+}
+func (m *FundingShimCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingShimCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingShimCancel proto.InternalMessageInfo
+
+func (m *FundingShimCancel) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+type FundingPsbtVerify struct {
+	// / The funded but not yet signed PSBT that pays to the funding output.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	// / The pending channel ID of the channel the PSBT funds.
+	PendingChanId        []byte   `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingPsbtVerify) Reset()         { *m = FundingPsbtVerify{} }
+func (m *FundingPsbtVerify) String() string { return proto.CompactTextString(m) }
+func (*FundingPsbtVerify) ProtoMessage()    {}
+func (*FundingPsbtVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{92}
+}
+func (m *FundingPsbtVerify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingPsbtVerify.Unmarshal(m, b)
+}
+func (m *FundingPsbtVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingPsbtVerify.Marshal(b, m, deterministic)
+}
+func (dst *FundingPsbtVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingPsbtVerify.Merge(dst, src)
+}
+func (m *FundingPsbtVerify) XXX_Size() int {
+	return xxx_messageInfo_FundingPsbtVerify.Size(m)
+}
+func (m *FundingPsbtVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingPsbtVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingPsbtVerify proto.InternalMessageInfo
+
+func (m *FundingPsbtVerify) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+func (m *FundingPsbtVerify) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+type FundingPsbtFinalize struct {
+	// *
+	// The signed PSBT that pays to the funding output. Its transaction must match
+	// the PSBT verified before.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// / The pending channel ID of the channel the PSBT funds.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// The final, fully signed funding transaction, as an alternative to the
+	// signed_psbt. Must be used if the funding transaction has been constructed
+	// fully externally.
+	FinalRawTx           []byte   `protobuf:"bytes,3,opt,name=final_raw_tx,proto3" json:"final_raw_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingPsbtFinalize) Reset()         { *m = FundingPsbtFinalize{} }
+func (m *FundingPsbtFinalize) String() string { return proto.CompactTextString(m) }
+func (*FundingPsbtFinalize) ProtoMessage()    {}
+func (*FundingPsbtFinalize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{93}
+}
+func (m *FundingPsbtFinalize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingPsbtFinalize.Unmarshal(m, b)
+}
+func (m *FundingPsbtFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingPsbtFinalize.Marshal(b, m, deterministic)
+}
+func (dst *FundingPsbtFinalize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingPsbtFinalize.Merge(dst, src)
+}
+func (m *FundingPsbtFinalize) XXX_Size() int {
+	return xxx_messageInfo_FundingPsbtFinalize.Size(m)
+}
+func (m *FundingPsbtFinalize) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingPsbtFinalize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingPsbtFinalize proto.InternalMessageInfo
+
+func (m *FundingPsbtFinalize) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *FundingPsbtFinalize) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *FundingPsbtFinalize) GetFinalRawTx() []byte {
+	if m != nil {
+		return m.FinalRawTx
+	}
+	return nil
+}
+
+type FundingTransitionMsg struct {
+	// / Cancels the funding workflow of a channel opened with a funding shim.
+	ShimCancel *FundingShimCancel `protobuf:"bytes,1,opt,name=shim_cancel,proto3" json:"shim_cancel,omitempty"`
+	// / Verifies a funded PSBT against the funding output of the channel.
+	PsbtVerify *FundingPsbtVerify `protobuf:"bytes,2,opt,name=psbt_verify,proto3" json:"psbt_verify,omitempty"`
+	// / Hands over the signed funding transaction, resuming the funding workflow.
+	PsbtFinalize         *FundingPsbtFinalize `protobuf:"bytes,3,opt,name=psbt_finalize,proto3" json:"psbt_finalize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FundingTransitionMsg) Reset()         { *m = FundingTransitionMsg{} }
+func (m *FundingTransitionMsg) String() string { return proto.CompactTextString(m) }
+func (*FundingTransitionMsg) ProtoMessage()    {}
+func (*FundingTransitionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{94}
+}
+func (m *FundingTransitionMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingTransitionMsg.Unmarshal(m, b)
+}
+func (m *FundingTransitionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingTransitionMsg.Marshal(b, m, deterministic)
+}
+func (dst *FundingTransitionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingTransitionMsg.Merge(dst, src)
+}
+func (m *FundingTransitionMsg) XXX_Size() int {
+	return xxx_messageInfo_FundingTransitionMsg.Size(m)
+}
+func (m *FundingTransitionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingTransitionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingTransitionMsg proto.InternalMessageInfo
+
+func (m *FundingTransitionMsg) GetShimCancel() *FundingShimCancel {
+	if m != nil {
+		return m.ShimCancel
+	}
+	return nil
+}
+
+func (m *FundingTransitionMsg) GetPsbtVerify() *FundingPsbtVerify {
+	if m != nil {
+		return m.PsbtVerify
+	}
+	return nil
+}
+
+func (m *FundingTransitionMsg) GetPsbtFinalize() *FundingPsbtFinalize {
+	if m != nil {
+		return m.PsbtFinalize
+	}
+	return nil
+}
+
+type FundingStateStepResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingStateStepResp) Reset()         { *m = FundingStateStepResp{} }
+func (m *FundingStateStepResp) String() string { return proto.CompactTextString(m) }
+func (*FundingStateStepResp) ProtoMessage()    {}
+func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{95}
+}
+func (m *FundingStateStepResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingStateStepResp.Unmarshal(m, b)
+}
+func (m *FundingStateStepResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingStateStepResp.Marshal(b, m, deterministic)
+}
+func (dst *FundingStateStepResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStateStepResp.Merge(dst, src)
+}
+func (m *FundingStateStepResp) XXX_Size() int {
+	return xxx_messageInfo_FundingStateStepResp.Size(m)
+}
+func (m *FundingStateStepResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStateStepResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStateStepResp proto.InternalMessageInfo
+
+type BatchOpenChannel struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat,proto3" json:"push_sat,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
+	// / The number of satoshis we require the remote peer to reserve.
+	RemoteChanReserveSat int64    `protobuf:"varint,7,opt,name=remote_chan_reserve_sat,proto3" json:"remote_chan_reserve_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOpenChannel) Reset()         { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()    {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{96}
+}
+func (m *BatchOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannel.Unmarshal(m, b)
+}
+func (m *BatchOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannel.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannel.Merge(dst, src)
+}
+func (m *BatchOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannel.Size(m)
+}
+func (m *BatchOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannel proto.InternalMessageInfo
+
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteChanReserveSat() int64 {
+	if m != nil {
+		return m.RemoteChanReserveSat
+	}
+	return 0
+}
+
+type BatchOpenChannelRequest struct {
+	// / The list of channels to open.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,4,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed     bool     `protobuf:"varint,5,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOpenChannelRequest) Reset()         { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()    {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{97}
+}
+func (m *BatchOpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelRequest.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelRequest.Merge(dst, src)
+}
+func (m *BatchOpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelRequest.Size(m)
+}
+func (m *BatchOpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelRequest proto.InternalMessageInfo
+
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+type BatchOpenChannelResponse struct {
+	// / The pending channels of the batch, in the order they were requested.
+	PendingChannels      []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels,proto3" json:"pending_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BatchOpenChannelResponse) Reset()         { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()    {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{98}
+}
+func (m *BatchOpenChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelResponse.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelResponse.Merge(dst, src)
+}
+func (m *BatchOpenChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelResponse.Size(m)
+}
+func (m *BatchOpenChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelResponse proto.InternalMessageInfo
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
+type PendingHTLC struct {
+	// / The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// / The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The next block height at which we can spend the current stage
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	// *
+	// The number of blocks remaining until the current stage can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
+	// / Indicates whether the htlc is in its first or second stage of recovery
+	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()    {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{99}
+}
+func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
+}
+func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
+}
+func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingHTLC.Merge(dst, src)
+}
+func (m *PendingHTLC) XXX_Size() int {
+	return xxx_messageInfo_PendingHTLC.Size(m)
+}
+func (m *PendingHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
+
+func (m *PendingHTLC) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *PendingHTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetStage() uint32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+type PendingChannelsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
+
+func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()    {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{100}
 }
-func (m *OpenStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenStatusUpdate.Marshal(b, m, deterministic)
+func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
 }
-func (dst *OpenStatusUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenStatusUpdate.Merge(dst, src)
+func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
 }
-func (m *OpenStatusUpdate) XXX_Size() int {
-	return xxx_messageInfo_OpenStatusUpdate.Size(m)
+func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
 }
-func (m *OpenStatusUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenStatusUpdate.DiscardUnknown(m)
+func (m *PendingChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+}
+func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenStatusUpdate proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+
+type PendingChannelsResponse struct {
+	// / The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
+	// / Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
+	// / Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
+	// / Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
+	// / Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()    {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101}
+}
+func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
+}
+func (m *PendingChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse.Size(m)
+}
+func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+	if m != nil {
+		return m.PendingOpenChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
+	if m != nil {
+		return m.PendingClosingChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+	if m != nil {
+		return m.PendingForceClosingChannels
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+	if m != nil {
+		return m.WaitingCloseChannels
+	}
+	return nil
+}
+
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	Capacity      int64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	// / This minimum satoshies this node is required to reseve in
+	// / its balance
+	LocalChanReserve int64 `protobuf:"varint,6,opt,name=local_chan_reserve,proto3" json:"local_chan_reserve,omitempty"`
+	// / This minimum satoshies the other node is required to reseve in
+	// / its balance
+	RemoteChanReserve    int64    `protobuf:"varint,7,opt,name=remote_chan_reserve,proto3" json:"remote_chan_reserve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101, 0}
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
+	}
+	return ""
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetLocalChanReserve() int64 {
+	if m != nil {
+		return m.LocalChanReserve
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteChanReserve() int64 {
+	if m != nil {
+		return m.RemoteChanReserve
+	}
+	return 0
+}
+
+type PendingChannelsResponse_PendingOpenChannel struct {
+	// / The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The height at which this channel will be confirmed
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of
+	// commitment transactions. The fee amount is persisted with the channel
+	// in order to allow the fee amount to be removed and recalculated with
+	// each channel state update, including updates that happen after a system
+	// restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will
+	// pay at all times, for both the funding transaction and commitment
+	// transaction. This value can later be updated once the channel is open.
+	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101, 1}
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
 
-type isOpenStatusUpdate_Update interface {
-	isOpenStatusUpdate_Update()
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+	if m != nil {
+		return m.ConfirmationHeight
+	}
+	return 0
 }
 
-type OpenStatusUpdate_ChanPending struct {
-	ChanPending *PendingUpdate `protobuf:"bytes,1,opt,name=chan_pending,proto3,oneof"`
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
+	if m != nil {
+		return m.CommitFee
+	}
+	return 0
 }
 
-type OpenStatusUpdate_Confirmation struct {
-	Confirmation *ConfirmationUpdate `protobuf:"bytes,2,opt,name=confirmation,proto3,oneof"`
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
+	if m != nil {
+		return m.CommitWeight
+	}
+	return 0
 }
 
-type OpenStatusUpdate_ChanOpen struct {
-	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,proto3,oneof"`
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
+	if m != nil {
+		return m.FeePerKw
+	}
+	return 0
 }
 
-func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update() {}
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	// / The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The balance in satoshis encumbered in this channel
+	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101, 2}
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
+}
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
+}
 
-func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update() {}
+var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
 
-func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Update
+		return m.Channel
 	}
 	return nil
 }
 
-func (m *OpenStatusUpdate) GetChanPending() *PendingUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanPending); ok {
-		return x.ChanPending
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+	if m != nil {
+		return m.LimboBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *OpenStatusUpdate) GetConfirmation() *ConfirmationUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_Confirmation); ok {
-		return x.Confirmation
-	}
-	return nil
+type PendingChannelsResponse_ClosedChannel struct {
+	// / The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
-	if x, ok := m.GetUpdate().(*OpenStatusUpdate_ChanOpen); ok {
-		return x.ChanOpen
-	}
-	return nil
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101, 3}
 }
-
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
-		(*OpenStatusUpdate_ChanPending)(nil),
-		(*OpenStatusUpdate_Confirmation)(nil),
-		(*OpenStatusUpdate_ChanOpen)(nil),
-	}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
 }
-
-func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPending); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_Confirmation:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Confirmation); err != nil {
-			return err
-		}
-	case *OpenStatusUpdate_ChanOpen:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanOpen); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
-	}
-	return nil
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+}
+func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
 }
 
-func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*OpenStatusUpdate)
-	switch tag {
-	case 1: // update.chan_pending
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(PendingUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanPending{msg}
-		return true, err
-	case 2: // update.confirmation
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ConfirmationUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_Confirmation{msg}
-		return true, err
-	case 3: // update.chan_open
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelOpenUpdate)
-		err := b.DecodeMessage(msg)
-		m.Update = &OpenStatusUpdate_ChanOpen{msg}
-		return true, err
-	default:
-		return false, nil
+var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
 	}
+	return nil
 }
 
-func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*OpenStatusUpdate)
-	// update
-	switch x := m.Update.(type) {
-	case *OpenStatusUpdate_ChanPending:
-		s := proto.Size(x.ChanPending)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_Confirmation:
-		s := proto.Size(x.Confirmation)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *OpenStatusUpdate_ChanOpen:
-		s := proto.Size(x.ChanOpen)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+	if m != nil {
+		return m.ClosingTxid
 	}
-	return n
+	return ""
 }
 
-type PendingHTLC struct {
-	// / The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	// / The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// / The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// / The next block height at which we can spend the current stage
+type PendingChannelsResponse_ForceClosedChannel struct {
+	// / The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	// / The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	// / The height at which funds can be sweeped into the wallet
 	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	// *
-	// The number of blocks remaining until the current stage can be swept.
+	//
+	// Remaining # of blocks until the commitment output can be swept.
 	// Negative values indicate how many blocks have passed since becoming
 	// mature.
 	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / Indicates whether the htlc is in its first or second stage of recovery
-	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// / The total value of funds successfully recovered from this channel
+	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()    {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{71}
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
 }
-func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{101, 4}
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
 }
-func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingHTLC.Merge(dst, src)
+func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
 }
-func (m *PendingHTLC) XXX_Size() int {
-	return xxx_messageInfo_PendingHTLC.Size(m)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
 }
-func (m *PendingHTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
 
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Incoming
+		return m.Channel
 	}
-	return false
+	return nil
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.Amount
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.Outpoint
+		return m.LimboBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
 	if m != nil {
 		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
 	if m != nil {
 		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
 	if m != nil {
-		return m.Stage
+		return m.RecoveredBalance
 	}
 	return 0
 }
 
-type PendingChannelsRequest struct {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+	if m != nil {
+		return m.PendingHtlcs
+	}
+	return nil
+}
+
+type WalletBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()    {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{72}
+func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()    {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{102}
 }
-func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
+func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
 }
-func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
+func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
+func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
 }
-func (m *PendingChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+func (m *WalletBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceRequest.Size(m)
 }
-func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
+func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
 
-type PendingChannelsResponse struct {
-	// / The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
-	// / Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
-	// / Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
-	// / Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
-	// / Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()    {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73}
+func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()    {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{103}
 }
-func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
+func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
+func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
+func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
 }
-func (m *PendingChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse.Size(m)
+func (m *WalletBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceResponse.Size(m)
 }
-func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
+var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
-		return m.TotalLimboBalance
+		return m.TotalBalance
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
-	if m != nil {
-		return m.PendingOpenChannels
-	}
-	return nil
-}
-
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
-	if m != nil {
-		return m.PendingClosingChannels
-	}
-	return nil
-}
-
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
 	if m != nil {
-		return m.PendingForceClosingChannels
+		return m.ConfirmedBalance
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
 	if m != nil {
-		return m.WaitingCloseChannels
+		return m.UnconfirmedBalance
 	}
-	return nil
+	return 0
 }
 
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub string `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
-	ChannelPoint  string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	Capacity      int64  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	LocalBalance  int64  `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	RemoteBalance int64  `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
-	// / This minimum satoshies this node is required to reseve in
-	// / its balance
-	LocalChanReserve int64 `protobuf:"varint,6,opt,name=local_chan_reserve,proto3" json:"local_chan_reserve,omitempty"`
-	// / This minimum satoshies the other node is required to reseve in
-	// / its balance
-	RemoteChanReserve    int64    `protobuf:"varint,7,opt,name=remote_chan_reserve,proto3" json:"remote_chan_reserve,omitempty"`
+type ChannelBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73, 0}
+func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()    {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{104}
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
+func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+func (m *ChannelBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
+var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
-	if m != nil {
-		return m.RemoteNodePub
-	}
-	return ""
+type ChannelBalanceResponse struct {
+	// / Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// / Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
-	if m != nil {
-		return m.ChannelPoint
-	}
-	return ""
+func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()    {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{105}
 }
-
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
 }
-
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
-	if m != nil {
-		return m.LocalBalance
-	}
-	return 0
+func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
+}
+func (m *ChannelBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+}
+func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
-	if m != nil {
-		return m.RemoteBalance
-	}
-	return 0
-}
+var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalChanReserve() int64 {
+func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
-		return m.LocalChanReserve
+		return m.Balance
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteChanReserve() int64 {
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
 	if m != nil {
-		return m.RemoteChanReserve
+		return m.PendingOpenBalance
 	}
 	return 0
 }
 
-type PendingChannelsResponse_PendingOpenChannel struct {
-	// / The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
+type QueryRoutesRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// / The max number of routes to return.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
 	// *
-	// The amount calculated to be paid in fees for the current set of
-	// commitment transactions. The fee amount is persisted with the channel
-	// in order to allow the fee amount to be removed and recalculated with
-	// each channel state update, including updates that happen after a system
-	// restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
 	// *
-	// The required number of satoshis per kilo-weight that the requester will
-	// pay at all times, for both the funding transaction and commitment
-	// transaction. This value can later be updated once the channel is open.
-	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,6,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any hop may be used.
+	LastHopPubkey        []byte   `protobuf:"bytes,7,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73, 1}
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{106}
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesRequest.Size(m)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
-		return m.Channel
+		return m.PubKey
 	}
-	return nil
+	return ""
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+func (m *QueryRoutesRequest) GetAmt() int64 {
 	if m != nil {
-		return m.ConfirmationHeight
+		return m.Amt
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
+func (m *QueryRoutesRequest) GetNumRoutes() int32 {
 	if m != nil {
-		return m.CommitFee
+		return m.NumRoutes
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
 	if m != nil {
-		return m.CommitWeight
+		return m.FinalCltvDelta
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
 	if m != nil {
-		return m.FeePerKw
+		return m.FeeLimit
 	}
-	return 0
-}
-
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	// / The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The balance in satoshis encumbered in this channel
-	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73, 2}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
-}
-func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *QueryRoutesRequest) GetOutgoingChanId() uint64 {
 	if m != nil {
-		return m.Channel
+		return m.OutgoingChanId
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+func (m *QueryRoutesRequest) GetLastHopPubkey() []byte {
 	if m != nil {
-		return m.LimboBalance
+		return m.LastHopPubkey
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsResponse_ClosedChannel struct {
-	// / The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+type QueryRoutesResponse struct {
+	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73, 3}
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{107}
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
+func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesResponse.Size(m)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
-		return m.Channel
+		return m.Routes
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
-	if m != nil {
-		return m.ClosingTxid
-	}
-	return ""
-}
-
-type PendingChannelsResponse_ForceClosedChannel struct {
-	// / The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	// / The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	// / The height at which funds can be sweeped into the wallet
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	//
-	// Remaining # of blocks until the commitment output can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type Hop struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
+	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// An optional public key of the hop. If the public key is given, the payment
+	// can be executed without relying on a copy of the channel graph.
+	PubKey string `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// *
+	// If set to true, the hop is passed a TLV payload rather than the legacy
+	// fixed-size payload, which requires the node to signal support for TLV onion
+	// payloads.
+	TlvPayload           bool     `protobuf:"varint,9,opt,name=tlv_payload,proto3" json:"tlv_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
-}
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{73, 4}
-}
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{108}
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hop.Unmarshal(m, b)
 }
-func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+func (dst *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+func (m *Hop) XXX_Size() int {
+	return xxx_messageInfo_Hop.Size(m)
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
+var xxx_messageInfo_Hop proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *Hop) GetChanId() uint64 {
 	if m != nil {
-		return m.Channel
+		return m.ChanId
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+func (m *Hop) GetChanCapacity() int64 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.ChanCapacity
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+// Deprecated: Do not use.
+func (m *Hop) GetAmtToForward() int64 {
 	if m != nil {
-		return m.LimboBalance
+		return m.AmtToForward
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+// Deprecated: Do not use.
+func (m *Hop) GetFee() int64 {
 	if m != nil {
-		return m.MaturityHeight
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+func (m *Hop) GetExpiry() uint32 {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+func (m *Hop) GetAmtToForwardMsat() int64 {
 	if m != nil {
-		return m.RecoveredBalance
+		return m.AmtToForwardMsat
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
+func (m *Hop) GetFeeMsat() int64 {
 	if m != nil {
-		return m.PendingHtlcs
+		return m.FeeMsat
 	}
-	return nil
+	return 0
 }
 
-type WalletBalanceRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Hop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()    {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{74}
-}
-func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
-}
-func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
-}
-func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
-}
-func (m *WalletBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceRequest.Size(m)
-}
-func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
+func (m *Hop) GetTlvPayload() bool {
+	if m != nil {
+		return m.TlvPayload
+	}
+	return false
 }
 
-var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
-
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+// *
+// A path through the channel graph which runs over one or more channels in
+// succession. This struct carries all the information required to craft the
+// Sphinx onion packet, and send the payment along the first hop in the path. A
+// route is only selected as valid if all the channels have sufficient capacity to
+// carry the initial payment amount after fees are accounted for.
+type Route struct {
+	// *
+	// The cumulative (final) time lock across the entire route.  This is the CLTV
+	// value that should be extended to the first hop in the route. All other hops
+	// will decrement the time-lock as advertised, leaving enough time for all
+	// hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The sum of the fees paid at each hop within the final route.  In the case
+	// of a one-hop payment, this value will be zero as we don't need to pay a fee
+	// it ourself.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
+	// *
+	// The total amount of funds required to complete a payment over this route.
+	// This value includes the cumulative fees at each hop. As a result, the HTLC
+	// extended to the first-hop in the route will need to have at least this many
+	// satoshis, otherwise the route will fail at an intermediate node due to an
+	// insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
+	// *
+	// Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	// *
+	// The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	// *
+	// The total amount in millisatoshis.
+	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()    {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{75}
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{109}
 }
-func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
 }
-func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
 }
-func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
 }
-func (m *WalletBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceResponse.Size(m)
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
 }
-func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_Route proto.InternalMessageInfo
 
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
-		return m.TotalBalance
+		return m.TotalTimeLock
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+// Deprecated: Do not use.
+func (m *Route) GetTotalFees() int64 {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.TotalFees
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+// Deprecated: Do not use.
+func (m *Route) GetTotalAmt() int64 {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.TotalAmt
 	}
 	return 0
 }
 
-type ChannelBalanceRequest struct {
+func (m *Route) GetHops() []*Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *Route) GetTotalFeesMsat() int64 {
+	if m != nil {
+		return m.TotalFeesMsat
+	}
+	return 0
+}
+
+func (m *Route) GetTotalAmtMsat() int64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+type QueryMissionControlRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()    {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{76}
+func (m *QueryMissionControlRequest) Reset()         { *m = QueryMissionControlRequest{} }
+func (m *QueryMissionControlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissionControlRequest) ProtoMessage()    {}
+func (*QueryMissionControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{110}
 }
-func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
+func (m *QueryMissionControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissionControlRequest.Unmarshal(m, b)
 }
-func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
+func (m *QueryMissionControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissionControlRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
+func (dst *QueryMissionControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissionControlRequest.Merge(dst, src)
 }
-func (m *ChannelBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
+func (m *QueryMissionControlRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryMissionControlRequest.Size(m)
 }
-func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
+func (m *QueryMissionControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissionControlRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryMissionControlRequest proto.InternalMessageInfo
 
-type ChannelBalanceResponse struct {
-	// / Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// / Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
+type QueryMissionControlResponse struct {
+	// / The last failures of nodes as a whole.
+	Nodes []*NodeHistory `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// / The last results of pairs of nodes.
+	Pairs                []*PairHistory `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *QueryMissionControlResponse) Reset()         { *m = QueryMissionControlResponse{} }
+func (m *QueryMissionControlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissionControlResponse) ProtoMessage()    {}
+func (*QueryMissionControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{111}
+}
+func (m *QueryMissionControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissionControlResponse.Unmarshal(m, b)
+}
+func (m *QueryMissionControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissionControlResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryMissionControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissionControlResponse.Merge(dst, src)
+}
+func (m *QueryMissionControlResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryMissionControlResponse.Size(m)
+}
+func (m *QueryMissionControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissionControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissionControlResponse proto.InternalMessageInfo
+
+func (m *QueryMissionControlResponse) GetNodes() []*NodeHistory {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *QueryMissionControlResponse) GetPairs() []*PairHistory {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// / NodeHistory contains the last failure of a node as a whole.
+type NodeHistory struct {
+	// / The hex-encoded compressed public key of the node.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / The unix timestamp of the last failure localized to the node.
+	LastFailTime         int64    `protobuf:"varint,2,opt,name=last_fail_time,proto3" json:"last_fail_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()    {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{77}
+func (m *NodeHistory) Reset()         { *m = NodeHistory{} }
+func (m *NodeHistory) String() string { return proto.CompactTextString(m) }
+func (*NodeHistory) ProtoMessage()    {}
+func (*NodeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{112}
 }
-func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
+func (m *NodeHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeHistory.Unmarshal(m, b)
 }
-func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+func (m *NodeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeHistory.Marshal(b, m, deterministic)
 }
-func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
+func (dst *NodeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHistory.Merge(dst, src)
 }
-func (m *ChannelBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+func (m *NodeHistory) XXX_Size() int {
+	return xxx_messageInfo_NodeHistory.Size(m)
 }
-func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+func (m *NodeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_NodeHistory proto.InternalMessageInfo
 
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *NodeHistory) GetPubkey() string {
 	if m != nil {
-		return m.Balance
+		return m.Pubkey
 	}
-	return 0
+	return ""
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *NodeHistory) GetLastFailTime() int64 {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.LastFailTime
 	}
 	return 0
 }
 
-type QueryRoutesRequest struct {
-	// / The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// / The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
-	// / The max number of routes to return.
-	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
-	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+// / PairHistory contains the last result of forwarding between a pair of nodes.
+type PairHistory struct {
+	// / The hex-encoded compressed public key of the forwarding node.
+	NodeFrom string `protobuf:"bytes,1,opt,name=node_from,proto3" json:"node_from,omitempty"`
+	// / The hex-encoded compressed public key of the node forwarded to.
+	NodeTo string `protobuf:"bytes,2,opt,name=node_to,proto3" json:"node_to,omitempty"`
+	// / The unix timestamp of the last result.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The amount of the last result in millisatoshis.
+	AmtMsat int64 `protobuf:"varint,4,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	// / Whether the last result was a success.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
 	// *
-	// The maximum number of satoshis that will be paid as a fee of the payment.
-	// This value can be represented either as a percentage of the amount being
-	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	// send the payment.
-	FeeLimit             *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	// The current estimated success probability of forwarding the amount of the
+	// last result between the pair of nodes.
+	SuccessProb          float64  `protobuf:"fixed64,6,opt,name=success_prob,proto3" json:"success_prob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()    {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{78}
+func (m *PairHistory) Reset()         { *m = PairHistory{} }
+func (m *PairHistory) String() string { return proto.CompactTextString(m) }
+func (*PairHistory) ProtoMessage()    {}
+func (*PairHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{113}
 }
-func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
+func (m *PairHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PairHistory.Unmarshal(m, b)
 }
-func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+func (m *PairHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PairHistory.Marshal(b, m, deterministic)
 }
-func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
+func (dst *PairHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairHistory.Merge(dst, src)
 }
-func (m *QueryRoutesRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesRequest.Size(m)
+func (m *PairHistory) XXX_Size() int {
+	return xxx_messageInfo_PairHistory.Size(m)
 }
-func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+func (m *PairHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+var xxx_messageInfo_PairHistory proto.InternalMessageInfo
 
-func (m *QueryRoutesRequest) GetPubKey() string {
+func (m *PairHistory) GetNodeFrom() string {
 	if m != nil {
-		return m.PubKey
+		return m.NodeFrom
 	}
 	return ""
 }
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *PairHistory) GetNodeTo() string {
 	if m != nil {
-		return m.Amt
+		return m.NodeTo
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+func (m *PairHistory) GetTimestamp() int64 {
 	if m != nil {
-		return m.NumRoutes
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+func (m *PairHistory) GetAmtMsat() int64 {
 	if m != nil {
-		return m.FinalCltvDelta
+		return m.AmtMsat
 	}
 	return 0
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+func (m *PairHistory) GetSuccess() bool {
 	if m != nil {
-		return m.FeeLimit
+		return m.Success
 	}
-	return nil
+	return false
 }
 
-type QueryRoutesResponse struct {
-	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+func (m *PairHistory) GetSuccessProb() float64 {
+	if m != nil {
+		return m.SuccessProb
+	}
+	return 0
+}
+
+type QueryProbabilityRequest struct {
+	// / The hex-encoded compressed public key of the forwarding node.
+	FromNode string `protobuf:"bytes,1,opt,name=from_node,proto3" json:"from_node,omitempty"`
+	// / The hex-encoded compressed public key of the node forwarded to.
+	ToNode string `protobuf:"bytes,2,opt,name=to_node,proto3" json:"to_node,omitempty"`
+	// / The amount to forward in millisatoshis.
+	AmtMsat              int64    `protobuf:"varint,3,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()    {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{79}
+func (m *QueryProbabilityRequest) Reset()         { *m = QueryProbabilityRequest{} }
+func (m *QueryProbabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProbabilityRequest) ProtoMessage()    {}
+func (*QueryProbabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{114}
 }
-func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
+func (m *QueryProbabilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryProbabilityRequest.Unmarshal(m, b)
 }
-func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+func (m *QueryProbabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryProbabilityRequest.Marshal(b, m, deterministic)
 }
-func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
+func (dst *QueryProbabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProbabilityRequest.Merge(dst, src)
 }
-func (m *QueryRoutesResponse) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesResponse.Size(m)
+func (m *QueryProbabilityRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryProbabilityRequest.Size(m)
 }
-func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+func (m *QueryProbabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProbabilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProbabilityRequest proto.InternalMessageInfo
 
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *QueryProbabilityRequest) GetFromNode() string {
 	if m != nil {
-		return m.Routes
+		return m.FromNode
 	}
-	return nil
+	return ""
 }
 
-type Hop struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
-	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
-	// *
-	// An optional public key of the hop. If the public key is given, the payment
-	// can be executed without relying on a copy of the channel graph.
-	PubKey               string   `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *QueryProbabilityRequest) GetToNode() string {
+	if m != nil {
+		return m.ToNode
+	}
+	return ""
 }
 
-func (m *Hop) Reset()         { *m = Hop{} }
-func (m *Hop) String() string { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()    {}
-func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{80}
+func (m *QueryProbabilityRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
 }
-func (m *Hop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hop.Unmarshal(m, b)
+
+type QueryProbabilityResponse struct {
+	// / The estimated success probability of forwarding the amount.
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+	// / The last result of the pair of nodes, if any.
+	History              *PairHistory `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+
+func (m *QueryProbabilityResponse) Reset()         { *m = QueryProbabilityResponse{} }
+func (m *QueryProbabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProbabilityResponse) ProtoMessage()    {}
+func (*QueryProbabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{115}
 }
-func (dst *Hop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hop.Merge(dst, src)
+func (m *QueryProbabilityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryProbabilityResponse.Unmarshal(m, b)
 }
-func (m *Hop) XXX_Size() int {
-	return xxx_messageInfo_Hop.Size(m)
+func (m *QueryProbabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryProbabilityResponse.Marshal(b, m, deterministic)
 }
-func (m *Hop) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hop.DiscardUnknown(m)
+func (dst *QueryProbabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProbabilityResponse.Merge(dst, src)
+}
+func (m *QueryProbabilityResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryProbabilityResponse.Size(m)
+}
+func (m *QueryProbabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProbabilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hop proto.InternalMessageInfo
+var xxx_messageInfo_QueryProbabilityResponse proto.InternalMessageInfo
 
-func (m *Hop) GetChanId() uint64 {
+func (m *QueryProbabilityResponse) GetProbability() float64 {
 	if m != nil {
-		return m.ChanId
+		return m.Probability
 	}
 	return 0
 }
 
-func (m *Hop) GetChanCapacity() int64 {
+func (m *QueryProbabilityResponse) GetHistory() *PairHistory {
 	if m != nil {
-		return m.ChanCapacity
+		return m.History
 	}
-	return 0
+	return nil
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetAmtToForward() int64 {
-	if m != nil {
-		return m.AmtToForward
-	}
-	return 0
+type EstimateRouteFeeRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// / The max number of routes to probe. Defaults to 3.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit             *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetFee() int64 {
+func (m *EstimateRouteFeeRequest) Reset()         { *m = EstimateRouteFeeRequest{} }
+func (m *EstimateRouteFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateRouteFeeRequest) ProtoMessage()    {}
+func (*EstimateRouteFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{116}
+}
+func (m *EstimateRouteFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateRouteFeeRequest.Unmarshal(m, b)
+}
+func (m *EstimateRouteFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateRouteFeeRequest.Marshal(b, m, deterministic)
+}
+func (dst *EstimateRouteFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRouteFeeRequest.Merge(dst, src)
+}
+func (m *EstimateRouteFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateRouteFeeRequest.Size(m)
+}
+func (m *EstimateRouteFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRouteFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRouteFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateRouteFeeRequest) GetPubKey() string {
 	if m != nil {
-		return m.Fee
+		return m.PubKey
 	}
-	return 0
+	return ""
 }
 
-func (m *Hop) GetExpiry() uint32 {
+func (m *EstimateRouteFeeRequest) GetAmt() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.Amt
 	}
 	return 0
 }
 
-func (m *Hop) GetAmtToForwardMsat() int64 {
+func (m *EstimateRouteFeeRequest) GetNumRoutes() int32 {
 	if m != nil {
-		return m.AmtToForwardMsat
+		return m.NumRoutes
 	}
 	return 0
 }
 
-func (m *Hop) GetFeeMsat() int64 {
+func (m *EstimateRouteFeeRequest) GetFinalCltvDelta() int32 {
 	if m != nil {
-		return m.FeeMsat
+		return m.FinalCltvDelta
 	}
 	return 0
 }
 
-func (m *Hop) GetPubKey() string {
+func (m *EstimateRouteFeeRequest) GetFeeLimit() *FeeLimit {
 	if m != nil {
-		return m.PubKey
+		return m.FeeLimit
 	}
-	return ""
+	return nil
 }
 
-// *
-// A path through the channel graph which runs over one or more channels in
-// succession. This struct carries all the information required to craft the
-// Sphinx onion packet, and send the payment along the first hop in the path. A
-// route is only selected as valid if all the channels have sufficient capacity to
-// carry the initial payment amount after fees are accounted for.
-type Route struct {
-	// *
-	// The cumulative (final) time lock across the entire route.  This is the CLTV
-	// value that should be extended to the first hop in the route. All other hops
-	// will decrement the time-lock as advertised, leaving enough time for all
-	// hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
-	// *
-	// The sum of the fees paid at each hop within the final route.  In the case
-	// of a one-hop payment, this value will be zero as we don't need to pay a fee
-	// it ourself.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
-	// *
-	// The total amount of funds required to complete a payment over this route.
-	// This value includes the cumulative fees at each hop. As a result, the HTLC
-	// extended to the first-hop in the route will need to have at least this many
-	// satoshis, otherwise the route will fail at an intermediate node due to an
-	// insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
-	// *
-	// Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
-	// *
-	// The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
-	// *
-	// The total amount in millisatoshis.
-	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+type EstimateRouteFeeResponse struct {
+	// / The cheapest route that was probed successfully.
+	Route *Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// / The fee of the route in satoshis.
+	Fee int64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// / The fee of the route in millisatoshis.
+	FeeMsat int64 `protobuf:"varint,3,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// / The number of probes that failed before the successful one.
+	NumFailedProbes      int32    `protobuf:"varint,4,opt,name=num_failed_probes,proto3" json:"num_failed_probes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{81}
-}
-func (m *Route) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Route.Unmarshal(m, b)
+func (m *EstimateRouteFeeResponse) Reset()         { *m = EstimateRouteFeeResponse{} }
+func (m *EstimateRouteFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateRouteFeeResponse) ProtoMessage()    {}
+func (*EstimateRouteFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{117}
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+func (m *EstimateRouteFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateRouteFeeResponse.Unmarshal(m, b)
 }
-func (dst *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(dst, src)
+func (m *EstimateRouteFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateRouteFeeResponse.Marshal(b, m, deterministic)
 }
-func (m *Route) XXX_Size() int {
-	return xxx_messageInfo_Route.Size(m)
+func (dst *EstimateRouteFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRouteFeeResponse.Merge(dst, src)
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *EstimateRouteFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateRouteFeeResponse.Size(m)
 }
-
-var xxx_messageInfo_Route proto.InternalMessageInfo
-
-func (m *Route) GetTotalTimeLock() uint32 {
-	if m != nil {
-		return m.TotalTimeLock
-	}
-	return 0
+func (m *EstimateRouteFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRouteFeeResponse.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalFees() int64 {
-	if m != nil {
-		return m.TotalFees
-	}
-	return 0
-}
+var xxx_messageInfo_EstimateRouteFeeResponse proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalAmt() int64 {
+func (m *EstimateRouteFeeResponse) GetRoute() *Route {
 	if m != nil {
-		return m.TotalAmt
+		return m.Route
 	}
-	return 0
+	return nil
 }
 
-func (m *Route) GetHops() []*Hop {
+func (m *EstimateRouteFeeResponse) GetFee() int64 {
 	if m != nil {
-		return m.Hops
+		return m.Fee
 	}
-	return nil
+	return 0
 }
 
-func (m *Route) GetTotalFeesMsat() int64 {
+func (m *EstimateRouteFeeResponse) GetFeeMsat() int64 {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.FeeMsat
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+func (m *EstimateRouteFeeResponse) GetNumFailedProbes() int32 {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.NumFailedProbes
 	}
 	return 0
 }
@@ -5614,7 +8066,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{82}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{118}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -5659,7 +8111,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{83}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{119}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
@@ -5720,7 +8172,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{84}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{120}
 }
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
@@ -5787,7 +8239,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{85}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{121}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -5836,7 +8288,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{86}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{122}
 }
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
@@ -5919,7 +8371,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{87}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{123}
 }
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
@@ -6010,7 +8462,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{88}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{124}
 }
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
@@ -6052,7 +8504,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{89}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{125}
 }
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
@@ -6101,7 +8553,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{90}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{126}
 }
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
@@ -6138,7 +8590,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{91}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{127}
 }
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
@@ -6177,7 +8629,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{92}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{128}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -6270,7 +8722,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{93}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{129}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -6300,7 +8752,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{94}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{130}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -6330,7 +8782,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{95}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{131}
 }
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
@@ -6363,7 +8815,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{96}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{132}
 }
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
@@ -6418,7 +8870,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{97}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{133}
 }
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
@@ -6486,7 +8938,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{98}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{134}
 }
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
@@ -6566,7 +9018,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{99}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{135}
 }
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
@@ -6636,7 +9088,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{100}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{136}
 }
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HopHint.Unmarshal(m, b)
@@ -6705,7 +9157,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{101}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{137}
 }
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
@@ -6806,7 +9258,16 @@ type Invoice struct {
 	// amount was ultimately accepted. Additionally, it's possible that the sender
 	// paid MORE that was specified in the original invoice. So we'll record that
 	// here as well.
-	AmtPaidMsat          int64    `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	// *
+	// The state the invoice is in. Hold invoices are accepted once the HTLCs
+	// paying to them are held, until they're settled or canceled.
+	State Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
+	// *
+	// The payment address of the invoice, a random secret included in its
+	// payment request that senders must pass to us when paying it. It's chosen
+	// by the node when the invoice is added.
+	PaymentAddr          []byte   `protobuf:"bytes,22,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -6816,7 +9277,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{102}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{138}
 }
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invoice.Unmarshal(m, b)
@@ -6977,6 +9438,20 @@ func (m *Invoice) GetAmtPaidMsat() int64 {
 	return 0
 }
 
+func (m *Invoice) GetState() Invoice_InvoiceState {
+	if m != nil {
+		return m.State
+	}
+	return Invoice_OPEN
+}
+
+func (m *Invoice) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
 type AddInvoiceResponse struct {
 	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 	// *
@@ -6999,7 +9474,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{103}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{139}
 }
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
@@ -7040,6 +9515,253 @@ func (m *AddInvoiceResponse) GetAddIndex() uint64 {
 	return 0
 }
 
+type AddHoldInvoiceRequest struct {
+	// *
+	// An optional memo to attach along with the invoice. Used for record keeping
+	// purposes for the invoice's creator, and will also be set in the description
+	// field of the encoded payment request if the description_hash field is not
+	// being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// / The hash of the preimage, which is only revealed on settlement.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// / The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// *
+	// Hash (SHA-256) of a description of the payment. Used if the description of
+	// payment (memo) is too long to naturally fit within the description field
+	// of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,4,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// / Fallback on-chain address.
+	FallbackAddr string `protobuf:"bytes,6,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	// / Delta to use for the time-lock of the CLTV extended to the final hop.
+	CltvExpiry uint64 `protobuf:"varint,7,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	// / Whether this invoice should include routing hints for private channels.
+	Private              bool     `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddHoldInvoiceRequest) Reset()         { *m = AddHoldInvoiceRequest{} }
+func (m *AddHoldInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*AddHoldInvoiceRequest) ProtoMessage()    {}
+func (*AddHoldInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{140}
+}
+func (m *AddHoldInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddHoldInvoiceRequest.Unmarshal(m, b)
+}
+func (m *AddHoldInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddHoldInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddHoldInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddHoldInvoiceRequest.Merge(dst, src)
+}
+func (m *AddHoldInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_AddHoldInvoiceRequest.Size(m)
+}
+func (m *AddHoldInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddHoldInvoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddHoldInvoiceRequest proto.InternalMessageInfo
+
+func (m *AddHoldInvoiceRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AddHoldInvoiceRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *AddHoldInvoiceRequest) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *AddHoldInvoiceRequest) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return nil
+}
+
+func (m *AddHoldInvoiceRequest) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *AddHoldInvoiceRequest) GetFallbackAddr() string {
+	if m != nil {
+		return m.FallbackAddr
+	}
+	return ""
+}
+
+func (m *AddHoldInvoiceRequest) GetCltvExpiry() uint64 {
+	if m != nil {
+		return m.CltvExpiry
+	}
+	return 0
+}
+
+func (m *AddHoldInvoiceRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+type SettleInvoiceRequest struct {
+	// / The preimage of the payment hash of the hold invoice to settle.
+	Preimage             []byte   `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleInvoiceRequest) Reset()         { *m = SettleInvoiceRequest{} }
+func (m *SettleInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*SettleInvoiceRequest) ProtoMessage()    {}
+func (*SettleInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{141}
+}
+func (m *SettleInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleInvoiceRequest.Unmarshal(m, b)
+}
+func (m *SettleInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (dst *SettleInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleInvoiceRequest.Merge(dst, src)
+}
+func (m *SettleInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_SettleInvoiceRequest.Size(m)
+}
+func (m *SettleInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleInvoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleInvoiceRequest proto.InternalMessageInfo
+
+func (m *SettleInvoiceRequest) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+type SettleInvoiceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleInvoiceResponse) Reset()         { *m = SettleInvoiceResponse{} }
+func (m *SettleInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*SettleInvoiceResponse) ProtoMessage()    {}
+func (*SettleInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{142}
+}
+func (m *SettleInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleInvoiceResponse.Unmarshal(m, b)
+}
+func (m *SettleInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (dst *SettleInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleInvoiceResponse.Merge(dst, src)
+}
+func (m *SettleInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_SettleInvoiceResponse.Size(m)
+}
+func (m *SettleInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleInvoiceResponse proto.InternalMessageInfo
+
+type CancelInvoiceRequest struct {
+	// / The payment hash of the invoice to cancel.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelInvoiceRequest) Reset()         { *m = CancelInvoiceRequest{} }
+func (m *CancelInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelInvoiceRequest) ProtoMessage()    {}
+func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{143}
+}
+func (m *CancelInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelInvoiceRequest.Unmarshal(m, b)
+}
+func (m *CancelInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelInvoiceRequest.Merge(dst, src)
+}
+func (m *CancelInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelInvoiceRequest.Size(m)
+}
+func (m *CancelInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelInvoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelInvoiceRequest proto.InternalMessageInfo
+
+func (m *CancelInvoiceRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type CancelInvoiceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelInvoiceResponse) Reset()         { *m = CancelInvoiceResponse{} }
+func (m *CancelInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelInvoiceResponse) ProtoMessage()    {}
+func (*CancelInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{144}
+}
+func (m *CancelInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelInvoiceResponse.Unmarshal(m, b)
+}
+func (m *CancelInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (dst *CancelInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelInvoiceResponse.Merge(dst, src)
+}
+func (m *CancelInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelInvoiceResponse.Size(m)
+}
+func (m *CancelInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelInvoiceResponse proto.InternalMessageInfo
+
 type PaymentHash struct {
 	// *
 	// The hex-encoded payment hash of the invoice to be looked up. The passed
@@ -7056,7 +9778,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{104}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{145}
 }
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
@@ -7112,7 +9834,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{105}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{146}
 }
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
@@ -7182,7 +9904,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{106}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{147}
 }
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
@@ -7245,7 +9967,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{107}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{148}
 }
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
@@ -7305,7 +10027,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{108}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{149}
 }
 func (m *Payment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payment.Unmarshal(m, b)
@@ -7392,7 +10114,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{109}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{150}
 }
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
@@ -7424,7 +10146,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{110}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{151}
 }
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
@@ -7442,11 +10164,217 @@ func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+type TrackPaymentRequest struct {
+	// / The hash of the payment to track
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackPaymentRequest) Reset()         { *m = TrackPaymentRequest{} }
+func (m *TrackPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*TrackPaymentRequest) ProtoMessage()    {}
+func (*TrackPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{152}
+}
+func (m *TrackPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackPaymentRequest.Unmarshal(m, b)
+}
+func (m *TrackPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackPaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *TrackPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackPaymentRequest.Merge(dst, src)
+}
+func (m *TrackPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_TrackPaymentRequest.Size(m)
+}
+func (m *TrackPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackPaymentRequest proto.InternalMessageInfo
+
+func (m *TrackPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type PaymentAttempt struct {
+	// / The identifier of the attempt among those of the same payment
+	AttemptId uint64 `protobuf:"varint,1,opt,name=attempt_id,proto3" json:"attempt_id,omitempty"`
+	// / The state the attempt is in
+	State PaymentAttempt_AttemptState `protobuf:"varint,2,opt,name=state,proto3,enum=lnrpc.PaymentAttempt_AttemptState" json:"state,omitempty"`
+	// / The route the attempt was sent along
+	Route *Route `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	// / The time at which the attempt was sent, in nanoseconds since epoch
+	AttemptTimeNs int64 `protobuf:"varint,4,opt,name=attempt_time_ns,proto3" json:"attempt_time_ns,omitempty"`
+	// *
+	// The time at which the attempt settled or failed, in nanoseconds since
+	// epoch. Zero while the attempt is in flight.
+	ResolveTimeNs int64 `protobuf:"varint,5,opt,name=resolve_time_ns,proto3" json:"resolve_time_ns,omitempty"`
+	// *
+	// The index of the node that reported the failure of the attempt within the
+	// route, where zero is our own node and one is the first hop.
+	FailureSourceIndex uint32 `protobuf:"varint,6,opt,name=failure_source_index,proto3" json:"failure_source_index,omitempty"`
+	// *
+	// The code of the failure message reported for the attempt, as defined in
+	// BOLT #4. Zero if the attempt didn't fail with a failure message.
+	FailureCode          uint32   `protobuf:"varint,7,opt,name=failure_code,proto3" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentAttempt) Reset()         { *m = PaymentAttempt{} }
+func (m *PaymentAttempt) String() string { return proto.CompactTextString(m) }
+func (*PaymentAttempt) ProtoMessage()    {}
+func (*PaymentAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{153}
+}
+func (m *PaymentAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentAttempt.Unmarshal(m, b)
+}
+func (m *PaymentAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentAttempt.Marshal(b, m, deterministic)
+}
+func (dst *PaymentAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentAttempt.Merge(dst, src)
+}
+func (m *PaymentAttempt) XXX_Size() int {
+	return xxx_messageInfo_PaymentAttempt.Size(m)
+}
+func (m *PaymentAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentAttempt proto.InternalMessageInfo
+
+func (m *PaymentAttempt) GetAttemptId() uint64 {
+	if m != nil {
+		return m.AttemptId
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetState() PaymentAttempt_AttemptState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentAttempt_IN_FLIGHT
+}
+
+func (m *PaymentAttempt) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *PaymentAttempt) GetAttemptTimeNs() int64 {
+	if m != nil {
+		return m.AttemptTimeNs
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetResolveTimeNs() int64 {
+	if m != nil {
+		return m.ResolveTimeNs
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetFailureSourceIndex() uint32 {
+	if m != nil {
+		return m.FailureSourceIndex
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+type PaymentUpdate struct {
+	// / The payment hash
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The status of the payment. A grounded payment has no HTLC in flight, but
+	// may still be retried.
+	Status PaymentUpdate_PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lnrpc.PaymentUpdate_PaymentStatus" json:"status,omitempty"`
+	// / The reason the payment failed, if its status is FAILED
+	FailureReason PaymentUpdate_FailureReason `protobuf:"varint,3,opt,name=failure_reason,proto3,enum=lnrpc.PaymentUpdate_FailureReason" json:"failure_reason,omitempty"`
+	// / All attempts made to send the payment, in the order they were made
+	Attempts             []*PaymentAttempt `protobuf:"bytes,4,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PaymentUpdate) Reset()         { *m = PaymentUpdate{} }
+func (m *PaymentUpdate) String() string { return proto.CompactTextString(m) }
+func (*PaymentUpdate) ProtoMessage()    {}
+func (*PaymentUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{154}
+}
+func (m *PaymentUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentUpdate.Unmarshal(m, b)
+}
+func (m *PaymentUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentUpdate.Marshal(b, m, deterministic)
+}
+func (dst *PaymentUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentUpdate.Merge(dst, src)
+}
+func (m *PaymentUpdate) XXX_Size() int {
+	return xxx_messageInfo_PaymentUpdate.Size(m)
+}
+func (m *PaymentUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentUpdate proto.InternalMessageInfo
+
+func (m *PaymentUpdate) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PaymentUpdate) GetStatus() PaymentUpdate_PaymentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PaymentUpdate_GROUNDED
+}
+
+func (m *PaymentUpdate) GetFailureReason() PaymentUpdate_FailureReason {
+	if m != nil {
+		return m.FailureReason
+	}
+	return PaymentUpdate_FAILURE_REASON_NONE
+}
 
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *PaymentUpdate) GetAttempts() []*PaymentAttempt {
 	if m != nil {
-		return m.Payments
+		return m.Attempts
 	}
 	return nil
 }
@@ -7461,7 +10389,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{111}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{155}
 }
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
@@ -7491,7 +10419,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{112}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{156}
 }
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
@@ -7522,7 +10450,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{113}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{157}
 }
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
@@ -7559,7 +10487,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{114}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{158}
 }
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
@@ -7591,7 +10519,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{115}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{159}
 }
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
@@ -7636,7 +10564,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{116}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{160}
 }
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
@@ -7675,7 +10603,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{117}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{161}
 }
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
@@ -7703,26 +10631,28 @@ func (m *PayReqString) GetPayReq() string {
 }
 
 type PayReq struct {
-	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
-	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Destination     string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	NumSatoshis     int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
+	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiry          int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description     string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	// / The payment address of the invoice, if it has one.
+	PaymentAddr          []byte   `protobuf:"bytes,11,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{118}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{162}
 }
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReq.Unmarshal(m, b)
@@ -7812,6 +10742,13 @@ func (m *PayReq) GetRouteHints() []*RouteHint {
 	return nil
 }
 
+func (m *PayReq) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
 type FeeReportRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -7822,7 +10759,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{119}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{163}
 }
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
@@ -7860,7 +10797,7 @@ func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{120}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{164}
 }
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
@@ -7926,7 +10863,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{121}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{165}
 }
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
@@ -7994,7 +10931,7 @@ func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{122}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{166}
 }
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
@@ -8155,7 +11092,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{123}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{167}
 }
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
@@ -8175,6 +11112,150 @@ func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
 
+type CommitFeePolicyUpdateRequest struct {
+	// / The channel to update the commitment fee policy of
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// / The confirmation target (in blocks) the fee estimator is queried with
+	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,proto3" json:"conf_target,omitempty"`
+	// / The lowest commitment fee rate (in sat/kw) we propose
+	MinFeePerKw int64 `protobuf:"varint,3,opt,name=min_fee_per_kw,proto3" json:"min_fee_per_kw,omitempty"`
+	// / The highest commitment fee rate (in sat/kw) we propose
+	MaxFeePerKw int64 `protobuf:"varint,4,opt,name=max_fee_per_kw,proto3" json:"max_fee_per_kw,omitempty"`
+	// *
+	// The percentage by which the estimated fee rate must deviate from the
+	// current commitment fee rate before we propose a fee update
+	Hysteresis uint32 `protobuf:"varint,5,opt,name=hysteresis,proto3" json:"hysteresis,omitempty"`
+	// *
+	// Fields set to zero don't override the current policy, unless they're
+	// flagged as specified below, in which case they're reset to their default.
+	// A zero max_fee_per_kw leaves the fee rate unbounded, and a zero hysteresis
+	// proposes every change of the estimated fee rate.
+	ConfTargetSpecified  bool     `protobuf:"varint,6,opt,name=conf_target_specified,proto3" json:"conf_target_specified,omitempty"`
+	MinFeePerKwSpecified bool     `protobuf:"varint,7,opt,name=min_fee_per_kw_specified,proto3" json:"min_fee_per_kw_specified,omitempty"`
+	MaxFeePerKwSpecified bool     `protobuf:"varint,8,opt,name=max_fee_per_kw_specified,proto3" json:"max_fee_per_kw_specified,omitempty"`
+	HysteresisSpecified  bool     `protobuf:"varint,9,opt,name=hysteresis_specified,proto3" json:"hysteresis_specified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitFeePolicyUpdateRequest) Reset()         { *m = CommitFeePolicyUpdateRequest{} }
+func (m *CommitFeePolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitFeePolicyUpdateRequest) ProtoMessage()    {}
+func (*CommitFeePolicyUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{168}
+}
+func (m *CommitFeePolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitFeePolicyUpdateRequest.Unmarshal(m, b)
+}
+func (m *CommitFeePolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitFeePolicyUpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *CommitFeePolicyUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitFeePolicyUpdateRequest.Merge(dst, src)
+}
+func (m *CommitFeePolicyUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_CommitFeePolicyUpdateRequest.Size(m)
+}
+func (m *CommitFeePolicyUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitFeePolicyUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitFeePolicyUpdateRequest proto.InternalMessageInfo
+
+func (m *CommitFeePolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetConfTarget() uint32 {
+	if m != nil {
+		return m.ConfTarget
+	}
+	return 0
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetMinFeePerKw() int64 {
+	if m != nil {
+		return m.MinFeePerKw
+	}
+	return 0
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetMaxFeePerKw() int64 {
+	if m != nil {
+		return m.MaxFeePerKw
+	}
+	return 0
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetHysteresis() uint32 {
+	if m != nil {
+		return m.Hysteresis
+	}
+	return 0
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetConfTargetSpecified() bool {
+	if m != nil {
+		return m.ConfTargetSpecified
+	}
+	return false
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetMinFeePerKwSpecified() bool {
+	if m != nil {
+		return m.MinFeePerKwSpecified
+	}
+	return false
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetMaxFeePerKwSpecified() bool {
+	if m != nil {
+		return m.MaxFeePerKwSpecified
+	}
+	return false
+}
+
+func (m *CommitFeePolicyUpdateRequest) GetHysteresisSpecified() bool {
+	if m != nil {
+		return m.HysteresisSpecified
+	}
+	return false
+}
+
+type CommitFeePolicyUpdateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitFeePolicyUpdateResponse) Reset()         { *m = CommitFeePolicyUpdateResponse{} }
+func (m *CommitFeePolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitFeePolicyUpdateResponse) ProtoMessage()    {}
+func (*CommitFeePolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{169}
+}
+func (m *CommitFeePolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitFeePolicyUpdateResponse.Unmarshal(m, b)
+}
+func (m *CommitFeePolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitFeePolicyUpdateResponse.Marshal(b, m, deterministic)
+}
+func (dst *CommitFeePolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitFeePolicyUpdateResponse.Merge(dst, src)
+}
+func (m *CommitFeePolicyUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_CommitFeePolicyUpdateResponse.Size(m)
+}
+func (m *CommitFeePolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitFeePolicyUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitFeePolicyUpdateResponse proto.InternalMessageInfo
+
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
 	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
@@ -8193,7 +11274,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{124}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{170}
 }
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
@@ -8263,7 +11344,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{125}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{171}
 }
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
@@ -8339,7 +11420,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{126}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{172}
 }
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
@@ -8383,7 +11464,7 @@ func (m *BackupEventSubscription) Reset()         { *m = BackupEventSubscription
 func (m *BackupEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*BackupEventSubscription) ProtoMessage()    {}
 func (*BackupEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{127}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{173}
 }
 func (m *BackupEventSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupEventSubscription.Unmarshal(m, b)
@@ -8413,7 +11494,7 @@ func (m *BackupEventUpdate) Reset()         { *m = BackupEventUpdate{} }
 func (m *BackupEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*BackupEventUpdate) ProtoMessage()    {}
 func (*BackupEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_e0f985b54d26ef92, []int{128}
+	return fileDescriptor_rpc_31abb292330f8c1e, []int{174}
 }
 func (m *BackupEventUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupEventUpdate.Unmarshal(m, b)
@@ -8449,7 +11530,19 @@ func init() {
 	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
 	proto.RegisterType((*RouteError)(nil), "lnrpc.RouteError")
 	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*RebalanceRequest)(nil), "lnrpc.RebalanceRequest")
+	proto.RegisterType((*RebalanceResponse)(nil), "lnrpc.RebalanceResponse")
 	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*CircuitKey)(nil), "lnrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "lnrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "lnrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "lnrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "lnrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "lnrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "lnrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "lnrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "lnrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "lnrpc.LinkFailEvent")
 	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
 	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
 	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
@@ -8491,10 +11584,15 @@ func init() {
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*Feature)(nil), "lnrpc.Feature")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
 	proto.RegisterType((*PeerSubscription)(nil), "lnrpc.PeerSubscription")
 	proto.RegisterType((*PeerNotification)(nil), "lnrpc.PeerNotification")
+	proto.RegisterType((*SendCustomMessageRequest)(nil), "lnrpc.SendCustomMessageRequest")
+	proto.RegisterType((*SendCustomMessageResponse)(nil), "lnrpc.SendCustomMessageResponse")
+	proto.RegisterType((*SubscribeCustomMessagesRequest)(nil), "lnrpc.SubscribeCustomMessagesRequest")
+	proto.RegisterType((*CustomMessage)(nil), "lnrpc.CustomMessage")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
 	proto.RegisterType((*GetBackupRequest)(nil), "lnrpc.GetBackupRequest")
@@ -8507,6 +11605,17 @@ func init() {
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
+	proto.RegisterType((*PsbtShim)(nil), "lnrpc.PsbtShim")
+	proto.RegisterType((*FundingShim)(nil), "lnrpc.FundingShim")
+	proto.RegisterType((*FundingShimCancel)(nil), "lnrpc.FundingShimCancel")
+	proto.RegisterType((*FundingPsbtVerify)(nil), "lnrpc.FundingPsbtVerify")
+	proto.RegisterType((*FundingPsbtFinalize)(nil), "lnrpc.FundingPsbtFinalize")
+	proto.RegisterType((*FundingTransitionMsg)(nil), "lnrpc.FundingTransitionMsg")
+	proto.RegisterType((*FundingStateStepResp)(nil), "lnrpc.FundingStateStepResp")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
 	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
 	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
 	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
@@ -8523,6 +11632,14 @@ func init() {
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
 	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
 	proto.RegisterType((*Route)(nil), "lnrpc.Route")
+	proto.RegisterType((*QueryMissionControlRequest)(nil), "lnrpc.QueryMissionControlRequest")
+	proto.RegisterType((*QueryMissionControlResponse)(nil), "lnrpc.QueryMissionControlResponse")
+	proto.RegisterType((*NodeHistory)(nil), "lnrpc.NodeHistory")
+	proto.RegisterType((*PairHistory)(nil), "lnrpc.PairHistory")
+	proto.RegisterType((*QueryProbabilityRequest)(nil), "lnrpc.QueryProbabilityRequest")
+	proto.RegisterType((*QueryProbabilityResponse)(nil), "lnrpc.QueryProbabilityResponse")
+	proto.RegisterType((*EstimateRouteFeeRequest)(nil), "lnrpc.EstimateRouteFeeRequest")
+	proto.RegisterType((*EstimateRouteFeeResponse)(nil), "lnrpc.EstimateRouteFeeResponse")
 	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
 	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
 	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
@@ -8545,6 +11662,11 @@ func init() {
 	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
 	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
 	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
+	proto.RegisterType((*AddHoldInvoiceRequest)(nil), "lnrpc.AddHoldInvoiceRequest")
+	proto.RegisterType((*SettleInvoiceRequest)(nil), "lnrpc.SettleInvoiceRequest")
+	proto.RegisterType((*SettleInvoiceResponse)(nil), "lnrpc.SettleInvoiceResponse")
+	proto.RegisterType((*CancelInvoiceRequest)(nil), "lnrpc.CancelInvoiceRequest")
+	proto.RegisterType((*CancelInvoiceResponse)(nil), "lnrpc.CancelInvoiceResponse")
 	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
 	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
 	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
@@ -8552,6 +11674,9 @@ func init() {
 	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
 	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
 	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
+	proto.RegisterType((*TrackPaymentRequest)(nil), "lnrpc.TrackPaymentRequest")
+	proto.RegisterType((*PaymentAttempt)(nil), "lnrpc.PaymentAttempt")
+	proto.RegisterType((*PaymentUpdate)(nil), "lnrpc.PaymentUpdate")
 	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
@@ -8565,13 +11690,21 @@ func init() {
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*CommitFeePolicyUpdateRequest)(nil), "lnrpc.CommitFeePolicyUpdateRequest")
+	proto.RegisterType((*CommitFeePolicyUpdateResponse)(nil), "lnrpc.CommitFeePolicyUpdateResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
 	proto.RegisterType((*BackupEventSubscription)(nil), "lnrpc.BackupEventSubscription")
 	proto.RegisterType((*BackupEventUpdate)(nil), "lnrpc.BackupEventUpdate")
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_ResolveAction", ForwardHtlcInterceptResponse_ResolveAction_name, ForwardHtlcInterceptResponse_ResolveAction_value)
+	proto.RegisterEnum("lnrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
 	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.PaymentAttempt_AttemptState", PaymentAttempt_AttemptState_name, PaymentAttempt_AttemptState_value)
+	proto.RegisterEnum("lnrpc.PaymentUpdate_PaymentStatus", PaymentUpdate_PaymentStatus_name, PaymentUpdate_PaymentStatus_value)
+	proto.RegisterEnum("lnrpc.PaymentUpdate_FailureReason", PaymentUpdate_FailureReason_name, PaymentUpdate_FailureReason_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8880,6 +12013,18 @@ type LightningClient interface {
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
 	SubscribePeers(ctx context.Context, in *PeerSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeersClient, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom message to a connected peer, allowing
+	// applications to run their own protocols over existing peer connections.
+	// The message type must be at least 32768. Sending fails if it exceeds the
+	// peer's rate limit for custom messages.
+	SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client over which the custom messages received from any peer are
+	// sent. Messages received from a peer in excess of its rate limit are
+	// dropped.
+	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -8916,6 +12061,20 @@ type LightningClient interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// * lncli: `openchannel --psbt`
+	// FundingStateStep is an advanced funding related call that allows the caller
+	// to progress the funding workflow of a channel opened with a funding shim.
+	// The caller is able to verify a funded PSBT against the funding output of
+	// the channel, hand over the signed PSBT or the final raw funding
+	// transaction, or cancel the funding workflow altogether.
+	FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels to
+	// different remote peers within a single funding transaction. All funding
+	// workflows are run in parallel, and the funding transaction is only signed
+	// once every peer accepted its channel. If any of the peers fails before
+	// then, all pending channels of the batch are canceled.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -8953,11 +12112,44 @@ type LightningClient interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which HTLCs
+	// that are about to be forwarded are intercepted and handed to the client.
+	// For each intercepted HTLC, the client must respond with how to resolve
+	// it. HTLCs that aren't resolved in time are resumed by the switch. Only one
+	// interceptor can be active at a time.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error)
+	// * lncli: `subscribehtlcevents`
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client over which the events of all htlcs sent, received and
+	// forwarded by the node are sent.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error)
+	// * lncli: `rebalance`
+	// Rebalance shifts balance from one of our channels to another by paying a
+	// self-invoice over a circular route, leaving our node over the outgoing
+	// channel and returning to it over the incoming channel. The call blocks
+	// until the payment either fails or succeeds.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
 	// unique payment preimage.
 	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// * lncli: `addholdinvoice`
+	// AddHoldInvoice adds a new invoice of which only the payment hash is known.
+	// HTLCs paying to the invoice are accepted and held until the invoice is
+	// either settled with its preimage using SettleInvoice, or canceled using
+	// CancelInvoice. If neither happens in time, the invoice is canceled before
+	// the held HTLCs expire.
+	AddHoldInvoice(ctx context.Context, in *AddHoldInvoiceRequest, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// * lncli: `settleinvoice`
+	// SettleInvoice settles an accepted hold invoice using the preimage of its
+	// payment hash, settling all HTLCs held for it.
+	SettleInvoice(ctx context.Context, in *SettleInvoiceRequest, opts ...grpc.CallOption) (*SettleInvoiceResponse, error)
+	// * lncli: `cancelinvoice`
+	// CancelInvoice cancels an open or accepted invoice, failing back all HTLCs
+	// held for it. Settled invoices can't be canceled.
+	CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*CancelInvoiceResponse, error)
 	// * lncli: `listinvoices`
 	// ListInvoices returns a list of all the invoices currently stored within the
 	// database. Any active debug invoices are ignored. It has full support for
@@ -8983,7 +12175,8 @@ type LightningClient interface {
 	// settle_index is specified, the next, we'll send out all settle events for
 	// invoices with a settle_index greater than the specified value.  One or both
 	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
+	// the latest add/settle events. Hold invoices are additionally sent out as
+	// they're accepted or canceled.
 	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
 	// * lncli: `decodepayreq`
 	// DecodePayReq takes an encoded payment request string and attempts to decode
@@ -8993,6 +12186,14 @@ type LightningClient interface {
 	// * lncli: `listpayments`
 	// ListPayments returns a list of all outgoing payments.
 	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// * lncli: `trackpayment`
+	// TrackPayment returns a uni-directional stream (server -> client) of the
+	// status of an outgoing payment, along with every attempt made to send it.
+	// The current state of the payment is sent first, followed by an update each
+	// time it changes. As attempts are persisted, payments sent before a restart
+	// can be tracked as well. The stream ends once the payment succeeded or
+	// failed. An error is returned if the payment was never initiated.
+	TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Lightning_TrackPaymentClient, error)
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
@@ -9021,6 +12222,25 @@ type LightningClient interface {
 	// send an HTLC, also including the necessary information that should be
 	// present within the Sphinx packet encapsulated within the HTLC.
 	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// * lncli: `querymc`
+	// QueryMissionControl returns the payment results observed by mission
+	// control, which are used to estimate the success probabilities of routes
+	// during path finding.
+	QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error)
+	// * lncli: `queryprob`
+	// QueryProbability returns the estimated success probability of forwarding a
+	// payment of the given amount from one node to another, along with the last
+	// payment result observed for the pair of nodes.
+	QueryProbability(ctx context.Context, in *QueryProbabilityRequest, opts ...grpc.CallOption) (*QueryProbabilityResponse, error)
+	// * lncli: `estimateroutefee`
+	// EstimateRouteFee probes the candidate routes to a destination, in order of
+	// increasing fees, by sending HTLCs with a random payment hash along them.
+	// As the destination doesn't know the payment hash, a probe that reaches it
+	// is failed, which proves that the route is able to carry the payment
+	// without making it. The cheapest route that was probed successfully is
+	// returned along with its fee. The outcome of each probe is reported to
+	// mission control.
+	EstimateRouteFee(ctx context.Context, in *EstimateRouteFeeRequest, opts ...grpc.CallOption) (*EstimateRouteFeeResponse, error)
 	// * lncli: `getnetworkinfo`
 	// GetNetworkInfo returns some basic stats about the known channel graph from
 	// the point of view of the node.
@@ -9051,6 +12271,12 @@ type LightningClient interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `updatecommitfeepolicy`
+	// UpdateCommitFeePolicy overrides the policy governing the commitment fee
+	// rate we propose for a particular channel we initiated. Only the set fields
+	// of the request override the current policy. Overrides are persisted until
+	// the channel is closed.
+	UpdateCommitFeePolicy(ctx context.Context, in *CommitFeePolicyUpdateRequest, opts ...grpc.CallOption) (*CommitFeePolicyUpdateResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -9294,6 +12520,47 @@ func (x *lightningSubscribePeersClient) Recv() (*PeerNotification, error) {
 	return m, nil
 }
 
+func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error) {
+	out := new(SendCustomMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCustomMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeCustomMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeCustomMessagesClient interface {
+	Recv() (*CustomMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeCustomMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error) {
+	m := new(CustomMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
@@ -9358,7 +12625,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9389,8 +12656,26 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 	return m, nil
 }
 
+func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
+	out := new(FundingStateStepResp)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9431,7 +12716,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9471,7 +12756,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9510,6 +12795,78 @@ func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRe
 	return out, nil
 }
 
+func (c *lightningClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
 	out := new(AddInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, opts...)
@@ -9519,6 +12876,33 @@ func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...g
 	return out, nil
 }
 
+func (c *lightningClient) AddHoldInvoice(ctx context.Context, in *AddHoldInvoiceRequest, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
+	out := new(AddInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddHoldInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SettleInvoice(ctx context.Context, in *SettleInvoiceRequest, opts ...grpc.CallOption) (*SettleInvoiceResponse, error) {
+	out := new(SettleInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SettleInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*CancelInvoiceResponse, error) {
+	out := new(CancelInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CancelInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
 	out := new(ListInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, opts...)
@@ -9538,7 +12922,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9587,6 +12971,38 @@ func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequ
 	return out, nil
 }
 
+func (c *lightningClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Lightning_TrackPaymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/TrackPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningTrackPaymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_TrackPaymentClient interface {
+	Recv() (*PaymentUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningTrackPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningTrackPaymentClient) Recv() (*PaymentUpdate, error) {
+	m := new(PaymentUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
 	out := new(DeleteAllPaymentsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, opts...)
@@ -9605,27 +13021,54 @@ func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphReq
 	return out, nil
 }
 
-func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
-	out := new(ChannelEdge)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, opts...)
+func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
+	out := new(ChannelEdge)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error) {
+	out := new(QueryMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryMissionControl", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
-	out := new(NodeInfo)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
+func (c *lightningClient) QueryProbability(ctx context.Context, in *QueryProbabilityRequest, opts ...grpc.CallOption) (*QueryProbabilityResponse, error) {
+	out := new(QueryProbabilityResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryProbability", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
-	out := new(QueryRoutesResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
+func (c *lightningClient) EstimateRouteFee(ctx context.Context, in *EstimateRouteFeeRequest, opts ...grpc.CallOption) (*EstimateRouteFeeResponse, error) {
+	out := new(EstimateRouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/EstimateRouteFee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9651,7 +13094,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9709,6 +13152,15 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) UpdateCommitFeePolicy(ctx context.Context, in *CommitFeePolicyUpdateRequest, opts ...grpc.CallOption) (*CommitFeePolicyUpdateResponse, error) {
+	out := new(CommitFeePolicyUpdateResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateCommitFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
@@ -9719,7 +13171,7 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 }
 
 func (c *lightningClient) SubscribeBackupEvents(ctx context.Context, in *BackupEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeBackupEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeBackupEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[12], "/lnrpc.Lightning/SubscribeBackupEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9827,6 +13279,18 @@ type LightningServer interface {
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
 	SubscribePeers(*PeerSubscription, Lightning_SubscribePeersServer) error
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom message to a connected peer, allowing
+	// applications to run their own protocols over existing peer connections.
+	// The message type must be at least 32768. Sending fails if it exceeds the
+	// peer's rate limit for custom messages.
+	SendCustomMessage(context.Context, *SendCustomMessageRequest) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client over which the custom messages received from any peer are
+	// sent. Messages received from a peer in excess of its rate limit are
+	// dropped.
+	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -9863,6 +13327,20 @@ type LightningServer interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// * lncli: `openchannel --psbt`
+	// FundingStateStep is an advanced funding related call that allows the caller
+	// to progress the funding workflow of a channel opened with a funding shim.
+	// The caller is able to verify a funded PSBT against the funding output of
+	// the channel, hand over the signed PSBT or the final raw funding
+	// transaction, or cancel the funding workflow altogether.
+	FundingStateStep(context.Context, *FundingTransitionMsg) (*FundingStateStepResp, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels to
+	// different remote peers within a single funding transaction. All funding
+	// workflows are run in parallel, and the funding transaction is only signed
+	// once every peer accepted its channel. If any of the peers fails before
+	// then, all pending channels of the batch are canceled.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -9900,11 +13378,44 @@ type LightningServer interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which HTLCs
+	// that are about to be forwarded are intercepted and handed to the client.
+	// For each intercepted HTLC, the client must respond with how to resolve
+	// it. HTLCs that aren't resolved in time are resumed by the switch. Only one
+	// interceptor can be active at a time.
+	HtlcInterceptor(Lightning_HtlcInterceptorServer) error
+	// * lncli: `subscribehtlcevents`
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client over which the events of all htlcs sent, received and
+	// forwarded by the node are sent.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Lightning_SubscribeHtlcEventsServer) error
+	// * lncli: `rebalance`
+	// Rebalance shifts balance from one of our channels to another by paying a
+	// self-invoice over a circular route, leaving our node over the outgoing
+	// channel and returning to it over the incoming channel. The call blocks
+	// until the payment either fails or succeeds.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
 	// unique payment preimage.
 	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
+	// * lncli: `addholdinvoice`
+	// AddHoldInvoice adds a new invoice of which only the payment hash is known.
+	// HTLCs paying to the invoice are accepted and held until the invoice is
+	// either settled with its preimage using SettleInvoice, or canceled using
+	// CancelInvoice. If neither happens in time, the invoice is canceled before
+	// the held HTLCs expire.
+	AddHoldInvoice(context.Context, *AddHoldInvoiceRequest) (*AddInvoiceResponse, error)
+	// * lncli: `settleinvoice`
+	// SettleInvoice settles an accepted hold invoice using the preimage of its
+	// payment hash, settling all HTLCs held for it.
+	SettleInvoice(context.Context, *SettleInvoiceRequest) (*SettleInvoiceResponse, error)
+	// * lncli: `cancelinvoice`
+	// CancelInvoice cancels an open or accepted invoice, failing back all HTLCs
+	// held for it. Settled invoices can't be canceled.
+	CancelInvoice(context.Context, *CancelInvoiceRequest) (*CancelInvoiceResponse, error)
 	// * lncli: `listinvoices`
 	// ListInvoices returns a list of all the invoices currently stored within the
 	// database. Any active debug invoices are ignored. It has full support for
@@ -9930,7 +13441,8 @@ type LightningServer interface {
 	// settle_index is specified, the next, we'll send out all settle events for
 	// invoices with a settle_index greater than the specified value.  One or both
 	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
+	// the latest add/settle events. Hold invoices are additionally sent out as
+	// they're accepted or canceled.
 	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
 	// * lncli: `decodepayreq`
 	// DecodePayReq takes an encoded payment request string and attempts to decode
@@ -9940,6 +13452,14 @@ type LightningServer interface {
 	// * lncli: `listpayments`
 	// ListPayments returns a list of all outgoing payments.
 	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	// * lncli: `trackpayment`
+	// TrackPayment returns a uni-directional stream (server -> client) of the
+	// status of an outgoing payment, along with every attempt made to send it.
+	// The current state of the payment is sent first, followed by an update each
+	// time it changes. As attempts are persisted, payments sent before a restart
+	// can be tracked as well. The stream ends once the payment succeeded or
+	// failed. An error is returned if the payment was never initiated.
+	TrackPayment(*TrackPaymentRequest, Lightning_TrackPaymentServer) error
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
@@ -9968,6 +13488,25 @@ type LightningServer interface {
 	// send an HTLC, also including the necessary information that should be
 	// present within the Sphinx packet encapsulated within the HTLC.
 	QueryRoutes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
+	// * lncli: `querymc`
+	// QueryMissionControl returns the payment results observed by mission
+	// control, which are used to estimate the success probabilities of routes
+	// during path finding.
+	QueryMissionControl(context.Context, *QueryMissionControlRequest) (*QueryMissionControlResponse, error)
+	// * lncli: `queryprob`
+	// QueryProbability returns the estimated success probability of forwarding a
+	// payment of the given amount from one node to another, along with the last
+	// payment result observed for the pair of nodes.
+	QueryProbability(context.Context, *QueryProbabilityRequest) (*QueryProbabilityResponse, error)
+	// * lncli: `estimateroutefee`
+	// EstimateRouteFee probes the candidate routes to a destination, in order of
+	// increasing fees, by sending HTLCs with a random payment hash along them.
+	// As the destination doesn't know the payment hash, a probe that reaches it
+	// is failed, which proves that the route is able to carry the payment
+	// without making it. The cheapest route that was probed successfully is
+	// returned along with its fee. The outcome of each probe is reported to
+	// mission control.
+	EstimateRouteFee(context.Context, *EstimateRouteFeeRequest) (*EstimateRouteFeeResponse, error)
 	// * lncli: `getnetworkinfo`
 	// GetNetworkInfo returns some basic stats about the known channel graph from
 	// the point of view of the node.
@@ -9998,6 +13537,12 @@ type LightningServer interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `updatecommitfeepolicy`
+	// UpdateCommitFeePolicy overrides the policy governing the commitment fee
+	// rate we propose for a particular channel we initiated. Only the set fields
+	// of the request override the current policy. Overrides are persisted until
+	// the channel is closed.
+	UpdateCommitFeePolicy(context.Context, *CommitFeePolicyUpdateRequest) (*CommitFeePolicyUpdateResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -10368,6 +13913,45 @@ func (x *lightningSubscribePeersServer) Send(m *PeerNotification) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SendCustomMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCustomMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCustomMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCustomMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCustomMessage(ctx, req.(*SendCustomMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeCustomMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCustomMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeCustomMessages(m, &lightningSubscribeCustomMessagesServer{stream})
+}
+
+type Lightning_SubscribeCustomMessagesServer interface {
+	Send(*CustomMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeCustomMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -10515,6 +14099,42 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundingTransitionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundingStateStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundingStateStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundingStateStep(ctx, req.(*FundingTransitionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CloseChannelRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -10642,6 +14262,71 @@ func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).HtlcInterceptor(&lightningHtlcInterceptorServer{stream})
+}
+
+type Lightning_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeHtlcEvents(m, &lightningSubscribeHtlcEventsServer{stream})
+}
+
+type Lightning_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Invoice)
 	if err := dec(in); err != nil {
@@ -10660,6 +14345,60 @@ func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_AddHoldInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHoldInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddHoldInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddHoldInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddHoldInvoice(ctx, req.(*AddHoldInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SettleInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SettleInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SettleInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SettleInvoice(ctx, req.(*SettleInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_CancelInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CancelInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CancelInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CancelInvoice(ctx, req.(*CancelInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInvoiceRequest)
 	if err := dec(in); err != nil {
@@ -10753,6 +14492,27 @@ func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_TrackPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackPaymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).TrackPayment(m, &lightningTrackPaymentServer{stream})
+}
+
+type Lightning_TrackPaymentServer interface {
+	Send(*PaymentUpdate) error
+	grpc.ServerStream
+}
+
+type lightningTrackPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningTrackPaymentServer) Send(m *PaymentUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAllPaymentsRequest)
 	if err := dec(in); err != nil {
@@ -10843,6 +14603,60 @@ func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_QueryMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).QueryMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/QueryMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).QueryMissionControl(ctx, req.(*QueryMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_QueryProbability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProbabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).QueryProbability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/QueryProbability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).QueryProbability(ctx, req.(*QueryProbabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRouteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).EstimateRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/EstimateRouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).EstimateRouteFee(ctx, req.(*EstimateRouteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetworkInfoRequest)
 	if err := dec(in); err != nil {
@@ -10954,6 +14768,24 @@ func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_UpdateCommitFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitFeePolicyUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateCommitFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateCommitFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateCommitFeePolicy(ctx, req.(*CommitFeePolicyUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -11065,6 +14897,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
 		},
+		{
+			MethodName: "SendCustomMessage",
+			Handler:    _Lightning_SendCustomMessage_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
@@ -11093,6 +14929,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "FundingStateStep",
+			Handler:    _Lightning_FundingStateStep_Handler,
+		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
@@ -11105,10 +14949,26 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendToRouteSync",
 			Handler:    _Lightning_SendToRouteSync_Handler,
 		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Lightning_Rebalance_Handler,
+		},
 		{
 			MethodName: "AddInvoice",
 			Handler:    _Lightning_AddInvoice_Handler,
 		},
+		{
+			MethodName: "AddHoldInvoice",
+			Handler:    _Lightning_AddHoldInvoice_Handler,
+		},
+		{
+			MethodName: "SettleInvoice",
+			Handler:    _Lightning_SettleInvoice_Handler,
+		},
+		{
+			MethodName: "CancelInvoice",
+			Handler:    _Lightning_CancelInvoice_Handler,
+		},
 		{
 			MethodName: "ListInvoices",
 			Handler:    _Lightning_ListInvoices_Handler,
@@ -11145,6 +15005,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryRoutes",
 			Handler:    _Lightning_QueryRoutes_Handler,
 		},
+		{
+			MethodName: "QueryMissionControl",
+			Handler:    _Lightning_QueryMissionControl_Handler,
+		},
+		{
+			MethodName: "QueryProbability",
+			Handler:    _Lightning_QueryProbability_Handler,
+		},
+		{
+			MethodName: "EstimateRouteFee",
+			Handler:    _Lightning_EstimateRouteFee_Handler,
+		},
 		{
 			MethodName: "GetNetworkInfo",
 			Handler:    _Lightning_GetNetworkInfo_Handler,
@@ -11165,6 +15037,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "UpdateCommitFeePolicy",
+			Handler:    _Lightning_UpdateCommitFeePolicy_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
@@ -11181,6 +15057,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribePeers_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeCustomMessages",
+			Handler:       _Lightning_SubscribeCustomMessages_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
@@ -11203,11 +15084,27 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Lightning_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Lightning_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeInvoices",
 			Handler:       _Lightning_SubscribeInvoices_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TrackPayment",
+			Handler:       _Lightning_TrackPayment_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelGraph",
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
@@ -11222,463 +15119,616 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_e0f985b54d26ef92) }
-
-var fileDescriptor_rpc_e0f985b54d26ef92 = []byte{
-	// 7276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4d, 0x6c, 0x1c, 0xc9,
-	0x75, 0xb0, 0x7a, 0x38, 0xfc, 0x7b, 0x43, 0x0e, 0x87, 0xc5, 0xbf, 0xd1, 0x48, 0xab, 0xd5, 0xb6,
-	0xf5, 0xad, 0xb4, 0xfa, 0xf6, 0x93, 0xb4, 0xb4, 0xbd, 0x5e, 0xef, 0x7e, 0xb1, 0x43, 0x91, 0x94,
-	0x28, 0x9b, 0x2b, 0xd1, 0x4d, 0xc9, 0x8a, 0xbd, 0x09, 0xc6, 0xcd, 0x99, 0x22, 0xd9, 0xd6, 0x4c,
-	0xf7, 0xb8, 0xbb, 0x87, 0xd4, 0x78, 0x2d, 0x20, 0x4e, 0x82, 0x04, 0x08, 0x62, 0x18, 0x81, 0x03,
-	0x04, 0x0e, 0x10, 0x38, 0x70, 0x72, 0xb0, 0x01, 0x5f, 0xe3, 0x4b, 0x72, 0xcc, 0x25, 0x07, 0x23,
-	0x07, 0x9f, 0x82, 0x00, 0x41, 0x80, 0xe4, 0x92, 0xf8, 0x16, 0x20, 0x40, 0x2e, 0x01, 0x82, 0xf7,
-	0xea, 0xa7, 0xab, 0xba, 0x7b, 0x48, 0xd9, 0xde, 0xe4, 0xd6, 0xf5, 0xea, 0x75, 0xfd, 0xbc, 0x7a,
-	0x7f, 0xf5, 0xea, 0x55, 0xc1, 0x6c, 0x3c, 0xe8, 0xdc, 0x1a, 0xc4, 0x51, 0x1a, 0xb1, 0xc9, 0x5e,
-	0x18, 0x0f, 0x3a, 0xad, 0xcb, 0x47, 0x51, 0x74, 0xd4, 0xe3, 0xb7, 0xfd, 0x41, 0x70, 0xdb, 0x0f,
-	0xc3, 0x28, 0xf5, 0xd3, 0x20, 0x0a, 0x13, 0x81, 0xe4, 0x7e, 0x05, 0xea, 0xf7, 0x79, 0xb8, 0xcf,
-	0x79, 0xd7, 0xe3, 0x5f, 0x1b, 0xf2, 0x24, 0x65, 0xff, 0x17, 0x16, 0x7d, 0xfe, 0x75, 0xce, 0xbb,
-	0xed, 0x81, 0x9f, 0x24, 0x83, 0xe3, 0xd8, 0x4f, 0x78, 0xd3, 0xb9, 0xea, 0xdc, 0x98, 0xf3, 0x1a,
-	0xa2, 0x62, 0x4f, 0xc3, 0xd9, 0x6b, 0x30, 0x97, 0x20, 0x2a, 0x0f, 0xd3, 0x38, 0x1a, 0x8c, 0x9a,
-	0x15, 0xc2, 0xab, 0x21, 0x6c, 0x5b, 0x80, 0xdc, 0x1e, 0x2c, 0xe8, 0x1e, 0x92, 0x41, 0x14, 0x26,
-	0x9c, 0xdd, 0x81, 0xe5, 0x4e, 0x30, 0x38, 0xe6, 0x71, 0x9b, 0x7e, 0xee, 0x87, 0xbc, 0x1f, 0x85,
-	0x41, 0xa7, 0xe9, 0x5c, 0x9d, 0xb8, 0x31, 0xeb, 0x31, 0x51, 0x87, 0x7f, 0xbc, 0x2f, 0x6b, 0xd8,
-	0x75, 0x58, 0xe0, 0xa1, 0x80, 0xf3, 0x2e, 0xfd, 0x25, 0xbb, 0xaa, 0x67, 0x60, 0xfc, 0xc1, 0xfd,
-	0x1b, 0x07, 0x16, 0x1f, 0x84, 0x41, 0xfa, 0xd4, 0xef, 0xf5, 0x78, 0xaa, 0xe6, 0x74, 0x1d, 0x16,
-	0x4e, 0x09, 0x40, 0x73, 0x3a, 0x8d, 0xe2, 0xae, 0x9c, 0x51, 0x5d, 0x80, 0xf7, 0x24, 0x74, 0xec,
-	0xc8, 0x2a, 0x63, 0x47, 0x56, 0x4a, 0xae, 0x89, 0x31, 0xe4, 0xba, 0x0e, 0x0b, 0x31, 0xef, 0x44,
-	0x27, 0x3c, 0x1e, 0xb5, 0x4f, 0x83, 0xb0, 0x1b, 0x9d, 0x36, 0xab, 0x57, 0x9d, 0x1b, 0x93, 0x5e,
-	0x5d, 0x81, 0x9f, 0x12, 0xd4, 0x5d, 0x06, 0x66, 0xce, 0x42, 0xd0, 0xcd, 0x3d, 0x82, 0xa5, 0x27,
-	0x61, 0x2f, 0xea, 0x3c, 0xfb, 0x05, 0x67, 0x57, 0xd2, 0x7d, 0xa5, 0xb4, 0xfb, 0x55, 0x58, 0xb6,
-	0x3b, 0x92, 0x03, 0xe0, 0xb0, 0xb2, 0x79, 0xec, 0x87, 0x47, 0x5c, 0x35, 0xa9, 0x86, 0xf0, 0x06,
-	0x34, 0x3a, 0xc3, 0x38, 0xe6, 0x61, 0x61, 0x0c, 0x0b, 0x12, 0xae, 0x07, 0xf1, 0x1a, 0xcc, 0x85,
-	0xfc, 0x34, 0x43, 0x93, 0x2c, 0x13, 0xf2, 0x53, 0x85, 0xe2, 0x36, 0x61, 0x35, 0xdf, 0x8d, 0x1c,
-	0xc0, 0x77, 0x2b, 0x50, 0x7b, 0x1c, 0xfb, 0x61, 0xe2, 0x77, 0x90, 0x8b, 0x59, 0x13, 0xa6, 0xd3,
-	0xe7, 0xed, 0x63, 0x3f, 0x39, 0xa6, 0xee, 0x66, 0x3d, 0x55, 0x64, 0xab, 0x30, 0xe5, 0xf7, 0xa3,
-	0x61, 0x98, 0x52, 0x07, 0x13, 0x9e, 0x2c, 0xb1, 0x37, 0x61, 0x31, 0x1c, 0xf6, 0xdb, 0x9d, 0x28,
-	0x3c, 0x0c, 0xe2, 0xbe, 0x90, 0x05, 0x5a, 0xaf, 0x49, 0xaf, 0x58, 0xc1, 0xae, 0x00, 0x1c, 0x20,
-	0x1d, 0x44, 0x17, 0x55, 0xea, 0xc2, 0x80, 0x30, 0x17, 0xe6, 0x64, 0x89, 0x07, 0x47, 0xc7, 0x69,
-	0x73, 0x92, 0x1a, 0xb2, 0x60, 0xd8, 0x46, 0x1a, 0xf4, 0x79, 0x3b, 0x49, 0xfd, 0xfe, 0xa0, 0x39,
-	0x45, 0xa3, 0x31, 0x20, 0x54, 0x1f, 0xa5, 0x7e, 0xaf, 0x7d, 0xc8, 0x79, 0xd2, 0x9c, 0x96, 0xf5,
-	0x1a, 0xc2, 0x5e, 0x87, 0x7a, 0x97, 0x27, 0x69, 0xdb, 0xef, 0x76, 0x63, 0x9e, 0x24, 0x3c, 0x69,
-	0xce, 0x10, 0x37, 0xe6, 0xa0, 0x48, 0xb5, 0xfb, 0x3c, 0x35, 0xa8, 0x93, 0xc8, 0xd5, 0x71, 0x77,
-	0x81, 0x19, 0xe0, 0x2d, 0x9e, 0xfa, 0x41, 0x2f, 0x61, 0x6f, 0xc3, 0x5c, 0x6a, 0x20, 0x93, 0xf4,
-	0xd5, 0xd6, 0xd9, 0x2d, 0x52, 0x1b, 0xb7, 0x8c, 0x1f, 0x3c, 0x0b, 0xcf, 0xbd, 0x0f, 0x33, 0xf7,
-	0x38, 0xdf, 0x0d, 0xfa, 0x41, 0xca, 0x56, 0x61, 0xf2, 0x30, 0x78, 0xce, 0xc5, 0x62, 0x4f, 0xec,
-	0x5c, 0xf0, 0x44, 0x91, 0xb5, 0x60, 0x7a, 0xc0, 0xe3, 0x0e, 0x57, 0xe4, 0xdf, 0xb9, 0xe0, 0x29,
-	0xc0, 0xdd, 0x69, 0x98, 0xec, 0xe1, 0xcf, 0xee, 0x0f, 0x2a, 0x50, 0xdb, 0xe7, 0xa1, 0x66, 0x22,
-	0x06, 0x55, 0x9c, 0x92, 0x64, 0x1c, 0xfa, 0x66, 0xaf, 0x42, 0x8d, 0xa6, 0x99, 0xa4, 0x71, 0x10,
-	0x1e, 0x51, 0x63, 0xb3, 0x1e, 0x20, 0x68, 0x9f, 0x20, 0xac, 0x01, 0x13, 0x7e, 0x3f, 0xa5, 0x15,
-	0x9c, 0xf0, 0xf0, 0x13, 0x19, 0x6c, 0xe0, 0x8f, 0xfa, 0xc8, 0x8b, 0x7a, 0xd5, 0xe6, 0xbc, 0x9a,
-	0x84, 0xed, 0xe0, 0xb2, 0xdd, 0x82, 0x25, 0x13, 0x45, 0xb5, 0x3e, 0x49, 0xad, 0x2f, 0x1a, 0x98,
-	0xb2, 0x93, 0xeb, 0xb0, 0xa0, 0xf0, 0x63, 0x31, 0x58, 0x5a, 0xc7, 0x59, 0xaf, 0x2e, 0xc1, 0x6a,
-	0x0a, 0x37, 0xa0, 0x71, 0x18, 0x84, 0x7e, 0xaf, 0xdd, 0xe9, 0xa5, 0x27, 0xed, 0x2e, 0xef, 0xa5,
-	0x3e, 0xad, 0xe8, 0xa4, 0x57, 0x27, 0xf8, 0x66, 0x2f, 0x3d, 0xd9, 0x42, 0x28, 0x7b, 0x13, 0x66,
-	0x0f, 0x39, 0x6f, 0x13, 0x25, 0x9a, 0x33, 0x57, 0x9d, 0x1b, 0xb5, 0xf5, 0x05, 0x49, 0x7a, 0x45,
-	0x5d, 0x6f, 0xe6, 0x50, 0x7e, 0xb9, 0x5d, 0x00, 0x2f, 0x1a, 0xa6, 0x7c, 0x3b, 0x8e, 0xa3, 0x98,
-	0xbd, 0x05, 0xf3, 0x7a, 0x38, 0x08, 0x25, 0x8a, 0xd5, 0xd6, 0xe7, 0xe4, 0xff, 0x84, 0xe9, 0x29,
-	0x22, 0x50, 0x89, 0x7d, 0x2c, 0xfb, 0x85, 0x63, 0x1b, 0x92, 0x92, 0x0a, 0x89, 0xda, 0x75, 0x7f,
-	0xe2, 0xc0, 0x9c, 0x58, 0x10, 0xa9, 0xa8, 0xaf, 0xe5, 0xff, 0x12, 0x42, 0x66, 0x03, 0xd9, 0x4d,
-	0x68, 0x28, 0xc0, 0x20, 0xe6, 0x41, 0xdf, 0x3f, 0xe2, 0x52, 0xaa, 0x0b, 0x70, 0xb6, 0x9e, 0x1f,
-	0xfa, 0x44, 0xc9, 0xd0, 0x6d, 0x14, 0xf6, 0x29, 0x98, 0x3f, 0xf4, 0x83, 0x1e, 0xef, 0x8a, 0x72,
-	0xd2, 0xac, 0x12, 0xa7, 0x2e, 0x9a, 0xff, 0xd0, 0x04, 0x3c, 0x1b, 0xcf, 0xfd, 0x96, 0x03, 0x0c,
-	0xe7, 0xf3, 0x38, 0x12, 0xed, 0xca, 0x45, 0xca, 0x33, 0x88, 0xf3, 0xd2, 0x0c, 0x52, 0x19, 0xc7,
-	0x20, 0xd7, 0x60, 0x4a, 0x8e, 0x6d, 0x82, 0xc6, 0x66, 0xcf, 0x47, 0xd6, 0xb9, 0xdf, 0x77, 0x60,
-	0x0e, 0x15, 0x5b, 0xc8, 0x7b, 0x7b, 0x51, 0x10, 0xa6, 0xec, 0x0e, 0xb0, 0xc3, 0x61, 0xd8, 0x0d,
-	0xc2, 0xa3, 0x76, 0xfa, 0x3c, 0xe8, 0xb6, 0x0f, 0x46, 0xd8, 0x04, 0x8d, 0x67, 0xe7, 0x82, 0x57,
-	0x52, 0xc7, 0xde, 0x84, 0x86, 0x05, 0x4d, 0x52, 0xb9, 0x94, 0x3b, 0x17, 0xbc, 0x42, 0x0d, 0xaa,
-	0xa7, 0x68, 0x98, 0x0e, 0x86, 0x69, 0x3b, 0x08, 0xbb, 0xfc, 0x39, 0x11, 0x7b, 0xde, 0xb3, 0x60,
-	0x77, 0xeb, 0x30, 0x67, 0xfe, 0xe7, 0x7e, 0x06, 0x1a, 0xbb, 0xa8, 0xb7, 0xc2, 0x20, 0x3c, 0xda,
-	0x10, 0xca, 0x05, 0x95, 0xe9, 0x60, 0x78, 0xf0, 0x8c, 0x8f, 0x24, 0x03, 0xc8, 0x12, 0x4a, 0xec,
-	0x71, 0x94, 0xa4, 0x92, 0x2e, 0xf4, 0xed, 0xfe, 0xb3, 0x03, 0x0b, 0x48, 0xf4, 0xf7, 0xfd, 0x70,
-	0xa4, 0x28, 0xbe, 0x0b, 0x73, 0xd8, 0xd4, 0xe3, 0x68, 0x43, 0xa8, 0x64, 0xa1, 0x6a, 0x6e, 0x48,
-	0x22, 0xe5, 0xb0, 0x6f, 0x99, 0xa8, 0xe8, 0x45, 0x8c, 0x3c, 0xeb, 0x6f, 0xd4, 0x09, 0xa9, 0x1f,
-	0x1f, 0xf1, 0x94, 0x94, 0xb5, 0x54, 0xde, 0x20, 0x40, 0x9b, 0x51, 0x78, 0xc8, 0xae, 0xc2, 0x5c,
-	0xe2, 0xa7, 0xed, 0x01, 0x8f, 0x89, 0x6a, 0x24, 0xd7, 0x13, 0x1e, 0x24, 0x7e, 0xba, 0xc7, 0xe3,
-	0xbb, 0xa3, 0x94, 0xb7, 0x3e, 0x0b, 0x8b, 0x85, 0x5e, 0x50, 0x95, 0x64, 0x53, 0xc4, 0x4f, 0xb6,
-	0x0c, 0x93, 0x27, 0x7e, 0x6f, 0xc8, 0xa5, 0x0d, 0x11, 0x85, 0x77, 0x2b, 0xef, 0x38, 0xee, 0xeb,
-	0xd0, 0xc8, 0x86, 0x2d, 0xa5, 0x85, 0x41, 0x15, 0x29, 0x28, 0x1b, 0xa0, 0x6f, 0xf7, 0x9b, 0x8e,
-	0x40, 0xdc, 0x8c, 0x02, 0xad, 0x8f, 0x11, 0x11, 0xd5, 0xb6, 0x42, 0xc4, 0xef, 0xb1, 0xf6, 0xea,
-	0x97, 0x9f, 0xac, 0x7b, 0x1d, 0x16, 0x8d, 0x21, 0x9c, 0x31, 0xd8, 0x6f, 0x39, 0xb0, 0xf8, 0x90,
-	0x9f, 0xca, 0x55, 0x57, 0xa3, 0x7d, 0x07, 0xaa, 0xe9, 0x68, 0x20, 0x94, 0x4c, 0x7d, 0xfd, 0x9a,
-	0x5c, 0xb4, 0x02, 0xde, 0x2d, 0x59, 0x7c, 0x3c, 0x1a, 0x70, 0x8f, 0xfe, 0x70, 0x3f, 0x03, 0x35,
-	0x03, 0xc8, 0xd6, 0x60, 0xe9, 0xe9, 0x83, 0xc7, 0x0f, 0xb7, 0xf7, 0xf7, 0xdb, 0x7b, 0x4f, 0xee,
-	0x7e, 0x7e, 0xfb, 0x4b, 0xed, 0x9d, 0x8d, 0xfd, 0x9d, 0xc6, 0x05, 0xb6, 0x0a, 0xec, 0xe1, 0xf6,
-	0xfe, 0xe3, 0xed, 0x2d, 0x0b, 0xee, 0xb8, 0xb7, 0x80, 0x99, 0xdd, 0xc8, 0x91, 0x37, 0x61, 0x5a,
-	0x1a, 0x3d, 0x65, 0xf3, 0x65, 0xd1, 0x6d, 0x41, 0x73, 0x7f, 0x78, 0xb0, 0x7f, 0xea, 0x0f, 0x36,
-	0x7b, 0x01, 0x0f, 0x53, 0x74, 0xa1, 0x94, 0x0d, 0x1c, 0xc2, 0xc5, 0x92, 0x3a, 0xd9, 0x64, 0x0b,
-	0x66, 0xb4, 0xe6, 0x12, 0xda, 0x40, 0x97, 0x89, 0xc7, 0x51, 0x4b, 0x08, 0x8d, 0x46, 0xdf, 0x8a,
-	0x53, 0x84, 0x9b, 0x47, 0x9c, 0x92, 0x49, 0x88, 0x30, 0x37, 0xb2, 0xe4, 0xde, 0xd7, 0xdd, 0xee,
-	0xf3, 0xf8, 0x24, 0xe8, 0x70, 0x63, 0x4c, 0xba, 0x69, 0xc7, 0x68, 0x3a, 0x6b, 0xa8, 0x62, 0x35,
-	0x34, 0x80, 0x56, 0x59, 0x43, 0xe7, 0xd1, 0x64, 0x5c, 0x7b, 0xec, 0x2a, 0xd4, 0x4c, 0xc7, 0x45,
-	0xd8, 0x4f, 0x13, 0xe4, 0x7e, 0xc7, 0xc9, 0x91, 0xec, 0xa9, 0x9f, 0x76, 0x8e, 0xd5, 0xd8, 0xcf,
-	0x22, 0x99, 0x24, 0x4f, 0x25, 0x23, 0xcf, 0xeb, 0x50, 0x4f, 0xc4, 0xb0, 0xdb, 0x72, 0x34, 0x82,
-	0x76, 0x39, 0x68, 0x7e, 0x54, 0xd5, 0xe2, 0xa8, 0x1e, 0x6a, 0x3a, 0x58, 0x83, 0x7a, 0x19, 0x3a,
-	0x24, 0x9d, 0x38, 0x18, 0xa4, 0x8a, 0x0e, 0xa2, 0xe4, 0x6e, 0xa1, 0xab, 0x9b, 0x0c, 0x78, 0x98,
-	0x0a, 0x55, 0xa0, 0xe6, 0x37, 0xbe, 0xa5, 0x12, 0x86, 0x70, 0xff, 0xd3, 0x81, 0x95, 0x5c, 0x33,
-	0x72, 0x44, 0x99, 0x5c, 0x3b, 0x96, 0x5c, 0xe7, 0x66, 0x2a, 0xfc, 0x70, 0x13, 0xc4, 0x3e, 0x05,
-	0x93, 0xc3, 0xf4, 0x79, 0xa4, 0x4c, 0xca, 0x6b, 0x52, 0xf0, 0x4a, 0xbb, 0xb9, 0xf5, 0x24, 0x7d,
-	0x1e, 0x79, 0x02, 0xbf, 0xd5, 0x83, 0x2a, 0x16, 0x0b, 0xce, 0xa9, 0x53, 0xe2, 0x9c, 0x8e, 0x53,
-	0x3b, 0x4a, 0x3d, 0x4c, 0x64, 0xea, 0x01, 0xb5, 0xa1, 0x30, 0x23, 0x55, 0x32, 0x23, 0xa2, 0xe0,
-	0x7e, 0x03, 0x2e, 0xd9, 0x8c, 0xe9, 0xf1, 0x2e, 0xe7, 0xfd, 0x97, 0xe1, 0x93, 0x9c, 0x6e, 0xab,
-	0x9c, 0xab, 0xdb, 0x26, 0x0a, 0xba, 0x6d, 0x1d, 0x2e, 0x97, 0xf7, 0x7e, 0x86, 0x9a, 0xfb, 0x33,
-	0x27, 0xc7, 0x43, 0x1e, 0x47, 0x0b, 0x78, 0xfe, 0xca, 0xff, 0x1f, 0x98, 0x8f, 0x09, 0x55, 0xaa,
-	0x24, 0x69, 0xf7, 0xea, 0x02, 0xa8, 0x5c, 0xf1, 0x9f, 0x5f, 0x65, 0x57, 0x0b, 0xd3, 0x7a, 0x4b,
-	0x13, 0xd5, 0x1e, 0xe1, 0x19, 0xb3, 0x7a, 0x1d, 0xd8, 0x7e, 0x70, 0x14, 0xbe, 0xcf, 0x93, 0xc4,
-	0x3f, 0xd2, 0xbe, 0x4e, 0x03, 0x26, 0xfa, 0xc9, 0x91, 0xa4, 0x3c, 0x7e, 0xba, 0x1f, 0x87, 0x25,
-	0x0b, 0x4f, 0x36, 0x79, 0x19, 0x66, 0x93, 0xe0, 0x28, 0xf4, 0xd3, 0x61, 0xcc, 0x65, 0xbb, 0x19,
-	0xc0, 0xbd, 0x07, 0xcb, 0x5f, 0xe4, 0x71, 0x70, 0x38, 0x3a, 0xaf, 0x79, 0xbb, 0x9d, 0x4a, 0xbe,
-	0x9d, 0x6d, 0x58, 0xc9, 0xb5, 0x23, 0xbb, 0x17, 0x96, 0x56, 0x4e, 0x69, 0xc6, 0x13, 0x85, 0x9c,
-	0xf2, 0xd2, 0x7e, 0x87, 0xfb, 0x04, 0xd8, 0x66, 0x14, 0x86, 0xbc, 0x93, 0xee, 0x71, 0x1e, 0x67,
-	0x91, 0x8b, 0xcc, 0xac, 0xd6, 0xd6, 0xd7, 0xa4, 0xbc, 0xe4, 0x9d, 0x19, 0x69, 0x6f, 0x19, 0x54,
-	0x07, 0x3c, 0xee, 0x53, 0xc3, 0x33, 0x1e, 0x7d, 0xbb, 0x2b, 0xb0, 0x64, 0x35, 0x2b, 0x37, 0x9d,
-	0x6f, 0xc1, 0xca, 0x56, 0x90, 0x74, 0x8a, 0x1d, 0x36, 0x61, 0x7a, 0x30, 0x3c, 0x68, 0x67, 0x4e,
-	0x83, 0x2a, 0xe2, 0x5e, 0x2c, 0xff, 0x8b, 0x6c, 0xec, 0x77, 0x1d, 0xa8, 0xee, 0x3c, 0xde, 0xdd,
-	0x44, 0xc1, 0x08, 0xc2, 0x4e, 0xd4, 0x47, 0xbf, 0x52, 0x4c, 0x5a, 0x97, 0xc7, 0x4a, 0xe5, 0x65,
-	0x98, 0x25, 0x77, 0x14, 0x25, 0x58, 0x6a, 0xd0, 0x0c, 0x80, 0x5b, 0x5b, 0xfe, 0x7c, 0x10, 0xc4,
-	0xb4, 0x77, 0x35, 0x55, 0xe8, 0xbc, 0x57, 0xac, 0x70, 0xff, 0x69, 0x12, 0xa6, 0xa5, 0x33, 0x4a,
-	0xfd, 0x75, 0xd2, 0xe0, 0x84, 0xcb, 0x91, 0xc8, 0x12, 0xfa, 0xff, 0x31, 0xef, 0x47, 0xa9, 0xd6,
-	0xda, 0x62, 0x19, 0x6c, 0x20, 0x62, 0x75, 0x44, 0x43, 0xed, 0x01, 0xba, 0xb5, 0x52, 0x69, 0xd8,
-	0x40, 0x24, 0x16, 0x02, 0xda, 0x41, 0x97, 0xc6, 0x54, 0xf5, 0x54, 0x11, 0x29, 0xd1, 0xf1, 0x07,
-	0x7e, 0x27, 0x48, 0x47, 0xd2, 0x7b, 0xd1, 0x65, 0x6c, 0xbb, 0x17, 0x75, 0xfc, 0x5e, 0xfb, 0xc0,
-	0xef, 0xf9, 0x61, 0x87, 0xcb, 0xfd, 0xb3, 0x0d, 0x44, 0xf3, 0x22, 0x87, 0xa4, 0xd0, 0xc4, 0x36,
-	0x3a, 0x07, 0xc5, 0xad, 0x76, 0x27, 0xea, 0xf7, 0x83, 0x14, 0x77, 0xd6, 0xb4, 0xeb, 0x9a, 0xf0,
-	0x0c, 0x08, 0xcd, 0x44, 0x94, 0x4e, 0x05, 0xf5, 0x66, 0x45, 0x6f, 0x16, 0x10, 0x5b, 0xc1, 0xad,
-	0x1b, 0x8a, 0xef, 0xb3, 0xd3, 0x26, 0x88, 0x56, 0x32, 0x08, 0xae, 0xc3, 0x30, 0x4c, 0x78, 0x9a,
-	0xe2, 0x56, 0x44, 0x0d, 0xa8, 0x46, 0x68, 0xc5, 0x0a, 0x76, 0x07, 0x96, 0xc4, 0x66, 0x3f, 0xf1,
-	0xd3, 0x28, 0x39, 0x0e, 0x92, 0x76, 0x82, 0xdb, 0xe6, 0x39, 0xc2, 0x2f, 0xab, 0x62, 0xef, 0xc0,
-	0x5a, 0x0e, 0x1c, 0xf3, 0x0e, 0x0f, 0x4e, 0x78, 0xb7, 0x39, 0x4f, 0x7f, 0x8d, 0xab, 0x46, 0xa3,
-	0x13, 0x0e, 0xfb, 0xed, 0xe1, 0xa0, 0xeb, 0xe3, 0x46, 0xa3, 0x4e, 0xeb, 0x60, 0x82, 0x68, 0x6b,
-	0xc9, 0xc5, 0x6e, 0xe0, 0x38, 0xed, 0x75, 0x92, 0xe6, 0x02, 0x19, 0x9f, 0x9a, 0x14, 0x26, 0xe4,
-	0x5c, 0xcf, 0xc6, 0x40, 0xa6, 0xec, 0x24, 0xb4, 0xd9, 0xf5, 0x47, 0xcd, 0x06, 0xb1, 0x5b, 0x06,
-	0x20, 0x19, 0x89, 0x83, 0x13, 0x3f, 0xe5, 0xcd, 0x45, 0xe2, 0x2d, 0x55, 0x64, 0xb7, 0x80, 0x89,
-	0x55, 0x24, 0x3e, 0x88, 0x39, 0xba, 0x02, 0xbc, 0xc9, 0x68, 0x06, 0x25, 0x35, 0x48, 0x28, 0xb9,
-	0x9c, 0xd6, 0x0f, 0x4b, 0x82, 0x50, 0x25, 0x55, 0xee, 0xf7, 0x1c, 0x58, 0xda, 0x0d, 0x92, 0x54,
-	0xb2, 0xb9, 0xf6, 0x68, 0x5f, 0x85, 0x9a, 0x60, 0xf0, 0x76, 0x14, 0xf6, 0x46, 0x92, 0xe7, 0x41,
-	0x80, 0x1e, 0x85, 0xbd, 0x11, 0xee, 0x96, 0x83, 0xd0, 0x44, 0x11, 0x5a, 0x62, 0x4e, 0x01, 0x09,
-	0xe9, 0x55, 0xa8, 0x0d, 0x86, 0x07, 0xbd, 0xa0, 0x23, 0x50, 0x26, 0x44, 0x2b, 0x02, 0x44, 0x08,
-	0xb8, 0xcf, 0x14, 0x73, 0x15, 0x18, 0x55, 0xc2, 0xa8, 0x49, 0x18, 0xa2, 0xb8, 0x77, 0x61, 0xd9,
-	0x1e, 0xa0, 0x54, 0x87, 0x37, 0x61, 0x46, 0x4a, 0x4f, 0xd2, 0xac, 0xd1, 0x0a, 0xd4, 0xe5, 0x0a,
-	0x48, 0x54, 0x4f, 0xd7, 0xbb, 0x3f, 0xae, 0xc2, 0x92, 0x84, 0x6e, 0xf6, 0xa2, 0x84, 0xef, 0x0f,
-	0xfb, 0x7d, 0x3f, 0x2e, 0x11, 0x4b, 0xe7, 0x1c, 0xb1, 0xac, 0xd8, 0x62, 0x89, 0xc2, 0x72, 0xec,
-	0x07, 0xa1, 0xd8, 0x24, 0x0b, 0x99, 0x36, 0x20, 0xec, 0x06, 0x2c, 0x74, 0x7a, 0x51, 0x22, 0x36,
-	0x8e, 0x66, 0x80, 0x2c, 0x0f, 0x2e, 0xaa, 0x91, 0xc9, 0x32, 0x35, 0x62, 0xaa, 0x81, 0xa9, 0x9c,
-	0x1a, 0x70, 0x61, 0x0e, 0x1b, 0xe5, 0x4a, 0xab, 0x4d, 0x8b, 0x8d, 0xac, 0x09, 0xc3, 0xf1, 0xe4,
-	0x85, 0x4e, 0x48, 0xf8, 0x42, 0x99, 0xc8, 0x05, 0x7d, 0x4e, 0x5a, 0xd3, 0xc0, 0x9e, 0x95, 0x22,
-	0x57, 0xac, 0x62, 0xf7, 0x00, 0x44, 0x5f, 0xb4, 0x13, 0x02, 0xda, 0x09, 0xbd, 0x6e, 0xaf, 0x88,
-	0x49, 0xfb, 0x5b, 0x58, 0x18, 0xc6, 0x9c, 0xf6, 0x42, 0xc6, 0x9f, 0xee, 0xef, 0x3b, 0x50, 0x33,
-	0xea, 0xd8, 0x0a, 0x2c, 0x6e, 0x3e, 0x7a, 0xb4, 0xb7, 0xed, 0x6d, 0x3c, 0x7e, 0xf0, 0xc5, 0xed,
-	0xf6, 0xe6, 0xee, 0xa3, 0xfd, 0xed, 0xc6, 0x05, 0x04, 0xef, 0x3e, 0xda, 0xdc, 0xd8, 0x6d, 0xdf,
-	0x7b, 0xe4, 0x6d, 0x2a, 0xb0, 0x83, 0xfb, 0x24, 0x6f, 0xfb, 0xfd, 0x47, 0x8f, 0xb7, 0x2d, 0x78,
-	0x85, 0x35, 0x60, 0xee, 0xae, 0xb7, 0xbd, 0xb1, 0xb9, 0x23, 0x21, 0x13, 0x6c, 0x19, 0x1a, 0xf7,
-	0x9e, 0x3c, 0xdc, 0x7a, 0xf0, 0xf0, 0x7e, 0x7b, 0x73, 0xe3, 0xe1, 0xe6, 0xf6, 0xee, 0xf6, 0x56,
-	0xa3, 0xca, 0xe6, 0x61, 0x76, 0xe3, 0xee, 0xc6, 0xc3, 0xad, 0x47, 0x0f, 0xb7, 0xb7, 0x1a, 0x93,
-	0xee, 0x07, 0xb0, 0xb2, 0x17, 0x0f, 0x43, 0xde, 0xcd, 0xcb, 0x07, 0x3a, 0x8e, 0xfc, 0x28, 0x08,
-	0x4d, 0xc7, 0x71, 0xde, 0xb3, 0x60, 0xc8, 0x1d, 0x3c, 0xec, 0x9a, 0xee, 0xeb, 0xbc, 0x67, 0x40,
-	0xdc, 0x3f, 0x72, 0x60, 0xde, 0x6a, 0x9d, 0xf8, 0x11, 0x29, 0xd1, 0xb5, 0x9b, 0xb5, 0x81, 0xec,
-	0x52, 0x9e, 0x1f, 0x41, 0xf1, 0x6b, 0xce, 0x52, 0x4c, 0x14, 0x2d, 0x85, 0xcd, 0xee, 0xd5, 0x12,
-	0x76, 0x77, 0x3f, 0x07, 0xab, 0xf9, 0x39, 0xeb, 0x43, 0x89, 0x4c, 0xe4, 0x44, 0x7c, 0x62, 0x59,
-	0x2e, 0xb0, 0xf5, 0x83, 0x21, 0x78, 0xff, 0xe8, 0xc0, 0x0a, 0xad, 0x7a, 0x81, 0x80, 0x57, 0xa1,
-	0xd6, 0x89, 0xa2, 0x01, 0x47, 0x8b, 0xab, 0x8d, 0xaa, 0x09, 0x42, 0xe5, 0x21, 0x54, 0xdc, 0x61,
-	0x14, 0x77, 0xb8, 0xd4, 0x2f, 0x40, 0xa0, 0x7b, 0x08, 0x41, 0xe5, 0x21, 0xc5, 0x43, 0x60, 0x08,
-	0xf5, 0x52, 0x13, 0x30, 0x81, 0xb2, 0x0a, 0x53, 0x07, 0x31, 0xf7, 0x3b, 0xc7, 0x52, 0xb3, 0xc8,
-	0x12, 0x7b, 0x23, 0x8b, 0x11, 0x75, 0x90, 0x7b, 0x7b, 0xbc, 0x4b, 0x12, 0x37, 0xe3, 0x2d, 0x48,
-	0xf8, 0xa6, 0x04, 0xa3, 0xee, 0xf6, 0x0f, 0xfc, 0xb0, 0x1b, 0x85, 0xbc, 0x4b, 0x42, 0x37, 0xe3,
-	0x65, 0x00, 0x77, 0x0f, 0x56, 0xf3, 0xf3, 0x93, 0xc4, 0x7a, 0xbb, 0x40, 0xac, 0xd6, 0x78, 0x69,
-	0x30, 0x48, 0xf6, 0x6f, 0x0e, 0x54, 0xd1, 0x1d, 0x1a, 0xef, 0x3a, 0x99, 0xee, 0xf7, 0x84, 0xed,
-	0x7e, 0x5f, 0x01, 0xa0, 0x20, 0x98, 0x30, 0x90, 0xc2, 0x89, 0x30, 0x20, 0x59, 0x7d, 0xcc, 0x3b,
-	0x27, 0x34, 0x63, 0x5d, 0x8f, 0x10, 0xe4, 0x1e, 0x74, 0xbb, 0xe9, 0x6f, 0xa9, 0x60, 0x54, 0x59,
-	0xd5, 0xd1, 0x9f, 0xd3, 0x59, 0x1d, 0xfd, 0xd7, 0x84, 0xe9, 0x20, 0x3c, 0x88, 0x86, 0x61, 0x97,
-	0x14, 0xca, 0x8c, 0xa7, 0x8a, 0x48, 0xbe, 0x01, 0x29, 0xba, 0xa0, 0xaf, 0xd4, 0x47, 0x06, 0x70,
-	0x19, 0x34, 0x50, 0xb9, 0xe3, 0x7c, 0x75, 0x28, 0xfe, 0x6d, 0x58, 0x34, 0x60, 0x92, 0x9a, 0xaf,
-	0xc1, 0xe4, 0x00, 0x01, 0x92, 0x94, 0xca, 0xd8, 0x92, 0xdf, 0x28, 0x6a, 0xb0, 0x2d, 0x2c, 0xee,
-	0x0f, 0x0f, 0xc4, 0xbe, 0x35, 0x88, 0x42, 0xb7, 0x2b, 0x60, 0x0f, 0xa3, 0x34, 0x38, 0x0c, 0x3a,
-	0xbe, 0x3a, 0x10, 0x39, 0x9f, 0xae, 0x15, 0x9b, 0xae, 0x68, 0xc0, 0x85, 0xa7, 0xca, 0xbb, 0x92,
-	0xcf, 0x32, 0x80, 0xdb, 0x80, 0xfa, 0x7d, 0x9e, 0x3e, 0x08, 0x0f, 0x23, 0x35, 0x87, 0x6f, 0x57,
-	0x61, 0x41, 0x83, 0xe4, 0x14, 0x6e, 0xc0, 0x42, 0xd0, 0xe5, 0x61, 0x1a, 0xa4, 0xa3, 0xb6, 0x15,
-	0x2a, 0xcc, 0x83, 0xd1, 0xd3, 0xf7, 0x7b, 0x81, 0xaf, 0x46, 0x21, 0x0a, 0x6c, 0x1d, 0x96, 0xd1,
-	0x0d, 0x51, 0x9e, 0x85, 0x66, 0x2e, 0x11, 0xb1, 0x2c, 0xad, 0x43, 0x35, 0x8e, 0x70, 0x69, 0xa7,
-	0xf5, 0x2f, 0xc2, 0xe3, 0x2d, 0xab, 0xc2, 0x99, 0x8a, 0x96, 0x90, 0xd8, 0x93, 0xc2, 0x55, 0xd1,
-	0x80, 0xc2, 0x7e, 0x79, 0x4a, 0xaa, 0xbd, 0xdc, 0x61, 0x8e, 0x71, 0x20, 0x34, 0x53, 0x38, 0x10,
-	0x42, 0x23, 0x34, 0x0a, 0x3b, 0xbc, 0xdb, 0x4e, 0xa3, 0x36, 0x19, 0x4b, 0xe2, 0x8b, 0x19, 0x2f,
-	0x0f, 0xa6, 0xa3, 0x2b, 0x9e, 0xa4, 0x21, 0x4f, 0xc9, 0x9e, 0xcc, 0x78, 0xaa, 0x88, 0x72, 0x4d,
-	0x28, 0xc2, 0xf4, 0xcf, 0x7a, 0xb2, 0x84, 0x5b, 0x96, 0x61, 0x1c, 0x24, 0xcd, 0x39, 0x82, 0xd2,
-	0x37, 0xfb, 0x04, 0xac, 0x1c, 0xf0, 0x24, 0x6d, 0x1f, 0x73, 0xbf, 0xcb, 0x63, 0xe2, 0x3b, 0x71,
-	0xce, 0x24, 0x3c, 0xc1, 0xf2, 0x4a, 0xec, 0xfb, 0x84, 0xc7, 0x49, 0x10, 0x85, 0xe4, 0x03, 0xce,
-	0x7a, 0xaa, 0x88, 0xed, 0x21, 0x41, 0xb4, 0xf7, 0xa3, 0xa9, 0xba, 0x40, 0xc4, 0x28, 0xaf, 0x44,
-	0xee, 0xbc, 0xcf, 0xd3, 0xbb, 0x7e, 0xe7, 0xd9, 0x70, 0xa0, 0xb8, 0xe4, 0x0d, 0x58, 0x34, 0x60,
-	0xd9, 0x36, 0xef, 0x30, 0xe8, 0xf1, 0x44, 0x1e, 0xf5, 0x8a, 0x82, 0xfb, 0x75, 0xda, 0xce, 0xe9,
-	0x63, 0xb7, 0x27, 0xe4, 0x8b, 0xb2, 0x4b, 0x30, 0x2b, 0x08, 0x9b, 0x1c, 0xfb, 0x2a, 0x74, 0x40,
-	0x80, 0xfd, 0x63, 0x1f, 0xd5, 0xe3, 0x41, 0x49, 0xfc, 0x84, 0x60, 0x3b, 0x62, 0xa9, 0xae, 0x41,
-	0x5d, 0x1d, 0xe8, 0x25, 0xed, 0x1e, 0x3f, 0x4c, 0x55, 0xf8, 0x3b, 0x1c, 0xf6, 0xb1, 0xbb, 0x64,
-	0x97, 0x1f, 0xa6, 0xee, 0x43, 0x58, 0x94, 0x2a, 0xeb, 0xd1, 0x80, 0xab, 0xae, 0x3f, 0x5d, 0xe6,
-	0x3a, 0xd5, 0xd6, 0x97, 0x6c, 0x1d, 0x47, 0x31, 0xfc, 0xbc, 0x81, 0xf1, 0x80, 0x99, 0x2a, 0x50,
-	0x36, 0x28, 0xfd, 0x17, 0x15, 0x64, 0x97, 0xd3, 0xb1, 0x60, 0xb8, 0x28, 0xc9, 0xb0, 0xd3, 0x51,
-	0x02, 0x3a, 0xe3, 0xa9, 0xa2, 0xfb, 0x03, 0x07, 0x96, 0xa8, 0x35, 0x65, 0x83, 0x74, 0x64, 0xf6,
-	0xe5, 0x87, 0x39, 0xd7, 0x31, 0x0f, 0x1e, 0x70, 0x1d, 0x0c, 0xc3, 0x23, 0x0a, 0x1f, 0x45, 0xe0,
-	0xe2, 0xef, 0x1d, 0x58, 0x14, 0xba, 0x3f, 0xf5, 0xd3, 0x61, 0x22, 0xa7, 0xff, 0xff, 0xa5, 0xe9,
-	0x57, 0x32, 0x2c, 0x07, 0xaa, 0x0d, 0xac, 0x80, 0x0a, 0xe4, 0x9d, 0x0b, 0x9e, 0x8d, 0xcc, 0x3e,
-	0x0b, 0x73, 0xe6, 0xa9, 0x2c, 0x8d, 0xb9, 0xb6, 0x7e, 0x51, 0xcd, 0xb2, 0xc0, 0x39, 0x3b, 0x17,
-	0x3c, 0xeb, 0x07, 0xf6, 0x1e, 0x79, 0xb2, 0x61, 0x9b, 0x9a, 0x95, 0x27, 0x4e, 0x17, 0x4b, 0xec,
-	0x95, 0xfe, 0xdd, 0x40, 0xbf, 0x3b, 0x03, 0x53, 0x62, 0x73, 0xe4, 0xde, 0x87, 0x79, 0x6b, 0xa4,
-	0x56, 0x18, 0x66, 0x4e, 0x06, 0xc9, 0xf2, 0x47, 0x2e, 0x95, 0xe2, 0x91, 0x8b, 0xfb, 0xb3, 0x09,
-	0x60, 0xc8, 0x6d, 0xb9, 0xe5, 0xc4, 0xdd, 0x59, 0xd4, 0xb5, 0xf6, 0xda, 0x73, 0x9e, 0x09, 0xc2,
-	0x2d, 0x93, 0x51, 0x54, 0xa7, 0x52, 0xc2, 0x4c, 0x96, 0xd4, 0xa0, 0x56, 0x95, 0x5e, 0x86, 0xf4,
-	0x07, 0x64, 0x54, 0x41, 0xac, 0x5b, 0x69, 0x1d, 0x05, 0xec, 0x86, 0xc9, 0x31, 0xee, 0x1e, 0xd5,
-	0x6e, 0x5c, 0x95, 0xf3, 0x0c, 0x32, 0x75, 0x2e, 0x83, 0x4c, 0xe7, 0x19, 0xc4, 0xdc, 0x0f, 0xce,
-	0xd8, 0xfb, 0xc1, 0x6b, 0x30, 0xdf, 0x47, 0xff, 0x32, 0xed, 0x75, 0xda, 0x7d, 0xec, 0x5d, 0x6e,
-	0xbe, 0x2d, 0x20, 0xbb, 0x09, 0x0d, 0xb5, 0xd5, 0xd3, 0x9b, 0x4e, 0x20, 0x1a, 0x17, 0xe0, 0xa8,
-	0xee, 0xf1, 0x67, 0xd2, 0x00, 0xb4, 0x01, 0x9f, 0xf4, 0x32, 0x00, 0x6e, 0xd3, 0x13, 0x64, 0xb1,
-	0xf6, 0x30, 0x94, 0xdc, 0xc2, 0xbb, 0xb4, 0xed, 0x9e, 0xf1, 0x8a, 0x15, 0xb8, 0xe9, 0x2e, 0xd9,
-	0x62, 0x12, 0x95, 0xe4, 0xa6, 0x7b, 0x4c, 0xb5, 0xfb, 0x53, 0x07, 0x1a, 0xb8, 0xda, 0x96, 0x44,
-	0xbc, 0x0b, 0x24, 0x90, 0x2f, 0x29, 0x10, 0x16, 0xee, 0x2f, 0x2f, 0x0f, 0xef, 0xc0, 0x2c, 0x35,
-	0x18, 0x0d, 0x78, 0x28, 0xc5, 0xa1, 0x69, 0x8b, 0x43, 0xa6, 0x0b, 0x77, 0x2e, 0x78, 0x19, 0xb2,
-	0x21, 0x0c, 0x7f, 0xe7, 0x40, 0x4d, 0x0e, 0xf3, 0x17, 0x0e, 0x67, 0xb5, 0x60, 0x06, 0xe5, 0xc2,
-	0x88, 0x19, 0xe9, 0x32, 0x1a, 0xd2, 0xbe, 0x9f, 0x0e, 0x63, 0xf4, 0x1c, 0xac, 0x50, 0x56, 0x1e,
-	0x8c, 0x6e, 0x00, 0xa9, 0xfd, 0xa4, 0x9d, 0x06, 0xbd, 0xb6, 0xaa, 0x95, 0xa9, 0x18, 0x65, 0x55,
-	0xa8, 0xfd, 0x92, 0xd4, 0x3f, 0xe2, 0xd2, 0xc2, 0x8b, 0x82, 0xdb, 0x84, 0x55, 0x39, 0xa1, 0x9c,
-	0x3b, 0xef, 0x7e, 0x6f, 0x1e, 0xd6, 0x0a, 0x55, 0x7a, 0xdb, 0x20, 0x63, 0x34, 0xbd, 0xa0, 0x7f,
-	0x10, 0xe9, 0xbd, 0xa4, 0x63, 0x86, 0x6f, 0xac, 0x2a, 0x76, 0x04, 0x2b, 0xca, 0x95, 0x41, 0x9a,
-	0x66, 0x26, 0xb6, 0x42, 0xde, 0xdf, 0x5b, 0x36, 0x0f, 0xe4, 0x3b, 0x54, 0x70, 0x53, 0x7f, 0x94,
-	0xb7, 0xc7, 0x8e, 0xa1, 0xa9, 0x7d, 0x26, 0x69, 0x68, 0x0c, 0xbf, 0x0a, 0xfb, 0x7a, 0xf3, 0x9c,
-	0xbe, 0x2c, 0xef, 0xdf, 0x1b, 0xdb, 0x1a, 0x1b, 0xc1, 0x15, 0x55, 0x47, 0x96, 0xa4, 0xd8, 0x5f,
-	0xf5, 0xa5, 0xe6, 0x46, 0xfb, 0x1a, 0xbb, 0xd3, 0x73, 0x1a, 0x66, 0x5f, 0x85, 0xd5, 0x53, 0x3f,
-	0x48, 0xd5, 0xb0, 0x0c, 0x8f, 0x65, 0x92, 0xba, 0x5c, 0x3f, 0xa7, 0xcb, 0xa7, 0xe2, 0x67, 0xcb,
-	0xbc, 0x8e, 0x69, 0xb1, 0xf5, 0xa3, 0x0a, 0xd4, 0xed, 0x76, 0x90, 0x4d, 0xa5, 0xdc, 0x2b, 0xf5,
-	0xab, 0xfc, 0xde, 0x1c, 0xb8, 0xb8, 0x3f, 0xad, 0x94, 0x85, 0x63, 0xce, 0xd9, 0xe1, 0xda, 0xb1,
-	0xd0, 0xea, 0xcb, 0xc5, 0x42, 0x27, 0x4b, 0x63, 0xa1, 0xe5, 0xe1, 0xb7, 0xa9, 0x9f, 0x37, 0xfc,
-	0x36, 0x3d, 0x36, 0xfc, 0xd6, 0xfa, 0x0f, 0x07, 0x58, 0x91, 0x5b, 0xd9, 0x7d, 0xb1, 0xc3, 0x0f,
-	0x79, 0x4f, 0x6a, 0xbd, 0xff, 0xf7, 0x72, 0x1c, 0xaf, 0x56, 0x47, 0xfd, 0x8d, 0x23, 0x32, 0xd5,
-	0x9a, 0x1d, 0x8b, 0x28, 0xab, 0xca, 0xc5, 0x7f, 0xab, 0xe7, 0xc7, 0x7f, 0x27, 0xcf, 0x8f, 0xff,
-	0x4e, 0xe5, 0xe3, 0xbf, 0xad, 0xdf, 0x71, 0x60, 0xa9, 0x84, 0xad, 0x3e, 0xba, 0x89, 0x23, 0x23,
-	0x58, 0xda, 0xa6, 0x22, 0x19, 0xc1, 0x04, 0xb6, 0xbe, 0x01, 0xf3, 0x96, 0x28, 0x7d, 0x74, 0xfd,
-	0xe7, 0xbd, 0x59, 0x99, 0x4b, 0x64, 0xc2, 0x5a, 0x3f, 0xab, 0x00, 0x2b, 0x8a, 0xf3, 0xff, 0xea,
-	0x18, 0x8a, 0x74, 0x9a, 0x28, 0xa1, 0xd3, 0xff, 0xa8, 0xa5, 0x79, 0x13, 0x16, 0x65, 0x6a, 0xa5,
-	0x11, 0x67, 0x14, 0x1c, 0x53, 0xac, 0x40, 0x7f, 0xde, 0x0e, 0xbe, 0xcf, 0x58, 0x29, 0x79, 0x86,
-	0xb9, 0xcd, 0xc5, 0xe0, 0xdd, 0x55, 0x58, 0x16, 0xa9, 0x9a, 0x77, 0x45, 0x53, 0xca, 0x72, 0xfd,
-	0xa9, 0x03, 0x2b, 0xb9, 0x8a, 0x2c, 0xb5, 0x4b, 0x18, 0x27, 0xdb, 0x62, 0xd9, 0x40, 0x1c, 0xbf,
-	0x76, 0x81, 0x72, 0xdc, 0x56, 0xac, 0x40, 0xfa, 0x18, 0x2e, 0x53, 0x8e, 0xea, 0x65, 0x55, 0xee,
-	0x9a, 0x48, 0x28, 0x0d, 0x79, 0x2f, 0x37, 0xf0, 0x43, 0x91, 0x02, 0x6a, 0x56, 0x64, 0x47, 0xfc,
-	0xf6, 0x90, 0x55, 0x11, 0xbd, 0x5d, 0xcb, 0x10, 0xda, 0xe3, 0x2d, 0xad, 0x73, 0x7f, 0xec, 0x00,
-	0xfb, 0xc2, 0x90, 0xc7, 0x23, 0xca, 0xd4, 0xd2, 0x01, 0xbc, 0xb5, 0x7c, 0x18, 0x65, 0x6a, 0x30,
-	0x3c, 0xf8, 0x3c, 0x1f, 0xa9, 0x74, 0xc3, 0x4a, 0x96, 0x6e, 0xf8, 0x0a, 0x00, 0x6e, 0x33, 0x75,
-	0xfa, 0x17, 0x79, 0x99, 0xe1, 0xb0, 0x2f, 0x1a, 0x2c, 0xcd, 0x08, 0xac, 0x9e, 0x9f, 0x11, 0x38,
-	0x79, 0x5e, 0x46, 0xe0, 0x7b, 0xb0, 0x64, 0x8d, 0x5b, 0x2f, 0xab, 0x4a, 0x44, 0x73, 0xce, 0x48,
-	0x44, 0xfb, 0xbd, 0x0a, 0x4c, 0xec, 0x44, 0x03, 0x33, 0xf8, 0xef, 0xd8, 0xc1, 0x7f, 0x69, 0xad,
-	0xda, 0xda, 0x18, 0x49, 0x15, 0x63, 0x01, 0xd9, 0x4d, 0xa8, 0xfb, 0xfd, 0xb4, 0x9d, 0x46, 0x68,
-	0x9d, 0x4f, 0xfd, 0x58, 0x84, 0x8f, 0x26, 0xee, 0x56, 0x9a, 0x8e, 0x97, 0xab, 0x61, 0xcb, 0x30,
-	0xa1, 0x95, 0x2e, 0x21, 0x60, 0x11, 0x5d, 0x43, 0x3a, 0x9a, 0x1c, 0xc9, 0x70, 0x8c, 0x2c, 0x21,
-	0x2b, 0xd9, 0xff, 0x8b, 0x2d, 0x81, 0x10, 0x9d, 0xb2, 0x2a, 0xb4, 0x9c, 0x48, 0x3e, 0x42, 0x93,
-	0x11, 0x3c, 0x55, 0x36, 0xa3, 0x62, 0x33, 0xf6, 0x41, 0xed, 0xbf, 0x3a, 0x30, 0x29, 0x32, 0x24,
-	0x6f, 0xc0, 0x82, 0xe0, 0x7d, 0x1d, 0xff, 0x97, 0x01, 0xea, 0x3c, 0x98, 0xb9, 0x56, 0xc2, 0x6e,
-	0x45, 0x4f, 0xc8, 0x4c, 0xda, 0xbd, 0x0a, 0xb3, 0xa2, 0xa4, 0x93, 0x53, 0x09, 0x25, 0x03, 0xb2,
-	0x2b, 0x50, 0x3d, 0x8e, 0x06, 0xca, 0x33, 0x02, 0x75, 0xc0, 0x16, 0x0d, 0x3c, 0x82, 0x67, 0xe3,
-	0xc1, 0xf6, 0xc4, 0xb4, 0x84, 0x35, 0xca, 0x83, 0xd1, 0xe2, 0xeb, 0x66, 0x4d, 0x32, 0xe5, 0xa0,
-	0xee, 0x4d, 0x58, 0x78, 0x18, 0x75, 0xb9, 0x11, 0xca, 0x1b, 0xcb, 0xe7, 0xee, 0x6f, 0x3a, 0x30,
-	0xa3, 0x90, 0xd9, 0x0d, 0xa8, 0xa2, 0x1b, 0x93, 0xdb, 0xa4, 0xe8, 0x83, 0x75, 0xc4, 0xf3, 0x08,
-	0x03, 0xb5, 0x32, 0xc5, 0x5c, 0x32, 0x97, 0x56, 0x45, 0x5c, 0x32, 0x8f, 0x4d, 0x0f, 0x37, 0xe7,
-	0xe8, 0xe4, 0xa0, 0xee, 0x0f, 0x1d, 0x98, 0xb7, 0xfa, 0xa0, 0x9c, 0x19, 0x3f, 0x49, 0xe5, 0x61,
-	0xa5, 0x5c, 0x1e, 0x13, 0x64, 0x2e, 0x74, 0xc5, 0x0e, 0x7f, 0xea, 0xb0, 0xe3, 0x84, 0x19, 0x76,
-	0xbc, 0x03, 0xb3, 0x59, 0x5a, 0x75, 0xd5, 0xd2, 0xb6, 0xd8, 0xa3, 0x4a, 0x19, 0xc8, 0x90, 0xb0,
-	0x9d, 0x4e, 0xd4, 0x8b, 0x62, 0x79, 0x86, 0x25, 0x0a, 0xee, 0x7b, 0x50, 0x33, 0xf0, 0x71, 0x18,
-	0x21, 0x4f, 0x4f, 0xa3, 0xf8, 0x99, 0x8a, 0xc2, 0xca, 0xa2, 0x4e, 0xfd, 0xab, 0x64, 0xa9, 0x7f,
-	0xee, 0xdf, 0x3a, 0x30, 0x8f, 0x3c, 0x18, 0x84, 0x47, 0x7b, 0x51, 0x2f, 0xe8, 0x8c, 0x68, 0xed,
-	0x15, 0xbb, 0x49, 0x9d, 0xa1, 0x78, 0xd1, 0x06, 0x23, 0xd7, 0xab, 0xfd, 0xb1, 0x14, 0x51, 0x5d,
-	0x46, 0x19, 0x46, 0x09, 0x38, 0xf0, 0x13, 0x29, 0x16, 0xd2, 0xfc, 0x59, 0x40, 0x94, 0x34, 0x04,
-	0xc4, 0x7e, 0xca, 0xdb, 0xfd, 0xa0, 0xd7, 0x0b, 0x04, 0xae, 0x70, 0x8e, 0xca, 0xaa, 0xb0, 0xcf,
-	0x6e, 0x90, 0xf8, 0x07, 0xd9, 0xb9, 0x82, 0x2e, 0xbb, 0x7f, 0x55, 0x81, 0x9a, 0x54, 0xdc, 0xdb,
-	0xdd, 0x23, 0x2e, 0x0f, 0x11, 0xe5, 0xf9, 0x8d, 0x54, 0x32, 0xe6, 0x89, 0x8e, 0xac, 0xb7, 0x5c,
-	0x62, 0x03, 0x92, 0x5f, 0xf2, 0x89, 0xe2, 0x92, 0x5f, 0x86, 0x59, 0x64, 0xbd, 0xb7, 0xc8, 0xf7,
-	0x16, 0x67, 0x3e, 0x19, 0x40, 0xd5, 0xae, 0x53, 0xed, 0x64, 0x56, 0x4b, 0x80, 0x33, 0x8f, 0x1c,
-	0xdf, 0x81, 0x39, 0xd9, 0x0c, 0xad, 0x09, 0xe9, 0x94, 0x8c, 0xf9, 0xad, 0xf5, 0xf2, 0x2c, 0x4c,
-	0xf5, 0xe7, 0xba, 0xfa, 0x73, 0xe6, 0xbc, 0x3f, 0x15, 0xa6, 0x7b, 0x5f, 0x9f, 0xe4, 0xde, 0x8f,
-	0xfd, 0x81, 0xce, 0xb5, 0xbb, 0x03, 0x4b, 0x41, 0xd8, 0xe9, 0x0d, 0xbb, 0xbc, 0x3d, 0x0c, 0xfd,
-	0x30, 0x8c, 0x86, 0x61, 0x87, 0xab, 0x54, 0x99, 0xb2, 0x2a, 0xb7, 0xab, 0x13, 0x8d, 0xa9, 0x21,
-	0x76, 0x13, 0x26, 0xb1, 0xa3, 0xfc, 0xc9, 0x96, 0x2d, 0xc2, 0x02, 0x85, 0xdd, 0x80, 0x49, 0xde,
-	0x3d, 0xe2, 0x6a, 0x3f, 0xca, 0xec, 0xc8, 0x00, 0xae, 0xaa, 0x27, 0x10, 0x50, 0xa1, 0x20, 0x34,
-	0xa7, 0x50, 0x6c, 0x8b, 0x32, 0x85, 0xc5, 0x07, 0x5d, 0x77, 0x19, 0xd8, 0x43, 0x21, 0x03, 0xe6,
-	0x51, 0xc2, 0x6f, 0x4f, 0x40, 0xcd, 0x00, 0xa3, 0x6e, 0x38, 0xc2, 0x01, 0xb7, 0xbb, 0x81, 0xdf,
-	0xe7, 0x29, 0x8f, 0x25, 0xdf, 0xe7, 0xa0, 0x88, 0xe7, 0x9f, 0x1c, 0xb5, 0xa3, 0x61, 0xda, 0xee,
-	0xf2, 0xa3, 0x98, 0x0b, 0x23, 0x8f, 0x46, 0xc7, 0x82, 0x22, 0x5e, 0xdf, 0x7f, 0x6e, 0xe2, 0x09,
-	0x0e, 0xca, 0x41, 0xd5, 0xc1, 0x80, 0xa0, 0x51, 0x35, 0x3b, 0x18, 0x10, 0x14, 0xc9, 0x6b, 0xb5,
-	0xc9, 0x12, 0xad, 0xf6, 0x36, 0xac, 0x0a, 0xfd, 0x25, 0x25, 0xbd, 0x9d, 0x63, 0xac, 0x31, 0xb5,
-	0xec, 0x26, 0x34, 0x70, 0xcc, 0x4a, 0x24, 0x92, 0xe0, 0xeb, 0x62, 0x4f, 0xe5, 0x78, 0x05, 0x38,
-	0xe2, 0x52, 0xf8, 0xca, 0xc4, 0x15, 0x47, 0xdc, 0x05, 0x38, 0xe1, 0xfa, 0xcf, 0x6d, 0xdc, 0x59,
-	0x89, 0x9b, 0x83, 0xbb, 0xf3, 0x50, 0xdb, 0x4f, 0x23, 0x1d, 0xb9, 0xaf, 0xc3, 0x9c, 0x28, 0xca,
-	0x94, 0xa5, 0x4b, 0x70, 0x91, 0xb8, 0xe8, 0x71, 0x34, 0x88, 0x7a, 0xd1, 0xd1, 0xc8, 0x3a, 0x84,
-	0xfa, 0x89, 0x03, 0x4b, 0x56, 0xad, 0x0c, 0x70, 0x7d, 0x42, 0x08, 0x81, 0xce, 0x35, 0x71, 0xac,
-	0x9c, 0x7d, 0xe4, 0x37, 0x81, 0x28, 0x02, 0x9c, 0x4f, 0x64, 0xfa, 0xc9, 0x06, 0x2c, 0xa8, 0x91,
-	0xa9, 0x1f, 0x05, 0x17, 0x36, 0x8b, 0x5c, 0x28, 0xff, 0xaf, 0xcb, 0x1f, 0x54, 0x13, 0xbf, 0x22,
-	0x53, 0x05, 0xba, 0x34, 0x47, 0x15, 0xe9, 0xd0, 0xc7, 0x93, 0xe6, 0x6e, 0x44, 0x8d, 0xa0, 0xa3,
-	0x81, 0x89, 0xfb, 0x07, 0x0e, 0x40, 0x36, 0x3a, 0x3a, 0x20, 0xd5, 0x06, 0x42, 0x1c, 0x5a, 0x18,
-	0xc6, 0xe0, 0x35, 0x98, 0xd3, 0xc7, 0x5b, 0x99, 0xcd, 0xa9, 0x29, 0x18, 0x3a, 0x8c, 0xd7, 0x61,
-	0xe1, 0xa8, 0x17, 0x1d, 0x90, 0xc1, 0xa6, 0x1c, 0xb8, 0x44, 0xa5, 0xbe, 0x0a, 0xf0, 0x3d, 0x09,
-	0xcd, 0x0c, 0x54, 0xd5, 0x30, 0x50, 0xee, 0xb7, 0x2a, 0xfa, 0x7c, 0x22, 0x9b, 0xf3, 0x58, 0x29,
-	0x63, 0xeb, 0x05, 0x75, 0x3a, 0xe6, 0x38, 0x80, 0x62, 0x7a, 0x7b, 0xe7, 0x86, 0x1c, 0xde, 0x83,
-	0x7a, 0x2c, 0xf4, 0x95, 0x52, 0x66, 0xd5, 0x33, 0x94, 0xd9, 0x7c, 0x6c, 0x59, 0xb1, 0x37, 0xa0,
-	0xe1, 0x77, 0x4f, 0x78, 0x9c, 0x06, 0xb4, 0x25, 0x23, 0x17, 0x42, 0xa8, 0xe0, 0x05, 0x03, 0x4e,
-	0x96, 0xfd, 0x3a, 0x2c, 0xc8, 0x13, 0x47, 0x8d, 0x29, 0x2f, 0xd8, 0x64, 0x60, 0x44, 0x74, 0xff,
-	0x5c, 0x1d, 0x85, 0xd8, 0x6b, 0x38, 0x9e, 0x22, 0xe6, 0xec, 0x2a, 0xb9, 0xd9, 0x7d, 0x2c, 0x9f,
-	0x91, 0x30, 0x61, 0xa4, 0x95, 0x74, 0xe5, 0x31, 0x92, 0x4d, 0xd2, 0xea, 0xcb, 0x90, 0xd4, 0xfd,
-	0xa9, 0x03, 0xd3, 0x3b, 0xd1, 0x60, 0x47, 0x26, 0xd8, 0x90, 0x20, 0xe8, 0x74, 0x4d, 0x55, 0x3c,
-	0x23, 0xf5, 0xa6, 0xd4, 0x72, 0xcf, 0xe7, 0x2d, 0xf7, 0xaf, 0xc2, 0x25, 0x8a, 0x3a, 0xc4, 0xd1,
-	0x20, 0x8a, 0x51, 0x18, 0xfd, 0x9e, 0x30, 0xd3, 0x51, 0x98, 0x1e, 0x2b, 0x35, 0x76, 0x16, 0x0a,
-	0x6d, 0xef, 0x70, 0x5b, 0x22, 0x9c, 0x6e, 0xe9, 0x69, 0x08, 0xed, 0x56, 0xac, 0x70, 0x3f, 0x0d,
-	0xb3, 0xe4, 0x2a, 0xd3, 0xb4, 0xde, 0x84, 0xd9, 0xe3, 0x68, 0xd0, 0x3e, 0x0e, 0xc2, 0x54, 0x09,
-	0x77, 0x3d, 0xf3, 0x61, 0x77, 0x88, 0x20, 0x1a, 0xc1, 0xfd, 0xe3, 0x49, 0x98, 0x7e, 0x10, 0x9e,
-	0x44, 0x41, 0x87, 0x4e, 0x4d, 0xfa, 0xbc, 0x1f, 0xa9, 0xe4, 0x55, 0xfc, 0x46, 0x52, 0x50, 0x92,
-	0x9a, 0x4e, 0xcf, 0x56, 0x45, 0x74, 0x10, 0xe2, 0xec, 0x5a, 0x91, 0x10, 0x1d, 0x03, 0x82, 0x1b,
-	0x88, 0xd8, 0xbc, 0xe7, 0x25, 0x4b, 0xd9, 0xd5, 0x8d, 0x49, 0xe3, 0xea, 0x06, 0x9d, 0xb1, 0x89,
-	0x64, 0x20, 0x99, 0xed, 0xa0, 0x8a, 0xb4, 0xe1, 0x89, 0xb9, 0x88, 0x16, 0x91, 0xab, 0x31, 0x2d,
-	0x37, 0x3c, 0x26, 0x10, 0xdd, 0x11, 0xf1, 0x83, 0xc0, 0x11, 0xca, 0xd7, 0x04, 0xa1, 0xeb, 0x96,
-	0xbf, 0x2a, 0x36, 0x2b, 0x78, 0x3e, 0x07, 0x46, 0x0d, 0xdd, 0xe5, 0x5a, 0x91, 0x8a, 0x39, 0x80,
-	0xb8, 0x36, 0x95, 0x87, 0x1b, 0xdb, 0x24, 0x91, 0x47, 0xa8, 0xb6, 0x49, 0xc8, 0x28, 0x7e, 0xaf,
-	0x77, 0xe0, 0x77, 0x9e, 0x51, 0xfa, 0x31, 0x9d, 0x5f, 0xcc, 0x7a, 0x36, 0x90, 0xd2, 0x51, 0xb2,
-	0xd5, 0xa4, 0xf3, 0x8a, 0xaa, 0x67, 0x82, 0xd8, 0x3a, 0xd4, 0x68, 0x6b, 0x28, 0xd7, 0xb3, 0x4e,
-	0xeb, 0xd9, 0x30, 0xf7, 0x8e, 0xb4, 0xa2, 0x26, 0x92, 0x79, 0x92, 0xb3, 0x60, 0x9f, 0xe4, 0x08,
-	0xa5, 0x29, 0x0f, 0xc0, 0x1a, 0xd4, 0x5b, 0x06, 0x40, 0x6b, 0x2a, 0x09, 0x26, 0x10, 0x16, 0x09,
-	0xc1, 0x82, 0xb1, 0x2b, 0x30, 0x83, 0xdb, 0x96, 0x81, 0x1f, 0x74, 0x45, 0x46, 0x20, 0xed, 0x9e,
-	0x34, 0x0c, 0xdb, 0x50, 0xdf, 0x74, 0x04, 0x23, 0x92, 0x00, 0x2d, 0x18, 0xd2, 0x46, 0x97, 0x49,
-	0x88, 0x96, 0xc5, 0x8a, 0x5a, 0x40, 0x37, 0x05, 0xb6, 0xd1, 0xed, 0x4a, 0xde, 0x34, 0xb3, 0xf6,
-	0x63, 0xf3, 0x72, 0x98, 0xe2, 0xaa, 0x92, 0xd5, 0xad, 0x94, 0xaf, 0xee, 0x99, 0x34, 0x70, 0xb7,
-	0xa1, 0xb6, 0x67, 0x5c, 0x37, 0x23, 0x26, 0x57, 0x17, 0xcd, 0xa4, 0x60, 0x18, 0x10, 0x63, 0x38,
-	0x15, 0x73, 0x38, 0xee, 0x5f, 0x38, 0xc0, 0x76, 0x83, 0x24, 0xd5, 0xc3, 0xd7, 0xf9, 0x5b, 0x3a,
-	0xd8, 0x91, 0x25, 0x38, 0x5a, 0x30, 0xc4, 0xa1, 0xa1, 0xb4, 0xa3, 0xc3, 0xc3, 0x84, 0xab, 0x74,
-	0x1a, 0x0b, 0x86, 0x1c, 0x8a, 0x3e, 0x0e, 0xfa, 0x0b, 0x81, 0xe8, 0x21, 0x91, 0x69, 0x35, 0x05,
-	0x38, 0xea, 0xd9, 0x98, 0x9f, 0xf0, 0x38, 0xd1, 0xa2, 0xa5, 0xcb, 0x3a, 0x0f, 0x33, 0x4f, 0xe5,
-	0x9b, 0x30, 0xa3, 0xdb, 0xb5, 0x55, 0x88, 0xc2, 0xd4, 0xf5, 0xa8, 0xaa, 0xc8, 0xeb, 0xb7, 0x06,
-	0x2d, 0xd4, 0x66, 0xb1, 0x82, 0xdd, 0x02, 0x76, 0x18, 0xc4, 0x79, 0xf4, 0x09, 0x42, 0x2f, 0xa9,
-	0x71, 0x9f, 0xc2, 0x92, 0xec, 0xd2, 0x74, 0x6e, 0xec, 0x45, 0x74, 0xce, 0x63, 0xe4, 0x4a, 0x91,
-	0x91, 0xdd, 0xff, 0x72, 0x60, 0x5a, 0xae, 0x34, 0x2d, 0x4b, 0xfe, 0xde, 0x61, 0x76, 0x05, 0x53,
-	0x30, 0x58, 0xd3, 0xba, 0x71, 0x46, 0x5c, 0x2f, 0x55, 0x57, 0x41, 0x41, 0x4d, 0x94, 0x29, 0x28,
-	0x06, 0xd5, 0x81, 0x9f, 0x1e, 0xd3, 0x5e, 0x76, 0xd6, 0xa3, 0x6f, 0xd6, 0x10, 0x91, 0x17, 0xa1,
-	0x08, 0x29, 0xea, 0x52, 0x76, 0x63, 0x53, 0xd8, 0xdb, 0xe2, 0x8d, 0xcd, 0xcb, 0x30, 0x4b, 0x03,
-	0x68, 0x67, 0x81, 0x95, 0x0c, 0x80, 0x9c, 0x2b, 0x0a, 0x24, 0x61, 0x32, 0xa3, 0x3a, 0x83, 0xb8,
-	0x2b, 0x62, 0xe5, 0x25, 0x09, 0xf4, 0x89, 0x9a, 0xcc, 0x7b, 0xcd, 0xc0, 0x19, 0x47, 0xc8, 0x01,
-	0xe4, 0x39, 0x42, 0xa2, 0x7a, 0xba, 0xde, 0x6d, 0x41, 0x73, 0x8b, 0xf7, 0x78, 0xca, 0x37, 0x7a,
-	0xbd, 0x7c, 0xfb, 0x97, 0xe0, 0x62, 0x49, 0x9d, 0xf4, 0x67, 0xbf, 0x00, 0x2b, 0x1b, 0x22, 0xc7,
-	0xed, 0xa3, 0xca, 0xa7, 0x70, 0x9b, 0xb0, 0x9a, 0x6f, 0x52, 0x76, 0x76, 0x0f, 0x16, 0xb7, 0xf8,
-	0xc1, 0xf0, 0x68, 0x97, 0x9f, 0x64, 0x1d, 0x31, 0xa8, 0x26, 0xc7, 0xd1, 0xa9, 0x14, 0x4c, 0xfa,
-	0x66, 0xaf, 0x00, 0xf4, 0x10, 0xa7, 0x9d, 0x0c, 0x78, 0x47, 0xdd, 0x9c, 0x20, 0xc8, 0xfe, 0x80,
-	0x77, 0xdc, 0xb7, 0x81, 0x99, 0xed, 0x48, 0x7a, 0xa1, 0x3d, 0x1a, 0x1e, 0xb4, 0x93, 0x51, 0x92,
-	0xf2, 0xbe, 0xba, 0xaf, 0x62, 0x82, 0xdc, 0xeb, 0x30, 0xb7, 0xe7, 0x8f, 0x3c, 0xfe, 0x35, 0x79,
-	0x53, 0x75, 0x0d, 0xa6, 0x07, 0xfe, 0x08, 0xd5, 0x94, 0x8e, 0xf8, 0x50, 0xb5, 0xfb, 0xef, 0x15,
-	0x98, 0x12, 0x98, 0xd8, 0x6a, 0x97, 0x27, 0x69, 0x10, 0x8a, 0xf3, 0x65, 0xd9, 0xaa, 0x01, 0x2a,
-	0xb0, 0x72, 0xa5, 0x84, 0x95, 0xe5, 0xae, 0x49, 0x65, 0xa1, 0x4b, 0x7e, 0xb5, 0x60, 0xc8, 0x5c,
-	0x59, 0xca, 0x92, 0x08, 0x39, 0x64, 0x80, 0x5c, 0x70, 0x30, 0xb3, 0x7a, 0x62, 0x7c, 0x4a, 0x4a,
-	0x25, 0xe7, 0x9a, 0xa0, 0x52, 0xdb, 0x3a, 0x2d, 0x18, 0xbc, 0x60, 0x5b, 0x0b, 0x36, 0x74, 0xe6,
-	0x25, 0x6c, 0xa8, 0xd8, 0x4a, 0x9d, 0x65, 0x43, 0xe1, 0x25, 0x6c, 0xa8, 0xcb, 0xa0, 0x71, 0x8f,
-	0x73, 0x8f, 0xa3, 0x77, 0xa6, 0x78, 0xf7, 0xbb, 0x0e, 0x34, 0x24, 0x17, 0xe9, 0x3a, 0xf6, 0x9a,
-	0xe5, 0x85, 0x96, 0x66, 0x72, 0x5f, 0x83, 0x79, 0xf2, 0x0d, 0x75, 0x14, 0x54, 0x86, 0x6c, 0x2d,
-	0x20, 0xce, 0x43, 0x1d, 0x55, 0xf5, 0x83, 0x9e, 0xba, 0xf7, 0x67, 0x80, 0x54, 0x20, 0x35, 0xf6,
-	0x65, 0x82, 0x8f, 0xe3, 0xe9, 0xb2, 0xfb, 0xd7, 0x0e, 0x2c, 0x1a, 0x03, 0x96, 0x5c, 0xf8, 0x1e,
-	0x28, 0x69, 0x10, 0x21, 0x51, 0x21, 0xb9, 0x6b, 0xb6, 0xd8, 0x64, 0xbf, 0x59, 0xc8, 0xb4, 0x98,
-	0xfe, 0x88, 0x06, 0x98, 0x0c, 0xfb, 0x52, 0x89, 0x9a, 0x20, 0x64, 0xa4, 0x53, 0xce, 0x9f, 0x69,
-	0x14, 0xa1, 0xc6, 0x2d, 0x18, 0x25, 0x8f, 0xa0, 0x4f, 0xab, 0x91, 0x84, 0x3d, 0xb3, 0x81, 0xee,
-	0x3f, 0x38, 0xb0, 0x24, 0x36, 0x27, 0x72, 0xeb, 0xa7, 0x2f, 0xf2, 0x4c, 0x89, 0xdd, 0x98, 0x90,
-	0xc8, 0x9d, 0x0b, 0x9e, 0x2c, 0xb3, 0x4f, 0xbe, 0xe4, 0x86, 0x4a, 0x27, 0x0d, 0x8d, 0x59, 0x8b,
-	0x89, 0xb2, 0xb5, 0x38, 0x83, 0xd2, 0x65, 0x21, 0xc0, 0xc9, 0xd2, 0x10, 0xe0, 0xdd, 0x69, 0x98,
-	0x4c, 0x3a, 0xd1, 0x80, 0xbb, 0xab, 0xb0, 0x6c, 0x4f, 0x4e, 0xaa, 0xa0, 0xef, 0x3b, 0xd0, 0xbc,
-	0x27, 0x42, 0xe5, 0x41, 0x78, 0xb4, 0x13, 0x24, 0x69, 0x14, 0xeb, 0xab, 0xd9, 0x57, 0x00, 0x92,
-	0xd4, 0x8f, 0x53, 0x91, 0xc3, 0x2a, 0x03, 0x74, 0x19, 0x04, 0xc7, 0xc8, 0xc3, 0xae, 0xa8, 0x15,
-	0x6b, 0xa3, 0xcb, 0x05, 0x1f, 0x42, 0x6e, 0x9f, 0x2c, 0x4b, 0xfc, 0xba, 0xc8, 0xc2, 0x43, 0x5f,
-	0x81, 0x9f, 0x90, 0x5e, 0x17, 0xfb, 0x92, 0x1c, 0xd4, 0xfd, 0x4b, 0x07, 0x16, 0xb2, 0x41, 0x6e,
-	0x23, 0xd0, 0xd6, 0x0e, 0xd2, 0xfc, 0x66, 0xda, 0x41, 0x85, 0x0e, 0x03, 0xb4, 0xc7, 0x66, 0xb2,
-	0xb8, 0x80, 0x90, 0xc4, 0xca, 0x52, 0x34, 0x54, 0x0e, 0x8e, 0x09, 0x12, 0x79, 0x29, 0xe8, 0x09,
-	0x48, 0xaf, 0x46, 0x96, 0x28, 0x55, 0xb6, 0x9f, 0xd2, 0x5f, 0x53, 0x62, 0x63, 0x26, 0x8b, 0xca,
-	0x94, 0x4e, 0x13, 0x14, 0x3f, 0xdd, 0x6f, 0x3b, 0x70, 0xb1, 0x84, 0xb8, 0x52, 0x32, 0xb6, 0x60,
-	0xf1, 0x50, 0x57, 0x2a, 0x02, 0x08, 0xf1, 0x58, 0x55, 0x67, 0x3b, 0xf6, 0xa4, 0xbd, 0xe2, 0x0f,
-	0xda, 0xf7, 0x11, 0x24, 0xb5, 0x12, 0xcb, 0x8a, 0x15, 0xee, 0x45, 0x58, 0x13, 0x59, 0x97, 0xd4,
-	0x9e, 0x15, 0xac, 0x59, 0x82, 0x45, 0xa3, 0x4a, 0xb0, 0xc9, 0xfa, 0x1f, 0x4e, 0x40, 0x5d, 0x9c,
-	0x11, 0x8a, 0x37, 0x5f, 0x78, 0xcc, 0xde, 0x87, 0x69, 0xf9, 0x66, 0x0f, 0x5b, 0x91, 0xc3, 0xb4,
-	0x5f, 0x09, 0x6a, 0xad, 0xe6, 0xc1, 0x92, 0xd7, 0x96, 0x7e, 0xeb, 0xa7, 0xff, 0xf2, 0x9d, 0xca,
-	0x3c, 0xab, 0xdd, 0x3e, 0x79, 0xeb, 0xf6, 0x11, 0x0f, 0x13, 0x6c, 0xe3, 0xd7, 0x01, 0xb2, 0xd7,
-	0x6c, 0x58, 0x53, 0xfb, 0x78, 0xb9, 0x67, 0x7a, 0x5a, 0x17, 0x4b, 0x6a, 0x64, 0xbb, 0x17, 0xa9,
-	0xdd, 0x25, 0xb7, 0x8e, 0xed, 0x06, 0x61, 0x90, 0x8a, 0xa7, 0x6d, 0xde, 0x75, 0x6e, 0xb2, 0x2e,
-	0xcc, 0x99, 0x8f, 0xd5, 0xb0, 0x96, 0xbe, 0x28, 0x5b, 0x78, 0x2a, 0xa7, 0x75, 0xa9, 0xb4, 0x4e,
-	0xc5, 0xb9, 0xa8, 0x8f, 0x15, 0xb7, 0x81, 0x7d, 0x0c, 0x09, 0x23, 0xeb, 0xa5, 0x07, 0x75, 0xfb,
-	0x4d, 0x1a, 0x76, 0xd9, 0x50, 0x03, 0x85, 0x17, 0x71, 0x5a, 0xaf, 0x8c, 0xa9, 0x95, 0x7d, 0xbd,
-	0x42, 0x7d, 0xad, 0xb9, 0x0c, 0xfb, 0xea, 0x10, 0x8e, 0x7a, 0x11, 0xe7, 0x5d, 0xe7, 0xe6, 0xfa,
-	0x8f, 0xae, 0xc3, 0xac, 0x0e, 0xce, 0xb2, 0xaf, 0xc2, 0xbc, 0x75, 0x88, 0xcb, 0xd4, 0x34, 0xca,
-	0xce, 0x7c, 0x5b, 0x97, 0xcb, 0x2b, 0x65, 0xc7, 0x57, 0xa8, 0xe3, 0x26, 0x5b, 0xc5, 0x8e, 0xe5,
-	0x29, 0xe8, 0x6d, 0x3a, 0xba, 0x16, 0x69, 0xc9, 0xcf, 0xc4, 0x3c, 0xb3, 0x83, 0x57, 0x6b, 0x9e,
-	0x85, 0x83, 0x5a, 0x6b, 0x9e, 0xc5, 0xd3, 0x5a, 0xf7, 0x32, 0x75, 0xb7, 0xca, 0x96, 0xcd, 0xee,
-	0x74, 0xd0, 0x94, 0x53, 0x22, 0xb9, 0xf9, 0x64, 0x0d, 0x7b, 0x45, 0x33, 0x56, 0xd9, 0x53, 0x36,
-	0x9a, 0x45, 0x8a, 0xef, 0xd9, 0xb8, 0x4d, 0xea, 0x8a, 0x31, 0x5a, 0x3e, 0xf3, 0xc5, 0x1a, 0xf6,
-	0x01, 0xcc, 0xea, 0x07, 0x10, 0xd8, 0x9a, 0xf1, 0xea, 0x84, 0xf9, 0x2a, 0x43, 0xab, 0x59, 0xac,
-	0x28, 0x63, 0x0c, 0xb3, 0x65, 0x64, 0x8c, 0x5d, 0x58, 0x91, 0x32, 0x76, 0xc0, 0x7f, 0x9e, 0x99,
-	0x94, 0x3c, 0xb4, 0x73, 0xc7, 0x61, 0xef, 0xc1, 0x8c, 0x7a, 0x57, 0x82, 0xad, 0x96, 0xbf, 0x8f,
-	0xd1, 0x5a, 0x2b, 0xc0, 0xa5, 0xb6, 0xf9, 0x12, 0x40, 0xf6, 0x5e, 0x82, 0x96, 0xb3, 0xc2, 0x4b,
-	0x0d, 0x9a, 0x88, 0xc5, 0xc7, 0x15, 0xdc, 0x55, 0x9a, 0x6a, 0x83, 0x91, 0x9c, 0x85, 0xfc, 0x54,
-	0xdd, 0x11, 0x18, 0xc2, 0x62, 0xe1, 0xf9, 0x04, 0xf6, 0xaa, 0x1a, 0xc8, 0x98, 0x47, 0x17, 0x5a,
-	0x57, 0xc7, 0x23, 0xd8, 0x72, 0xc0, 0x56, 0xb0, 0xbf, 0x64, 0x78, 0x90, 0x9c, 0xfa, 0x83, 0x0e,
-	0xa1, 0xa1, 0x90, 0xb3, 0x11, 0xb0, 0xe2, 0xab, 0x07, 0x2c, 0xd7, 0x6c, 0xf1, 0x65, 0x85, 0xd6,
-	0x6b, 0x67, 0x60, 0x94, 0x09, 0x82, 0xec, 0x59, 0xbe, 0x46, 0x90, 0xeb, 0xda, 0x78, 0x68, 0x80,
-	0x95, 0xce, 0xc8, 0x7c, 0x18, 0x21, 0xdf, 0x75, 0xc9, 0x2b, 0x05, 0xa5, 0x5d, 0x8b, 0x49, 0x9f,
-	0x52, 0x27, 0x1c, 0xe6, 0xad, 0x5b, 0xfe, 0xec, 0x52, 0xf9, 0xdd, 0x7f, 0x5b, 0xde, 0x4b, 0x1f,
-	0x06, 0x50, 0x8a, 0x93, 0x2d, 0x0a, 0xa5, 0x46, 0x28, 0x32, 0xfd, 0xf2, 0x9b, 0x0e, 0x2c, 0x97,
-	0x5d, 0x9e, 0x67, 0x6e, 0x29, 0xf5, 0xac, 0x7b, 0xfd, 0xad, 0x8f, 0x9d, 0x89, 0x23, 0x3b, 0xbf,
-	0x4a, 0x9d, 0xb7, 0x58, 0xb3, 0x48, 0xe3, 0x58, 0x74, 0xf5, 0x02, 0x96, 0x4a, 0x2e, 0xba, 0xb3,
-	0x52, 0x22, 0x5a, 0xd7, 0xf4, 0x5b, 0xee, 0x59, 0x28, 0xb2, 0xff, 0x57, 0xa9, 0xff, 0x8b, 0x6c,
-	0xad, 0x40, 0x68, 0x71, 0x65, 0x9f, 0x6d, 0x41, 0xcd, 0xb8, 0x0c, 0xcf, 0x94, 0x60, 0x14, 0x2f,
-	0xd2, 0xb7, 0x5a, 0x65, 0x55, 0x52, 0xee, 0x3e, 0x07, 0xf3, 0xd6, 0xad, 0x76, 0xbd, 0x5e, 0x65,
-	0x77, 0xe6, 0xf5, 0x7a, 0x95, 0x5f, 0x84, 0xff, 0x32, 0xd4, 0x8c, 0x3b, 0xe8, 0xcc, 0xc8, 0xea,
-	0xcd, 0xdd, 0x3e, 0xd7, 0x23, 0x2a, 0xbb, 0xb2, 0xbe, 0x4c, 0x13, 0xaf, 0xbb, 0xb3, 0x38, 0x71,
-	0xba, 0xdd, 0x82, 0xaa, 0xea, 0xab, 0x50, 0xb7, 0x6f, 0xa5, 0x6b, 0xdd, 0x5e, 0x7a, 0xbf, 0x5d,
-	0xeb, 0xf6, 0x31, 0x57, 0xd9, 0xa5, 0x5a, 0xbc, 0xb9, 0xa4, 0x3b, 0xb9, 0xfd, 0xa1, 0x3c, 0x6e,
-	0x7f, 0xc1, 0xbe, 0x80, 0x06, 0x4c, 0x5e, 0x74, 0x62, 0xd9, 0x5d, 0x7c, 0xfb, 0x3a, 0x94, 0xd6,
-	0xb9, 0x85, 0x3b, 0x51, 0xee, 0x22, 0x35, 0x5e, 0x63, 0xd9, 0x0c, 0x98, 0x0f, 0x75, 0xad, 0x69,
-	0xed, 0x76, 0xf3, 0x57, 0xa3, 0x5a, 0x66, 0x85, 0x79, 0x3f, 0x4a, 0x8d, 0x99, 0x19, 0x63, 0x4e,
-	0x54, 0x9b, 0x77, 0x1c, 0xe1, 0xf8, 0xd0, 0xcd, 0x26, 0xc3, 0xf1, 0x31, 0x2f, 0x3f, 0x19, 0x8e,
-	0x8f, 0x75, 0x01, 0x2a, 0xef, 0xf8, 0xa4, 0x01, 0xb6, 0xf1, 0x04, 0x66, 0xf5, 0x1d, 0x18, 0x3d,
-	0xd8, 0xfc, 0x4d, 0x19, 0x4d, 0x84, 0xc2, 0x75, 0x19, 0x77, 0x85, 0x1a, 0x5d, 0x60, 0xf3, 0xb2,
-	0xd1, 0x03, 0xd1, 0x52, 0x08, 0x0b, 0xb9, 0x74, 0x39, 0x6d, 0x6c, 0xca, 0x33, 0x98, 0x5b, 0x57,
-	0xce, 0xce, 0xb2, 0xb3, 0xcd, 0xb4, 0x32, 0xcf, 0xb7, 0x55, 0xc2, 0xf9, 0x6f, 0xc0, 0x9c, 0x79,
-	0x4b, 0x59, 0x7b, 0x58, 0x25, 0x77, 0xab, 0xb5, 0x87, 0x55, 0x76, 0xad, 0x59, 0xb1, 0x25, 0x9b,
-	0x33, 0xbb, 0x41, 0xb6, 0xb4, 0xaf, 0x19, 0x66, 0x2e, 0x47, 0xd9, 0xed, 0xca, 0xcc, 0xe5, 0x28,
-	0xbd, 0x9b, 0x68, 0x2f, 0xb1, 0x9e, 0x8b, 0x38, 0xd3, 0x61, 0x01, 0xd4, 0xed, 0xfb, 0x9f, 0xba,
-	0xaf, 0xd2, 0xab, 0xb0, 0xba, 0xaf, 0xf2, 0x4b, 0xa3, 0x6e, 0x8b, 0xfa, 0x5a, 0x66, 0xe4, 0xc6,
-	0x0d, 0x08, 0x47, 0x4f, 0xeb, 0xcb, 0xb0, 0x60, 0xa4, 0xbd, 0xee, 0x8f, 0xc2, 0x8e, 0x96, 0xe6,
-	0xe2, 0xe5, 0x8f, 0x56, 0xd9, 0xa6, 0xd2, 0x5d, 0xa3, 0xe6, 0x17, 0x5d, 0x8b, 0x5e, 0x28, 0xc9,
-	0x9b, 0x50, 0x33, 0x53, 0x6a, 0xcf, 0x68, 0x77, 0xcd, 0xa8, 0x32, 0x6f, 0x20, 0xdc, 0x71, 0xd8,
-	0x9f, 0x38, 0x30, 0x67, 0x25, 0xa8, 0x5a, 0x87, 0xa4, 0xb9, 0x76, 0x9a, 0x66, 0x9d, 0xd9, 0x90,
-	0xeb, 0xd1, 0x20, 0x77, 0x6f, 0x7e, 0xce, 0xa2, 0xf7, 0x87, 0x56, 0x70, 0xe2, 0x56, 0xfe, 0x69,
-	0xb2, 0x17, 0x79, 0x04, 0xf3, 0x82, 0xcc, 0x8b, 0x3b, 0x0e, 0xfb, 0xa1, 0x03, 0x75, 0x3b, 0xa4,
-	0xa6, 0x57, 0xaa, 0x34, 0x78, 0xa7, 0x57, 0x6a, 0x4c, 0x1c, 0xee, 0xcb, 0x34, 0xca, 0xc7, 0x37,
-	0x3d, 0x6b, 0x94, 0xf2, 0xae, 0xeb, 0x2f, 0x37, 0x5a, 0xf6, 0xae, 0x78, 0xc7, 0x50, 0xc5, 0x79,
-	0x99, 0xe1, 0x9f, 0xe5, 0x97, 0xd7, 0x7c, 0x5e, 0xef, 0x86, 0x73, 0xc7, 0x61, 0x5f, 0x11, 0xaf,
-	0xa5, 0xc9, 0x7f, 0x89, 0x4b, 0x5e, 0xf6, 0x7f, 0xf7, 0x1a, 0xcd, 0xe9, 0x8a, 0x7b, 0xd1, 0x9a,
-	0x53, 0xde, 0x41, 0xdd, 0x10, 0xa3, 0x93, 0x8f, 0xe0, 0x65, 0x36, 0xae, 0xf0, 0x30, 0xde, 0xf8,
-	0x41, 0xf6, 0xc5, 0x20, 0x25, 0xba, 0xc5, 0xca, 0x2f, 0xd9, 0x8c, 0x7b, 0x93, 0xc6, 0x7a, 0xcd,
-	0x7d, 0x75, 0xec, 0x58, 0x6f, 0x53, 0x60, 0x0c, 0x47, 0xbc, 0x07, 0x90, 0x9d, 0xc9, 0xb0, 0xdc,
-	0x99, 0x80, 0xf6, 0x5e, 0x8b, 0xc7, 0x36, 0xb6, 0xbc, 0xa8, 0xa3, 0x03, 0x6c, 0xf1, 0x03, 0xa1,
-	0xc1, 0x1e, 0xa8, 0xd3, 0x84, 0x8b, 0x86, 0x96, 0xb2, 0x0f, 0x4f, 0x5a, 0xad, 0xb2, 0xaa, 0x32,
-	0xfd, 0xa5, 0x8f, 0x26, 0x9e, 0xc0, 0xfc, 0x6e, 0x14, 0x3d, 0x1b, 0x0e, 0xf4, 0x09, 0xa7, 0x1d,
-	0xb3, 0xde, 0xf1, 0x93, 0xe3, 0x56, 0x6e, 0x16, 0xb6, 0x6b, 0x24, 0x9b, 0xba, 0xfd, 0x61, 0x76,
-	0xe6, 0xf3, 0x82, 0xf9, 0xe4, 0x72, 0x0b, 0xd3, 0xa4, 0x07, 0xde, 0xb2, 0x9b, 0xb1, 0x8c, 0x5e,
-	0xbe, 0x0b, 0xcb, 0xcd, 0x54, 0xa3, 0xb5, 0xcc, 0xdd, 0x1e, 0xcc, 0x6d, 0xf1, 0x4e, 0xd4, 0xe5,
-	0x32, 0xf0, 0xbb, 0x94, 0x0d, 0x5c, 0x47, 0x8c, 0x5b, 0xf3, 0x16, 0xd0, 0x36, 0x15, 0x03, 0x7f,
-	0x14, 0xf3, 0xaf, 0xdd, 0xfe, 0x50, 0x86, 0x94, 0x5f, 0x28, 0x53, 0xa1, 0x62, 0xee, 0x96, 0xa9,
-	0xc8, 0x05, 0xe9, 0x2d, 0x53, 0x51, 0x08, 0xd2, 0x5b, 0xa4, 0x56, 0x31, 0x7f, 0xd6, 0x83, 0xc5,
-	0x42, 0x5c, 0x5f, 0x6f, 0x43, 0xc6, 0x9d, 0x06, 0xe8, 0x6d, 0xc8, 0xf8, 0x23, 0x01, 0xd9, 0xdb,
-	0x4d, 0xbb, 0xb7, 0x7d, 0x98, 0xdf, 0xe2, 0x82, 0x58, 0x22, 0x8d, 0x2a, 0x77, 0xc9, 0xdd, 0x4c,
-	0xd2, 0xca, 0x2b, 0x70, 0xaa, 0xb3, 0xbd, 0x18, 0xca, 0x61, 0x62, 0x1f, 0x40, 0xed, 0x3e, 0x4f,
-	0x55, 0xde, 0x94, 0xde, 0xe4, 0xe5, 0x12, 0xa9, 0x5a, 0x25, 0x69, 0x57, 0x36, 0xcf, 0x50, 0x6b,
-	0xb7, 0x79, 0xf7, 0x88, 0x0b, 0xe5, 0xd4, 0x0e, 0xba, 0x2f, 0xd8, 0xaf, 0x51, 0xe3, 0x3a, 0x71,
-	0x73, 0xd5, 0x48, 0xb7, 0x31, 0x1b, 0x5f, 0xc8, 0xc1, 0xcb, 0x5a, 0x0e, 0xa3, 0x2e, 0x37, 0xfc,
-	0xb9, 0x10, 0x6a, 0x46, 0xbe, 0xb1, 0x16, 0xa0, 0x62, 0xee, 0xb4, 0x16, 0xa0, 0x92, 0xf4, 0x64,
-	0xf7, 0x06, 0xf5, 0xe3, 0xb2, 0xab, 0x59, 0x3f, 0x22, 0x25, 0x39, 0xeb, 0xe9, 0xf6, 0x87, 0x7e,
-	0x3f, 0x7d, 0xc1, 0x9e, 0xd2, 0xb5, 0x73, 0x33, 0x37, 0x2c, 0xdb, 0xb5, 0xe6, 0xd3, 0xc8, 0x34,
-	0xb1, 0x8c, 0x2a, 0x7b, 0x27, 0x2b, 0xba, 0x22, 0x9f, 0xec, 0x93, 0x00, 0xfb, 0x69, 0x34, 0xd8,
-	0xf2, 0x79, 0x3f, 0x0a, 0x33, 0x5d, 0x9b, 0xe5, 0x3f, 0x65, 0xfa, 0xcb, 0x48, 0x82, 0x62, 0x4f,
-	0x8d, 0x6d, 0xbe, 0x95, 0x5a, 0xa7, 0x98, 0x6b, 0x6c, 0x8a, 0x94, 0x26, 0x48, 0x49, 0x9a, 0xd4,
-	0x1d, 0x87, 0x6d, 0x00, 0x64, 0x07, 0x3b, 0x7a, 0xd3, 0x5e, 0x38, 0x33, 0xd2, 0x6a, 0xaf, 0xe4,
-	0x14, 0x68, 0x0f, 0x66, 0xb3, 0x93, 0x82, 0xb5, 0x2c, 0x67, 0xdc, 0x3a, 0x57, 0xd0, 0x16, 0xbc,
-	0x10, 0xbf, 0x77, 0x1b, 0x44, 0x2a, 0x60, 0x33, 0x48, 0x2a, 0x0a, 0xca, 0x07, 0xb0, 0x24, 0x06,
-	0xa8, 0xdd, 0x11, 0xca, 0xe8, 0x51, 0x33, 0x29, 0x89, 0xa1, 0x6b, 0x69, 0x2e, 0x0d, 0x41, 0x5b,
-	0xe1, 0x3b, 0xe4, 0x56, 0x91, 0x4d, 0x84, 0xaa, 0xb9, 0x0f, 0x8b, 0x85, 0xf8, 0xa9, 0x16, 0xe9,
-	0x71, 0x61, 0x6b, 0x2d, 0xd2, 0x63, 0x43, 0xaf, 0xca, 0x77, 0x76, 0x81, 0xf6, 0x7e, 0xa7, 0x41,
-	0xda, 0x39, 0xc6, 0xee, 0xf6, 0x8d, 0x75, 0x34, 0x62, 0xa1, 0x09, 0x53, 0x2e, 0xf2, 0x98, 0xd8,
-	0xa9, 0x26, 0x5f, 0x21, 0x80, 0x7a, 0xc7, 0x39, 0x98, 0xa2, 0xc7, 0xd4, 0x3f, 0xfe, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x98, 0x6a, 0xbd, 0x7e, 0x5d, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_31abb292330f8c1e) }
+
+var fileDescriptor_rpc_31abb292330f8c1e = []byte{
+	// 9728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x6c, 0x24, 0x49,
+	0x72, 0xd8, 0x54, 0x77, 0xf3, 0x15, 0xdd, 0x4d, 0x36, 0x93, 0x43, 0x4e, 0x4f, 0xcd, 0x8b, 0x5b,
+	0xbb, 0xda, 0x1d, 0x8d, 0x57, 0xf3, 0x5a, 0x69, 0xb5, 0x37, 0x7b, 0x5a, 0x1d, 0x87, 0x6c, 0x0e,
+	0xe7, 0x8e, 0x43, 0xf2, 0x8a, 0x9c, 0x5d, 0xdd, 0xc3, 0x6e, 0x15, 0xbb, 0x93, 0x64, 0xdd, 0x74,
+	0x57, 0xf5, 0x55, 0x55, 0x0f, 0x87, 0xb7, 0x5a, 0xc0, 0x92, 0x65, 0xc3, 0x30, 0x7c, 0x10, 0x0c,
+	0x19, 0x02, 0x24, 0xc0, 0x90, 0x21, 0xd9, 0x1f, 0x67, 0xfb, 0xc7, 0x30, 0xec, 0x9f, 0xb3, 0x3f,
+	0x6c, 0x18, 0x30, 0xfc, 0x61, 0xd8, 0x80, 0xbe, 0x0c, 0xc3, 0x02, 0x0c, 0xe9, 0xc7, 0xf0, 0xaf,
+	0x0d, 0x18, 0xb0, 0x0d, 0x1b, 0x91, 0xaf, 0xca, 0xac, 0xca, 0x26, 0x79, 0xba, 0xb5, 0x01, 0xff,
+	0x90, 0x9d, 0x11, 0x91, 0xef, 0xc8, 0xc8, 0xc8, 0x88, 0xc8, 0x2c, 0x98, 0x4b, 0x46, 0xbd, 0xfb,
+	0xa3, 0x24, 0xce, 0x62, 0x32, 0x35, 0x88, 0x92, 0x51, 0xcf, 0xbd, 0x79, 0x1c, 0xc7, 0xc7, 0x03,
+	0xfa, 0x20, 0x18, 0x85, 0x0f, 0x82, 0x28, 0x8a, 0xb3, 0x20, 0x0b, 0xe3, 0x28, 0xe5, 0x44, 0xde,
+	0xaf, 0xc2, 0xfc, 0x33, 0x1a, 0xed, 0x53, 0xda, 0xf7, 0xe9, 0xf7, 0xc7, 0x34, 0xcd, 0xc8, 0x9f,
+	0x83, 0xc5, 0x80, 0xfe, 0x80, 0xd2, 0x7e, 0x77, 0x14, 0xa4, 0xe9, 0xe8, 0x24, 0x09, 0x52, 0xda,
+	0x76, 0x56, 0x9d, 0xbb, 0x0d, 0xbf, 0xc5, 0x11, 0x7b, 0x0a, 0x4e, 0xde, 0x82, 0x46, 0x8a, 0xa4,
+	0x34, 0xca, 0x92, 0x78, 0x74, 0xd6, 0xae, 0x30, 0xba, 0x3a, 0xc2, 0x3a, 0x1c, 0xe4, 0x0d, 0x60,
+	0x41, 0xd5, 0x90, 0x8e, 0xe2, 0x28, 0xa5, 0xe4, 0x21, 0x5c, 0xed, 0x85, 0xa3, 0x13, 0x9a, 0x74,
+	0x59, 0xe6, 0x61, 0x44, 0x87, 0x71, 0x14, 0xf6, 0xda, 0xce, 0x6a, 0xf5, 0xee, 0x9c, 0x4f, 0x38,
+	0x0e, 0x73, 0xbc, 0x10, 0x18, 0xf2, 0x1e, 0x2c, 0xd0, 0x88, 0xc3, 0x69, 0x9f, 0xe5, 0x12, 0x55,
+	0xcd, 0xe7, 0x60, 0xcc, 0xe0, 0xfd, 0x4b, 0x07, 0x16, 0x9f, 0x47, 0x61, 0xf6, 0x59, 0x30, 0x18,
+	0xd0, 0x4c, 0xf6, 0xe9, 0x3d, 0x58, 0x38, 0x65, 0x00, 0xd6, 0xa7, 0xd3, 0x38, 0xe9, 0x8b, 0x1e,
+	0xcd, 0x73, 0xf0, 0x9e, 0x80, 0x4e, 0x6c, 0x59, 0x65, 0x62, 0xcb, 0xac, 0xc3, 0x55, 0x9d, 0x30,
+	0x5c, 0xef, 0xc1, 0x42, 0x42, 0x7b, 0xf1, 0x6b, 0x9a, 0x9c, 0x75, 0x4f, 0xc3, 0xa8, 0x1f, 0x9f,
+	0xb6, 0x6b, 0xab, 0xce, 0xdd, 0x29, 0x7f, 0x5e, 0x82, 0x3f, 0x63, 0x50, 0xef, 0x2a, 0x10, 0xbd,
+	0x17, 0x7c, 0xdc, 0xbc, 0x63, 0x58, 0x7a, 0x19, 0x0d, 0xe2, 0xde, 0xab, 0x3f, 0x63, 0xef, 0x2c,
+	0xd5, 0x57, 0xac, 0xd5, 0xaf, 0xc0, 0x55, 0xb3, 0x22, 0xd1, 0x00, 0x0a, 0xcb, 0xeb, 0x27, 0x41,
+	0x74, 0x4c, 0x65, 0x91, 0xb2, 0x09, 0x3f, 0x0b, 0xad, 0xde, 0x38, 0x49, 0x68, 0x54, 0x6a, 0xc3,
+	0x82, 0x80, 0xab, 0x46, 0xbc, 0x05, 0x8d, 0x88, 0x9e, 0xe6, 0x64, 0x82, 0x65, 0x22, 0x7a, 0x2a,
+	0x49, 0xbc, 0x36, 0xac, 0x14, 0xab, 0x11, 0x0d, 0xf8, 0xdd, 0x0a, 0xd4, 0x0f, 0x92, 0x20, 0x4a,
+	0x83, 0x1e, 0x72, 0x31, 0x69, 0xc3, 0x4c, 0xf6, 0xa6, 0x7b, 0x12, 0xa4, 0x27, 0xac, 0xba, 0x39,
+	0x5f, 0x26, 0xc9, 0x0a, 0x4c, 0x07, 0xc3, 0x78, 0x1c, 0x65, 0xac, 0x82, 0xaa, 0x2f, 0x52, 0xe4,
+	0x7d, 0x58, 0x8c, 0xc6, 0xc3, 0x6e, 0x2f, 0x8e, 0x8e, 0xc2, 0x64, 0xc8, 0xd7, 0x02, 0x9b, 0xaf,
+	0x29, 0xbf, 0x8c, 0x20, 0xb7, 0x01, 0x0e, 0x71, 0x1c, 0x78, 0x15, 0x35, 0x56, 0x85, 0x06, 0x21,
+	0x1e, 0x34, 0x44, 0x8a, 0x86, 0xc7, 0x27, 0x59, 0x7b, 0x8a, 0x15, 0x64, 0xc0, 0xb0, 0x8c, 0x2c,
+	0x1c, 0xd2, 0x6e, 0x9a, 0x05, 0xc3, 0x51, 0x7b, 0x9a, 0xb5, 0x46, 0x83, 0x30, 0x7c, 0x9c, 0x05,
+	0x83, 0xee, 0x11, 0xa5, 0x69, 0x7b, 0x46, 0xe0, 0x15, 0x84, 0xbc, 0x0b, 0xf3, 0x7d, 0x9a, 0x66,
+	0xdd, 0xa0, 0xdf, 0x4f, 0x68, 0x9a, 0xd2, 0xb4, 0x3d, 0xcb, 0xb8, 0xb1, 0x00, 0xc5, 0x51, 0x7b,
+	0x46, 0x33, 0x6d, 0x74, 0x52, 0x31, 0x3b, 0xde, 0x36, 0x10, 0x0d, 0xbc, 0x41, 0xb3, 0x20, 0x1c,
+	0xa4, 0xe4, 0x43, 0x68, 0x64, 0x1a, 0x31, 0x5b, 0x7d, 0xf5, 0xc7, 0xe4, 0x3e, 0x13, 0x1b, 0xf7,
+	0xb5, 0x0c, 0xbe, 0x41, 0xe7, 0x3d, 0x83, 0xd9, 0x4d, 0x4a, 0xb7, 0xc3, 0x61, 0x98, 0x91, 0x15,
+	0x98, 0x3a, 0x0a, 0xdf, 0x50, 0x3e, 0xd9, 0xd5, 0xad, 0x2b, 0x3e, 0x4f, 0x12, 0x17, 0x66, 0x46,
+	0x34, 0xe9, 0x51, 0x39, 0xfc, 0x5b, 0x57, 0x7c, 0x09, 0x78, 0x3a, 0x03, 0x53, 0x03, 0xcc, 0xec,
+	0xfd, 0xa3, 0x2a, 0xd4, 0xf7, 0x69, 0xa4, 0x98, 0x88, 0x40, 0x0d, 0xbb, 0x24, 0x18, 0x87, 0xfd,
+	0x26, 0x77, 0xa0, 0xce, 0xba, 0x99, 0x66, 0x49, 0x18, 0x1d, 0xb3, 0xc2, 0xe6, 0x7c, 0x40, 0xd0,
+	0x3e, 0x83, 0x90, 0x16, 0x54, 0x83, 0x61, 0xc6, 0x66, 0xb0, 0xea, 0xe3, 0x4f, 0x64, 0xb0, 0x51,
+	0x70, 0x36, 0x44, 0x5e, 0x54, 0xb3, 0xd6, 0xf0, 0xeb, 0x02, 0xb6, 0x85, 0xd3, 0x76, 0x1f, 0x96,
+	0x74, 0x12, 0x59, 0xfa, 0x14, 0x2b, 0x7d, 0x51, 0xa3, 0x14, 0x95, 0xbc, 0x07, 0x0b, 0x92, 0x3e,
+	0xe1, 0x8d, 0x65, 0xf3, 0x38, 0xe7, 0xcf, 0x0b, 0xb0, 0xec, 0xc2, 0x5d, 0x68, 0x1d, 0x85, 0x51,
+	0x30, 0xe8, 0xf6, 0x06, 0xd9, 0xeb, 0x6e, 0x9f, 0x0e, 0xb2, 0x80, 0xcd, 0xe8, 0x94, 0x3f, 0xcf,
+	0xe0, 0xeb, 0x83, 0xec, 0xf5, 0x06, 0x42, 0xc9, 0xfb, 0x30, 0x77, 0x44, 0x69, 0x97, 0x8d, 0x44,
+	0x7b, 0x76, 0xd5, 0xb9, 0x5b, 0x7f, 0xbc, 0x20, 0x86, 0x5e, 0x8e, 0xae, 0x3f, 0x7b, 0x24, 0x7e,
+	0x91, 0x5b, 0x00, 0xc3, 0xe0, 0x4d, 0x37, 0x3d, 0x09, 0x92, 0x7e, 0xda, 0x9e, 0x5b, 0x75, 0xee,
+	0x36, 0xfd, 0xb9, 0x61, 0xf0, 0x66, 0x9f, 0x01, 0x70, 0x19, 0xbc, 0xa2, 0x67, 0x29, 0x8d, 0xfa,
+	0x6d, 0x58, 0x75, 0xee, 0xce, 0xfa, 0x32, 0x89, 0x0d, 0x8a, 0xc7, 0xd9, 0x71, 0x1c, 0x46, 0xc7,
+	0xdd, 0xde, 0x49, 0x10, 0x75, 0xc3, 0x7e, 0xbb, 0xbe, 0xea, 0xdc, 0xad, 0xf9, 0xf3, 0x12, 0x8e,
+	0x4b, 0xed, 0x79, 0x9f, 0xbc, 0x0b, 0x0b, 0x83, 0x20, 0xcd, 0xba, 0x27, 0xf1, 0xa8, 0x3b, 0x1a,
+	0x1f, 0xbe, 0xa2, 0x67, 0xed, 0x06, 0x1b, 0xb9, 0x26, 0x82, 0xb7, 0xe2, 0xd1, 0x1e, 0x03, 0x7a,
+	0x7d, 0x00, 0x3f, 0x1e, 0x67, 0xb4, 0x93, 0x24, 0x71, 0x42, 0x1e, 0x41, 0x53, 0x8d, 0x0c, 0x42,
+	0xd9, 0xe4, 0xd5, 0x1f, 0x37, 0x44, 0x57, 0x18, 0xa5, 0x2f, 0xe7, 0x83, 0xa5, 0xc8, 0xdb, 0x79,
+	0x16, 0x8a, 0x65, 0x88, 0x49, 0x95, 0x44, 0xac, 0x5c, 0xef, 0x7f, 0x3b, 0xd0, 0xe0, 0xbc, 0x21,
+	0xf6, 0x8c, 0x77, 0x8a, 0xb9, 0xf8, 0x7a, 0x37, 0x81, 0xe4, 0x1e, 0xb4, 0x24, 0x60, 0x94, 0xd0,
+	0x70, 0x18, 0x1c, 0x53, 0x21, 0x60, 0x4a, 0x70, 0xf2, 0xb8, 0xd8, 0xf4, 0xaa, 0xa5, 0xe9, 0x26,
+	0x09, 0xf9, 0x45, 0x68, 0x1e, 0x05, 0xe1, 0x80, 0xf6, 0x79, 0x3a, 0x6d, 0xd7, 0xd8, 0xa2, 0x59,
+	0xd4, 0xf3, 0xb0, 0x0e, 0xf8, 0x26, 0x1d, 0x79, 0x08, 0x0d, 0x36, 0x79, 0x32, 0xdf, 0xd4, 0x6a,
+	0xb5, 0x54, 0x97, 0x41, 0xe1, 0xfd, 0x7d, 0x07, 0x5a, 0x3e, 0x3d, 0x0c, 0x06, 0x41, 0xd4, 0xa3,
+	0x92, 0xbf, 0xee, 0x59, 0xa6, 0xd3, 0x61, 0xd3, 0x59, 0x82, 0x23, 0x6d, 0x18, 0xf5, 0xe2, 0xa1,
+	0x4e, 0x5b, 0xe1, 0xb4, 0x45, 0xb8, 0x65, 0x15, 0xfd, 0x9c, 0xce, 0x9f, 0x35, 0x3b, 0x7f, 0xe6,
+	0x14, 0xde, 0x1f, 0x3a, 0xb0, 0xa8, 0xb5, 0x56, 0x4c, 0x9a, 0x57, 0x58, 0x8a, 0x7c, 0x65, 0x1b,
+	0xb0, 0x9f, 0x68, 0xca, 0x3c, 0x98, 0x9a, 0x3c, 0x55, 0x1c, 0x45, 0x5c, 0xc0, 0x65, 0xd3, 0x1d,
+	0xa6, 0x01, 0x6f, 0x77, 0xd5, 0x57, 0x69, 0xef, 0x87, 0x0e, 0x10, 0xe4, 0xaa, 0x83, 0x98, 0x67,
+	0x11, 0xa3, 0xfa, 0x96, 0xb5, 0x99, 0x97, 0x91, 0x18, 0x95, 0x49, 0x12, 0xe3, 0x1d, 0x98, 0x16,
+	0x33, 0x5d, 0xb5, 0xcc, 0xb4, 0xc0, 0x79, 0x5f, 0x03, 0x58, 0x0f, 0x93, 0xde, 0x38, 0xcc, 0xbe,
+	0x41, 0xcf, 0x70, 0x15, 0x9b, 0x73, 0x2a, 0x93, 0x88, 0x39, 0xc9, 0x06, 0xbd, 0x7c, 0x06, 0x65,
+	0xd2, 0xfb, 0xcd, 0x2a, 0xdc, 0xd8, 0x8c, 0x93, 0xd3, 0x20, 0xe9, 0x6f, 0x65, 0x83, 0xde, 0xf3,
+	0x28, 0x43, 0x29, 0x3b, 0x52, 0x02, 0xa9, 0x03, 0x57, 0xf3, 0xc9, 0xe6, 0x55, 0x75, 0x71, 0x69,
+	0xf3, 0x65, 0x2a, 0xf9, 0x36, 0x6f, 0x84, 0x6f, 0x25, 0x27, 0x8f, 0xb5, 0x62, 0xf8, 0x46, 0xca,
+	0x07, 0x98, 0xb7, 0xc6, 0x8a, 0x23, 0x77, 0x61, 0x41, 0xc1, 0xe9, 0x9b, 0x51, 0x98, 0x9c, 0xb1,
+	0x69, 0x6b, 0xfa, 0x45, 0x70, 0x89, 0x4d, 0x6a, 0x16, 0x36, 0xf9, 0x04, 0x5c, 0xc5, 0xe1, 0x42,
+	0x06, 0xd3, 0xbe, 0xe2, 0xeb, 0x29, 0xd6, 0x8e, 0x73, 0x28, 0xb0, 0x07, 0x0a, 0xab, 0xf7, 0x60,
+	0x9a, 0xf7, 0xc0, 0x86, 0xc3, 0x1e, 0x28, 0xb8, 0xe8, 0xc1, 0x0c, 0xef, 0x41, 0x01, 0xec, 0xfd,
+	0x69, 0x05, 0x6e, 0xda, 0xa7, 0x41, 0xac, 0x84, 0x2f, 0x69, 0x1e, 0x9e, 0xc3, 0x34, 0xdf, 0x86,
+	0xd9, 0xc8, 0xcf, 0x3f, 0x7e, 0x24, 0x97, 0xe4, 0x39, 0x75, 0xdf, 0xf7, 0x69, 0x1a, 0x0f, 0x5e,
+	0xd3, 0x35, 0x96, 0xd1, 0x17, 0x05, 0xe0, 0x3a, 0x51, 0xeb, 0x8d, 0xeb, 0xab, 0xb3, 0xda, 0x3a,
+	0x6b, 0xa0, 0xf8, 0x1a, 0x27, 0xb4, 0xdb, 0x8b, 0xfb, 0x94, 0x4d, 0x48, 0xd3, 0x37, 0x60, 0x56,
+	0x51, 0x34, 0x65, 0x17, 0x45, 0xde, 0x26, 0x34, 0x8d, 0x46, 0x10, 0x80, 0xe9, 0xfd, 0xce, 0xc1,
+	0xc1, 0x76, 0xa7, 0x75, 0x85, 0xcc, 0x42, 0x6d, 0x73, 0xed, 0xf9, 0x76, 0xcb, 0x41, 0xa8, 0xdf,
+	0xd9, 0x7f, 0xf9, 0xa2, 0xd3, 0xaa, 0x90, 0x25, 0x58, 0xe0, 0xbf, 0xbb, 0x2f, 0x76, 0x37, 0x9e,
+	0x6f, 0x3e, 0xef, 0x6c, 0xb4, 0xaa, 0xde, 0x4d, 0x70, 0xf7, 0xc7, 0x87, 0x69, 0x2f, 0x09, 0x0f,
+	0x29, 0xf6, 0xb5, 0xf3, 0x9a, 0x46, 0x99, 0x52, 0x73, 0xfe, 0x53, 0x0d, 0xe6, 0x14, 0x94, 0x3c,
+	0x84, 0x25, 0x43, 0xcc, 0x45, 0x74, 0x90, 0xaf, 0x2c, 0x1b, 0x0a, 0x73, 0x18, 0x2d, 0x17, 0x39,
+	0x38, 0x8f, 0xdb, 0x50, 0x86, 0x88, 0x95, 0x0b, 0xb4, 0x5a, 0x10, 0xb1, 0x02, 0x6e, 0x8c, 0x97,
+	0xa4, 0xad, 0x15, 0xc6, 0x4b, 0xd2, 0x7a, 0xd0, 0x40, 0x05, 0x91, 0xe9, 0x87, 0xdd, 0x28, 0x15,
+	0xe3, 0x6a, 0xc0, 0xc8, 0x13, 0x00, 0x8a, 0x1d, 0xed, 0x66, 0x67, 0x23, 0xca, 0xd8, 0x78, 0xfe,
+	0xb1, 0x2b, 0xd8, 0x41, 0x8d, 0xc2, 0x7d, 0xf6, 0xf7, 0xe0, 0x6c, 0x44, 0x7d, 0x8d, 0x9a, 0x7c,
+	0x05, 0x9a, 0x47, 0x9c, 0x63, 0xba, 0x0c, 0xca, 0xd8, 0xba, 0xfe, 0x78, 0xc9, 0xe4, 0x26, 0x96,
+	0xd7, 0x37, 0x29, 0xc9, 0x33, 0x20, 0x12, 0x80, 0xec, 0x20, 0xf2, 0x73, 0x05, 0xe6, 0x9a, 0x99,
+	0x7f, 0x33, 0x08, 0x07, 0xbc, 0x0c, 0x4b, 0x16, 0x54, 0x3f, 0x53, 0x9a, 0x65, 0x03, 0x2a, 0x8a,
+	0x98, 0x5b, 0x75, 0x34, 0xf5, 0x73, 0x9f, 0xa1, 0x78, 0x6e, 0x83, 0x8e, 0x7c, 0x02, 0x0b, 0x83,
+	0x30, 0x7a, 0xa5, 0xd7, 0x0e, 0x2c, 0xeb, 0x55, 0x91, 0x75, 0x3b, 0x8c, 0x5e, 0xe5, 0x55, 0x17,
+	0x89, 0xbd, 0xaf, 0xc2, 0x9c, 0x1a, 0x14, 0x52, 0x87, 0x99, 0x97, 0x3b, 0xdf, 0xd8, 0xd9, 0xfd,
+	0x6c, 0x87, 0x33, 0xe2, 0x7e, 0x67, 0x67, 0xa3, 0xe5, 0x20, 0xd8, 0xef, 0xac, 0x77, 0x9e, 0x7f,
+	0x8a, 0x9c, 0x58, 0x87, 0x99, 0xcd, 0x5d, 0xff, 0xb3, 0x35, 0x1f, 0x39, 0xf0, 0x5f, 0x38, 0x30,
+	0xcb, 0x57, 0xd9, 0x51, 0x8c, 0x67, 0x09, 0x35, 0xcd, 0x38, 0x37, 0xa8, 0xf5, 0x33, 0x06, 0x6b,
+	0xfa, 0x65, 0x04, 0x52, 0xab, 0x89, 0x56, 0xd4, 0x15, 0x4e, 0x5d, 0x42, 0x18, 0x65, 0x07, 0x43,
+	0x21, 0xac, 0x38, 0x6f, 0x95, 0x11, 0x46, 0xd9, 0x8a, 0x9a, 0x73, 0x57, 0x19, 0xe1, 0x7d, 0x00,
+	0x0d, 0x7d, 0x8a, 0xc9, 0xdb, 0x50, 0x0b, 0xa3, 0xa3, 0xb8, 0xed, 0x18, 0xdb, 0xbc, 0xec, 0xa6,
+	0xcf, 0x90, 0x1e, 0x81, 0x56, 0x71, 0x5e, 0xbd, 0x26, 0x2a, 0xf0, 0x6a, 0xa2, 0xf0, 0xf0, 0xdd,
+	0x34, 0x46, 0xff, 0x52, 0x25, 0x97, 0xa4, 0x4d, 0xc5, 0x22, 0x6d, 0xde, 0x85, 0x79, 0x99, 0x16,
+	0x5b, 0x6f, 0x95, 0x2b, 0xe0, 0x26, 0x54, 0xa7, 0xeb, 0xb3, 0x73, 0x8e, 0x38, 0xb4, 0x15, 0xa0,
+	0x28, 0xfd, 0xe4, 0x28, 0xb2, 0xd5, 0x35, 0xeb, 0xab, 0xb4, 0xf7, 0x07, 0x0e, 0x34, 0xd6, 0xf9,
+	0x22, 0xdf, 0x8b, 0x43, 0x26, 0x4a, 0xc8, 0xd1, 0x38, 0xea, 0xb3, 0xf9, 0x79, 0x13, 0xf6, 0xbb,
+	0x87, 0x67, 0xb8, 0xb1, 0x33, 0x2d, 0x61, 0xeb, 0x8a, 0x6f, 0xc1, 0x91, 0xf7, 0xa1, 0x65, 0x40,
+	0xd3, 0x4c, 0xa8, 0xb9, 0x5b, 0x57, 0xfc, 0x12, 0x06, 0x07, 0x20, 0x1e, 0x67, 0xa3, 0x71, 0xd6,
+	0x0d, 0xa3, 0x3e, 0x7d, 0x23, 0xb6, 0x49, 0x03, 0xf6, 0x74, 0x1e, 0x1a, 0x7a, 0x3e, 0xef, 0x13,
+	0x68, 0x6d, 0xe3, 0xf1, 0x32, 0x0a, 0xa3, 0xe3, 0x35, 0x7e, 0x06, 0xc4, 0x33, 0xaf, 0xd0, 0xdc,
+	0xb9, 0x72, 0x2c, 0x52, 0x78, 0xb0, 0x3a, 0x89, 0xd3, 0x4c, 0x68, 0x2b, 0xec, 0xb7, 0xf7, 0x27,
+	0x0e, 0x2c, 0xa0, 0x2a, 0xf4, 0x22, 0x88, 0xce, 0xa4, 0xb2, 0xb0, 0x0d, 0x0d, 0x2c, 0xea, 0x20,
+	0x5e, 0xe3, 0x27, 0x67, 0x7e, 0x22, 0xbc, 0xab, 0x96, 0xa4, 0x41, 0x7d, 0x5f, 0x27, 0x45, 0x63,
+	0xcf, 0x99, 0x6f, 0xe4, 0xc6, 0xa3, 0x5b, 0x16, 0x24, 0xc7, 0x34, 0x63, 0x67, 0x6a, 0x71, 0xc6,
+	0x06, 0x0e, 0x5a, 0x8f, 0xa3, 0x23, 0xb2, 0x0a, 0x8d, 0x34, 0xc8, 0xba, 0x23, 0x9a, 0xb0, 0x51,
+	0x63, 0xf3, 0x50, 0xf5, 0x21, 0x0d, 0xb2, 0x3d, 0x9a, 0x3c, 0x3d, 0xcb, 0xa8, 0xfb, 0xcb, 0xb0,
+	0x58, 0xaa, 0x05, 0x75, 0xd5, 0xbc, 0x8b, 0xf8, 0x93, 0x5c, 0x85, 0xa9, 0xd7, 0xc1, 0x60, 0x4c,
+	0xc5, 0x51, 0x9f, 0x27, 0x9e, 0x54, 0x3e, 0x72, 0xbc, 0x77, 0xa1, 0x95, 0x37, 0x5b, 0x6c, 0xc5,
+	0x04, 0x6a, 0x38, 0x82, 0xa2, 0x00, 0xf6, 0xdb, 0xfb, 0x75, 0x87, 0x13, 0xae, 0xc7, 0xa1, 0x3a,
+	0x36, 0x23, 0x21, 0x9e, 0xae, 0x25, 0x21, 0xfe, 0x9e, 0x68, 0x56, 0xf8, 0xe9, 0x3b, 0xeb, 0xbd,
+	0x07, 0x8b, 0x5a, 0x13, 0xce, 0x69, 0xec, 0x0f, 0x1d, 0x58, 0xdc, 0xa1, 0xa7, 0x62, 0xd6, 0x65,
+	0x6b, 0x3f, 0x82, 0x1a, 0xdb, 0x09, 0x1c, 0xb6, 0x13, 0xbc, 0x23, 0x26, 0xad, 0x44, 0x77, 0x5f,
+	0x24, 0xd9, 0x9e, 0xc0, 0x72, 0x78, 0x9f, 0x40, 0x5d, 0x03, 0x92, 0x6b, 0xb0, 0xf4, 0xd9, 0xf3,
+	0x83, 0x9d, 0xce, 0xfe, 0x7e, 0x77, 0xef, 0xe5, 0xd3, 0x6f, 0x74, 0xbe, 0xd5, 0xdd, 0x5a, 0xdb,
+	0xdf, 0x6a, 0x5d, 0x21, 0x2b, 0x40, 0x76, 0x3a, 0xfb, 0x07, 0x9d, 0x0d, 0x03, 0xee, 0x78, 0xf7,
+	0x81, 0xe8, 0xd5, 0x88, 0x96, 0xb7, 0x61, 0x46, 0xd8, 0x26, 0xa4, 0x69, 0x46, 0x24, 0x3d, 0x17,
+	0xda, 0xfb, 0xe3, 0xc3, 0xfd, 0xd3, 0x60, 0xb4, 0x3e, 0x08, 0x69, 0x94, 0xa1, 0xa5, 0x4b, 0xee,
+	0xe1, 0x63, 0xb8, 0x6e, 0xc1, 0x89, 0x22, 0x75, 0x95, 0xc5, 0x29, 0xa8, 0x2c, 0xc8, 0xe3, 0xa8,
+	0x3b, 0xf2, 0xa3, 0x03, 0xfb, 0x2d, 0x39, 0x85, 0x6b, 0x37, 0xf8, 0x53, 0x5b, 0x21, 0x5c, 0xc7,
+	0x14, 0x29, 0xef, 0x99, 0xaa, 0x76, 0x9f, 0x26, 0xaf, 0xc3, 0x1e, 0xd5, 0xda, 0xa4, 0x8a, 0x76,
+	0xb4, 0xa2, 0xf3, 0x82, 0x2a, 0x46, 0x41, 0x23, 0x70, 0x6d, 0x05, 0x5d, 0x34, 0x26, 0x93, 0xca,
+	0x23, 0xab, 0x50, 0xd7, 0xed, 0x4b, 0xfc, 0x80, 0xa6, 0x83, 0xbc, 0xdf, 0x76, 0x0a, 0x43, 0xf6,
+	0x59, 0x90, 0xf5, 0x4e, 0x64, 0xdb, 0xcf, 0x1b, 0x32, 0x31, 0x3c, 0x95, 0x7c, 0x78, 0xde, 0x85,
+	0xf9, 0x94, 0x37, 0x5b, 0x9a, 0x00, 0xf8, 0xd8, 0x15, 0xa0, 0xc5, 0x56, 0xd5, 0xca, 0xad, 0xda,
+	0x01, 0xd7, 0xd6, 0xa8, 0xcb, 0x8c, 0x03, 0xaa, 0x77, 0xa3, 0x4c, 0x8e, 0x03, 0x4f, 0x79, 0x1b,
+	0x68, 0x91, 0x4c, 0x47, 0x34, 0xca, 0xb8, 0x28, 0x90, 0xfd, 0x9b, 0x5c, 0x92, 0x85, 0x21, 0xbc,
+	0xff, 0xee, 0xc0, 0x72, 0xa1, 0x18, 0xd1, 0xa2, 0x7c, 0x5d, 0x3b, 0xc6, 0xba, 0x2e, 0xf4, 0x94,
+	0x9b, 0x4b, 0x75, 0x10, 0xf9, 0x45, 0x98, 0x1a, 0x67, 0x6f, 0x62, 0x79, 0xd0, 0x7b, 0x4b, 0x2c,
+	0x3c, 0x6b, 0x35, 0xf7, 0x5f, 0x66, 0x6f, 0x62, 0x9f, 0xd3, 0xbb, 0x03, 0xa8, 0x61, 0xb2, 0x64,
+	0x43, 0x74, 0x2c, 0x36, 0xc4, 0x49, 0x62, 0x47, 0x8a, 0x87, 0x6a, 0x2e, 0x1e, 0x50, 0x1a, 0xf2,
+	0x6d, 0x84, 0x6b, 0xed, 0x3c, 0xe1, 0xfd, 0x1a, 0xdc, 0x30, 0x19, 0xd3, 0xa7, 0x7d, 0x4a, 0x87,
+	0x97, 0xe1, 0x93, 0x82, 0x6c, 0xab, 0x5c, 0x28, 0xdb, 0xaa, 0x25, 0xd9, 0xf6, 0x18, 0x6e, 0xda,
+	0x6b, 0x3f, 0x47, 0xcc, 0xfd, 0x6d, 0xa7, 0xc0, 0x43, 0x3e, 0xc5, 0x1d, 0xf0, 0xe2, 0x99, 0xff,
+	0x19, 0x68, 0x26, 0x8c, 0x54, 0x88, 0x24, 0xb1, 0xef, 0xcd, 0x73, 0xa0, 0xb4, 0x98, 0xfe, 0xe4,
+	0x22, 0xbb, 0x56, 0xea, 0xd6, 0x23, 0xb8, 0x61, 0x6d, 0xe1, 0x39, 0xbd, 0x7a, 0x17, 0xc8, 0x7e,
+	0x78, 0x1c, 0xbd, 0xa0, 0x69, 0x1a, 0x1c, 0x2b, 0x0b, 0x44, 0x0b, 0xaa, 0xc3, 0xf4, 0x58, 0x8c,
+	0x3c, 0xfe, 0xf4, 0x3e, 0x80, 0x25, 0x83, 0x4e, 0x14, 0x79, 0x13, 0xe6, 0xd2, 0xf0, 0x38, 0x0a,
+	0xb2, 0x71, 0x42, 0x45, 0xb9, 0x39, 0xc0, 0xdb, 0x84, 0xab, 0x9f, 0xd2, 0x24, 0x3c, 0x3a, 0xbb,
+	0xa8, 0x78, 0xb3, 0x9c, 0x4a, 0xb1, 0x9c, 0x0e, 0x2c, 0x17, 0xca, 0x11, 0xd5, 0xf3, 0x9d, 0x56,
+	0x74, 0x69, 0xd6, 0xe7, 0x89, 0x82, 0xf0, 0x52, 0x7a, 0x87, 0xf7, 0x12, 0xc8, 0x7a, 0x1c, 0x45,
+	0xb4, 0x97, 0xed, 0x51, 0x9a, 0xe4, 0x0e, 0xa6, 0x7c, 0x5b, 0xcd, 0xcf, 0x0c, 0x45, 0x65, 0x46,
+	0xec, 0xb7, 0x04, 0x6a, 0x23, 0x9a, 0x0c, 0x59, 0xc1, 0xb3, 0x3e, 0xfb, 0xed, 0x2d, 0xc3, 0x92,
+	0x51, 0xac, 0xf0, 0x0d, 0x3c, 0x82, 0xe5, 0x8d, 0x30, 0xed, 0x95, 0x2b, 0x6c, 0xc3, 0xcc, 0x68,
+	0x7c, 0xd8, 0xcd, 0x95, 0x06, 0x99, 0x44, 0x93, 0x79, 0x31, 0x8b, 0x28, 0xec, 0xaf, 0x38, 0x50,
+	0xdb, 0x3a, 0xd8, 0x5e, 0x37, 0x14, 0x45, 0xc7, 0x54, 0x14, 0x27, 0xae, 0xca, 0x9b, 0x30, 0xc7,
+	0x8c, 0x44, 0x4c, 0xc3, 0xe7, 0x12, 0x34, 0x07, 0xa0, 0xae, 0xce, 0xac, 0x06, 0xcc, 0xc5, 0xa0,
+	0x8b, 0xd0, 0xa6, 0x5f, 0x46, 0x78, 0x7f, 0x3c, 0x0d, 0x33, 0x42, 0x19, 0x65, 0xf5, 0xf5, 0xb2,
+	0xf0, 0x35, 0x15, 0x2d, 0x11, 0x29, 0xb4, 0x8d, 0x26, 0x74, 0x18, 0x67, 0x4a, 0x6a, 0xf3, 0x69,
+	0x30, 0x81, 0x48, 0x25, 0x8f, 0xae, 0x23, 0x54, 0x6b, 0x85, 0xd0, 0x30, 0x81, 0xba, 0x11, 0xaa,
+	0x66, 0x1a, 0xa1, 0x5c, 0x98, 0xed, 0x05, 0xa3, 0xa0, 0x17, 0x66, 0x67, 0x42, 0x7b, 0x51, 0x69,
+	0x2c, 0x7b, 0x10, 0xf7, 0x82, 0x41, 0x57, 0x58, 0x00, 0x85, 0x9b, 0xc3, 0x04, 0xe2, 0xf6, 0x22,
+	0x9a, 0x24, 0xc9, 0xb8, 0xb7, 0xa3, 0x00, 0x45, 0x8f, 0x48, 0x2f, 0x1e, 0x0e, 0xc3, 0x0c, 0x1d,
+	0x20, 0xec, 0x6c, 0x59, 0xf5, 0x35, 0x08, 0xeb, 0x09, 0x4f, 0x9d, 0xf2, 0xd1, 0x9b, 0xe3, 0xb5,
+	0x19, 0x40, 0x2c, 0x05, 0x0d, 0x7f, 0xb8, 0x7c, 0x5f, 0x9d, 0xb2, 0x33, 0x62, 0xd5, 0xd7, 0x20,
+	0x38, 0x0f, 0xe3, 0x88, 0x1f, 0x2d, 0xfb, 0xaa, 0x41, 0x75, 0x46, 0x56, 0x46, 0xa0, 0x71, 0x80,
+	0xfb, 0x64, 0xd2, 0x20, 0x8b, 0xd3, 0x93, 0x30, 0xed, 0xa6, 0x78, 0xf4, 0x6c, 0x30, 0x7a, 0x1b,
+	0x8a, 0x7c, 0x04, 0xd7, 0x0a, 0xe0, 0x84, 0xf6, 0x68, 0xf8, 0x9a, 0xf6, 0xdb, 0x4d, 0x96, 0x6b,
+	0x12, 0x1a, 0x37, 0x1d, 0x74, 0x45, 0x8d, 0x47, 0xfd, 0x00, 0x0f, 0x1a, 0xf3, 0x6c, 0x1e, 0x74,
+	0x10, 0x33, 0xbb, 0xd3, 0xa8, 0x2f, 0x6d, 0x06, 0x69, 0x7b, 0x81, 0x6d, 0x3e, 0x75, 0x79, 0xc0,
+	0x3a, 0xd8, 0x5e, 0xf7, 0x4d, 0x0a, 0x64, 0xca, 0x5e, 0xca, 0x7c, 0x12, 0xc1, 0x59, 0xbb, 0xc5,
+	0x3d, 0x08, 0x0a, 0xc0, 0xd6, 0x48, 0x12, 0xbe, 0x0e, 0x32, 0xda, 0x5e, 0xe4, 0x1e, 0x04, 0x91,
+	0x24, 0xf7, 0x81, 0xf0, 0x59, 0x64, 0x7c, 0x90, 0x50, 0x54, 0x05, 0x68, 0x9b, 0xb0, 0x1e, 0x58,
+	0x30, 0x38, 0x50, 0x62, 0x3a, 0x8d, 0x0c, 0x4b, 0x7c, 0xa0, 0x2c, 0x28, 0xf2, 0x55, 0xb8, 0xce,
+	0xcb, 0x19, 0x25, 0xf1, 0x28, 0x4e, 0x69, 0xbf, 0xab, 0xcd, 0xdb, 0x55, 0x96, 0x6f, 0x32, 0x01,
+	0x1a, 0x06, 0x25, 0x9f, 0x5b, 0xb2, 0x2f, 0xb3, 0xec, 0xe7, 0x50, 0x78, 0xbf, 0xef, 0xc0, 0xd2,
+	0x76, 0x98, 0x66, 0x62, 0x91, 0x29, 0x7d, 0xfa, 0x0e, 0xd4, 0xf9, 0xf2, 0xea, 0xc6, 0xd1, 0xe0,
+	0x4c, 0xac, 0x38, 0xe0, 0xa0, 0xdd, 0x68, 0x70, 0x86, 0x7e, 0x8c, 0x30, 0xd2, 0x49, 0xb8, 0x8c,
+	0x6a, 0x84, 0x91, 0x46, 0x74, 0x07, 0xea, 0xa3, 0xf1, 0xe1, 0x20, 0xec, 0x71, 0x92, 0x2a, 0x2f,
+	0x85, 0x83, 0x18, 0x01, 0xda, 0x9e, 0xf9, 0x48, 0x73, 0x8a, 0x1a, 0xa3, 0xa8, 0x0b, 0x18, 0x92,
+	0x78, 0x4f, 0xe1, 0xaa, 0xd9, 0x40, 0x21, 0x8c, 0xef, 0xc1, 0xac, 0x58, 0xbb, 0x69, 0xbb, 0xce,
+	0xe6, 0x7f, 0x5e, 0xda, 0x11, 0x39, 0xd8, 0x57, 0x78, 0xef, 0x9f, 0xd4, 0x60, 0x49, 0x40, 0xd7,
+	0x07, 0x71, 0x4a, 0xf7, 0xc7, 0xc3, 0x61, 0x90, 0x58, 0x84, 0x82, 0x73, 0x81, 0x50, 0xa8, 0x98,
+	0x42, 0x01, 0x97, 0xea, 0x49, 0x10, 0x46, 0xdc, 0x70, 0xcb, 0x25, 0x8a, 0x06, 0x41, 0x13, 0x6a,
+	0x6f, 0x10, 0xa7, 0xfc, 0xd8, 0xaa, 0x7b, 0x51, 0x8b, 0xe0, 0xb2, 0x10, 0x9b, 0xb2, 0x09, 0x31,
+	0x5d, 0x08, 0x4d, 0x17, 0x84, 0x90, 0x07, 0x0d, 0x2c, 0x94, 0x4a, 0x99, 0xca, 0x6d, 0xb5, 0x06,
+	0x0c, 0xdb, 0x53, 0x5c, 0xf2, 0x5c, 0xbe, 0x2c, 0xd8, 0x16, 0x3c, 0x3a, 0x69, 0x51, 0x66, 0x6b,
+	0xd4, 0x73, 0x62, 0xc1, 0x97, 0x51, 0x64, 0x13, 0x80, 0xd7, 0xc5, 0xce, 0x61, 0xc0, 0xce, 0x61,
+	0xef, 0x9a, 0x33, 0xa2, 0x8f, 0xfd, 0x7d, 0x4c, 0x8c, 0x13, 0xca, 0xad, 0x73, 0x79, 0x4e, 0xef,
+	0xaf, 0x39, 0x50, 0xd7, 0x70, 0x64, 0x19, 0x16, 0xd7, 0x77, 0x77, 0xf7, 0x3a, 0xfe, 0xda, 0xc1,
+	0xf3, 0x4f, 0x3b, 0xdd, 0xf5, 0xed, 0xdd, 0x7d, 0xb4, 0x9b, 0x2e, 0xc3, 0xe2, 0xf6, 0xee, 0xfa,
+	0xda, 0x76, 0x77, 0x73, 0xd7, 0x5f, 0x97, 0x60, 0x07, 0x4f, 0x69, 0x7e, 0xe7, 0xc5, 0xee, 0x41,
+	0xc7, 0x80, 0x57, 0x48, 0x0b, 0x1a, 0x4f, 0xfd, 0xce, 0xda, 0xfa, 0x96, 0x80, 0x54, 0xc9, 0x55,
+	0x68, 0x6d, 0xbe, 0xdc, 0xd9, 0x78, 0xbe, 0xf3, 0xac, 0xbb, 0xbe, 0xb6, 0xb3, 0xde, 0xd9, 0xee,
+	0x6c, 0xb4, 0x6a, 0xa4, 0x09, 0x73, 0x6b, 0x4f, 0xd7, 0x76, 0x36, 0x76, 0x77, 0x3a, 0x1b, 0xad,
+	0x29, 0xef, 0x3b, 0xb0, 0xbc, 0x97, 0x8c, 0x23, 0xda, 0x2f, 0xae, 0x0f, 0x54, 0x5b, 0xe9, 0x71,
+	0x18, 0xe9, 0x6a, 0x6b, 0xd3, 0x37, 0x60, 0xc8, 0x1d, 0x34, 0xea, 0xeb, 0xca, 0x73, 0xd3, 0xd7,
+	0x20, 0xde, 0xdf, 0x74, 0xa0, 0x69, 0x94, 0xce, 0xf8, 0x71, 0xc0, 0x96, 0xa8, 0x51, 0xac, 0x09,
+	0x24, 0x37, 0x8a, 0xfc, 0x08, 0x9a, 0x51, 0x56, 0x67, 0x91, 0x6a, 0x79, 0x9f, 0x32, 0xd9, 0xbd,
+	0x66, 0x61, 0x77, 0xef, 0xeb, 0xb0, 0x52, 0xec, 0xb3, 0x8a, 0x5c, 0xc9, 0x97, 0x1c, 0xb7, 0x8e,
+	0x48, 0xab, 0xa3, 0x91, 0x41, 0x5b, 0x78, 0x7f, 0xec, 0xc0, 0x32, 0x9b, 0xf5, 0xd2, 0x00, 0xae,
+	0x42, 0xbd, 0x17, 0xc7, 0x23, 0x9a, 0x04, 0xda, 0x96, 0xae, 0x83, 0x50, 0x78, 0x70, 0xb9, 0x77,
+	0x14, 0x27, 0x3d, 0x2a, 0xe4, 0x0b, 0x30, 0xd0, 0x26, 0x42, 0x50, 0x78, 0x88, 0xe5, 0xc1, 0x29,
+	0xb8, 0x78, 0xa9, 0x73, 0x18, 0x27, 0x59, 0x81, 0xe9, 0xc3, 0x84, 0x06, 0xbd, 0x13, 0x21, 0x59,
+	0x44, 0x0a, 0x23, 0x36, 0xa4, 0x3d, 0xa9, 0x87, 0xdc, 0x3b, 0xa0, 0x7d, 0x61, 0x08, 0x5b, 0x10,
+	0xf0, 0x75, 0x01, 0xc6, 0x9d, 0x23, 0x38, 0x0c, 0xa2, 0x7e, 0x1c, 0xd1, 0x3e, 0x5b, 0x74, 0xb3,
+	0x7e, 0x0e, 0xf0, 0xf6, 0x60, 0xa5, 0xd8, 0x3f, 0x31, 0x58, 0x1f, 0x96, 0x06, 0xcb, 0x9d, 0xbc,
+	0x1a, 0xb4, 0x21, 0xfb, 0x9d, 0x0a, 0xd4, 0x50, 0x19, 0x9b, 0xac, 0xb8, 0xe9, 0xca, 0x7f, 0xd5,
+	0x54, 0xfe, 0x31, 0x62, 0x03, 0x4d, 0x70, 0x7c, 0x7b, 0xe6, 0x2a, 0x8c, 0x06, 0xc9, 0xf1, 0x09,
+	0xed, 0xbd, 0x16, 0x86, 0x75, 0x0d, 0x82, 0xdc, 0x83, 0x4a, 0x3f, 0xcb, 0x2d, 0x04, 0x8c, 0x4c,
+	0x4b, 0x1c, 0xcb, 0x39, 0x93, 0xe3, 0x58, 0xbe, 0x36, 0xcc, 0x84, 0xd1, 0x61, 0x3c, 0x8e, 0xfa,
+	0x4c, 0xa0, 0xcc, 0xfa, 0x32, 0x89, 0xc3, 0x37, 0x92, 0xa6, 0x5d, 0x21, 0x3e, 0x72, 0x00, 0x0a,
+	0xf1, 0x23, 0xca, 0xb4, 0xee, 0xb4, 0x0d, 0x86, 0x10, 0xdf, 0xe4, 0x60, 0x5f, 0xe1, 0xbd, 0x21,
+	0xcc, 0x08, 0x20, 0x6a, 0xf4, 0x87, 0xa1, 0x5c, 0x1d, 0xf8, 0x13, 0x35, 0xe5, 0x28, 0x18, 0x4a,
+	0x65, 0x9e, 0xfd, 0x46, 0x16, 0x63, 0x7a, 0xc5, 0xf7, 0xc7, 0x61, 0x42, 0xfb, 0x92, 0x3d, 0x34,
+	0x10, 0x53, 0x6f, 0xd3, 0xee, 0xab, 0x28, 0x3e, 0x8d, 0x04, 0x83, 0xa8, 0x34, 0x5a, 0x7c, 0x71,
+	0xdf, 0xc1, 0xa9, 0x50, 0x3e, 0x96, 0x0f, 0x61, 0x51, 0x83, 0x89, 0x89, 0x7e, 0x0b, 0xa6, 0x46,
+	0x08, 0x68, 0x3b, 0x86, 0x16, 0x82, 0x44, 0x3e, 0xc7, 0x60, 0x59, 0x98, 0x14, 0xde, 0x9b, 0x11,
+	0x6a, 0xb8, 0x5e, 0x9f, 0xc3, 0x76, 0xe2, 0x2c, 0x3c, 0x0a, 0x7b, 0x81, 0x0c, 0xe8, 0xb9, 0x78,
+	0xca, 0x2b, 0xe6, 0x94, 0xa3, 0x66, 0xc3, 0x55, 0x78, 0xd5, 0xc7, 0x1c, 0xe0, 0x7d, 0x0a, 0x6d,
+	0x66, 0x56, 0x1b, 0xa7, 0x59, 0x3c, 0x2c, 0x9c, 0x8b, 0xd8, 0xe9, 0x82, 0x26, 0xd2, 0xb2, 0x83,
+	0xbf, 0x11, 0xc6, 0xe4, 0x37, 0x97, 0x56, 0xec, 0x37, 0xc2, 0xfa, 0x41, 0x16, 0x08, 0x5d, 0x9e,
+	0xfd, 0xf6, 0x6e, 0xc0, 0x75, 0x4b, 0xb9, 0xe2, 0xf8, 0xb0, 0x0a, 0xb7, 0x95, 0xa3, 0xca, 0xa0,
+	0x50, 0x03, 0xf9, 0x0d, 0x68, 0x1a, 0x88, 0x9f, 0xaa, 0x2d, 0x2d, 0x8c, 0xe2, 0xcb, 0x98, 0x49,
+	0x5d, 0x14, 0xff, 0xe3, 0x1a, 0x2c, 0x28, 0x90, 0x98, 0x26, 0x74, 0xc8, 0xf6, 0x69, 0x94, 0x85,
+	0xd9, 0x59, 0xd7, 0xb0, 0x13, 0x17, 0xc1, 0x78, 0xcc, 0x0b, 0x06, 0x61, 0x20, 0x47, 0x9a, 0x27,
+	0xd0, 0x85, 0x8a, 0x3a, 0xa8, 0x54, 0x2b, 0xd5, 0xda, 0xe6, 0xe6, 0x6a, 0x2b, 0x0e, 0x77, 0x51,
+	0x84, 0x0b, 0x35, 0x49, 0x65, 0xe1, 0xc7, 0x1d, 0x1b, 0x0a, 0x67, 0x93, 0x97, 0x84, 0x0c, 0x35,
+	0xc5, 0xf5, 0x54, 0x05, 0x28, 0x19, 0x4b, 0xa6, 0xc5, 0xae, 0x53, 0x08, 0xb8, 0xd2, 0x82, 0xb6,
+	0x66, 0x4b, 0x41, 0x5b, 0xa8, 0x03, 0x9c, 0x45, 0x3d, 0xda, 0xef, 0x66, 0x71, 0x97, 0xe9, 0x2a,
+	0x6c, 0x59, 0xce, 0xfa, 0x45, 0x30, 0xf2, 0x5c, 0x46, 0xd3, 0x2c, 0xa2, 0x99, 0x8c, 0xab, 0x11,
+	0x49, 0x14, 0xab, 0x8c, 0x84, 0x6b, 0x5e, 0x73, 0xbe, 0x48, 0xe1, 0xec, 0x8c, 0x93, 0x30, 0x6d,
+	0x37, 0x18, 0x94, 0xfd, 0x26, 0x3f, 0x0f, 0xcb, 0x87, 0x14, 0x23, 0x6b, 0x68, 0xd0, 0xa7, 0x49,
+	0x57, 0x79, 0xf1, 0xc4, 0x31, 0xc0, 0x8e, 0xc4, 0xba, 0x5f, 0xd3, 0x24, 0x45, 0x5f, 0xef, 0x3c,
+	0xe7, 0x77, 0x91, 0xc4, 0xf2, 0x70, 0x40, 0xc2, 0xa8, 0x30, 0x74, 0xed, 0x05, 0x36, 0x18, 0x76,
+	0xa4, 0x21, 0x68, 0x5a, 0x17, 0x08, 0x1a, 0x02, 0xad, 0x67, 0x34, 0x7b, 0x1a, 0xf4, 0x5e, 0x8d,
+	0x47, 0x92, 0xa3, 0x7e, 0x16, 0x16, 0x35, 0x58, 0x6e, 0x0f, 0x38, 0x0a, 0x07, 0x34, 0x15, 0xa1,
+	0x9b, 0x3c, 0xe1, 0xfd, 0x80, 0x9d, 0xfb, 0x55, 0x18, 0xdd, 0x4b, 0x76, 0x68, 0x21, 0x37, 0x60,
+	0x8e, 0x4f, 0x42, 0x7a, 0x12, 0x48, 0x1b, 0x13, 0x03, 0xec, 0x9f, 0x04, 0xb8, 0x93, 0x1d, 0x5a,
+	0x0c, 0x6d, 0x0c, 0xb6, 0xc5, 0xa7, 0xf5, 0x1d, 0x98, 0x97, 0x01, 0x7a, 0x69, 0x77, 0x40, 0x8f,
+	0x32, 0xe9, 0x27, 0x89, 0xc6, 0x43, 0xac, 0x2e, 0xdd, 0xa6, 0x47, 0x68, 0x78, 0x5c, 0x14, 0xbb,
+	0xcb, 0xee, 0x88, 0xca, 0xaa, 0xbf, 0x62, 0xd3, 0x72, 0x73, 0x7f, 0xa7, 0xee, 0xec, 0x29, 0xea,
+	0x02, 0x03, 0x20, 0xfa, 0x6e, 0x25, 0x0a, 0x14, 0xaa, 0xa6, 0xf4, 0xc6, 0xc8, 0xc0, 0x16, 0x1d,
+	0x86, 0x13, 0x98, 0x8e, 0x7b, 0x3d, 0x29, 0xb0, 0x66, 0x7d, 0x99, 0x44, 0x0c, 0x1e, 0x40, 0xa4,
+	0x47, 0xaf, 0xea, 0xcb, 0xa4, 0xf7, 0x3f, 0x1d, 0x58, 0x62, 0xf5, 0x88, 0x3a, 0x73, 0xe3, 0xfe,
+	0xe5, 0x3b, 0xd0, 0xe8, 0x69, 0x29, 0x36, 0x43, 0x9a, 0xf6, 0xc0, 0x13, 0x5f, 0x82, 0xed, 0x0b,
+	0x15, 0x88, 0x3e, 0x1d, 0x84, 0x2c, 0x98, 0x54, 0x0a, 0x66, 0xae, 0xb2, 0x2f, 0x48, 0xb8, 0xf4,
+	0x4b, 0xbd, 0x07, 0x2d, 0x16, 0xbd, 0xa6, 0x17, 0x28, 0x0c, 0x04, 0x18, 0xc3, 0x96, 0xdb, 0xd3,
+	0xfe, 0xbd, 0x03, 0x8b, 0x5c, 0x29, 0xc8, 0x82, 0x6c, 0x9c, 0x8a, 0xc1, 0xfe, 0xaa, 0xd0, 0x09,
+	0xa5, 0x74, 0x11, 0x9d, 0x57, 0x9a, 0x17, 0x87, 0x72, 0xe2, 0xad, 0x2b, 0xbe, 0x49, 0x4c, 0x7e,
+	0x19, 0x1a, 0x7a, 0x4c, 0x27, 0x1b, 0x87, 0xfa, 0xe3, 0xeb, 0x72, 0xe4, 0x4a, 0x7c, 0xba, 0x75,
+	0xc5, 0x37, 0x32, 0x90, 0x8f, 0xd9, 0x11, 0x27, 0xea, 0xb2, 0x62, 0xdb, 0x55, 0x33, 0x7b, 0x89,
+	0x35, 0xb6, 0xae, 0xf8, 0x1a, 0xf9, 0xd3, 0x59, 0x98, 0xe6, 0x67, 0x76, 0x2f, 0x80, 0xa6, 0xd1,
+	0x52, 0xc3, 0x3a, 0xd8, 0x10, 0xb6, 0xdb, 0xa2, 0x27, 0xb0, 0x52, 0xf6, 0x04, 0x9e, 0xc3, 0x3d,
+	0xff, 0xbc, 0x06, 0x04, 0xb9, 0xbe, 0xc0, 0x3c, 0x68, 0x4e, 0x88, 0xfb, 0x86, 0x71, 0xa8, 0xe1,
+	0xeb, 0x20, 0x3c, 0xe3, 0x6b, 0x49, 0xd3, 0xc3, 0x6a, 0xc1, 0xe0, 0x4e, 0x20, 0x14, 0x53, 0xa1,
+	0x42, 0x0a, 0x33, 0x18, 0xe7, 0x12, 0x2b, 0x8e, 0x59, 0x98, 0xc7, 0x18, 0x39, 0x15, 0x64, 0xd2,
+	0x7c, 0x24, 0xd3, 0x45, 0x76, 0x9c, 0xbe, 0x90, 0x1d, 0x67, 0x4a, 0xec, 0xa8, 0x19, 0x30, 0x66,
+	0x4d, 0x03, 0xc6, 0x3b, 0xd0, 0x1c, 0x86, 0x11, 0x8f, 0xad, 0x60, 0x7e, 0x71, 0x61, 0x2d, 0x32,
+	0x80, 0x18, 0x9e, 0x21, 0x6d, 0x13, 0xca, 0x4a, 0x02, 0x6c, 0xf4, 0x4b, 0x70, 0xdc, 0xa2, 0x30,
+	0x33, 0x93, 0x44, 0xcc, 0x62, 0x34, 0xe5, 0xe7, 0x00, 0xb4, 0x2b, 0xa5, 0xc8, 0x7c, 0xdd, 0x71,
+	0x24, 0xf8, 0x88, 0xf6, 0x99, 0x9d, 0x68, 0xd6, 0x2f, 0x23, 0xd0, 0x4a, 0x64, 0xb1, 0x89, 0xb0,
+	0x51, 0x12, 0x56, 0xa2, 0x09, 0x68, 0x6c, 0x45, 0x7f, 0x2c, 0xc6, 0x99, 0x6d, 0x11, 0xb3, 0x7e,
+	0x0e, 0xc0, 0xf0, 0x0a, 0x39, 0x01, 0xe9, 0x49, 0x38, 0x6c, 0x2f, 0x18, 0xe1, 0x15, 0x9b, 0x1c,
+	0xb5, 0x7f, 0x12, 0x0e, 0x7d, 0x83, 0xce, 0xfb, 0xad, 0x0a, 0xb4, 0x90, 0x87, 0x8c, 0x15, 0xf8,
+	0x04, 0x98, 0x50, 0xb9, 0xe4, 0x02, 0x34, 0x68, 0x7f, 0xfa, 0xf5, 0xf7, 0x11, 0xcc, 0xb1, 0x02,
+	0xe3, 0x11, 0x8d, 0xc4, 0xf2, 0x6b, 0x9b, 0xcb, 0x2f, 0x97, 0xf4, 0x5b, 0x57, 0xfc, 0x9c, 0x98,
+	0x3c, 0x81, 0xb9, 0x51, 0x7a, 0x98, 0xf1, 0x11, 0xe2, 0x31, 0x8c, 0xf2, 0x04, 0xe2, 0xd3, 0xa0,
+	0x7f, 0xb6, 0x19, 0x27, 0x7b, 0xe9, 0x61, 0x26, 0x06, 0x03, 0xf3, 0x2a, 0x72, 0x6d, 0xe1, 0xfe,
+	0x5d, 0x07, 0x96, 0x2c, 0xe4, 0xa8, 0x46, 0x28, 0x16, 0x37, 0x1c, 0x11, 0x45, 0x30, 0x0b, 0x4a,
+	0x30, 0x17, 0x0a, 0xb7, 0x17, 0x17, 0xa0, 0x4c, 0x05, 0x4c, 0x0f, 0x33, 0xa9, 0xda, 0xe1, 0x6f,
+	0xac, 0x45, 0x57, 0xaa, 0xa4, 0x5d, 0xb6, 0xe1, 0x17, 0xc1, 0xde, 0x01, 0xcc, 0x62, 0xf3, 0x70,
+	0x4e, 0x6d, 0xb9, 0x1c, 0x6b, 0x2e, 0x54, 0x96, 0xa2, 0xb8, 0xcb, 0x0c, 0x52, 0xc2, 0x59, 0x36,
+	0xeb, 0x6b, 0x10, 0xef, 0xab, 0x50, 0xd7, 0x98, 0x05, 0xc3, 0x42, 0xd9, 0x18, 0x31, 0x9e, 0x32,
+	0xa3, 0x3a, 0x64, 0xe5, 0x7e, 0x4e, 0xe1, 0xfd, 0x12, 0x2c, 0x6a, 0xb9, 0xf9, 0x89, 0xf2, 0xf2,
+	0x8d, 0xf3, 0xba, 0x2a, 0x3b, 0x16, 0xce, 0x5d, 0x12, 0x28, 0xce, 0x70, 0xdc, 0x68, 0xbf, 0xcb,
+	0x06, 0x8b, 0x67, 0xd5, 0x41, 0xb6, 0x0a, 0x2a, 0xf6, 0x0a, 0xfe, 0xb2, 0x03, 0x4b, 0x5a, 0x0d,
+	0x9b, 0x61, 0x14, 0x0c, 0xc2, 0x1f, 0xb0, 0x83, 0x13, 0x7a, 0x43, 0x0a, 0x75, 0x68, 0xa0, 0xcb,
+	0xd7, 0xc1, 0xc2, 0x5b, 0xb0, 0xdc, 0x6e, 0x12, 0x9c, 0x76, 0xb3, 0x37, 0x62, 0x76, 0x0d, 0x98,
+	0xf7, 0xef, 0x1c, 0xb8, 0x2a, 0xda, 0xc1, 0x02, 0xef, 0x43, 0xe4, 0xfc, 0x17, 0xe9, 0x31, 0x79,
+	0x02, 0x75, 0x1c, 0x48, 0x71, 0x46, 0x6f, 0x3b, 0x06, 0xfb, 0x97, 0x86, 0xd6, 0xd7, 0x89, 0x31,
+	0x2f, 0x9b, 0x89, 0xd7, 0x6c, 0xdc, 0xda, 0x15, 0x5b, 0xde, 0x7c, 0x5c, 0x7d, 0x9d, 0x98, 0x7c,
+	0x0d, 0x9a, 0x7c, 0x2d, 0x88, 0x11, 0x69, 0x57, 0x8d, 0xe5, 0x63, 0x19, 0x33, 0xdf, 0xcc, 0x80,
+	0x77, 0x48, 0x64, 0xfb, 0xb2, 0x20, 0xa3, 0xfb, 0x19, 0x65, 0x6a, 0xa3, 0xf7, 0xf7, 0x2a, 0xd0,
+	0x7a, 0x8a, 0xde, 0x60, 0x6d, 0xa7, 0x2a, 0x6e, 0x51, 0x4e, 0x79, 0x8b, 0x9a, 0xb4, 0xe5, 0x54,
+	0x2e, 0xb9, 0xe5, 0x54, 0x0b, 0x5b, 0x8e, 0xb6, 0x5f, 0xd4, 0x2e, 0xd8, 0x2f, 0xa6, 0x2e, 0xbb,
+	0x5f, 0x4c, 0x4f, 0xd8, 0x2f, 0xce, 0x91, 0xf1, 0x33, 0xe7, 0xca, 0x78, 0xef, 0x3f, 0x3a, 0x70,
+	0xad, 0x38, 0x58, 0x72, 0x5b, 0xff, 0xa0, 0x64, 0x5e, 0x91, 0xbe, 0xb4, 0x52, 0x0e, 0x45, 0xf8,
+	0x25, 0xf8, 0x72, 0xcd, 0xdd, 0xaf, 0x76, 0xa9, 0xdd, 0x6f, 0x6a, 0xc2, 0xee, 0xe7, 0x7d, 0x17,
+	0xda, 0xe5, 0xee, 0x89, 0xb3, 0xc5, 0xd7, 0xa0, 0x55, 0x3a, 0x6a, 0x16, 0x6c, 0x6e, 0xfa, 0xc6,
+	0xe3, 0x97, 0xa8, 0xbd, 0x7f, 0xeb, 0x40, 0x5d, 0xd0, 0xfc, 0x99, 0x7d, 0x79, 0x2e, 0xcc, 0xa2,
+	0xf6, 0xa5, 0x39, 0xcc, 0x54, 0x1a, 0x65, 0xc0, 0x10, 0x4f, 0x4c, 0x78, 0x72, 0x36, 0xfc, 0x78,
+	0x45, 0x30, 0x1e, 0x83, 0xd9, 0x51, 0x26, 0xed, 0x66, 0xe1, 0xa0, 0x2b, 0xb1, 0xe2, 0xba, 0x90,
+	0x0d, 0x85, 0x7a, 0x7b, 0x9a, 0xa1, 0x37, 0x9e, 0x33, 0x15, 0x4f, 0xa0, 0xc3, 0x52, 0x74, 0xa8,
+	0x60, 0x4d, 0xf4, 0x7e, 0xbf, 0x09, 0xd7, 0x4a, 0x28, 0x65, 0xb5, 0x14, 0x0e, 0xaa, 0x41, 0x38,
+	0x3c, 0x8c, 0x95, 0x29, 0xdb, 0xd1, 0x7d, 0x57, 0x06, 0x8a, 0x1c, 0xc3, 0xb2, 0x1c, 0x4d, 0xdc,
+	0x49, 0xf3, 0x09, 0xa8, 0xb0, 0x09, 0x78, 0x64, 0x4e, 0x40, 0xb1, 0x42, 0x09, 0xd7, 0x67, 0xd5,
+	0x5e, 0x1e, 0x39, 0x81, 0xb6, 0x9a, 0x36, 0x71, 0x78, 0xd2, 0xec, 0x0a, 0x58, 0xd7, 0xfb, 0x17,
+	0xd4, 0x65, 0x18, 0x1f, 0xfd, 0x89, 0xa5, 0x91, 0x33, 0xb8, 0x2d, 0x71, 0xec, 0x0c, 0x54, 0xae,
+	0xaf, 0x76, 0xa9, 0xbe, 0x31, 0xb3, 0xaa, 0x59, 0xe9, 0x05, 0x05, 0x93, 0xef, 0xc1, 0xca, 0x69,
+	0x10, 0x66, 0xb2, 0x59, 0xda, 0x89, 0x9d, 0x5f, 0x03, 0x79, 0x7c, 0x41, 0x95, 0x9f, 0xf1, 0xcc,
+	0xc6, 0xc1, 0x70, 0x42, 0x89, 0xee, 0x3f, 0xa8, 0xc0, 0xbc, 0x59, 0x0e, 0xb2, 0xa9, 0x90, 0x2f,
+	0x52, 0xa0, 0x4a, 0x45, 0xa5, 0x00, 0x2e, 0x9b, 0xc7, 0x2b, 0x36, 0x6f, 0xd0, 0x05, 0x06, 0x76,
+	0xd3, 0x11, 0x5c, 0xbb, 0x9c, 0x23, 0x78, 0xca, 0xea, 0x08, 0xb6, 0xfb, 0x1e, 0xa7, 0x7f, 0x52,
+	0xdf, 0xe3, 0xcc, 0x44, 0xdf, 0xa3, 0xfb, 0xdf, 0x1c, 0x20, 0x65, 0x6e, 0x25, 0xcf, 0xb8, 0x83,
+	0x21, 0x52, 0x5b, 0xee, 0xcf, 0x5d, 0x8e, 0xe3, 0xe5, 0xec, 0xc8, 0xdc, 0xd8, 0x22, 0x5d, 0x99,
+	0x35, 0x5d, 0x21, 0x36, 0x54, 0xc1, 0xf9, 0x5d, 0xbb, 0xd8, 0xf9, 0x3d, 0x75, 0xb1, 0xf3, 0x7b,
+	0xba, 0xe8, 0xfc, 0x76, 0x7f, 0xd3, 0x81, 0x25, 0x0b, 0x5b, 0x7d, 0x79, 0x1d, 0x47, 0x46, 0x30,
+	0xa4, 0x4d, 0x45, 0x30, 0x82, 0x0e, 0x74, 0x7f, 0x0d, 0x9a, 0xc6, 0x52, 0xfa, 0xf2, 0xea, 0x2f,
+	0x5a, 0x68, 0xc4, 0x25, 0x33, 0x1d, 0xe6, 0xfe, 0x97, 0x0a, 0x90, 0xf2, 0x72, 0xfe, 0x7f, 0xda,
+	0x86, 0xf2, 0x38, 0x55, 0x2d, 0xe3, 0xf4, 0x7f, 0x75, 0xa7, 0x79, 0x1f, 0x16, 0xc5, 0xf5, 0x5f,
+	0xcd, 0xcd, 0xc9, 0x39, 0xa6, 0x8c, 0x40, 0x4b, 0x94, 0x19, 0x79, 0x30, 0x6b, 0x5c, 0x1b, 0xd5,
+	0xb6, 0xdb, 0x42, 0x00, 0x02, 0x2a, 0x84, 0xfc, 0x3a, 0xf1, 0x53, 0xe3, 0x4e, 0x9b, 0xf7, 0xb7,
+	0x1c, 0x58, 0x2e, 0x20, 0xf2, 0x3b, 0x7f, 0x7c, 0x73, 0x32, 0x77, 0x2c, 0x13, 0x88, 0xed, 0x57,
+	0x0a, 0x45, 0x81, 0xdb, 0xca, 0x08, 0x1c, 0x9f, 0x71, 0x54, 0x02, 0x8b, 0x51, 0xb7, 0xa1, 0xbc,
+	0x6b, 0xfc, 0xd2, 0x73, 0x44, 0x07, 0x85, 0x86, 0x1f, 0xc1, 0x4a, 0x11, 0x91, 0xc7, 0x37, 0x9a,
+	0x4d, 0x96, 0x49, 0x54, 0x63, 0x8d, 0x8d, 0xd0, 0x6c, 0xaf, 0x15, 0xe7, 0xfd, 0xd5, 0x0a, 0x90,
+	0x6f, 0x8e, 0x69, 0x72, 0xc6, 0x2e, 0x8f, 0x29, 0xff, 0xe1, 0xb5, 0xa2, 0xab, 0x04, 0xc3, 0xae,
+	0xf0, 0x1e, 0x99, 0xb8, 0xcc, 0x57, 0xc9, 0x2f, 0xf3, 0xdd, 0x02, 0x40, 0xd3, 0xa9, 0xba, 0x91,
+	0xc6, 0x74, 0xb6, 0x68, 0x3c, 0xe4, 0x05, 0x5a, 0x6f, 0xad, 0xd6, 0x2e, 0xbe, 0xb5, 0x3a, 0x75,
+	0xd1, 0xad, 0x55, 0xdb, 0xe5, 0xd3, 0xe9, 0xcb, 0x5e, 0x3e, 0x9d, 0xb1, 0x5d, 0x3e, 0xfd, 0x18,
+	0x96, 0x8c, 0x91, 0x50, 0x8c, 0x22, 0x6f, 0xdb, 0x39, 0xe7, 0xdc, 0xb6, 0xfb, 0x51, 0x05, 0xaa,
+	0x5b, 0xf1, 0xe8, 0x9c, 0x7b, 0x76, 0x62, 0xff, 0xeb, 0xaa, 0xed, 0x4d, 0x08, 0x2d, 0x03, 0x48,
+	0xee, 0xc1, 0x7c, 0x30, 0xcc, 0xd0, 0x4b, 0x20, 0xae, 0xb5, 0x70, 0xee, 0x79, 0x5a, 0x69, 0x3b,
+	0x7e, 0x01, 0x43, 0xae, 0x42, 0x55, 0x89, 0x71, 0x46, 0x80, 0x49, 0x54, 0x36, 0xc5, 0x7d, 0x32,
+	0xee, 0xe0, 0x10, 0x29, 0x64, 0x4e, 0x33, 0x7f, 0x7e, 0x47, 0xad, 0xea, 0xdb, 0x50, 0xc6, 0x6d,
+	0xc7, 0x19, 0xf3, 0xb6, 0xa3, 0xee, 0x4b, 0x9b, 0x35, 0x7d, 0x69, 0xab, 0x50, 0xcf, 0x06, 0xaf,
+	0xbb, 0xa3, 0xe0, 0x6c, 0x10, 0x07, 0x7d, 0xe1, 0xfd, 0xd0, 0x41, 0xde, 0x7f, 0x76, 0x60, 0x8a,
+	0x8d, 0x1e, 0x8a, 0x1e, 0xbe, 0xde, 0x54, 0xc8, 0x83, 0xf0, 0x3a, 0x16, 0xc1, 0xc4, 0x33, 0x2e,
+	0xb2, 0x57, 0x54, 0x97, 0x35, 0x28, 0x59, 0x85, 0x39, 0x9e, 0x52, 0xd7, 0x4d, 0x19, 0x49, 0x0e,
+	0x24, 0xb7, 0xf1, 0xb2, 0xc2, 0x48, 0x6a, 0x63, 0x20, 0x23, 0x9a, 0xe2, 0x91, 0xcf, 0xe0, 0x79,
+	0x7b, 0xb0, 0x3c, 0xfd, 0x80, 0x56, 0x04, 0xa3, 0x96, 0xa1, 0x8a, 0xd5, 0x07, 0xb2, 0x00, 0xc5,
+	0x5b, 0x65, 0x8c, 0xa9, 0x5e, 0x84, 0x29, 0xfa, 0x57, 0xd6, 0xe3, 0x28, 0x4b, 0x62, 0x79, 0xcc,
+	0xf2, 0xbe, 0x0f, 0x37, 0xac, 0x58, 0xe5, 0x54, 0x9b, 0x42, 0xf5, 0xa9, 0x78, 0x7d, 0x7e, 0x27,
+	0xee, 0xd3, 0xad, 0x30, 0xcd, 0xe2, 0xe4, 0xcc, 0xe7, 0x04, 0x48, 0x39, 0x0a, 0xc2, 0x44, 0xea,
+	0xd0, 0x4a, 0x62, 0x06, 0x61, 0xa2, 0x28, 0x19, 0x81, 0xf7, 0x02, 0xea, 0x5a, 0xfe, 0x89, 0xd7,
+	0x3a, 0xde, 0x85, 0x79, 0xb6, 0x68, 0xd8, 0xe5, 0x26, 0xe6, 0x5d, 0x16, 0x66, 0x25, 0x13, 0xea,
+	0xfd, 0x33, 0x3c, 0x06, 0xe5, 0xb5, 0x30, 0x0f, 0x1b, 0x6a, 0x7c, 0x47, 0x49, 0x3c, 0x14, 0x45,
+	0xe6, 0x00, 0xe4, 0x1a, 0x96, 0xc8, 0x62, 0xe9, 0x67, 0x15, 0x49, 0xcc, 0x97, 0xfb, 0xae, 0xb8,
+	0xc0, 0xcc, 0x01, 0xc8, 0x89, 0xc6, 0xcd, 0xa3, 0xaa, 0xaf, 0xd2, 0xba, 0x2b, 0x64, 0xca, 0x74,
+	0x85, 0x78, 0xd0, 0x10, 0x3f, 0x31, 0x38, 0xeb, 0x90, 0xcd, 0x90, 0xe3, 0x1b, 0x30, 0x6f, 0x08,
+	0xd7, 0xd8, 0x0c, 0xec, 0x25, 0xf1, 0x61, 0x70, 0x18, 0x0e, 0xc2, 0x4c, 0xdd, 0x58, 0xb9, 0x09,
+	0x73, 0xd8, 0x68, 0xa6, 0xc1, 0xca, 0xae, 0x28, 0x00, 0x56, 0x9b, 0xc5, 0x1c, 0x27, 0xba, 0x22,
+	0x92, 0x46, 0x63, 0xab, 0x66, 0x63, 0xbd, 0xef, 0x41, 0xbb, 0x5c, 0x9d, 0x98, 0xed, 0x55, 0xa8,
+	0x8f, 0x72, 0x30, 0xab, 0xd1, 0xf1, 0x75, 0x10, 0x79, 0x1f, 0x66, 0x4e, 0xf8, 0x38, 0x0b, 0x83,
+	0x8b, 0x6d, 0x9e, 0x25, 0x89, 0xf7, 0x63, 0x07, 0xae, 0x75, 0xd2, 0x2c, 0x1c, 0x06, 0x19, 0x65,
+	0xcb, 0x6d, 0x93, 0xd2, 0xff, 0x6f, 0xe4, 0xbb, 0xf7, 0x7b, 0x0e, 0xb4, 0xcb, 0xad, 0x57, 0x77,
+	0xbf, 0xa7, 0x26, 0xbf, 0x08, 0xc0, 0x51, 0xa4, 0xc5, 0xa5, 0xa3, 0xe8, 0x09, 0x4a, 0x46, 0x5d,
+	0x9e, 0x55, 0x0b, 0xf2, 0x4c, 0x3c, 0xdd, 0x21, 0x2e, 0xd6, 0xe3, 0xa0, 0x53, 0x69, 0x80, 0x28,
+	0x23, 0xbc, 0x7b, 0xb0, 0x80, 0x8b, 0x48, 0x73, 0x8a, 0x4f, 0x1c, 0x51, 0xef, 0x2f, 0x3a, 0x30,
+	0x2b, 0x89, 0xc9, 0x5d, 0xa8, 0x29, 0x76, 0xd2, 0x6f, 0x15, 0x8a, 0xf8, 0x64, 0xa4, 0xf3, 0x19,
+	0x05, 0x32, 0x2f, 0xd6, 0xab, 0x1d, 0x8e, 0xa5, 0x3f, 0x52, 0xc1, 0x72, 0x21, 0x54, 0x38, 0x32,
+	0x15, 0xa0, 0xde, 0x8f, 0xd8, 0xdd, 0x39, 0xad, 0x0e, 0x76, 0xf5, 0x00, 0x17, 0x32, 0x37, 0x43,
+	0x0b, 0xa1, 0xab, 0x83, 0x74, 0x01, 0x5f, 0x31, 0x05, 0xbc, 0x72, 0xe0, 0x57, 0x75, 0x07, 0xfe,
+	0x43, 0x98, 0xcb, 0x1f, 0x11, 0xa9, 0x95, 0xe4, 0x95, 0x8c, 0xbc, 0xce, 0x89, 0xb0, 0x9c, 0x5e,
+	0x3c, 0x88, 0x13, 0xe1, 0xd9, 0xe3, 0x09, 0xef, 0x63, 0xa8, 0x6b, 0xf4, 0x4c, 0x62, 0xd0, 0xec,
+	0x34, 0x4e, 0x5e, 0xc9, 0x98, 0x0d, 0x91, 0x54, 0x37, 0xa8, 0x2a, 0xf9, 0x0d, 0x2a, 0xef, 0x5f,
+	0x3b, 0xd0, 0x44, 0x26, 0x40, 0xf3, 0x61, 0x3c, 0x08, 0x7b, 0x67, 0x4c, 0xa2, 0xcb, 0x4d, 0x44,
+	0x70, 0xa7, 0xdc, 0x61, 0x4c, 0x30, 0x72, 0x87, 0xb4, 0xc2, 0x09, 0xa6, 0x51, 0x69, 0xdc, 0xbb,
+	0x91, 0x53, 0x0e, 0x83, 0xd4, 0x60, 0x1f, 0x13, 0x88, 0x3b, 0x2c, 0x02, 0x92, 0x20, 0xa3, 0xdd,
+	0x61, 0x38, 0x18, 0x84, 0xba, 0xc0, 0xb2, 0xa1, 0xb0, 0xce, 0x7e, 0x98, 0x06, 0x87, 0x79, 0x80,
+	0x94, 0x4a, 0x7b, 0x3f, 0xae, 0x40, 0x5d, 0xa8, 0x80, 0x9d, 0xfe, 0x31, 0x15, 0xd1, 0x90, 0xe6,
+	0x55, 0x63, 0x0d, 0x22, 0xf1, 0xc6, 0xe1, 0x5a, 0x83, 0x14, 0xa7, 0xbc, 0x5a, 0x9e, 0x72, 0x21,
+	0xbb, 0x1f, 0xb1, 0x53, 0x7c, 0x2d, 0x97, 0xdd, 0x0c, 0x20, 0xb1, 0x8f, 0x19, 0x76, 0x2a, 0xc7,
+	0x32, 0xc0, 0xb9, 0xb1, 0x93, 0x1f, 0x41, 0x43, 0x14, 0xc3, 0xe6, 0xa4, 0x3d, 0x63, 0x30, 0xbf,
+	0x31, 0x5f, 0xbe, 0x41, 0x29, 0x73, 0x3e, 0x96, 0x39, 0x67, 0x2f, 0xca, 0x29, 0x29, 0xbd, 0x67,
+	0x2a, 0x24, 0xf5, 0x59, 0x12, 0x8c, 0xd4, 0x95, 0x25, 0x7e, 0x71, 0x7b, 0x30, 0xee, 0xd3, 0xee,
+	0x38, 0x0a, 0xa2, 0x28, 0x1e, 0x47, 0x3d, 0x2a, 0x6f, 0x1c, 0xd8, 0x50, 0x5e, 0x1f, 0x1a, 0x7a,
+	0x41, 0xe4, 0x9e, 0xb9, 0x27, 0xdb, 0x97, 0x70, 0xbe, 0x2b, 0xd3, 0xfe, 0x31, 0x2d, 0xee, 0xca,
+	0xda, 0xac, 0xfa, 0x9c, 0x00, 0x05, 0x0a, 0xd3, 0x56, 0x4d, 0x81, 0x62, 0x6a, 0x92, 0x18, 0x06,
+	0x12, 0x3d, 0xef, 0xe3, 0xfb, 0x4d, 0x3b, 0x7c, 0x0d, 0x68, 0xe4, 0xde, 0x5f, 0xaa, 0x42, 0x5d,
+	0x03, 0xa3, 0x6c, 0x38, 0xc6, 0x06, 0x77, 0xfb, 0x61, 0x30, 0xa4, 0x99, 0x08, 0xfe, 0x69, 0xfa,
+	0x05, 0x28, 0xd2, 0x05, 0xaf, 0x8f, 0xbb, 0xf1, 0x38, 0xeb, 0xf6, 0xe9, 0x71, 0x22, 0x24, 0xa6,
+	0xe3, 0x17, 0xa0, 0x48, 0x87, 0x7e, 0x76, 0x8d, 0x8e, 0x73, 0x50, 0x01, 0x2a, 0x43, 0x6c, 0xf8,
+	0x18, 0xd5, 0xf2, 0x10, 0x1b, 0x3e, 0x22, 0x45, 0xa9, 0x36, 0x65, 0x91, 0x6a, 0x1f, 0xc2, 0x0a,
+	0x97, 0x5f, 0x62, 0xa5, 0x77, 0x0b, 0x8c, 0x35, 0x01, 0x8b, 0x56, 0x73, 0x6c, 0xb3, 0x5c, 0x12,
+	0x29, 0x7a, 0x16, 0x66, 0x58, 0x5f, 0x4a, 0x70, 0xa4, 0x65, 0x66, 0x65, 0x9d, 0x96, 0xc7, 0xea,
+	0x96, 0xe0, 0x8c, 0x36, 0x78, 0x63, 0xd2, 0xce, 0x09, 0xda, 0x02, 0x9c, 0x5d, 0x5a, 0xce, 0x62,
+	0x15, 0xd7, 0x32, 0x0f, 0x0d, 0x9e, 0x14, 0xa1, 0x5b, 0x37, 0xe0, 0x3a, 0xe3, 0xa2, 0x83, 0x78,
+	0x14, 0x0f, 0xe2, 0xe3, 0x33, 0x23, 0x64, 0xed, 0xdf, 0x38, 0xb0, 0x64, 0x60, 0x85, 0x83, 0xf4,
+	0xe7, 0xf9, 0x22, 0x50, 0x21, 0xfb, 0x8e, 0xf1, 0x2c, 0x0c, 0xf2, 0x1b, 0x27, 0xe4, 0x3e, 0x0d,
+	0xfe, 0x3b, 0x25, 0x6b, 0xb0, 0x20, 0x5b, 0x26, 0x33, 0x72, 0x2e, 0x6c, 0x97, 0xb9, 0x50, 0xe4,
+	0x9f, 0x17, 0x19, 0x64, 0x11, 0xbf, 0x24, 0x62, 0x9e, 0xf9, 0x43, 0x17, 0xd2, 0x66, 0xaa, 0xe2,
+	0x2c, 0x75, 0xbb, 0x86, 0x6c, 0x41, 0x4f, 0x01, 0x53, 0xef, 0xaf, 0x3b, 0x00, 0x79, 0xeb, 0x90,
+	0x31, 0xf2, 0x0d, 0x82, 0x87, 0xf4, 0xe4, 0x00, 0x8c, 0xd1, 0x51, 0x81, 0x62, 0xf9, 0x9e, 0x53,
+	0x97, 0x30, 0x54, 0x4d, 0xde, 0x83, 0x85, 0xe3, 0x41, 0x7c, 0xc8, 0xd4, 0x70, 0x11, 0x6b, 0x24,
+	0x6e, 0x10, 0x72, 0xb0, 0x08, 0x35, 0x4a, 0xf3, 0x0d, 0xaa, 0xa6, 0x6d, 0x50, 0xde, 0x0f, 0x2b,
+	0xb0, 0x58, 0xea, 0xf3, 0xc4, 0x55, 0x46, 0x1e, 0x97, 0xc4, 0xe9, 0x84, 0x90, 0x18, 0xe6, 0x13,
+	0xde, 0xbb, 0xd0, 0x78, 0xf9, 0x31, 0xcc, 0x27, 0x5c, 0x5e, 0x49, 0x61, 0x56, 0x3b, 0x47, 0x98,
+	0x35, 0x13, 0x3d, 0x89, 0xf1, 0x30, 0x41, 0xff, 0x35, 0x4d, 0xb2, 0x90, 0x19, 0x77, 0x98, 0x0a,
+	0x21, 0xe2, 0x61, 0x34, 0x38, 0xdb, 0xd9, 0xdf, 0x83, 0x05, 0x11, 0x9f, 0xa8, 0x28, 0xc5, 0x73,
+	0x52, 0x39, 0x18, 0x09, 0xbd, 0x3f, 0x94, 0xe1, 0x40, 0xe6, 0x1c, 0x4e, 0x1e, 0x11, 0xbd, 0x77,
+	0x95, 0x42, 0xef, 0xde, 0x2e, 0x86, 0x56, 0x57, 0xb5, 0xf8, 0xf8, 0xbe, 0x08, 0xb2, 0x32, 0x87,
+	0xb4, 0x76, 0x99, 0x21, 0xf5, 0xfe, 0xc8, 0x81, 0x99, 0xad, 0x78, 0xb4, 0x25, 0x6e, 0x0a, 0xb0,
+	0x85, 0xa0, 0x6e, 0xbd, 0xc9, 0xe4, 0x39, 0x77, 0x08, 0xac, 0x3b, 0x77, 0xb3, 0xb8, 0x73, 0x7f,
+	0x0d, 0x6e, 0x1c, 0x51, 0x71, 0x85, 0x23, 0xc1, 0xc5, 0x18, 0x0c, 0xf8, 0x36, 0x1d, 0x47, 0xd9,
+	0x89, 0x14, 0x63, 0xe7, 0x91, 0x30, 0x43, 0x11, 0x2a, 0xc0, 0xfc, 0xb0, 0x2d, 0x34, 0x0d, 0x2e,
+	0xdd, 0xca, 0x08, 0xef, 0x2b, 0x30, 0xc7, 0x74, 0x55, 0xd6, 0xad, 0xf7, 0x61, 0x0e, 0x4d, 0x13,
+	0x27, 0x61, 0x94, 0xc9, 0xc5, 0x3d, 0x9f, 0x9f, 0x4c, 0xb7, 0xd8, 0x80, 0x28, 0x02, 0xef, 0x1f,
+	0x4e, 0xc3, 0xcc, 0xf3, 0xe8, 0x75, 0x1c, 0xf6, 0x58, 0x94, 0xcf, 0x90, 0x0e, 0x63, 0x79, 0x07,
+	0x10, 0x7f, 0xe3, 0x50, 0xb0, 0xbb, 0x3e, 0xea, 0x96, 0xab, 0x4c, 0xa2, 0x82, 0x90, 0x74, 0x0b,
+	0xcf, 0xb2, 0x68, 0x10, 0x3c, 0x0a, 0x26, 0xfa, 0x1b, 0x39, 0x22, 0x95, 0xdf, 0x80, 0x9f, 0xd2,
+	0x6e, 0xc0, 0x63, 0x3d, 0xe2, 0x56, 0x83, 0x08, 0xdb, 0x96, 0x49, 0x66, 0xe8, 0x48, 0x28, 0xb7,
+	0x3b, 0x33, 0x55, 0x63, 0x46, 0x18, 0x3a, 0x74, 0x20, 0xf3, 0x82, 0xb3, 0x0c, 0x9c, 0x86, 0x0b,
+	0x5f, 0x1d, 0xc4, 0xbc, 0xe0, 0x85, 0x87, 0xd1, 0xe6, 0x38, 0xcf, 0x17, 0xc0, 0x28, 0xa1, 0xfb,
+	0x54, 0x09, 0x52, 0xde, 0x07, 0xe0, 0xcf, 0x3c, 0x15, 0xe1, 0x9a, 0x79, 0x84, 0x5f, 0xc7, 0x12,
+	0x29, 0xc6, 0x28, 0xc1, 0x60, 0x70, 0x18, 0xf4, 0x5e, 0xb1, 0xd8, 0x0a, 0x16, 0x55, 0x33, 0xe7,
+	0x9b, 0x40, 0x6c, 0xb5, 0x36, 0x9b, 0x2c, 0x8a, 0xa6, 0xe6, 0xeb, 0x20, 0xf2, 0x18, 0xea, 0xec,
+	0xfc, 0x21, 0xe6, 0x73, 0x9e, 0xcd, 0x67, 0x4b, 0x3f, 0xa0, 0xb0, 0x19, 0xd5, 0x89, 0x74, 0x7f,
+	0xf1, 0x82, 0xe9, 0x2f, 0xe6, 0x42, 0x53, 0x04, 0x6c, 0xb5, 0x58, 0x6d, 0x39, 0x80, 0x1d, 0x70,
+	0xf9, 0x80, 0x71, 0x82, 0x45, 0x46, 0x60, 0xc0, 0xc8, 0x6d, 0x7e, 0x1a, 0x1d, 0x05, 0x61, 0xbf,
+	0x4d, 0x94, 0x4d, 0x44, 0xc1, 0xb0, 0x0c, 0xf9, 0x9b, 0x39, 0x8d, 0xf9, 0x5d, 0x2a, 0x03, 0x86,
+	0x63, 0xa3, 0xd2, 0x6c, 0x11, 0xf1, 0x8b, 0x53, 0x26, 0x90, 0x3c, 0x62, 0x5e, 0xc5, 0x8c, 0xb2,
+	0x7b, 0x51, 0xf3, 0x8f, 0x6f, 0x88, 0x3e, 0x0b, 0x66, 0x95, 0xff, 0x99, 0xc3, 0xde, 0xe7, 0x94,
+	0xfa, 0xe3, 0x4c, 0x6c, 0xcc, 0x57, 0xcc, 0xc7, 0x99, 0x10, 0xe6, 0xad, 0x41, 0x43, 0xcf, 0x8a,
+	0x2f, 0xa9, 0xec, 0xee, 0x75, 0xf0, 0x4d, 0x95, 0x3a, 0xcc, 0xf0, 0x87, 0x7e, 0xf0, 0x59, 0x95,
+	0x06, 0xcc, 0xaa, 0x8b, 0x26, 0x15, 0x4c, 0xad, 0xad, 0xaf, 0x77, 0xf6, 0x0e, 0xd8, 0xd3, 0x3e,
+	0x19, 0x90, 0xb5, 0x7e, 0x5f, 0x94, 0xa2, 0x5f, 0xcb, 0x4e, 0xf4, 0x37, 0xb9, 0x44, 0xca, 0xc6,
+	0x77, 0x15, 0x3b, 0xdf, 0x9d, 0x3b, 0x3b, 0xde, 0xff, 0x70, 0x60, 0x79, 0xad, 0xdf, 0xdf, 0x8a,
+	0x07, 0x79, 0xd5, 0x2a, 0x34, 0xbc, 0xb4, 0x6e, 0x6d, 0x6f, 0x0c, 0xa8, 0x95, 0x57, 0xd5, 0x57,
+	0x9e, 0x8d, 0xdb, 0x6b, 0x17, 0x72, 0xfb, 0xd4, 0xf9, 0xdc, 0x3e, 0x7d, 0x09, 0x6e, 0x9f, 0x29,
+	0x73, 0xfb, 0xc4, 0xc8, 0x38, 0xef, 0x31, 0x5c, 0xe5, 0xcf, 0xb7, 0x14, 0xfa, 0x7e, 0xce, 0x65,
+	0x70, 0xb4, 0x86, 0x17, 0xf2, 0x08, 0xbd, 0xe9, 0x09, 0x5c, 0xe5, 0x41, 0x28, 0x85, 0xc2, 0x2e,
+	0xf1, 0x06, 0x1c, 0x33, 0xb1, 0x9b, 0x79, 0x45, 0xa1, 0x1d, 0xb4, 0x5c, 0xe5, 0xaf, 0xb0, 0x31,
+	0xf1, 0x28, 0xdf, 0x5f, 0x13, 0x53, 0xa3, 0x41, 0x34, 0x76, 0xa9, 0xe8, 0xec, 0xe2, 0xfd, 0x1d,
+	0x07, 0x08, 0x5e, 0x5b, 0xb0, 0x34, 0x4d, 0x1a, 0xdc, 0xf3, 0x3b, 0x7e, 0x06, 0x0c, 0x69, 0x18,
+	0xab, 0x74, 0xe3, 0xa3, 0xa3, 0x94, 0xca, 0x1b, 0x25, 0x06, 0x0c, 0x67, 0x1b, 0xb5, 0x63, 0xd4,
+	0x34, 0x43, 0x5e, 0x83, 0x7c, 0xb2, 0xa9, 0x04, 0xc7, 0xb1, 0x4d, 0x28, 0x46, 0x72, 0x2b, 0xa1,
+	0xac, 0xd2, 0xea, 0x2a, 0x62, 0x71, 0x15, 0xdc, 0xc3, 0xb8, 0x05, 0x51, 0xae, 0xb9, 0xf9, 0x48,
+	0x4a, 0x85, 0xc7, 0x4d, 0x8e, 0x9d, 0x17, 0x8d, 0x46, 0xf3, 0x0d, 0xb7, 0x8c, 0x40, 0x07, 0xeb,
+	0x51, 0x98, 0x14, 0xc9, 0xf9, 0x33, 0x43, 0x16, 0x8c, 0xf7, 0x19, 0x2c, 0xc9, 0x85, 0xae, 0xa9,
+	0xc5, 0xe6, 0x22, 0x73, 0x2e, 0x12, 0x81, 0x95, 0xb2, 0x08, 0xf4, 0xfe, 0x97, 0x03, 0x33, 0x62,
+	0xa6, 0xad, 0x1c, 0x33, 0x67, 0x72, 0x0c, 0x69, 0x1b, 0x4f, 0xbe, 0x30, 0x79, 0xc9, 0x01, 0xe5,
+	0xad, 0xad, 0x6a, 0xdb, 0xda, 0x30, 0xd4, 0x2e, 0xc8, 0x4e, 0x98, 0x15, 0x64, 0xce, 0x67, 0xbf,
+	0xa5, 0x35, 0x6a, 0x2a, 0xb7, 0x46, 0xd9, 0xde, 0x26, 0xe4, 0xab, 0xb0, 0x04, 0xc7, 0x31, 0x60,
+	0x0d, 0xd0, 0xc2, 0x7a, 0x72, 0x00, 0x72, 0x2e, 0x4f, 0x30, 0xd9, 0x2c, 0xae, 0x34, 0xe7, 0x10,
+	0x6f, 0x99, 0xcf, 0xbc, 0x18, 0x02, 0x15, 0xd5, 0x21, 0xae, 0x7e, 0xe6, 0xe0, 0x9c, 0x23, 0x44,
+	0x03, 0x8a, 0x1c, 0x21, 0x48, 0x7d, 0x85, 0xf7, 0xbe, 0x02, 0x4b, 0x07, 0x49, 0xd0, 0x7b, 0xb5,
+	0x67, 0x3e, 0x55, 0x7a, 0x99, 0x75, 0xf9, 0x5f, 0x31, 0x98, 0x80, 0x03, 0xd6, 0xb2, 0x8c, 0x0e,
+	0xb9, 0x86, 0x12, 0xf0, 0x9f, 0x9a, 0x89, 0x23, 0x87, 0x90, 0x8f, 0xe4, 0x0e, 0xc3, 0x1f, 0xa8,
+	0xf3, 0xcc, 0x66, 0x89, 0x52, 0xee, 0x8b, 0xff, 0x85, 0x8d, 0xe6, 0xe2, 0xc7, 0x1d, 0xef, 0xc2,
+	0x82, 0xac, 0x8b, 0xd9, 0x86, 0xa2, 0x54, 0x98, 0x6e, 0x8a, 0x60, 0x1e, 0xf4, 0xc0, 0x9e, 0x9c,
+	0x53, 0x94, 0xc2, 0x4d, 0x50, 0x00, 0xa3, 0x4b, 0x4e, 0x3d, 0x22, 0x15, 0x8f, 0x93, 0x9e, 0x64,
+	0x53, 0x1e, 0x78, 0x63, 0xc5, 0x95, 0x9e, 0xac, 0x9a, 0x29, 0x3f, 0x59, 0xe5, 0x7d, 0x04, 0x0d,
+	0xbd, 0x9b, 0x78, 0xb1, 0xf2, 0xf9, 0x4e, 0x77, 0x73, 0xfb, 0xf9, 0xb3, 0xad, 0x83, 0xd6, 0x15,
+	0x4c, 0xee, 0xbf, 0x5c, 0x5f, 0xef, 0x74, 0x36, 0xd8, 0xde, 0x08, 0x30, 0x8d, 0xaf, 0xe0, 0xe1,
+	0xce, 0xe8, 0xfd, 0x49, 0x15, 0x9a, 0x62, 0xc0, 0xf2, 0xfb, 0x06, 0x17, 0x4d, 0x16, 0x79, 0x02,
+	0xd3, 0x29, 0x0b, 0xda, 0xb5, 0x0f, 0x3d, 0x2f, 0x49, 0xa6, 0x78, 0x78, 0xaf, 0x2f, 0x72, 0x90,
+	0xaf, 0xe7, 0xcf, 0x66, 0x25, 0x34, 0x48, 0x63, 0x1e, 0x68, 0x3b, 0xa9, 0x8c, 0x4d, 0x4e, 0xea,
+	0x33, 0x4a, 0xbf, 0x90, 0x93, 0x3c, 0x82, 0x59, 0x31, 0x19, 0xd2, 0xc8, 0xb8, 0x6c, 0x65, 0x02,
+	0x5f, 0x91, 0x79, 0xcf, 0xa0, 0x69, 0xb4, 0x0b, 0x75, 0x83, 0x67, 0xfe, 0xee, 0xcb, 0x1d, 0x1c,
+	0x9b, 0x2b, 0xe6, 0xc8, 0x39, 0xe6, 0xc8, 0x55, 0xb4, 0x91, 0xab, 0x32, 0x2b, 0xaa, 0xd1, 0x3a,
+	0x7c, 0xcd, 0x08, 0xb1, 0x2f, 0xfd, 0x4e, 0xd7, 0xef, 0xac, 0xed, 0xef, 0xee, 0x74, 0x77, 0x76,
+	0x77, 0xf0, 0xfa, 0xac, 0x0b, 0x2b, 0x05, 0xc4, 0xc1, 0xf3, 0x17, 0x9d, 0xdd, 0x97, 0x58, 0xc3,
+	0x0d, 0xb8, 0x56, 0xca, 0xd4, 0xf5, 0x77, 0x5f, 0x1e, 0xe0, 0x45, 0xda, 0x36, 0x5c, 0x2d, 0x20,
+	0x3b, 0xbe, 0xbf, 0xeb, 0xb7, 0xaa, 0xe4, 0x7d, 0xb8, 0x5b, 0xc0, 0x3c, 0xdf, 0x59, 0xdf, 0xf5,
+	0xfd, 0xce, 0xfa, 0x41, 0x77, 0x6f, 0xed, 0x5b, 0x2f, 0x3a, 0x3b, 0x07, 0xdd, 0x8d, 0xce, 0xc1,
+	0xda, 0xf3, 0xed, 0xfd, 0x56, 0x0d, 0x9f, 0x41, 0xda, 0xa0, 0x03, 0x9a, 0xd1, 0xb5, 0xc1, 0xa0,
+	0xb8, 0xee, 0x6f, 0xc0, 0x75, 0x0b, 0x4e, 0x6c, 0x8a, 0xdf, 0x84, 0xe5, 0x35, 0x7e, 0xfd, 0xf2,
+	0xcb, 0xba, 0x25, 0x82, 0x71, 0x65, 0xc5, 0x22, 0x45, 0x65, 0x9b, 0xb0, 0xb8, 0x41, 0x0f, 0xc7,
+	0xc7, 0xdb, 0xf4, 0x75, 0x5e, 0x11, 0x81, 0x5a, 0x7a, 0x12, 0x9f, 0x8a, 0x0d, 0x93, 0xfd, 0x46,
+	0x1f, 0xc4, 0x00, 0x69, 0xba, 0xe9, 0x88, 0xf6, 0xe4, 0x93, 0x22, 0x0c, 0xb2, 0x3f, 0xa2, 0x3d,
+	0xef, 0x43, 0x20, 0x7a, 0x39, 0xb9, 0x3f, 0x25, 0x1d, 0x1f, 0x76, 0xd3, 0xb3, 0x34, 0xa3, 0x43,
+	0x19, 0x3f, 0xad, 0x83, 0xbc, 0xf7, 0xa0, 0xb1, 0x17, 0xa0, 0xbf, 0x47, 0x3c, 0xac, 0x8a, 0x36,
+	0xfc, 0xe0, 0x0c, 0xd5, 0x3b, 0x65, 0xc3, 0x67, 0x68, 0xef, 0x77, 0xaa, 0x30, 0xcd, 0x29, 0xb1,
+	0xd4, 0x3e, 0x4d, 0xb3, 0x30, 0xe2, 0x11, 0xe7, 0xa2, 0x54, 0x0d, 0x54, 0x5a, 0x4f, 0x15, 0xcb,
+	0x16, 0x23, 0xec, 0x60, 0xf2, 0x79, 0x06, 0xb1, 0x8f, 0x18, 0x30, 0xd3, 0x25, 0x56, 0x2b, 0xba,
+	0xc4, 0x26, 0x69, 0x76, 0xbc, 0x7d, 0x72, 0xf7, 0x14, 0x3b, 0x8a, 0x0e, 0xb2, 0xea, 0x8f, 0x33,
+	0x7c, 0xe3, 0x29, 0xc2, 0xcb, 0x7a, 0xe2, 0xec, 0x25, 0xf4, 0x44, 0x6e, 0x1c, 0x3b, 0xef, 0x54,
+	0x04, 0x97, 0x39, 0x15, 0x15, 0x0f, 0x07, 0x75, 0xcb, 0xe1, 0x00, 0x1f, 0x0e, 0xa4, 0xd4, 0xa7,
+	0x78, 0x26, 0x97, 0xfc, 0xfd, 0xbb, 0x0e, 0xb4, 0x04, 0xa7, 0x29, 0x1c, 0x79, 0xcb, 0xb0, 0x3d,
+	0x58, 0x1f, 0x22, 0x78, 0x07, 0x9a, 0xcc, 0x22, 0xa0, 0x7c, 0x44, 0xc2, 0x41, 0x6f, 0x00, 0x59,
+	0x84, 0xb8, 0x08, 0x75, 0x1a, 0x86, 0x03, 0xf9, 0x68, 0x96, 0x06, 0x92, 0x6e, 0xa6, 0x44, 0x86,
+	0xff, 0x3a, 0xbe, 0x4a, 0x7b, 0xff, 0xd4, 0x81, 0x45, 0xad, 0xc1, 0x82, 0x53, 0x3f, 0x06, 0xb9,
+	0x62, 0xb8, 0x7b, 0xdb, 0x8c, 0xb8, 0x2d, 0xf6, 0xc5, 0x37, 0x88, 0xd9, 0x84, 0x07, 0x67, 0xac,
+	0x81, 0xe9, 0x78, 0x28, 0x14, 0x20, 0x1d, 0x84, 0x03, 0x79, 0x4a, 0xe9, 0x2b, 0x45, 0xc2, 0x55,
+	0x30, 0x03, 0x86, 0x9d, 0x1f, 0xa2, 0x25, 0x43, 0x11, 0x71, 0x5d, 0xd4, 0x04, 0x7a, 0xff, 0xc1,
+	0x81, 0x25, 0x6e, 0x92, 0x12, 0x06, 0x3f, 0xf5, 0x0a, 0xce, 0x34, 0xb7, 0xc1, 0xf1, 0x55, 0xbb,
+	0x75, 0xc5, 0x17, 0x69, 0xf2, 0x0b, 0x97, 0x34, 0xa3, 0xa9, 0xab, 0x4d, 0x13, 0xe6, 0xa2, 0x6a,
+	0x9b, 0x8b, 0x73, 0x46, 0xda, 0xe6, 0xf8, 0x99, 0xb2, 0x3a, 0x7e, 0xf0, 0xcd, 0xf8, 0xb4, 0x17,
+	0x8f, 0x58, 0x54, 0xb9, 0xd9, 0x39, 0x21, 0xa6, 0xfe, 0x55, 0x15, 0x6e, 0xae, 0xb3, 0x08, 0xb8,
+	0x4d, 0x4a, 0x6d, 0xdd, 0xff, 0xa0, 0xc4, 0x5c, 0x13, 0x04, 0x63, 0xc1, 0x1f, 0x83, 0xf1, 0x40,
+	0x5d, 0x1e, 0x1f, 0x2d, 0xfc, 0x7b, 0x3a, 0x88, 0x99, 0xdc, 0xc3, 0x48, 0x7f, 0x71, 0x44, 0xb8,
+	0xf7, 0x4c, 0xa8, 0x34, 0xcd, 0x6b, 0x74, 0x35, 0x41, 0x67, 0x40, 0x51, 0xbd, 0x3a, 0x41, 0xc9,
+	0x97, 0xd0, 0x34, 0x94, 0xa6, 0x77, 0x0d, 0x82, 0x77, 0x3f, 0xb5, 0xea, 0x99, 0xac, 0x0d, 0x8f,
+	0x42, 0x75, 0x96, 0xb0, 0x23, 0xc9, 0x13, 0x68, 0x9b, 0xed, 0xd1, 0x32, 0xce, 0xb0, 0x8c, 0x13,
+	0xf1, 0x2c, 0x6f, 0xf0, 0xc6, 0x8a, 0x13, 0xe7, 0xc9, 0x89, 0x78, 0x54, 0xad, 0xf2, 0xb6, 0x6b,
+	0xf9, 0x78, 0xc0, 0x89, 0x15, 0xe7, 0xdd, 0x81, 0x5b, 0x13, 0x26, 0x52, 0x4c, 0xf5, 0x1f, 0x38,
+	0xd0, 0x16, 0x2f, 0x91, 0x62, 0xa4, 0x99, 0x70, 0xa9, 0x8b, 0x69, 0xbe, 0x0d, 0x90, 0x66, 0x41,
+	0xc2, 0xf5, 0x40, 0xa9, 0x9e, 0xe6, 0x10, 0x64, 0x47, 0x1a, 0xf5, 0xf3, 0x68, 0x89, 0x9a, 0xaf,
+	0xd2, 0xa5, 0xa3, 0x9e, 0xb0, 0x8f, 0xea, 0x30, 0x9c, 0x47, 0x79, 0xa4, 0x63, 0xcf, 0xc9, 0x4a,
+	0xc3, 0x63, 0x01, 0xea, 0xfd, 0x63, 0x07, 0x16, 0xf2, 0x46, 0xf2, 0xc7, 0x50, 0x8d, 0xcd, 0x42,
+	0x9c, 0x92, 0x14, 0x40, 0xf9, 0x06, 0x43, 0x3c, 0x36, 0xe9, 0xcf, 0x5a, 0x70, 0x08, 0xe3, 0x45,
+	0x91, 0x8a, 0xc7, 0xf2, 0x1c, 0xaa, 0x83, 0x78, 0x08, 0x3b, 0x1e, 0xd8, 0xc4, 0xe1, 0x53, 0xa4,
+	0xd8, 0xcd, 0xf9, 0x61, 0xc6, 0x72, 0xf1, 0xe8, 0x2b, 0x99, 0x94, 0x27, 0x1e, 0x6e, 0x34, 0xc0,
+	0x9f, 0xde, 0x6f, 0x39, 0x70, 0xdd, 0x32, 0xb8, 0x42, 0x08, 0x6e, 0xc0, 0xe2, 0x91, 0x42, 0xca,
+	0x01, 0xe0, 0x92, 0x70, 0xc5, 0x7c, 0xfb, 0x57, 0x76, 0xda, 0x2f, 0x67, 0x50, 0x47, 0x54, 0x3e,
+	0xa4, 0xc6, 0x4d, 0xc7, 0x32, 0xc2, 0xbb, 0x8e, 0x37, 0x20, 0xf0, 0xd2, 0x31, 0x2b, 0xcf, 0xf0,
+	0xc6, 0x2c, 0xc1, 0xa2, 0x86, 0xe2, 0x6c, 0xf2, 0xf8, 0x6f, 0x54, 0x61, 0x9e, 0x87, 0x13, 0xf2,
+	0x4f, 0x98, 0xd0, 0x84, 0xbc, 0x80, 0x19, 0xf1, 0x09, 0x1a, 0x22, 0x55, 0x51, 0xf3, 0xa3, 0x37,
+	0xee, 0x4a, 0x11, 0x2c, 0x78, 0x6d, 0xe9, 0x37, 0xfe, 0xe8, 0x4f, 0x7f, 0xbb, 0xd2, 0x24, 0xf5,
+	0x07, 0xaf, 0x1f, 0x3d, 0x38, 0xa6, 0x51, 0x8a, 0x65, 0x7c, 0x17, 0x20, 0xff, 0x38, 0x0b, 0x69,
+	0xab, 0xa3, 0x78, 0xe1, 0xab, 0x33, 0xee, 0x75, 0x0b, 0x46, 0x94, 0x7b, 0x9d, 0x95, 0xbb, 0xe4,
+	0xcd, 0x63, 0xb9, 0x61, 0x14, 0x66, 0xfc, 0x4b, 0x2d, 0x4f, 0x9c, 0x7b, 0xa4, 0x0f, 0x0d, 0xfd,
+	0xdb, 0x2b, 0xc4, 0x55, 0x0f, 0x0a, 0x96, 0xbe, 0xfc, 0xe2, 0xde, 0xb0, 0xe2, 0xa4, 0x23, 0x8b,
+	0xd5, 0xb1, 0xec, 0xb5, 0xb0, 0x8e, 0x31, 0xa3, 0xc8, 0x6b, 0x19, 0xc0, 0xbc, 0xf9, 0x89, 0x15,
+	0x72, 0x53, 0x13, 0x86, 0xa5, 0x0f, 0xbc, 0xb8, 0xb7, 0x26, 0x60, 0x45, 0x5d, 0xb7, 0x58, 0x5d,
+	0xd7, 0x3c, 0x82, 0x75, 0xf5, 0x18, 0x8d, 0xfc, 0xc0, 0xcb, 0x13, 0xe7, 0xde, 0xe3, 0xdf, 0x78,
+	0x04, 0x73, 0xca, 0xfb, 0x4a, 0xbe, 0x07, 0x4d, 0x23, 0xde, 0x93, 0xc8, 0x6e, 0xd8, 0xc2, 0x43,
+	0xdd, 0x9b, 0x76, 0xa4, 0xa8, 0xf8, 0x36, 0xab, 0xb8, 0x4d, 0x56, 0xb0, 0x62, 0x11, 0x30, 0xf9,
+	0x80, 0x45, 0xb9, 0xf2, 0x1b, 0xfc, 0xaf, 0x60, 0xde, 0x8c, 0xd1, 0x34, 0xfa, 0x59, 0x8a, 0xe9,
+	0x74, 0x6f, 0x4d, 0xc0, 0x8a, 0xea, 0x6e, 0xb2, 0xea, 0x56, 0xc8, 0x55, 0xbd, 0x3a, 0xe5, 0x15,
+	0xa5, 0xec, 0xcd, 0x05, 0xfd, 0x0b, 0x2c, 0xe4, 0x96, 0x62, 0x2c, 0xdb, 0x97, 0x59, 0x14, 0x8b,
+	0x94, 0x3f, 0xcf, 0xe2, 0xb5, 0x59, 0x55, 0x84, 0xb0, 0xe9, 0xd3, 0x3f, 0xc0, 0x42, 0xbe, 0x03,
+	0x73, 0xea, 0xa1, 0x58, 0x72, 0x4d, 0x7b, 0x9d, 0x57, 0x7f, 0xbd, 0xd6, 0x6d, 0x97, 0x11, 0x36,
+	0xc6, 0xd0, 0x4b, 0x46, 0xc6, 0xd8, 0x86, 0x65, 0xf5, 0x72, 0xc5, 0x4f, 0xd2, 0x13, 0xcb, 0x77,
+	0x63, 0x1e, 0x3a, 0xe4, 0x63, 0x98, 0x95, 0xef, 0xef, 0x92, 0x15, 0xfb, 0x3b, 0xc2, 0xee, 0xb5,
+	0x12, 0x5c, 0x48, 0x9b, 0x6f, 0x01, 0xe4, 0xef, 0xca, 0xaa, 0x75, 0x56, 0x7a, 0xd1, 0xd6, 0xbd,
+	0x6e, 0xc1, 0x88, 0xae, 0xae, 0xb0, 0xae, 0xb6, 0x08, 0x5b, 0x67, 0x11, 0x3d, 0x95, 0x37, 0x32,
+	0xc7, 0xb0, 0x58, 0x7a, 0x66, 0x96, 0xdc, 0x91, 0x0d, 0x99, 0xf0, 0x38, 0xad, 0xbb, 0x3a, 0x99,
+	0xc0, 0x5c, 0x07, 0x64, 0x19, 0xeb, 0x4b, 0xc7, 0x87, 0xe9, 0x69, 0x30, 0xea, 0x31, 0x32, 0x5c,
+	0xe4, 0xe4, 0x0c, 0x48, 0xf9, 0x75, 0x58, 0x52, 0x28, 0xb6, 0xfc, 0x02, 0xad, 0xfb, 0xd6, 0x39,
+	0x14, 0xb6, 0x85, 0x20, 0x6a, 0x16, 0xaf, 0xb6, 0x16, 0xaa, 0xd6, 0x1e, 0x64, 0x25, 0xd6, 0x1e,
+	0xe9, 0x0f, 0xc8, 0xba, 0x6f, 0x9d, 0x43, 0x71, 0x4e, 0xd5, 0xbc, 0xd3, 0xa7, 0xac, 0x12, 0x0a,
+	0x4d, 0xe3, 0x35, 0x54, 0x72, 0xc3, 0xfe, 0x46, 0xaa, 0xb9, 0xde, 0xad, 0x0f, 0xa8, 0x4a, 0xc1,
+	0x49, 0x16, 0xb9, 0x50, 0x63, 0x24, 0xe2, 0xa6, 0xd6, 0xaf, 0x3b, 0x70, 0xd5, 0xf6, 0xc8, 0x28,
+	0xf1, 0xac, 0xa3, 0x67, 0xbc, 0x7f, 0xea, 0xbe, 0x7d, 0x2e, 0x8d, 0xa8, 0x7c, 0x95, 0x55, 0xee,
+	0x92, 0x76, 0x79, 0x8c, 0x13, 0x5e, 0xd5, 0x17, 0xb0, 0x64, 0x79, 0x10, 0x94, 0x58, 0x07, 0xd1,
+	0x78, 0xce, 0xd4, 0xf5, 0xce, 0x23, 0x11, 0xf5, 0xdf, 0x61, 0xf5, 0x5f, 0x27, 0xd7, 0x4a, 0x03,
+	0xcd, 0x9f, 0x36, 0x25, 0x1b, 0x50, 0xd7, 0x1e, 0x0d, 0x25, 0x72, 0x61, 0x94, 0x1f, 0x1c, 0x75,
+	0x5d, 0x1b, 0x4a, 0xac, 0xbb, 0xaf, 0x43, 0xd3, 0x78, 0xfd, 0x53, 0xcd, 0x97, 0xed, 0x6d, 0x51,
+	0xf7, 0xa6, 0x1d, 0x29, 0xca, 0xfa, 0x36, 0xd4, 0xb5, 0xb7, 0x3a, 0x89, 0x76, 0xed, 0xbb, 0xf0,
+	0x4a, 0xa7, 0xeb, 0xda, 0x50, 0xa2, 0xe3, 0x57, 0x59, 0xc7, 0xe7, 0xbd, 0x39, 0xec, 0x38, 0x7b,
+	0x08, 0x06, 0x45, 0xd5, 0xf7, 0x60, 0xde, 0x7c, 0xbd, 0x53, 0xc9, 0x76, 0xeb, 0x3b, 0xa0, 0xee,
+	0xad, 0x09, 0x58, 0x53, 0x2c, 0xde, 0x5b, 0x52, 0x95, 0x3c, 0xf8, 0x5c, 0xc4, 0xd3, 0x7d, 0x41,
+	0xbe, 0x09, 0x73, 0xea, 0xdd, 0x23, 0x92, 0xbf, 0x59, 0x6a, 0xbe, 0x8e, 0xe4, 0xb6, 0xcb, 0x08,
+	0x51, 0xf8, 0x22, 0x2b, 0xbc, 0x4e, 0xf2, 0x1e, 0x90, 0x00, 0xe6, 0x95, 0xa4, 0x35, 0xcb, 0x2d,
+	0xbe, 0x94, 0xe4, 0xea, 0x08, 0xfd, 0xb9, 0x24, 0xd9, 0x66, 0xa2, 0xb5, 0x39, 0x95, 0x65, 0x3e,
+	0x74, 0xc8, 0xa7, 0xe2, 0x49, 0x71, 0xe3, 0xa1, 0xa1, 0x3b, 0xfa, 0xc6, 0x60, 0x79, 0x15, 0xc9,
+	0x5d, 0x9d, 0x4c, 0x20, 0x66, 0xf5, 0x57, 0xe0, 0xda, 0x84, 0xe7, 0x8d, 0xc8, 0xcf, 0xe4, 0x7c,
+	0x7c, 0xce, 0xf3, 0x47, 0xae, 0x0c, 0xa9, 0x30, 0xb0, 0x0f, 0x1d, 0xae, 0xaa, 0xb1, 0x67, 0x8b,
+	0x34, 0x55, 0x4d, 0x7f, 0xd9, 0xc8, 0x5d, 0x29, 0x82, 0xed, 0xaa, 0x5a, 0xc6, 0x3e, 0x2d, 0xf0,
+	0x12, 0xe6, 0xd4, 0xa3, 0x35, 0x6a, 0x78, 0x8b, 0x4f, 0xdb, 0xb8, 0xed, 0x32, 0x42, 0x14, 0xba,
+	0xcc, 0x0a, 0x5d, 0x20, 0x4d, 0x51, 0xe8, 0x21, 0x2f, 0x29, 0x82, 0x85, 0xc2, 0x5d, 0x20, 0xb5,
+	0x3d, 0xda, 0xaf, 0x67, 0xba, 0xb7, 0xcf, 0xbf, 0x42, 0x64, 0x2a, 0x16, 0x52, 0xa1, 0x78, 0x20,
+	0xdf, 0x50, 0xf8, 0xf3, 0xd0, 0xd0, 0x5f, 0x80, 0x54, 0x3a, 0xa1, 0xe5, 0xdd, 0x4a, 0xf7, 0x86,
+	0x15, 0x67, 0x2e, 0x24, 0xd2, 0xd0, 0xab, 0xc1, 0x85, 0x64, 0x3e, 0xe1, 0x96, 0x2b, 0x49, 0xb6,
+	0x97, 0xeb, 0xdc, 0x5b, 0x13, 0xb0, 0xe6, 0x42, 0x22, 0x4b, 0x7a, 0x25, 0x0f, 0x78, 0x98, 0x09,
+	0x09, 0x61, 0xde, 0x7c, 0x5b, 0x4f, 0xd5, 0x65, 0x7d, 0x66, 0xd0, 0xbd, 0x35, 0x01, 0x2b, 0xea,
+	0x72, 0x59, 0x5d, 0x57, 0x09, 0x53, 0x3c, 0x47, 0x8c, 0x46, 0x75, 0xeb, 0xdb, 0xb0, 0xa0, 0xdd,
+	0xe9, 0xdb, 0x3f, 0x8b, 0x7a, 0x4a, 0xfe, 0x94, 0xaf, 0x53, 0xbb, 0x36, 0x63, 0x80, 0x77, 0x8d,
+	0x15, 0xbf, 0xe8, 0x19, 0xe3, 0x85, 0xb2, 0x67, 0x1d, 0xea, 0x5a, 0x19, 0xe7, 0x95, 0x7b, 0x4d,
+	0x43, 0xe9, 0x8f, 0x6a, 0x3c, 0x74, 0xc8, 0x36, 0xb4, 0x8a, 0x57, 0xe4, 0x95, 0xac, 0xb5, 0xbd,
+	0x06, 0xe0, 0x16, 0x90, 0xc6, 0xc5, 0x7a, 0xb2, 0x6f, 0xb9, 0x57, 0x7f, 0x7b, 0xd2, 0x8d, 0x70,
+	0xd1, 0xb8, 0x3b, 0x13, 0xf1, 0x62, 0xa5, 0xff, 0x1e, 0x7e, 0x0b, 0x43, 0xbf, 0x20, 0x68, 0x84,
+	0x96, 0x15, 0x4a, 0x6b, 0xeb, 0x38, 0xbd, 0xaf, 0x9e, 0xcf, 0xc6, 0x71, 0xfb, 0xde, 0xd7, 0x0d,
+	0x96, 0xf8, 0xdc, 0x30, 0xee, 0xdd, 0x2f, 0x7e, 0x17, 0xe3, 0x8b, 0x22, 0x81, 0xfe, 0x0c, 0xce,
+	0x17, 0x0f, 0x1d, 0xf2, 0x23, 0x07, 0xe6, 0x4d, 0xb3, 0xb5, 0x62, 0x26, 0xab, 0x81, 0xdc, 0xbd,
+	0x35, 0x01, 0x2b, 0x98, 0xe9, 0xdb, 0xac, 0x95, 0x07, 0xf7, 0x7c, 0xa3, 0x95, 0xe2, 0xa9, 0xc3,
+	0x9f, 0xae, 0xb5, 0xf8, 0x18, 0x03, 0xca, 0x53, 0xe9, 0xe3, 0x24, 0x9a, 0x8c, 0x2d, 0x72, 0xa0,
+	0xfe, 0xdd, 0xbb, 0xbb, 0xce, 0x43, 0x87, 0xfc, 0x2a, 0x2c, 0x68, 0x79, 0x19, 0x23, 0x5f, 0x36,
+	0xbf, 0xf7, 0x0e, 0xeb, 0xd3, 0x6d, 0xef, 0xba, 0xd1, 0xa7, 0xa2, 0xd6, 0xbf, 0x06, 0x75, 0xed,
+	0xbb, 0x68, 0xb9, 0xe2, 0x50, 0xfa, 0x56, 0xda, 0xe4, 0x46, 0x0e, 0x61, 0x41, 0x23, 0x37, 0x56,
+	0xdb, 0x25, 0x8b, 0xf1, 0xee, 0xb1, 0xb6, 0xbe, 0xe3, 0xdd, 0x99, 0xd8, 0xd6, 0x07, 0xcc, 0xf8,
+	0x8c, 0x2d, 0xfe, 0x0b, 0xb0, 0x60, 0x7c, 0xed, 0x2a, 0x4e, 0xc8, 0xdb, 0x97, 0xf8, 0x18, 0x96,
+	0xeb, 0x9d, 0x4b, 0xc4, 0x1a, 0xc7, 0xba, 0xb3, 0x0d, 0x4b, 0x6a, 0x0b, 0xcb, 0x3f, 0x35, 0xa5,
+	0x6b, 0x72, 0x13, 0x3e, 0x43, 0xe5, 0xb6, 0x8a, 0x1f, 0x61, 0x7a, 0xe8, 0x90, 0x4f, 0x60, 0x4e,
+	0x7d, 0x1d, 0x4f, 0xed, 0x43, 0xc5, 0xaf, 0xfb, 0xb9, 0xed, 0x32, 0x42, 0x2c, 0xc3, 0x3d, 0x80,
+	0x3c, 0x3a, 0x86, 0x14, 0xbc, 0xff, 0xea, 0x00, 0x54, 0x0e, 0xa0, 0x31, 0x05, 0x98, 0x0c, 0x12,
+	0xc0, 0xf1, 0x7b, 0x0e, 0xf3, 0x66, 0xe0, 0x4b, 0xbe, 0x74, 0x6c, 0xf1, 0x30, 0xe7, 0xd4, 0x81,
+	0xfa, 0xa2, 0x11, 0x12, 0xa2, 0x64, 0x98, 0x2d, 0xb8, 0xc4, 0xbd, 0x69, 0x47, 0xe6, 0x65, 0x19,
+	0x91, 0x20, 0xaa, 0x2c, 0x5b, 0x6c, 0x89, 0x7b, 0xd3, 0x8e, 0x14, 0x65, 0x7d, 0x87, 0xef, 0x9a,
+	0x02, 0x9c, 0x2a, 0x76, 0x2c, 0x47, 0x82, 0xb8, 0xae, 0x0d, 0x65, 0xdb, 0x33, 0xe5, 0x10, 0x92,
+	0x97, 0xd0, 0xdc, 0x8e, 0xe3, 0x57, 0xe3, 0x91, 0x6c, 0x28, 0x31, 0x9d, 0x9c, 0x18, 0xaf, 0xe2,
+	0x16, 0x26, 0xca, 0x3c, 0x40, 0x88, 0xa2, 0x1e, 0x7c, 0x9e, 0x07, 0xb0, 0x7c, 0x41, 0x02, 0x58,
+	0x54, 0xac, 0xa5, 0x1a, 0xee, 0x9a, 0xc5, 0x18, 0xaa, 0x61, 0xb1, 0x0a, 0xe3, 0x30, 0x26, 0x5b,
+	0x6b, 0x28, 0x85, 0x7b, 0xd0, 0xd8, 0xa0, 0xe8, 0xa1, 0x16, 0xde, 0xb2, 0xa5, 0xbc, 0xe1, 0xca,
+	0xcd, 0xe6, 0x36, 0x0d, 0xa0, 0xa9, 0x9e, 0x8c, 0x82, 0xb3, 0x84, 0x7e, 0xff, 0xc1, 0xe7, 0xc2,
+	0x0f, 0xf7, 0x85, 0x54, 0x4f, 0x44, 0xcf, 0x4d, 0xf5, 0xa4, 0xe0, 0xd9, 0x74, 0x6f, 0x58, 0x71,
+	0xb6, 0xa1, 0x96, 0x01, 0x0c, 0xe4, 0x29, 0x34, 0xf4, 0x00, 0x06, 0x55, 0xbc, 0x25, 0xaa, 0x41,
+	0xe9, 0x95, 0x86, 0xc3, 0xfa, 0xa1, 0x43, 0x06, 0xb0, 0x58, 0x72, 0xa8, 0x2a, 0x4d, 0x78, 0x92,
+	0x1b, 0xd6, 0x5d, 0x9d, 0x4c, 0x60, 0xb6, 0xf8, 0x9e, 0xd9, 0xe2, 0x7d, 0x68, 0x6e, 0x50, 0x3e,
+	0xe0, 0xfc, 0x46, 0x42, 0xe1, 0xe1, 0x5b, 0xfd, 0xbe, 0x83, 0xbb, 0x64, 0xc1, 0x99, 0xe7, 0x05,
+	0x76, 0x1d, 0x80, 0x7c, 0x07, 0xea, 0xcf, 0x68, 0x26, 0xaf, 0x20, 0x28, 0x73, 0x4a, 0xe1, 0x4e,
+	0x82, 0x6b, 0xb9, 0xc1, 0x60, 0xf2, 0x1d, 0x2b, 0xed, 0x01, 0xed, 0x1f, 0x53, 0xbe, 0x63, 0x75,
+	0xc3, 0xfe, 0x17, 0xe4, 0x57, 0x58, 0xe1, 0xea, 0x0e, 0xd4, 0x8a, 0x16, 0xb9, 0xae, 0x17, 0xbe,
+	0x50, 0x80, 0xdb, 0x4a, 0x8e, 0xe2, 0x3e, 0xd5, 0x4e, 0x4e, 0x11, 0xd4, 0xb5, 0x2b, 0xbb, 0x6a,
+	0x11, 0x96, 0x2f, 0x34, 0xbb, 0xae, 0x0d, 0x25, 0xc6, 0xf9, 0x2e, 0xab, 0xc7, 0x23, 0xab, 0x79,
+	0x3d, 0xfc, 0xb6, 0x5b, 0x5e, 0xd3, 0x83, 0xcf, 0x83, 0x61, 0xf6, 0x05, 0xf9, 0x2e, 0x2c, 0x59,
+	0xee, 0x6b, 0x2a, 0xc1, 0x3d, 0xf9, 0xa6, 0xa7, 0xeb, 0x9d, 0x47, 0x22, 0x64, 0xca, 0x3e, 0xb4,
+	0x8a, 0x97, 0x03, 0x95, 0x92, 0x35, 0xe1, 0x92, 0xa2, 0x7b, 0x67, 0x22, 0x3e, 0x2f, 0xb4, 0x78,
+	0x8d, 0x4e, 0x15, 0x3a, 0xe1, 0x76, 0xa0, 0x7b, 0x67, 0x22, 0x5e, 0x14, 0xfa, 0x19, 0x7b, 0x13,
+	0x56, 0xbf, 0x6e, 0x92, 0xdb, 0xc9, 0x8a, 0x37, 0x53, 0x5c, 0x52, 0x46, 0x99, 0xb6, 0x33, 0x3e,
+	0xe4, 0xec, 0x4c, 0xf5, 0x0b, 0x00, 0x78, 0x61, 0x62, 0x23, 0xa0, 0xc3, 0x38, 0xca, 0x15, 0x91,
+	0xfc, 0x4a, 0x85, 0xbb, 0x64, 0xc0, 0x54, 0x7b, 0x72, 0xc3, 0xa2, 0x71, 0x5b, 0x47, 0x2e, 0xb2,
+	0x89, 0xb7, 0x2e, 0x5c, 0xd7, 0x46, 0xa1, 0x96, 0xf6, 0x1a, 0x40, 0x1e, 0x59, 0xa0, 0xcc, 0x84,
+	0xa5, 0xa0, 0x05, 0xf7, 0xba, 0x05, 0xa3, 0xb6, 0xd7, 0xb9, 0xdc, 0x0d, 0x7d, 0x2d, 0xbf, 0xf0,
+	0x68, 0x38, 0xad, 0xdd, 0x76, 0x19, 0x21, 0xb8, 0xb3, 0xc5, 0x86, 0x0a, 0xc8, 0x2c, 0x0e, 0x15,
+	0xf3, 0xf8, 0x86, 0xb0, 0xc4, 0x1b, 0xa8, 0x8e, 0x13, 0xec, 0x92, 0x80, 0xec, 0x89, 0xc5, 0x43,
+	0xe9, 0xde, 0xb0, 0xe2, 0x6c, 0x0e, 0x03, 0x5c, 0xb5, 0xfc, 0x82, 0x02, 0xee, 0xe4, 0x87, 0xb0,
+	0x2c, 0xaa, 0x32, 0xdd, 0x66, 0x4a, 0x1f, 0x3a, 0xcf, 0x2f, 0xea, 0xbe, 0x73, 0x3e, 0x91, 0x18,
+	0xa0, 0x21, 0x2c, 0x96, 0xbc, 0x42, 0x4a, 0x7c, 0x4e, 0x72, 0xc6, 0xb9, 0xab, 0x93, 0x09, 0xcc,
+	0xf3, 0xb5, 0x07, 0xd8, 0xad, 0xf4, 0x34, 0xcc, 0x7a, 0x27, 0xd8, 0xa5, 0x7d, 0x8d, 0x57, 0x34,
+	0x0f, 0x4f, 0xaa, 0x9d, 0x67, 0xac, 0x1e, 0x21, 0xb7, 0x5d, 0xc6, 0x4b, 0x3e, 0x39, 0x9c, 0x1e,
+	0x25, 0x71, 0x16, 0x7f, 0xf0, 0x7f, 0x06, 0x00, 0x31, 0x68, 0xdd, 0x4b, 0x23, 0x81, 0x00, 0x00,
 }
@@ -4167,6 +4167,25 @@ func (lc *LightningChannel) FullySynced() bool {
 	return localUpdatesSynced && remoteUpdatesSynced
 }
 
+// OweCommitment returns true if we need to send a commitment signature to the
+// remote party, as the tip of its commitment chain doesn't include all of our
+// updates yet, or all of its own updates we've accepted in our commitment.
+func (lc *LightningChannel) OweCommitment() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	lastLocalCommit := lc.localCommitChain.tip()
+	lastRemoteCommit := lc.remoteCommitChain.tip()
+
+	localUpdatesPending := lc.localUpdateLog.logIndex !=
+		lastRemoteCommit.ourMessageIndex
+
+	remoteUpdatesPending := lastLocalCommit.theirMessageIndex !=
+		lastRemoteCommit.theirMessageIndex
+
+	return localUpdatesPending || remoteUpdatesPending
+}
+
 // RevokeCurrentCommitment revokes the next lowest unrevoked commitment
 // transaction in the local commitment chain. As a result the edge of our
 // revocation window is extended by one, and the tail of our local commitment
@@ -5895,6 +5914,76 @@ func (lc *LightningChannel) CompleteCooperativeClose(localSig, remoteSig []byte,
 	return closeTx, ourBalance, nil
 }
 
+// DustExposure returns the total value of the HTLCs that are dust on our
+// commitment and on the remote commitment. As dust HTLCs don't get an output,
+// their value goes to the miners if the channel is force closed. All HTLCs
+// within the update logs are counted, including those that haven't been
+// locked in yet. If feePerKw is non-zero, dust is evaluated at this fee rate
+// instead of the fee rate of the latest commitments, which allows determining
+// the effect of a fee update before it's applied.
+func (lc *LightningChannel) DustExposure(
+	feePerKw SatPerKWeight) (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	ourFeePerKw := lc.localCommitChain.tip().feePerKw
+	theirFeePerKw := lc.remoteCommitChain.tip().feePerKw
+	if feePerKw != 0 {
+		ourFeePerKw = feePerKw
+		theirFeePerKw = feePerKw
+	}
+
+	ourDustLimit := lc.localChanCfg.DustLimit
+	theirDustLimit := lc.remoteChanCfg.DustLimit
+
+	var ourDust, theirDust lnwire.MilliSatoshi
+	addDust := func(log *updateLog, incoming bool) {
+		for e := log.Front(); e != nil; e = e.Next() {
+			htlc := e.Value.(*PaymentDescriptor)
+			if htlc.EntryType != Add {
+				continue
+			}
+
+			amt := htlc.Amount.ToSatoshis()
+			if htlcIsDust(incoming, true, ourFeePerKw, amt,
+				ourDustLimit) {
+
+				ourDust += htlc.Amount
+			}
+			if htlcIsDust(incoming, false, theirFeePerKw, amt,
+				theirDustLimit) {
+
+				theirDust += htlc.Amount
+			}
+		}
+	}
+	addDust(lc.localUpdateLog, false)
+	addDust(lc.remoteUpdateLog, true)
+
+	return ourDust, theirDust
+}
+
+// IsDustHTLC returns whether an HTLC of the passed amount would be dust on our
+// commitment and on the remote commitment at their latest fee rates.
+func (lc *LightningChannel) IsDustHTLC(amt lnwire.MilliSatoshi,
+	incoming bool) (bool, bool) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	ourDust := htlcIsDust(
+		incoming, true, lc.localCommitChain.tip().feePerKw,
+		amt.ToSatoshis(), lc.localChanCfg.DustLimit,
+	)
+	theirDust := htlcIsDust(
+		incoming, false, lc.remoteCommitChain.tip().feePerKw,
+		amt.ToSatoshis(), lc.remoteChanCfg.DustLimit,
+	)
+
+	return ourDust, theirDust
+}
+
 // AvailableBalance returns the current available balance within the channel.
 // By available balance, we mean that if at this very instance s new commitment
 // were to be created which evals all the log entries, what would our available
@@ -6493,3 +6493,97 @@ func TestChannelRestoreCommitHeight(t *testing.T) {
 	bobChannel = restoreAndAssertCommitHeights(t, bobChannel, true, 0, 2, 1)
 	bobChannel = restoreAndAssertCommitHeights(t, bobChannel, true, 1, 2, 2)
 }
+
+// TestDustExposure tests that the dust exposure of a channel accounts for the
+// dust HTLCs in both update logs on both commitments, and that a higher fee
+// rate turns additional HTLCs into dust.
+func TestDustExposure(t *testing.T) {
+	t.Parallel()
+
+	// Create a test channel which will be used for the duration of this
+	// unittest. The channel will be funded evenly with Alice having 5 BTC,
+	// and Bob having 5 BTC.
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	feePerKw := aliceChannel.CommitFeeRate()
+
+	// Alice offers a large HTLC and a small one that is dust on both
+	// commitments.
+	bigAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	smallAmt := lnwire.NewMSatFromSatoshis(1000)
+
+	// Bob offers an HTLC that is just above the dust limit of both
+	// commitments at the current fee rate, but becomes dust once the fee
+	// rate is doubled.
+	midAmt := lnwire.NewMSatFromSatoshis(
+		bobChannel.localChanCfg.DustLimit + htlcSuccessFee(feePerKw) + 1,
+	)
+
+	for i, amt := range []lnwire.MilliSatoshi{bigAmt, smallAmt} {
+		htlc, _ := createHTLC(i, amt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("alice unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("bob unable to receive htlc: %v", err)
+		}
+	}
+
+	htlc, _ := createHTLC(0, midAmt)
+	if _, err := bobChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("bob unable to add htlc: %v", err)
+	}
+	if _, err := aliceChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("alice unable to receive htlc: %v", err)
+	}
+
+	assertDust := func(channel *LightningChannel, fee SatPerKWeight,
+		expected lnwire.MilliSatoshi) {
+
+		t.Helper()
+
+		ourDust, theirDust := channel.DustExposure(fee)
+		if ourDust != expected || theirDust != expected {
+			t.Fatalf("expected dust exposure of %v on both "+
+				"commitments, got %v and %v", expected,
+				ourDust, theirDust)
+		}
+	}
+
+	assertIsDust := func(amt lnwire.MilliSatoshi, incoming,
+		expected bool) {
+
+		t.Helper()
+
+		ourDust, theirDust := aliceChannel.IsDustHTLC(amt, incoming)
+		if ourDust != expected || theirDust != expected {
+			t.Fatalf("expected htlc of %v to be dust: %v, got "+
+				"%v and %v", amt, expected, ourDust, theirDust)
+		}
+	}
+
+	assertIsDust(smallAmt, false, true)
+	assertIsDust(midAmt, true, false)
+	assertIsDust(bigAmt, false, false)
+
+	// Both parties should count the small HTLC as dust, both before and
+	// after the HTLCs have been locked in.
+	assertDust(aliceChannel, 0, smallAmt)
+	assertDust(bobChannel, 0, smallAmt)
+
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("state transition error: %v", err)
+	}
+
+	assertDust(aliceChannel, 0, smallAmt)
+	assertDust(bobChannel, 0, smallAmt)
+
+	// At double the fee rate, Bob's HTLC becomes dust as well, while the
+	// large HTLC remains above the dust limit.
+	assertDust(aliceChannel, feePerKw*2, smallAmt+midAmt)
+	assertDust(bobChannel, feePerKw*2, smallAmt+midAmt)
+}
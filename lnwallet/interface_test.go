@@ -409,7 +409,9 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	// side putting in 10 BTC.
 
 	// Alice initiates a channel funded with 5 BTC for each side, so 10 BTC
-	// total. She also generates 2 BTC in change.
+	// total. She also generates 2 BTC in change. As the initiator, she
+	// only reserves her own funds, Bob's will be added to the channel once
+	// she processes his contribution.
 	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -419,7 +421,7 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		NodeID:          bobPub,
 		NodeAddr:        bobAddr,
 		FundingAmount:   fundingAmount,
-		Capacity:        fundingAmount * 2,
+		Capacity:        fundingAmount,
 		CommitFeePerKw:  feePerKw,
 		FundingFeePerKw: feePerKw,
 		PushMSat:        0,
@@ -553,6 +555,13 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	if aliceChannels[0].ChanType != channeldb.DualFunder {
 		t.Fatalf("channel not detected as dual funder")
 	}
+	if !aliceChannels[0].IsInitiator {
+		t.Fatalf("alice should be the initiator of the channel")
+	}
+	if aliceChannels[0].Capacity != fundingAmount*2 {
+		t.Fatalf("incorrect capacity: expected %v, got %v",
+			fundingAmount*2, aliceChannels[0].Capacity)
+	}
 	bobChannels, err := bob.Cfg.Database.FetchOpenChannels(alicePub)
 	if err != nil {
 		t.Fatalf("unable to retrieve channel from DB: %v", err)
@@ -2171,6 +2180,216 @@ type walletTestCase struct {
 		test *testing.T)
 }
 
+func testExternalFundingReservationWorkflow(miner *rpctest.Harness,
+	alice, bob *lnwallet.LightningWallet, t *testing.T) {
+
+	// For this scenario, Alice will be the channel initiator, funding the
+	// channel with a transaction constructed outside of her wallet, while
+	// Bob will act as the responder to the workflow.
+	fundingAmt, err := btcutil.NewAmount(4)
+	if err != nil {
+		t.Fatalf("unable to create amt: %v", err)
+	}
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+	aliceReq := &lnwallet.InitFundingReserveMsg{
+		ChainHash:       chainHash,
+		NodeID:          bobPub,
+		NodeAddr:        bobAddr,
+		FundingAmount:   fundingAmt,
+		Capacity:        fundingAmt,
+		CommitFeePerKw:  feePerKw,
+		FundingFeePerKw: feePerKw,
+		Flags:           lnwire.FFAnnounceChannel,
+		ExternalFunding: true,
+	}
+	aliceChanReservation, err := alice.InitChannelReservation(aliceReq)
+	if err != nil {
+		t.Fatalf("unable to init channel reservation: %v", err)
+	}
+	aliceChanReservation.SetNumConfsRequired(numReqConfs)
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        lnwallet.DefaultDustLimit(),
+		ChanReserve:      fundingAmt / 100,
+		MaxPendingAmount: lnwire.NewMSatFromSatoshis(fundingAmt),
+		MinHTLC:          1,
+		MaxAcceptedHtlcs: lnwallet.MaxHTLCNumber / 2,
+		CsvDelay:         csvDelay,
+	}
+	err = aliceChanReservation.CommitConstraints(channelConstraints)
+	if err != nil {
+		t.Fatalf("unable to verify constraints: %v", err)
+	}
+
+	// As the channel is funded externally, Alice's wallet shouldn't have
+	// selected any coins.
+	aliceContribution := aliceChanReservation.OurContribution()
+	if len(aliceContribution.Inputs) != 0 {
+		t.Fatalf("alice shouldn't have any inputs, instead has %v",
+			len(aliceContribution.Inputs))
+	}
+	assertContributionInitPopulated(t, aliceContribution)
+
+	bobReq := &lnwallet.InitFundingReserveMsg{
+		ChainHash:       chainHash,
+		NodeID:          alicePub,
+		NodeAddr:        aliceAddr,
+		FundingAmount:   0,
+		Capacity:        fundingAmt,
+		CommitFeePerKw:  feePerKw,
+		FundingFeePerKw: feePerKw,
+		Flags:           lnwire.FFAnnounceChannel,
+	}
+	bobChanReservation, err := bob.InitChannelReservation(bobReq)
+	if err != nil {
+		t.Fatalf("unable to create bob reservation: %v", err)
+	}
+	err = bobChanReservation.CommitConstraints(channelConstraints)
+	if err != nil {
+		t.Fatalf("unable to verify constraints: %v", err)
+	}
+	bobChanReservation.SetNumConfsRequired(numReqConfs)
+	bobContribution := bobChanReservation.OurContribution()
+
+	err = bobChanReservation.ProcessSingleContribution(aliceContribution)
+	if err != nil {
+		t.Fatalf("bob unable to process alice's contribution: %v", err)
+	}
+	err = aliceChanReservation.ProcessContribution(bobContribution)
+	if err != nil {
+		t.Fatalf("alice unable to process bob's contribution: %v", err)
+	}
+
+	// Without the funding transaction, Alice shouldn't be able to sign
+	// Bob's version of the commitment transaction yet.
+	if _, sig := aliceChanReservation.OurSignatures(); sig != nil {
+		t.Fatalf("commitment sig generated without funding tx")
+	}
+
+	// We'll now construct the funding transaction externally. A
+	// transaction that doesn't pay the exact channel amount to the funding
+	// output should be rejected.
+	fundingOut, err := aliceChanReservation.FundingOutput()
+	if err != nil {
+		t.Fatalf("unable to fetch funding output: %v", err)
+	}
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1},
+			Index: 0,
+		},
+	})
+	fundingTx.AddTxOut(wire.NewTxOut(fundingOut.Value-1, fundingOut.PkScript))
+	if err := aliceChanReservation.VerifyFundingTx(fundingTx); err == nil {
+		t.Fatalf("funding tx with wrong amount accepted")
+	}
+	err = aliceChanReservation.ProcessExternalFunding(fundingTx)
+	if err == nil {
+		t.Fatalf("funding tx with wrong amount accepted")
+	}
+
+	fundingTx.TxOut[0].Value = fundingOut.Value
+	if err := aliceChanReservation.VerifyFundingTx(fundingTx); err != nil {
+		t.Fatalf("unable to verify funding tx: %v", err)
+	}
+	err = aliceChanReservation.ProcessExternalFunding(fundingTx)
+	if err != nil {
+		t.Fatalf("alice unable to process funding tx: %v", err)
+	}
+
+	// With the funding transaction known, the workflow carries on
+	// exactly as a regular single funder workflow.
+	_, aliceCommitSig := aliceChanReservation.OurSignatures()
+	if aliceCommitSig == nil {
+		t.Fatalf("commitment sig not found")
+	}
+	fundingPoint := aliceChanReservation.FundingOutpoint()
+	if fundingPoint.Hash != fundingTx.TxHash() {
+		t.Fatalf("funding outpoint %v doesn't reference funding tx %v",
+			fundingPoint, fundingTx.TxHash())
+	}
+	_, err = bobChanReservation.CompleteReservationSingle(
+		fundingPoint, aliceCommitSig,
+	)
+	if err != nil {
+		t.Fatalf("bob unable to consume single reservation: %v", err)
+	}
+	_, bobCommitSig := bobChanReservation.OurSignatures()
+	aliceChannel, err := aliceChanReservation.CompleteReservation(
+		nil, bobCommitSig,
+	)
+	if err != nil {
+		t.Fatalf("alice unable to complete reservation: %v", err)
+	}
+	if aliceChannel.FundingTxn.TxHash() != fundingTx.TxHash() {
+		t.Fatalf("external funding tx not stored")
+	}
+
+	assertReservationDeleted(aliceChanReservation, t)
+	assertReservationDeleted(bobChanReservation, t)
+}
+
+func testBatchFundingTx(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	// We'll create a batch funding transaction paying to two distinct
+	// funding outputs at once.
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+	outputs := make([]*wire.TxOut, 2)
+	for i := range outputs {
+		pkScript, err := lnwallet.WitnessScriptHash([]byte{byte(i)})
+		if err != nil {
+			t.Fatalf("unable to create funding script: %v", err)
+		}
+		outputs[i] = wire.NewTxOut(
+			int64(btcutil.SatoshiPerBitcoin), pkScript,
+		)
+	}
+
+	fundingTx, err := alice.CreateBatchFundingTx(outputs, feePerKw, 1)
+	if err != nil {
+		t.Fatalf("unable to create batch funding tx: %v", err)
+	}
+
+	// Both funding outputs should be found within the transaction, along
+	// with the change output.
+	for _, output := range outputs {
+		found, _ := lnwallet.FindScriptOutputIndex(
+			fundingTx, output.PkScript,
+		)
+		if !found {
+			t.Fatalf("funding output not found in batch funding tx")
+		}
+	}
+	if len(fundingTx.TxOut) != len(outputs)+1 {
+		t.Fatalf("expected %v outputs, instead have %v",
+			len(outputs)+1, len(fundingTx.TxOut))
+	}
+
+	// All of its inputs should be signed and locked.
+	if len(alice.LockedOutpoints()) != len(fundingTx.TxIn) {
+		t.Fatalf("expected %v locked outpoints, instead have %v",
+			len(fundingTx.TxIn), len(alice.LockedOutpoints()))
+	}
+	for _, txIn := range fundingTx.TxIn {
+		if len(txIn.Witness) == 0 {
+			t.Fatalf("batch funding tx input not signed")
+		}
+	}
+
+	// Once released, the inputs should be available again.
+	alice.ReleaseBatchFundingTx(fundingTx)
+	if len(alice.LockedOutpoints()) != 0 {
+		t.Fatalf("outpoints still locked")
+	}
+}
+
 var walletTests = []walletTestCase{
 	{
 		// TODO(wilmer): this test should remain first until the wallet
@@ -2187,6 +2406,14 @@ var walletTests = []walletTestCase{
 		name: "single funding workflow",
 		test: testSingleFunderReservationWorkflow,
 	},
+	{
+		name: "external funding workflow",
+		test: testExternalFundingReservationWorkflow,
+	},
+	{
+		name: "batch funding tx",
+		test: testBatchFundingTx,
+	},
 	{
 		name: "dual funder workflow",
 		test: testDualFundingReservationWorkflow,
@@ -1,11 +1,14 @@
 package lnwallet
 
 import (
+	"bytes"
+	"fmt"
 	"net"
 	"sync"
 
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/lnwire"
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -26,6 +29,11 @@ type ChannelContribution struct {
 	// Inputs to the funding transaction.
 	Inputs []*wire.TxIn
 
+	// PrevOutputs are the outputs spent by each of the Inputs, in the same
+	// order. They're used to verify the counterparty's input signatures
+	// within a dual funder workflow.
+	PrevOutputs []*wire.TxOut
+
 	// ChangeOutputs are the Outputs to be used in the case that the total
 	// value of the funding inputs is greater than the total potential
 	// channel capacity.
@@ -49,6 +57,24 @@ func (c *ChannelContribution) toChanConfig() channeldb.ChannelConfig {
 	return *c.ChannelConfig
 }
 
+// prevOutput returns the output spent by the contribution's input which
+// references the passed outpoint. If no such input is found, or the output it
+// spends is unknown, then nil is returned.
+func (c *ChannelContribution) prevOutput(op *wire.OutPoint) *wire.TxOut {
+	for i, txIn := range c.Inputs {
+		if txIn.PreviousOutPoint != *op {
+			continue
+		}
+		if i >= len(c.PrevOutputs) {
+			return nil
+		}
+
+		return c.PrevOutputs[i]
+	}
+
+	return nil
+}
+
 // InputScript represents any script inputs required to redeem a previous
 // output. This struct is used rather than just a witness, or scripSig in
 // order to accommodate nested p2sh which utilizes both types of input scripts.
@@ -120,6 +146,13 @@ type ChannelReservation struct {
 	// commitment state.
 	pushMSat lnwire.MilliSatoshi
 
+	// externalFunding is true if the funding transaction of this
+	// reservation is constructed outside of the wallet. In this case, the
+	// funding workflow pauses after the counterparty's contribution has
+	// been processed, until the funding transaction is handed over via
+	// .ProcessExternalFunding().
+	externalFunding bool
+
 	// chanOpen houses a struct containing the channel and additional
 	// confirmation details will be sent on once the channel is considered
 	// 'open'. A channel is open once the funding transaction has reached a
@@ -150,10 +183,11 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
 	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
 
+	switch {
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
 	// some funds to us within the first commitment state.
-	if fundingAmt == 0 {
+	case fundingAmt == 0:
 		ourBalance = pushMSat
 		theirBalance = capacityMSat - feeMSat - pushMSat
 		initiator = false
@@ -166,25 +200,37 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 				int64(2*DefaultDustLimit()),
 			)
 		}
-	} else {
-		// TODO(roasbeef): need to rework fee structure in general and
-		// also when we "unlock" dual funder within the daemon
-
-		if capacity == fundingAmt {
-			// If we're initiating a single funder workflow, then
-			// we pay all the initial fees within the commitment
-			// transaction. We also deduct our balance by the
-			// amount pushed as part of the initial state.
-			ourBalance = capacityMSat - feeMSat - pushMSat
-			theirBalance = pushMSat
-		} else {
-			// Otherwise, this is a dual funder workflow where both
-			// slides split the amount funded and the commitment
-			// fee.
-			ourBalance = fundingMSat - (feeMSat / 2)
-			theirBalance = capacityMSat - fundingMSat - (feeMSat / 2) + pushMSat
+
+	// If we only fund a portion of the channel, then we're the responder
+	// to a dual funder workflow. The initiator still pays all the fees
+	// within the commitment transaction, and there's no pushing for dual
+	// funder channels.
+	case fundingAmt != capacity:
+		if pushMSat != 0 {
+			return nil, ErrNonZeroPushAmount()
 		}
 
+		ourBalance = fundingMSat
+		theirBalance = capacityMSat - fundingMSat - feeMSat
+		initiator = false
+
+		// If the initiator doesn't have enough funds to actually pay
+		// the fees, then we'll bail our early.
+		if int64(theirBalance) < 0 {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee), int64(theirBalance.ToSatoshis()),
+				int64(2*DefaultDustLimit()),
+			)
+		}
+
+	// Otherwise, we're initiating the workflow, so we pay all the initial
+	// fees within the commitment transaction. We also deduct our balance
+	// by the amount pushed as part of the initial state. Should the
+	// remote party contribute funds of their own, the reservation will be
+	// converted to a dual funder one once their contribution is processed.
+	default:
+		ourBalance = capacityMSat - feeMSat - pushMSat
+		theirBalance = pushMSat
 		initiator = true
 
 		// If we, the initiator don't have enough funds to actually pay
@@ -211,19 +257,14 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 	}
 
 	// Next we'll set the channel type based on what we can ascertain about
-	// the balances/push amount within the channel.
+	// the amount we fund. If we only fund a portion of the capacity, then
+	// this is a dual funder channel. Otherwise, this is a single-funder
+	// channel, at least until the remote party contributes funds.
 	var chanType channeldb.ChannelType
-
-	// If either of the balances are zero at this point, or we have a
-	// non-zero push amt (there's no pushing for dual funder), then this is
-	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || pushMSat != 0 {
-		chanType = channeldb.SingleFunder
-	} else {
-		// Otherwise, this is a dual funder channel, and no side is
-		// technically the "initiator"
-		initiator = false
+	if fundingAmt != 0 && fundingAmt != capacity {
 		chanType = channeldb.DualFunder
+	} else {
+		chanType = channeldb.SingleFunder
 	}
 
 	return &ChannelReservation{
@@ -400,6 +441,132 @@ func (r *ChannelReservation) ProcessSingleContribution(theirContribution *Channe
 	return <-errChan
 }
 
+// VerifyCommitmentSig verifies that the passed signature of the counterparty
+// is valid for our version of the commitment transaction. This allows the
+// responder to a dual funder workflow to ensure it holds a valid commitment
+// before handing over the signatures for its inputs to the funding
+// transaction.
+//
+// NOTE: This method should only be called after a call to
+// .ProcessContribution().
+func (r *ChannelReservation) VerifyCommitmentSig(commitmentSig []byte) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	return verifyCommitSig(r, commitmentSig)
+}
+
+// FundingOutput returns the 2-of-2 multi-sig output that the funding
+// transaction of this reservation must pay to.
+//
+// NOTE: This method should only be called after a call to
+// .ProcessContribution().
+func (r *ChannelReservation) FundingOutput() (*wire.TxOut, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	_, fundingOut, err := r.fundingScripts()
+	return fundingOut, err
+}
+
+// VerifyFundingTx verifies that the passed transaction, constructed outside
+// of the wallet, pays the exact amount of the channel to the 2-of-2 multi-sig
+// output of this reservation. The transaction doesn't need to be signed yet.
+//
+// NOTE: This method should only be called after a call to
+// .ProcessContribution().
+func (r *ChannelReservation) VerifyFundingTx(fundingTx *wire.MsgTx) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	_, fundingOut, err := r.fundingScripts()
+	if err != nil {
+		return err
+	}
+
+	_, err = findFundingOutput(fundingTx, fundingOut)
+	return err
+}
+
+// ProcessExternalFunding hands the funding transaction of a reservation that
+// is funded externally over to the wallet. Once the transaction has been
+// verified to pay to the funding output, both commitment transactions are
+// constructed, and a signature for the counterparty's version is generated,
+// exactly as .ProcessContribution() would for a funding transaction
+// constructed by the wallet itself.
+func (r *ChannelReservation) ProcessExternalFunding(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addExternalFundingMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// fundingScripts returns the witness script of the 2-of-2 multi-sig output
+// of this reservation, along with the output itself.
+//
+// NOTE: The caller MUST hold the reservation's mutex.
+func (r *ChannelReservation) fundingScripts() ([]byte, *wire.TxOut, error) {
+	if r.theirContribution == nil {
+		return nil, nil, fmt.Errorf("counterparty contribution not " +
+			"yet processed")
+	}
+
+	ourKey := r.ourContribution.MultiSigKey.PubKey
+	theirKey := r.theirContribution.MultiSigKey.PubKey
+	return GenFundingPkScript(
+		ourKey.SerializeCompressed(), theirKey.SerializeCompressed(),
+		int64(r.partialState.Capacity),
+	)
+}
+
+// findFundingOutput returns the index of the given funding output within the
+// passed transaction. An error is returned if the transaction isn't sane, or
+// doesn't carry exactly one output paying the exact amount of the funding
+// output to its script.
+func findFundingOutput(fundingTx *wire.MsgTx,
+	fundingOut *wire.TxOut) (uint32, error) {
+
+	err := blockchain.CheckTransactionSanity(btcutil.NewTx(fundingTx))
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		index uint32
+		found bool
+	)
+	for i, txOut := range fundingTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingOut.PkScript) {
+			continue
+		}
+		if found {
+			return 0, fmt.Errorf("funding tx %v pays to the "+
+				"funding output more than once",
+				fundingTx.TxHash())
+		}
+		if txOut.Value != fundingOut.Value {
+			return 0, fmt.Errorf("funding output of tx %v has "+
+				"value %v, expected %v", fundingTx.TxHash(),
+				btcutil.Amount(txOut.Value),
+				btcutil.Amount(fundingOut.Value))
+		}
+
+		index = uint32(i)
+		found = true
+	}
+	if !found {
+		return 0, fmt.Errorf("funding tx %v doesn't pay to the "+
+			"funding output", fundingTx.TxHash())
+	}
+
+	return index, nil
+}
+
 // TheirContribution returns the counterparty's pending contribution to the
 // payment channel. See 'ChannelContribution' for further details regarding the
 // contents of a contribution. This attribute will ONLY be available after a
@@ -97,6 +97,12 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// ExternalFunding indicates that the funding transaction will be
+	// constructed outside of the wallet. If set, no coin selection is
+	// performed, and the funding transaction must later be handed over
+	// via the reservation's ProcessExternalFunding method.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -139,6 +145,21 @@ type addContributionMsg struct {
 	err chan error
 }
 
+// addExternalFundingMsg represents a message handing over the funding
+// transaction of an externally funded reservation, after the counterparty's
+// contribution has been processed. Once the funding transaction has been
+// verified, both commitment transactions are constructed, and a signature
+// for the remote node's version of the commitment transaction is generated.
+type addExternalFundingMsg struct {
+	pendingFundingID uint64
+
+	// fundingTx is the funding transaction as constructed externally.
+	fundingTx *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addSingleContributionMsg represents a message executing the second phase of
 // a single funder channel reservation workflow. This messages carries the
 // counterparty's "contribution" to the payment channel. As this message is
@@ -380,6 +401,8 @@ out:
 				l.handleSingleContribution(msg)
 			case *addContributionMsg:
 				l.handleContributionMsg(msg)
+			case *addExternalFundingMsg:
+				l.handleExternalFunding(msg)
 			case *addSingleFunderSigsMsg:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
@@ -465,15 +488,20 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	reservation.nodeAddr = req.NodeAddr
 	reservation.partialState.IdentityPub = req.NodeID
+	reservation.externalFunding = req.ExternalFunding
+	if req.ExternalFunding {
+		reservation.partialState.ChanType = channeldb.SingleFunderExternal
+	}
 
-	// If we're on the receiving end of a single funder channel then we
-	// don't need to perform any coin selection. Otherwise, attempt to
-	// obtain enough coins to meet the required funding amount.
-	if req.FundingAmount != 0 {
+	// If we're on the receiving end of a single funder channel, or the
+	// funding transaction is constructed externally, then we don't need
+	// to perform any coin selection. Otherwise, attempt to obtain enough
+	// coins to meet the required funding amount.
+	if req.FundingAmount != 0 && !req.ExternalFunding {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
-			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.FundingFeePerKw, req.FundingAmount, req.MinConfs, 1,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -691,6 +719,29 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	pendingReservation.theirContribution = req.contribution
 	theirContribution := req.contribution
 	ourContribution := pendingReservation.ourContribution
+	chanState := pendingReservation.partialState
+
+	// If we initiated this workflow and the remote party contributes
+	// funds of their own, then this is now a dual funder channel. Their
+	// contribution is added to the capacity of the channel, and credited
+	// to their balance in both commitment transactions. As the initiator,
+	// we continue to pay all the fees within the commitment transaction.
+	if chanState.IsInitiator && chanState.ChanType == channeldb.SingleFunder &&
+		theirContribution.FundingAmount != 0 {
+
+		if pendingReservation.pushMSat != 0 {
+			req.err <- ErrNonZeroPushAmount()
+			return
+		}
+
+		theirFunds := lnwire.NewMSatFromSatoshis(
+			theirContribution.FundingAmount,
+		)
+		chanState.ChanType = channeldb.DualFunder
+		chanState.Capacity += theirContribution.FundingAmount
+		chanState.LocalCommitment.RemoteBalance += theirFunds
+		chanState.RemoteCommitment.RemoteBalance += theirFunds
+	}
 
 	// Add all multi-party inputs and outputs to the transaction.
 	for _, ourInput := range ourContribution.Inputs {
@@ -721,6 +772,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		return
 	}
 
+	// If the funding transaction is constructed externally, then we're
+	// done for now. We'll construct and sign the commitment transactions
+	// once it has been handed over to us.
+	if pendingReservation.externalFunding {
+		pendingReservation.fundingTx = nil
+		req.err <- nil
+		return
+	}
+
 	// Sort the transaction. Since both side agree to a canonical ordering,
 	// by sorting we no longer need to send the entire transaction. Only
 	// signatures will be exchanged.
@@ -765,12 +825,99 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
-	fundingTxID := fundingTx.TxHash()
 	_, multiSigIndex := FindScriptOutputIndex(fundingTx, multiSigOut.PkScript)
+
+	err = l.signInitialCommitment(
+		pendingReservation, multiSigIndex, witnessScript, multiSigOut,
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// handleExternalFunding processes the funding transaction of a reservation
+// that is funded externally. Once the transaction has been verified to pay
+// to the funding output, the reservation will carry both versions of the
+// commitment transaction, and our signature for their version, exactly as
+// after handleContributionMsg for a funding transaction constructed by the
+// wallet itself.
+func (l *LightningWallet) handleExternalFunding(req *addExternalFundingMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	if !pendingReservation.externalFunding {
+		req.err <- fmt.Errorf("reservation isn't funded externally")
+		return
+	}
+	if pendingReservation.fundingTx != nil {
+		req.err <- fmt.Errorf("funding transaction already provided")
+		return
+	}
+
+	witnessScript, multiSigOut, err := pendingReservation.fundingScripts()
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	// Before going any further, we'll ensure the funding transaction pays
+	// the exact amount of the channel to the 2-of-2 multi-sig output.
+	multiSigIndex, err := findFundingOutput(req.fundingTx, multiSigOut)
+	if err != nil {
+		req.err <- err
+		return
+	}
+	pendingReservation.fundingTx = req.fundingTx
+
+	walletLog.Debugf("External funding tx for ChannelPoint(%v:%v) "+
+		"provided: %v", req.fundingTx.TxHash(), multiSigIndex,
+		spew.Sdump(req.fundingTx))
+
+	err = l.signInitialCommitment(
+		pendingReservation, multiSigIndex, witnessScript, multiSigOut,
+	)
+	if err != nil {
+		pendingReservation.fundingTx = nil
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// signInitialCommitment records the funding outpoint of the reservation's
+// funding transaction, then constructs both versions of the initial
+// commitment transaction, signing the remote party's version.
+//
+// NOTE: The caller MUST hold the reservation's mutex.
+func (l *LightningWallet) signInitialCommitment(
+	pendingReservation *ChannelReservation, multiSigIndex uint32,
+	witnessScript []byte, multiSigOut *wire.TxOut) error {
+
+	// Some temporary variables to cut down on the resolution verbosity.
+	fundingTx := pendingReservation.fundingTx
+	theirContribution := pendingReservation.theirContribution
+	ourContribution := pendingReservation.ourContribution
+	ourKey := ourContribution.MultiSigKey
+	chanState := pendingReservation.partialState
+
+	fundingTxID := fundingTx.TxHash()
 	fundingOutpoint := wire.NewOutPoint(&fundingTxID, multiSigIndex)
 	pendingReservation.partialState.FundingOutpoint = *fundingOutpoint
 
-	walletLog.Debugf("Funding tx for ChannelPoint(%v) generated: %v",
+	walletLog.Debugf("Funding tx for ChannelPoint(%v): %v",
 		fundingOutpoint, spew.Sdump(fundingTx))
 
 	// Initialize an empty sha-chain for them, tracking the current pending
@@ -782,7 +929,6 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// Store their current commitment point. We'll need this after the
 	// first state transition in order to verify the authenticity of the
 	// revocation.
-	chanState := pendingReservation.partialState
 	chanState.RemoteCurrentRevocation = theirContribution.FirstCommitmentPoint
 
 	// Create the txin to our commitment transaction; required to construct
@@ -804,39 +950,28 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
 	)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
-	// both commitment transactions.
+	// both commitment transactions. The initiator's key always comes
+	// first, matching the derivation used once the channel is open.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	if chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
 	} else {
-		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		switch bytes.Compare(ourSer, theirSer) {
-		case -1:
-			stateObfuscator = DeriveStateHintObfuscator(
-				ourContribution.PaymentBasePoint.PubKey,
-				theirContribution.PaymentBasePoint.PubKey,
-			)
-		default:
-			stateObfuscator = DeriveStateHintObfuscator(
-				theirContribution.PaymentBasePoint.PubKey,
-				ourContribution.PaymentBasePoint.PubKey,
-			)
-		}
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// Sort both transactions according to the agreed upon canonical
@@ -857,7 +992,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = SignDescriptor{
+	signDesc := SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -867,12 +1002,11 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	sigTheirCommit, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 	pendingReservation.ourCommitmentSig = sigTheirCommit
 
-	req.err <- nil
+	return nil
 }
 
 // handleSingleContribution is called as the second step to a single funder
@@ -951,6 +1085,16 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// signatures to their inputs.
 	res.theirFundingInputScripts = msg.theirFundingInputScripts
 	inputScripts := msg.theirFundingInputScripts
+	if len(inputScripts) != 0 &&
+		len(inputScripts) != len(res.theirContribution.Inputs) {
+
+		msg.err <- fmt.Errorf("expected %v funding input scripts, "+
+			"got %v", len(res.theirContribution.Inputs),
+			len(inputScripts))
+		msg.completeChan <- nil
+		return
+	}
+
 	fundingTx := res.fundingTx
 	sigIndex := 0
 	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
@@ -961,18 +1105,24 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 			txin.SignatureScript = inputScripts[sigIndex].ScriptSig
 
 			// Fetch the alleged previous output along with the
-			// pkscript referenced by this input.
+			// pkscript referenced by this input, as given to us
+			// within their contribution.
 			//
 			// TODO(roasbeef): when dual funder pass actual
 			// height-hint
-			pkScript, err := WitnessScriptHash(
-				txin.Witness[len(txin.Witness)-1],
+			prevOut := res.theirContribution.prevOutput(
+				&txin.PreviousOutPoint,
 			)
-			if err != nil {
+			if prevOut == nil {
+				msg.err <- fmt.Errorf("no previous output "+
+					"known for funding input %v",
+					txin.PreviousOutPoint)
+				msg.completeChan <- nil
+				return
 			}
 			output, err := l.Cfg.ChainIO.GetUtxo(
 				&txin.PreviousOutPoint,
-				pkScript, 0,
+				prevOut.PkScript, 0,
 			)
 			if output == nil {
 				msg.err <- fmt.Errorf("input to funding tx "+
@@ -980,6 +1130,14 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 				msg.completeChan <- nil
 				return
 			}
+			if output.Value != prevOut.Value {
+				msg.err <- fmt.Errorf("input to funding tx "+
+					"%v has value %v, expected %v",
+					txin.PreviousOutPoint, output.Value,
+					prevOut.Value)
+				msg.completeChan <- nil
+				return
+			}
 
 			// Ensure that the witness+sigScript combo is valid.
 			vm, err := txscript.NewEngine(output.PkScript,
@@ -1005,48 +1163,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// At this point, we can also record and verify their signature for our
 	// commitment transaction.
 	res.theirCommitmentSig = msg.theirCommitmentSig
-	commitTx := res.partialState.LocalCommitment.CommitTx
-	ourKey := res.ourContribution.MultiSigKey
-	theirKey := res.theirContribution.MultiSigKey
-
-	// Re-generate both the witnessScript and p2sh output. We sign the
-	// witnessScript script, but include the p2sh output as the subscript
-	// for verification.
-	witnessScript, _, err := GenFundingPkScript(
-		ourKey.PubKey.SerializeCompressed(),
-		theirKey.PubKey.SerializeCompressed(),
-		int64(res.partialState.Capacity),
-	)
-	if err != nil {
-		msg.err <- err
-		msg.completeChan <- nil
-		return
-	}
-
-	// Next, create the spending scriptSig, and then verify that the script
-	// is complete, allowing us to spend from the funding transaction.
-	channelValue := int64(res.partialState.Capacity)
-	hashCache := txscript.NewTxSigHashes(commitTx)
-	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
-		txscript.SigHashAll, commitTx, 0, channelValue)
-	if err != nil {
-		msg.err <- err
-		msg.completeChan <- nil
-		return
-	}
-
-	// Verify that we've received a valid signature from the remote party
-	// for our version of the commitment transaction.
 	theirCommitSig := msg.theirCommitmentSig
-	sig, err := btcec.ParseSignature(theirCommitSig, btcec.S256())
-	if err != nil {
+	if err := verifyCommitSig(res, theirCommitSig); err != nil {
 		msg.err <- err
 		msg.completeChan <- nil
 		return
-	} else if !sig.Verify(sigHash, theirKey.PubKey) {
-		msg.err <- fmt.Errorf("counterparty's commitment signature is invalid")
-		msg.completeChan <- nil
-		return
 	}
 	res.partialState.LocalCommitment.CommitSig = theirCommitSig
 
@@ -1091,6 +1212,50 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	msg.err <- nil
 }
 
+// verifyCommitSig verifies that the passed signature of the counterparty is
+// valid for our version of the commitment transaction within the target
+// reservation.
+//
+// NOTE: The caller MUST hold the reservation's mutex.
+func verifyCommitSig(res *ChannelReservation, theirCommitSig []byte) error {
+	commitTx := res.partialState.LocalCommitment.CommitTx
+	ourKey := res.ourContribution.MultiSigKey
+	theirKey := res.theirContribution.MultiSigKey
+
+	// Re-generate both the witnessScript and p2sh output. We sign the
+	// witnessScript script, but include the p2sh output as the subscript
+	// for verification.
+	witnessScript, _, err := GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(res.partialState.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Next, create the spending scriptSig, and then verify that the script
+	// is complete, allowing us to spend from the funding transaction.
+	channelValue := int64(res.partialState.Capacity)
+	hashCache := txscript.NewTxSigHashes(commitTx)
+	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
+		txscript.SigHashAll, commitTx, 0, channelValue)
+	if err != nil {
+		return err
+	}
+
+	// Verify that we've received a valid signature from the remote party
+	// for our version of the commitment transaction.
+	sig, err := btcec.ParseSignature(theirCommitSig, btcec.S256())
+	if err != nil {
+		return err
+	} else if !sig.Verify(sigHash, theirKey.PubKey) {
+		return fmt.Errorf("counterparty's commitment signature is invalid")
+	}
+
+	return nil
+}
+
 // handleSingleFunderSigs is called once the remote peer who initiated the
 // single funder workflow has assembled the funding transaction, and generated
 // a signature for our version of the commitment transaction. This method
@@ -1251,14 +1416,192 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	l.limboMtx.Unlock()
 }
 
+// CreateBatchFundingTx creates a transaction paying to all of the passed
+// channel funding outputs at once, funded from the coins of the wallet that
+// satisfy the minimum number of confirmations. The inputs of the transaction
+// are locked and signed, such that the transaction is ready to be handed over
+// to the reservations of the channels it funds. If the transaction is never
+// published, then its inputs must be released with ReleaseBatchFundingTx.
+func (l *LightningWallet) CreateBatchFundingTx(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32) (*wire.MsgTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("batch funding tx requires at least " +
+			"one funding output")
+	}
+
+	var amt btcutil.Amount
+	for _, output := range outputs {
+		amt += btcutil.Amount(output.Value)
+	}
+
+	contribution := &ChannelContribution{}
+	err := l.selectCoinsAndChange(
+		feeRate, amt, minConfs, len(outputs), contribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fundingTx := wire.NewMsgTx(2)
+	for _, txIn := range contribution.Inputs {
+		fundingTx.AddTxIn(txIn)
+	}
+	for _, output := range outputs {
+		fundingTx.AddTxOut(output)
+	}
+	for _, changeOutput := range contribution.ChangeOutputs {
+		fundingTx.AddTxOut(changeOutput)
+	}
+	txsort.InPlaceSort(fundingTx)
+
+	// With the transaction in its final order, we'll sign all of its
+	// inputs, all of which are ours.
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(fundingTx),
+	}
+	for i, txIn := range fundingTx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			l.ReleaseBatchFundingTx(fundingTx)
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			fundingTx, &signDesc,
+		)
+		if err != nil {
+			l.ReleaseBatchFundingTx(fundingTx)
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.ScriptSig
+		txIn.Witness = inputScript.Witness
+	}
+
+	walletLog.Infof("Created batch funding tx %v paying to %v funding "+
+		"outputs", fundingTx.TxHash(), len(outputs))
+
+	return fundingTx, nil
+}
+
+// ReleaseBatchFundingTx unlocks the inputs of a transaction created by
+// CreateBatchFundingTx, making them available to future funding requests.
+// This should only be called if the transaction will never be published.
+func (l *LightningWallet) ReleaseBatchFundingTx(fundingTx *wire.MsgTx) {
+	l.ReleaseFundingInputs(fundingTx)
+}
+
+// ReleaseFundingInputs unlocks the inputs of a funding transaction that will
+// never confirm, such as the one of a dual funded channel that timed out,
+// making those of our wallet available to future funding requests.
+func (l *LightningWallet) ReleaseFundingInputs(fundingTx *wire.MsgTx) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, txIn := range fundingTx.TxIn {
+		delete(l.lockedOutPoints, txIn.PreviousOutPoint)
+		l.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
+// CreateFundingCancelTx creates a transaction spending the inputs we
+// contributed to the passed funding transaction back to our wallet at the
+// given fee rate. Once it confirms, the funding transaction is no longer able
+// to, which allows the responder of a dual funded channel that handed over the
+// signatures for its inputs to safely forget the channel.
+func (l *LightningWallet) CreateFundingCancelTx(fundingTx *wire.MsgTx,
+	feeRate SatPerKWeight) (*wire.MsgTx, error) {
+
+	cancelTx := wire.NewMsgTx(2)
+	var (
+		prevOutputs    []*wire.TxOut
+		totalIn        btcutil.Amount
+		weightEstimate TxWeightEstimator
+	)
+	for _, txIn := range fundingTx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == ErrNotMine {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch txscript.GetScriptClass(info.PkScript) {
+		case txscript.WitnessV0PubKeyHashTy:
+			weightEstimate.AddP2WKHInput()
+		case txscript.ScriptHashTy:
+			weightEstimate.AddNestedP2WKHInput()
+		default:
+			return nil, fmt.Errorf("unsupported script of funding "+
+				"input %v", txIn.PreviousOutPoint)
+		}
+
+		cancelTx.AddTxIn(wire.NewTxIn(&txIn.PreviousOutPoint, nil, nil))
+		prevOutputs = append(prevOutputs, info)
+		totalIn += btcutil.Amount(info.Value)
+	}
+	if len(cancelTx.TxIn) == 0 {
+		return nil, fmt.Errorf("funding tx %v spends none of our "+
+			"outputs", fundingTx.TxHash())
+	}
+
+	addr, err := l.NewAddress(WitnessPubKey, false)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	weightEstimate.AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	if totalIn-fee <= DefaultDustLimit() {
+		return nil, fmt.Errorf("our inputs of funding tx %v worth %v "+
+			"don't cover the fee of %v", fundingTx.TxHash(),
+			totalIn, fee)
+	}
+	cancelTx.AddTxOut(wire.NewTxOut(int64(totalIn-fee), pkScript))
+
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(cancelTx),
+	}
+	for i, txIn := range cancelTx.TxIn {
+		signDesc.Output = prevOutputs[i]
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			cancelTx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.ScriptSig
+		txIn.Witness = inputScript.Witness
+	}
+
+	walletLog.Infof("Created tx %v canceling funding tx %v",
+		cancelTx.TxHash(), fundingTx.TxHash())
+
+	return cancelTx, nil
+}
+
 // selectCoinsAndChange performs coin selection in order to obtain witness
-// outputs which sum to at least 'numCoins' amount of satoshis. If coin
-// selection is successful/possible, then the selected coins are available
-// within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// outputs which sum to at least 'numCoins' amount of satoshis, to be paid to
+// numOutputs channel funding outputs. If coin selection is
+// successful/possible, then the selected coins are available within the
+// passed contribution's inputs. If necessary, a change address will also be
+// generated.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
+	amt btcutil.Amount, minConfs int32, numOutputs int,
 	contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
@@ -1280,7 +1623,9 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, numOutputs, coins,
+	)
 	if err != nil {
 		return err
 	}
@@ -1289,6 +1634,7 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// prevents concurrent funding requests from referring to and this
 	// double-spending the same set of coins.
 	contribution.Inputs = make([]*wire.TxIn, len(selectedCoins))
+	contribution.PrevOutputs = make([]*wire.TxOut, len(selectedCoins))
 	for i, coin := range selectedCoins {
 		outpoint := &coin.OutPoint
 		l.lockedOutPoints[*outpoint] = struct{}{}
@@ -1297,6 +1643,9 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 		// Empty sig script, we'll actually sign if this reservation is
 		// queued up to be completed (the other side accepts).
 		contribution.Inputs[i] = wire.NewTxIn(outpoint, nil, nil)
+		contribution.PrevOutputs[i] = wire.NewTxOut(
+			int64(coin.Value), coin.PkScript,
+		)
 	}
 
 	// Record any change output(s) generated as a result of the coin
@@ -1375,10 +1724,10 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 }
 
 // coinSelect attempts to select a sufficient amount of coins, including a
-// change output to fund amt satoshis, adhering to the specified fee rate. The
-// specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
-func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
+// change output to fund amt satoshis split over numOutputs channel funding
+// outputs, adhering to the specified fee rate. The specified fee rate should
+// be expressed in sat/kw for coin selection to function properly.
+func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount, numOutputs int,
 	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
 
 	amtNeeded := amt
@@ -1404,8 +1753,10 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			}
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
+		// Channel funding multisig outputs are P2WSH.
+		for i := 0; i < numOutputs; i++ {
+			weightEstimate.AddP2WSHOutput()
+		}
 
 		// Assume that change output is a P2WKH output.
 		//
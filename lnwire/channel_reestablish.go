@@ -60,6 +60,11 @@ type ChannelReestablish struct {
 	// LocalUnrevokedCommitPoint is the commitment point used in the
 	// current un-revoked commitment transaction of the sending party.
 	LocalUnrevokedCommitPoint *btcec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
 }
 
 // A compile time check to ensure ChannelReestablish implements the
@@ -81,14 +86,15 @@ func (a *ChannelReestablish) Encode(w io.Writer, pver uint32) error {
 	}
 
 	// If the commit point wasn't sent, then we won't write out any of the
-	// remaining fields as they're optional.
+	// remaining fields as they're optional. The extra data can only follow
+	// the commit point, so it is omitted as well.
 	if a.LocalUnrevokedCommitPoint == nil {
 		return nil
 	}
 
 	// Otherwise, we'll write out the remaining elements.
 	return writeElements(w, a.LastRemoteCommitSecret[:],
-		a.LocalUnrevokedCommitPoint)
+		a.LocalUnrevokedCommitPoint, a.ExtraData)
 }
 
 // Decode deserializes a serialized ChannelReestablish stored in the passed
@@ -126,10 +132,16 @@ func (a *ChannelReestablish) Decode(r io.Reader, pver uint32) error {
 	// If the field is present, then we'll copy it over and proceed.
 	copy(a.LastRemoteCommitSecret[:], buf[:])
 
-	// We'll conclude by parsing out the commitment point. We don't check
-	// the error in this case, as it hey included the commit secret, then
-	// they MUST also include the commit point.
-	return readElement(r, &a.LocalUnrevokedCommitPoint)
+	// Next we'll parse out the commitment point. We don't check the error
+	// in this case, as it hey included the commit secret, then they MUST
+	// also include the commit point.
+	err = readElement(r, &a.LocalUnrevokedCommitPoint)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll read any extra data that trails the commit point.
+	return a.ExtraData.Decode(r)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -145,22 +157,5 @@ func (a *ChannelReestablish) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (a *ChannelReestablish) MaxPayloadLength(pver uint32) uint32 {
-	var length uint32
-
-	// ChanID - 32 bytes
-	length += 32
-
-	// NextLocalCommitHeight - 8 bytes
-	length += 8
-
-	// RemoteCommitTailHeight - 8 bytes
-	length += 8
-
-	// LastRemoteCommitSecret - 32 bytes
-	length += 32
-
-	// LocalUnrevokedCommitPoint - 33 bytes
-	length += 33
-
-	return length
+	return MaxMessagePayload
 }
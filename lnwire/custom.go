@@ -0,0 +1,97 @@
+package lnwire
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// CustomTypeStart is the start of the range of message types reserved for
+// custom messages, which applications use to run their own protocols over
+// existing peer connections. All message types of at least CustomTypeStart
+// are treated as custom messages.
+const CustomTypeStart MessageType = 32768
+
+var (
+	// ErrNotCustomType is returned when creating a custom message with a
+	// type below CustomTypeStart.
+	ErrNotCustomType = errors.New("custom message type is below the " +
+		"custom type range")
+
+	// ErrReservedCustomType is returned when creating a custom message
+	// with a type within the custom range that's used by one of our own
+	// experimental messages.
+	ErrReservedCustomType = errors.New("custom message type is reserved")
+)
+
+// Custom is a message of a type within the custom range. Its payload is
+// opaque to us and left for applications to interpret.
+type Custom struct {
+	// Type is the message type, which is at least CustomTypeStart.
+	Type MessageType
+
+	// Data is the opaque payload of the message.
+	Data []byte
+}
+
+// NewCustom creates a new custom message of the given type carrying the
+// passed data. An error is returned if the type is outside the custom range,
+// or reserved for one of our own messages.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if msgType < CustomTypeStart {
+		return nil, ErrNotCustomType
+	}
+
+	msg, err := makeEmptyMessage(msgType)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := msg.(*Custom); !ok {
+		return nil, ErrReservedCustomType
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// Decode deserializes a serialized Custom message stored in the passed
+// io.Reader observing the specified protocol version. The entire remainder of
+// the message is read as its payload.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	var err error
+	c.Data, err = ioutil.ReadAll(r)
+	return err
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a Custom
+// complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,26 @@
+package lnwire
+
+import "testing"
+
+// TestNewCustom asserts that custom messages can only be created with a type
+// within the custom range, that isn't reserved for our own messages.
+func TestNewCustom(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCustom(CustomTypeStart-1, nil); err != ErrNotCustomType {
+		t.Fatalf("expected ErrNotCustomType, got %v", err)
+	}
+
+	if _, err := NewCustom(MsgTxAddInput, nil); err != ErrReservedCustomType {
+		t.Fatalf("expected ErrReservedCustomType, got %v", err)
+	}
+
+	msg, err := NewCustom(CustomTypeStart, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+	if msg.MsgType() != CustomTypeStart {
+		t.Fatalf("expected type %v, got %v", CustomTypeStart,
+			msg.MsgType())
+	}
+}
@@ -0,0 +1,229 @@
+package lnwire
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/breez/lightninglib/tlv"
+)
+
+// TestExtraOpaqueDataEncodeDecode tests that we're able to encode/decode
+// arbitrary payloads.
+func TestExtraOpaqueDataEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		// emptyBytes indicates if we should try to encode empty bytes
+		// or not.
+		emptyBytes bool
+
+		// inputBytes if emptyBytes is false, then we'll read in this
+		// set of bytes instead.
+		inputBytes []byte
+	}
+
+	// We should be able to read in an arbitrary set of bytes as an
+	// ExtraOpaqueData, then encode those new bytes into a new instance.
+	// The final two instances should be identical.
+	scenario := func(test testCase) bool {
+		var b bytes.Buffer
+		extraData := ExtraOpaqueData(test.inputBytes)
+
+		if err := extraData.Encode(&b); err != nil {
+			t.Fatalf("unable to encode extra data: %v", err)
+			return false
+		}
+
+		var newBytes ExtraOpaqueData
+		if err := newBytes.Decode(&b); err != nil {
+			t.Fatalf("unable to decode extra bytes: %v", err)
+			return false
+		}
+
+		if !bytes.Equal(extraData[:], newBytes[:]) {
+			t.Fatalf("expected %x, got %x", extraData,
+				newBytes)
+			return false
+		}
+
+		return true
+	}
+
+	// We'll make a function to generate random test data. Half of the
+	// time, we'll actually feed in blank bytes.
+	quickCfg := &quick.Config{
+		Values: func(v []reflect.Value, r *rand.Rand) {
+			var newTestCase testCase
+			if r.Int31()%2 == 0 {
+				newTestCase.emptyBytes = true
+			}
+
+			if !newTestCase.emptyBytes {
+				numBytes := r.Int31n(1000)
+				newTestCase.inputBytes = make([]byte, numBytes)
+
+				_, err := r.Read(newTestCase.inputBytes)
+				if err != nil {
+					t.Fatalf("unable to gen random bytes: %v", err)
+					return
+				}
+			}
+
+			v[0] = reflect.ValueOf(newTestCase)
+		},
+	}
+
+	if err := quick.Check(scenario, quickCfg); err != nil {
+		t.Fatalf("encode+decode test failed: %v", err)
+	}
+}
+
+// TestExtraOpaqueDataPackUnpackRecords tests that we're able to pack a set of
+// tlv.Records into a stream, and unpack them on the other side to obtain the
+// same set of records. Unknown odd records must be skipped, while unknown
+// even records must be rejected.
+func TestExtraOpaqueDataPackUnpackRecords(t *testing.T) {
+	t.Parallel()
+
+	var (
+		type1 tlv.Type = 1
+		type2 tlv.Type = 2
+		type3 tlv.Type = 3
+
+		channelType1 uint8 = 2
+		channelType2 uint8
+
+		hop1 uint32 = 99
+		hop2 uint32
+	)
+	testRecordsProducers := []tlv.RecordProducer{
+		&recordProducer{tlv.MakePrimitiveRecord(type1, &channelType1)},
+		&recordProducer{tlv.MakePrimitiveRecord(type2, &hop1)},
+	}
+
+	// Now that we have our set of sample records and types, we'll encode
+	// them into the passed ExtraOpaqueData instance.
+	var extraBytes ExtraOpaqueData
+	if err := extraBytes.PackRecords(testRecordsProducers...); err != nil {
+		t.Fatalf("unable to pack records: %v", err)
+	}
+
+	// We'll now simulate decoding these types _back_ into records on the
+	// other side.
+	newRecords := []tlv.RecordProducer{
+		&recordProducer{tlv.MakePrimitiveRecord(type1, &channelType2)},
+		&recordProducer{tlv.MakePrimitiveRecord(type2, &hop2)},
+	}
+	typeMap, err := extraBytes.ExtractRecords(newRecords...)
+	if err != nil {
+		t.Fatalf("unable to extract record: %v", err)
+	}
+
+	// We should find that the new backing values have been populated with
+	// the proper value.
+	switch {
+	case channelType1 != channelType2:
+		t.Fatalf("wrong record for channel type: expected %v, got %v",
+			channelType1, channelType2)
+
+	case hop1 != hop2:
+		t.Fatalf("wrong record for hop: expected %v, got %v", hop1,
+			hop2)
+	}
+
+	// Both types we created above should be found.
+	if _, ok := typeMap[type1]; !ok {
+		t.Fatalf("type1 not found in typeMap")
+	}
+	if _, ok := typeMap[type2]; !ok {
+		t.Fatalf("type2 not found in typeMap")
+	}
+
+	// If we only know of the odd record, the even one must be rejected.
+	channelType2 = 0
+	_, err = extraBytes.ExtractRecords(
+		&recordProducer{tlv.MakePrimitiveRecord(type1, &channelType2)},
+	)
+	if _, ok := err.(tlv.ErrUnknownRequiredType); !ok {
+		t.Fatalf("expected ErrUnknownRequiredType, got: %v", err)
+	}
+
+	// If we only know of the even record, the odd one is returned in its
+	// raw form.
+	hop2 = 0
+	typeMap, err = extraBytes.ExtractRecords(
+		&recordProducer{tlv.MakePrimitiveRecord(type2, &hop2)},
+		&recordProducer{tlv.MakePrimitiveRecord(type3, new(uint8))},
+	)
+	if err != nil {
+		t.Fatalf("unable to extract record: %v", err)
+	}
+	if hop2 != hop1 {
+		t.Fatalf("wrong record for hop: expected %v, got %v", hop1,
+			hop2)
+	}
+	if !bytes.Equal(typeMap[type1], []byte{channelType1}) {
+		t.Fatalf("expected raw value %x for type1, got %x",
+			[]byte{channelType1}, typeMap[type1])
+	}
+	if _, ok := typeMap[type3]; ok {
+		t.Fatalf("type3 should not be found in typeMap")
+	}
+}
+
+// TestOpenChannelDualFund tests that the request for a dual funded channel and
+// its funding fee rate survive the encoding of an OpenChannel message, and
+// that the extra data of messages without the request doesn't signal one.
+func TestOpenChannelDualFund(t *testing.T) {
+	t.Parallel()
+
+	var msg OpenChannel
+	_, dualFund, err := msg.DualFund()
+	if err != nil {
+		t.Fatalf("unable to parse extra data: %v", err)
+	}
+	if dualFund {
+		t.Fatalf("message without extra data requests dual funding")
+	}
+
+	const fundingFeePerKw = 2500
+	if err := msg.SetDualFund(fundingFeePerKw); err != nil {
+		t.Fatalf("unable to request dual funding: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := msg.ExtraData.Encode(&b); err != nil {
+		t.Fatalf("unable to encode extra data: %v", err)
+	}
+
+	var decoded OpenChannel
+	if err := decoded.ExtraData.Decode(&b); err != nil {
+		t.Fatalf("unable to decode extra data: %v", err)
+	}
+
+	feePerKw, dualFund, err := decoded.DualFund()
+	if err != nil {
+		t.Fatalf("unable to parse extra data: %v", err)
+	}
+	if !dualFund {
+		t.Fatalf("message doesn't request dual funding")
+	}
+	if feePerKw != fundingFeePerKw {
+		t.Fatalf("expected funding fee rate %v, got %v",
+			fundingFeePerKw, feePerKw)
+	}
+}
+
+// recordProducer is a simple helper struct that implements the
+// tlv.RecordProducer interface.
+type recordProducer struct {
+	record tlv.Record
+}
+
+// Record returns the underlying record.
+func (r *recordProducer) Record() tlv.Record {
+	return r.record
+}
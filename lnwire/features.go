@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
 )
 
 // FeatureBit represents a feature that can be enabled in either a local or
@@ -46,6 +47,60 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// GossipQueriesExRequired is a feature bit that indicates that the
+	// receiving peer MUST know of the extended gossip queries, which
+	// allow nodes to exchange the timestamps and checksums of the latest
+	// channel updates they know of, and to query for individual
+	// announcements and updates of a channel.
+	GossipQueriesExRequired FeatureBit = 10
+
+	// GossipQueriesExOptional is an optional feature bit that signals
+	// that the sending peer knows of the extended gossip queries.
+	GossipQueriesExOptional FeatureBit = 11
+
+	// TLVOnionPayloadRequired is a feature bit that indicates a node is
+	// able to decode the new TLV information included in the onion
+	// payload, and requires senders to use it.
+	TLVOnionPayloadRequired FeatureBit = 8
+
+	// TLVOnionPayloadOptional is an optional feature bit that indicates a
+	// node is able to decode the new TLV information included in the
+	// onion payload.
+	TLVOnionPayloadOptional FeatureBit = 9
+
+	// PaymentAddrRequired is an invoice feature bit that indicates that
+	// the payee requires the sender to pass the payment address of the
+	// invoice within the final hop's payload.
+	PaymentAddrRequired FeatureBit = 14
+
+	// PaymentAddrOptional is an optional invoice feature bit that signals
+	// that the payee includes a payment address in the invoice, which the
+	// sender should pass within the final hop's payload.
+	PaymentAddrOptional FeatureBit = 15
+
+	// MPPRequired is an invoice feature bit that indicates that the payee
+	// requires the sender to be able to split the payment into multiple
+	// HTLCs sent along different routes.
+	MPPRequired FeatureBit = 16
+
+	// MPPOptional is an optional invoice feature bit that signals that
+	// the payee accepts payments split into multiple HTLCs sent along
+	// different routes.
+	MPPOptional FeatureBit = 17
+
+	// DualFundRequired is a feature bit that indicates that the sending
+	// peer requires the other party to understand the dual funder
+	// workflow, in which both sides contribute funds to a new channel.
+	// The workflow isn't compatible with the one of the specification, so
+	// it uses a pair of bits outside of the range the specification
+	// assigns.
+	DualFundRequired FeatureBit = 228
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sending peer is able to contribute funds to channels opened by the
+	// other party using the dual funder workflow.
+	DualFundOptional FeatureBit = 229
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -61,25 +116,30 @@ const (
 	maxAllowedSize = 32781
 )
 
-// LocalFeatures is a mapping of known connection-local feature bits to a
-// descriptive name. All known local feature bits must be assigned a name in
-// this mapping. Local features are those which are only sent to the peer and
-// not advertised to the entire network. A full description of these feature
-// bits is provided in the BOLT-09 specification.
-var LocalFeatures = map[FeatureBit]string{
-	DataLossProtectRequired: "data-loss-protect-required",
-	DataLossProtectOptional: "data-loss-protect-optional",
+// Features is a mapping of known feature bits to a descriptive name. All
+// known feature bits must be assigned a name in this mapping, and feature bit
+// pairs must be assigned the same name. Feature bits are known regardless of
+// the context they're signaled in, whether that's the init message, a node
+// announcement or an invoice. A full description of these feature bits is
+// provided in the BOLT-09 specification.
+var Features = map[FeatureBit]string{
+	DataLossProtectRequired: "data-loss-protect",
+	DataLossProtectOptional: "data-loss-protect",
 	InitialRoutingSync:      "initial-routing-sync",
-	GossipQueriesRequired:   "gossip-queries-required",
-	GossipQueriesOptional:   "gossip-queries-optional",
+	GossipQueriesRequired:   "gossip-queries",
+	GossipQueriesOptional:   "gossip-queries",
+	GossipQueriesExRequired: "gossip-queries-ex",
+	GossipQueriesExOptional: "gossip-queries-ex",
+	TLVOnionPayloadRequired: "tlv-onion",
+	TLVOnionPayloadOptional: "tlv-onion",
+	PaymentAddrRequired:     "payment-addr",
+	PaymentAddrOptional:     "payment-addr",
+	MPPRequired:             "multi-path-payments",
+	MPPOptional:             "multi-path-payments",
+	DualFundRequired:        "dual-fund",
+	DualFundOptional:        "dual-fund",
 }
 
-// GlobalFeatures is a mapping of known global feature bits to a descriptive
-// name. All known global feature bits must be assigned a name in this mapping.
-// Global features are those which are advertised to the entire network. A full
-// description of these feature bits is provided in the BOLT-09 specification.
-var GlobalFeatures map[FeatureBit]string
-
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
 // RawFeatureVector itself just stores a set of bit flags but can be used to
 // construct a FeatureVector which binds meaning to each bit. Feature vectors
@@ -114,6 +174,34 @@ func (fv *RawFeatureVector) Unset(feature FeatureBit) {
 	delete(fv.features, feature)
 }
 
+// Clone returns a copy of the feature vector.
+func (fv *RawFeatureVector) Clone() *RawFeatureVector {
+	newFeatures := NewRawFeatureVector()
+	for feature := range fv.features {
+		newFeatures.Set(feature)
+	}
+	return newFeatures
+}
+
+// Merge sets all of the feature bits enabled in the passed feature vector.
+func (fv *RawFeatureVector) Merge(other *RawFeatureVector) {
+	for feature := range other.features {
+		fv.Set(feature)
+	}
+}
+
+// Features returns the enabled feature bits of the vector in ascending order.
+func (fv *RawFeatureVector) Features() []FeatureBit {
+	features := make([]FeatureBit, 0, len(fv.features))
+	for feature := range fv.features {
+		features = append(features, feature)
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i] < features[j]
+	})
+	return features
+}
+
 // SerializeSize returns the number of bytes needed to represent feature vector
 // in byte format.
 func (fv *RawFeatureVector) SerializeSize() int {
@@ -189,6 +277,45 @@ func (fv *RawFeatureVector) Decode(r io.Reader) error {
 	return nil
 }
 
+// EncodeBase32 returns the feature vector in the 5-bit group representation
+// used within payment requests. Every feature is encoded as a bit, and the bit
+// vector is serialized using the least number of groups, the last group
+// holding the lowest feature bits.
+func (fv *RawFeatureVector) EncodeBase32() []byte {
+	max := -1
+	for feature := range fv.features {
+		if int(feature) > max {
+			max = int(feature)
+		}
+	}
+	if max == -1 {
+		return nil
+	}
+
+	length := max/5 + 1
+	data := make([]byte, length)
+	for feature := range fv.features {
+		groupIndex := int(feature) / 5
+		bitIndex := feature % 5
+		data[length-groupIndex-1] |= 1 << bitIndex
+	}
+
+	return data
+}
+
+// DecodeBase32 sets the feature bits encoded within the passed 5-bit groups,
+// as serialized by EncodeBase32.
+func (fv *RawFeatureVector) DecodeBase32(data []byte) {
+	length := len(data)
+	for i := 0; i < length*5; i++ {
+		groupIndex := i / 5
+		bitIndex := uint(i % 5)
+		if (data[length-groupIndex-1]>>bitIndex)&1 == 1 {
+			fv.Set(FeatureBit(i))
+		}
+	}
+}
+
 // FeatureVector represents a set of enabled features. The set stores
 // information on enabled flags and metadata about the feature names. A feature
 // vector is serializable to a compact byte representation that is included in
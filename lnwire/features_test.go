@@ -158,6 +158,48 @@ func TestFeatureVectorEncodeDecode(t *testing.T) {
 	}
 }
 
+// TestFeatureVectorEncodeDecodeBase32 tests that feature vectors are encoded
+// into the 5-bit groups used within payment requests, and decoded back.
+func TestFeatureVectorEncodeDecodeBase32(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		bits            []FeatureBit
+		expectedEncoded []byte
+	}{
+		{
+			bits:            nil,
+			expectedEncoded: nil,
+		},
+		{
+			bits:            []FeatureBit{1, 4},
+			expectedEncoded: []byte{0x12},
+		},
+		{
+			bits:            []FeatureBit{5, 15},
+			expectedEncoded: []byte{0x01, 0x00, 0x01, 0x00},
+		},
+	}
+
+	for i, test := range tests {
+		fv := NewRawFeatureVector(test.bits...)
+
+		encoded := fv.EncodeBase32()
+		if !bytes.Equal(encoded, test.expectedEncoded) {
+			t.Fatalf("wrong encoding in case %d: got %v, expected %v",
+				i, encoded, test.expectedEncoded)
+		}
+
+		fv2 := NewRawFeatureVector()
+		fv2.DecodeBase32(encoded)
+		if !bytes.Equal(fv2.EncodeBase32(), test.expectedEncoded) {
+			t.Fatalf("wrong re-encoding in case %d: got %v, "+
+				"expected %v", i, fv2.EncodeBase32(),
+				test.expectedEncoded)
+		}
+	}
+}
+
 func TestFeatureVectorUnknownFeatures(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,378 @@
+package lnwire
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/breez/lightninglib/tlv"
+)
+
+// QueryOption is a bit field that a node sets within its QueryChannelRange
+// message in order to request extended information about each channel within
+// the ReplyChannelRange messages of the remote peer. These options are part of
+// the extended gossip queries.
+type QueryOption uint64
+
+const (
+	// QueryOptionTimestamps signals that the querying node wants the
+	// timestamps of the latest channel updates of both directions of each
+	// channel.
+	QueryOptionTimestamps QueryOption = 1 << 0
+
+	// QueryOptionChecksums signals that the querying node wants the
+	// checksums of the latest channel updates of both directions of each
+	// channel.
+	QueryOptionChecksums QueryOption = 1 << 1
+)
+
+// IsSet returns whether the passed option is set within the bit field.
+func (q QueryOption) IsSet(option QueryOption) bool {
+	return q&option == option
+}
+
+// QueryFlag is a bit field that a node attaches to each short channel ID of a
+// QueryShortChanIDs message in order to signal which of the announcements and
+// updates of the channel it wants the remote peer to send. These flags are
+// part of the extended gossip queries.
+type QueryFlag uint64
+
+const (
+	// QueryFlagChanAnn requests the channel announcement of the channel.
+	QueryFlagChanAnn QueryFlag = 1 << 0
+
+	// QueryFlagChanUpdate1 requests the channel update of the first node
+	// of the channel.
+	QueryFlagChanUpdate1 QueryFlag = 1 << 1
+
+	// QueryFlagChanUpdate2 requests the channel update of the second node
+	// of the channel.
+	QueryFlagChanUpdate2 QueryFlag = 1 << 2
+
+	// QueryFlagNodeAnn1 requests the node announcement of the first node
+	// of the channel.
+	QueryFlagNodeAnn1 QueryFlag = 1 << 3
+
+	// QueryFlagNodeAnn2 requests the node announcement of the second node
+	// of the channel.
+	QueryFlagNodeAnn2 QueryFlag = 1 << 4
+
+	// QueryFlagAll requests all announcements and updates of the channel,
+	// which is what a query without flags implies.
+	QueryFlagAll = QueryFlagChanAnn | QueryFlagChanUpdate1 |
+		QueryFlagChanUpdate2 | QueryFlagNodeAnn1 | QueryFlagNodeAnn2
+)
+
+// IsSet returns whether the passed flag is set within the bit field.
+func (q QueryFlag) IsSet(flag QueryFlag) bool {
+	return q&flag == flag
+}
+
+// ChanUpdateTimestamps holds the timestamps of the latest channel updates of
+// both directions of a channel. A zero timestamp signals that no channel
+// update is known for the direction.
+type ChanUpdateTimestamps struct {
+	// Timestamp1 is the timestamp of the channel update of the first
+	// node of the channel.
+	Timestamp1 uint32
+
+	// Timestamp2 is the timestamp of the channel update of the second
+	// node of the channel.
+	Timestamp2 uint32
+}
+
+// ChanUpdateChecksums holds the checksums of the latest channel updates of
+// both directions of a channel. A zero checksum signals that no channel update
+// is known for the direction.
+type ChanUpdateChecksums struct {
+	// Checksum1 is the checksum of the channel update of the first node
+	// of the channel.
+	Checksum1 uint32
+
+	// Checksum2 is the checksum of the channel update of the second node
+	// of the channel.
+	Checksum2 uint32
+}
+
+const (
+	// queryOptionsType is the TLV type of the query options within a
+	// QueryChannelRange message.
+	queryOptionsType tlv.Type = 1
+
+	// timestampsType is the TLV type of the encoded channel update
+	// timestamps within a ReplyChannelRange message.
+	timestampsType tlv.Type = 1
+
+	// checksumsType is the TLV type of the channel update checksums within
+	// a ReplyChannelRange message.
+	checksumsType tlv.Type = 3
+
+	// queryFlagsType is the TLV type of the encoded query flags within a
+	// QueryShortChanIDs message.
+	queryFlagsType tlv.Type = 1
+)
+
+// crc32cTable is the table of the Castagnoli polynomial used to compute the
+// checksums of channel updates.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ChanUpdateChecksum computes the checksum of a channel update as exchanged
+// within the extended gossip queries. The checksum is the CRC32C of the
+// serialized update, leaving out its signature and timestamp, such that two
+// updates only differing in their timestamp share the same checksum.
+func ChanUpdateChecksum(update *ChannelUpdate) (uint32, error) {
+	data, err := update.DataToSign()
+	if err != nil {
+		return 0, err
+	}
+
+	// The timestamp follows the 32-byte chain hash and the 8-byte short
+	// channel ID, so we'll snip it out of the signed data.
+	const timestampOffset = 32 + 8
+	checksumData := make([]byte, 0, len(data)-4)
+	checksumData = append(checksumData, data[:timestampOffset]...)
+	checksumData = append(checksumData, data[timestampOffset+4:]...)
+
+	return crc32.Checksum(checksumData, crc32cTable), nil
+}
+
+// chanIDSorter sorts a set of short channel ID's in ascending order, along
+// with any of the data attached to them. Each set of attached data must either
+// be nil or have the same length as the short channel ID's.
+type chanIDSorter struct {
+	shortChanIDs []ShortChannelID
+	timestamps   []ChanUpdateTimestamps
+	checksums    []ChanUpdateChecksums
+	queryFlags   []QueryFlag
+}
+
+// Len returns the number of short channel ID's.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s chanIDSorter) Len() int {
+	return len(s.shortChanIDs)
+}
+
+// Less returns whether the short channel ID at index i is smaller than the
+// one at index j.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s chanIDSorter) Less(i, j int) bool {
+	return s.shortChanIDs[i].ToUint64() < s.shortChanIDs[j].ToUint64()
+}
+
+// Swap swaps the short channel ID's at index i and j, along with their
+// attached data.
+//
+// NOTE: This is part of the sort.Interface interface.
+func (s chanIDSorter) Swap(i, j int) {
+	s.shortChanIDs[i], s.shortChanIDs[j] = s.shortChanIDs[j],
+		s.shortChanIDs[i]
+	if s.timestamps != nil {
+		s.timestamps[i], s.timestamps[j] = s.timestamps[j],
+			s.timestamps[i]
+	}
+	if s.checksums != nil {
+		s.checksums[i], s.checksums[j] = s.checksums[j], s.checksums[i]
+	}
+	if s.queryFlags != nil {
+		s.queryFlags[i], s.queryFlags[j] = s.queryFlags[j],
+			s.queryFlags[i]
+	}
+}
+
+// encodeChanIDData encodes the raw data attached to a set of short channel
+// ID's, prefixed by the encoding type, compressing it if the encoding type
+// calls for it.
+func encodeChanIDData(encodingType ShortChanIDEncoding,
+	data []byte) ([]byte, error) {
+
+	var b bytes.Buffer
+	if err := writeElements(&b, encodingType); err != nil {
+		return nil, err
+	}
+
+	switch encodingType {
+	case EncodingSortedPlain:
+		b.Write(data)
+
+	case EncodingSortedZlib:
+		zlibWriter := zlib.NewWriter(&b)
+		if _, err := zlibWriter.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zlibWriter.Close(); err != nil {
+			return nil, fmt.Errorf("unable to finalize "+
+				"compression: %v", err)
+		}
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeChanIDData decodes the raw data attached to a set of short channel
+// ID's, as encoded by encodeChanIDData.
+func decodeChanIDData(encoded []byte) ([]byte, error) {
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("no encoding type specified")
+	}
+
+	encodingType := ShortChanIDEncoding(encoded[0])
+	switch encodingType {
+	case EncodingSortedPlain:
+		return encoded[1:], nil
+
+	// As with the short channel ID's themselves, we'll guard against
+	// memory exhaustion by limiting both the concurrent zlib decoding
+	// instances and the size of the decompressed data.
+	case EncodingSortedZlib:
+		zlibDecodeMtx.Lock()
+		defer zlibDecodeMtx.Unlock()
+
+		zlibReader, err := zlib.NewReader(bytes.NewReader(encoded[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create zlib "+
+				"reader: %v", err)
+		}
+
+		var b bytes.Buffer
+		_, err = b.ReadFrom(io.LimitReader(zlibReader, maxZlibBufSize))
+		if err != nil {
+			return nil, fmt.Errorf("unable to deflate data: %v",
+				err)
+		}
+
+		return b.Bytes(), nil
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+}
+
+// encodeTimestamps encodes the passed channel update timestamps using the
+// passed encoding type.
+func encodeTimestamps(encodingType ShortChanIDEncoding,
+	timestamps []ChanUpdateTimestamps) ([]byte, error) {
+
+	var b bytes.Buffer
+	for _, timestamp := range timestamps {
+		err := writeElements(
+			&b, timestamp.Timestamp1, timestamp.Timestamp2,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encodeChanIDData(encodingType, b.Bytes())
+}
+
+// decodeTimestamps decodes a set of channel update timestamps, as encoded by
+// encodeTimestamps.
+func decodeTimestamps(encoded []byte) ([]ChanUpdateTimestamps, error) {
+	data, err := decodeChanIDData(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data)%8 != 0 {
+		return nil, fmt.Errorf("whole number of timestamps cannot "+
+			"be encoded in len=%v", len(data))
+	}
+
+	timestamps := make([]ChanUpdateTimestamps, len(data)/8)
+	r := bytes.NewReader(data)
+	for i := range timestamps {
+		err := readElements(
+			r, &timestamps[i].Timestamp1, &timestamps[i].Timestamp2,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return timestamps, nil
+}
+
+// encodeChecksums encodes the passed channel update checksums. Unlike the
+// timestamps, checksums are never compressed.
+func encodeChecksums(checksums []ChanUpdateChecksums) ([]byte, error) {
+	var b bytes.Buffer
+	for _, checksum := range checksums {
+		err := writeElements(&b, checksum.Checksum1, checksum.Checksum2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeChecksums decodes a set of channel update checksums, as encoded by
+// encodeChecksums.
+func decodeChecksums(data []byte) ([]ChanUpdateChecksums, error) {
+	if len(data)%8 != 0 {
+		return nil, fmt.Errorf("whole number of checksums cannot "+
+			"be encoded in len=%v", len(data))
+	}
+
+	checksums := make([]ChanUpdateChecksums, len(data)/8)
+	r := bytes.NewReader(data)
+	for i := range checksums {
+		err := readElements(
+			r, &checksums[i].Checksum1, &checksums[i].Checksum2,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checksums, nil
+}
+
+// encodeQueryFlags encodes the passed query flags using the passed encoding
+// type. Each flag is encoded in the BigSize format.
+func encodeQueryFlags(encodingType ShortChanIDEncoding,
+	flags []QueryFlag) ([]byte, error) {
+
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	for _, flag := range flags {
+		if err := tlv.WriteVarInt(&b, uint64(flag), &buf); err != nil {
+			return nil, err
+		}
+	}
+
+	return encodeChanIDData(encodingType, b.Bytes())
+}
+
+// decodeQueryFlags decodes a set of query flags, as encoded by
+// encodeQueryFlags.
+func decodeQueryFlags(encoded []byte) ([]QueryFlag, error) {
+	data, err := decodeChanIDData(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		flags []QueryFlag
+		buf   [8]byte
+	)
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		flag, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, QueryFlag(flag))
+	}
+
+	return flags, nil
+}
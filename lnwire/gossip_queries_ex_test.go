@@ -0,0 +1,81 @@
+package lnwire
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestChanUpdateChecksum tests that the checksum of a channel update doesn't
+// commit to its timestamp or signature, but does commit to its policy.
+func TestChanUpdateChecksum(t *testing.T) {
+	t.Parallel()
+
+	update := &ChannelUpdate{
+		ShortChannelID:  NewShortChanIDFromInt(42),
+		Timestamp:       1000,
+		TimeLockDelta:   144,
+		HtlcMinimumMsat: 1000,
+		BaseFee:         1000,
+		FeeRate:         1,
+	}
+	checksum, err := ChanUpdateChecksum(update)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+
+	// An update that only differs in its timestamp and signature should
+	// share the same checksum.
+	newUpdate := *update
+	newUpdate.Timestamp = 2000
+	newUpdate.Signature[0] = 1
+	newChecksum, err := ChanUpdateChecksum(&newUpdate)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+	if newChecksum != checksum {
+		t.Fatalf("expected checksum %x, got %x", checksum,
+			newChecksum)
+	}
+
+	// An update with a different policy should result in a different
+	// checksum.
+	newUpdate.FeeRate = 2
+	newChecksum, err = ChanUpdateChecksum(&newUpdate)
+	if err != nil {
+		t.Fatalf("unable to compute checksum: %v", err)
+	}
+	if newChecksum == checksum {
+		t.Fatalf("expected checksums to differ")
+	}
+}
+
+// TestQueryFlagsEncodeDecode tests that we're able to encode and decode a set
+// of query flags using both the plain and zlib encodings.
+func TestQueryFlagsEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	flags := []QueryFlag{
+		QueryFlagChanAnn, QueryFlagAll, QueryFlagChanUpdate1 |
+			QueryFlagChanUpdate2, 1 << 40,
+	}
+
+	encodings := []ShortChanIDEncoding{
+		EncodingSortedPlain, EncodingSortedZlib,
+	}
+	for _, encoding := range encodings {
+		encoded, err := encodeQueryFlags(encoding, flags)
+		if err != nil {
+			t.Fatalf("unable to encode flags: %v", err)
+		}
+
+		decoded, err := decodeQueryFlags(encoded)
+		if err != nil {
+			t.Fatalf("unable to decode flags: %v", err)
+		}
+
+		if !reflect.DeepEqual(flags, decoded) {
+			t.Fatalf("encoding=%v: expected %v, got %v", encoding,
+				flags, decoded)
+		}
+	}
+}
@@ -416,6 +416,9 @@ func writeElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case ExtraOpaqueData:
+		return e.Encode(w)
+
 	default:
 		return fmt.Errorf("Unknown type in writeElement: %T", e)
 	}
@@ -818,6 +821,8 @@ func readElement(r io.Reader, element interface{}) error {
 			return err
 		}
 		*e = addrBytes[:length]
+	case *ExtraOpaqueData:
+		return e.Decode(r)
 	default:
 		return fmt.Errorf("Unknown type in readElement: %T", e)
 	}
@@ -84,6 +84,20 @@ func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	return featureVec
 }
 
+// randExtraOpaqueData returns a random set of trailing bytes, or nil with a
+// 50/50 probability, so that messages are tested both with and without any
+// extension data.
+func randExtraOpaqueData(r *rand.Rand) ExtraOpaqueData {
+	if r.Int31n(2) == 0 {
+		return nil
+	}
+
+	extraData := make([]byte, r.Intn(100)+1)
+	r.Read(extraData)
+
+	return extraData
+}
+
 func randTCP4Addr(r *rand.Rand) (*net.TCPAddr, error) {
 	var ip [4]byte
 	if _, err := r.Read(ip[:]); err != nil {
@@ -238,7 +252,7 @@ func TestMaxOutPointIndex(t *testing.T) {
 func TestEmptyMessageUnknownType(t *testing.T) {
 	t.Parallel()
 
-	fakeType := MessageType(math.MaxUint16)
+	fakeType := CustomTypeStart - 1
 	if _, err := makeEmptyMessage(fakeType); err == nil {
 		t.Fatalf("should not be able to make an empty message of an " +
 			"unknown type")
@@ -301,6 +315,7 @@ func TestLightningWireProtocol(t *testing.T) {
 				randRawFeatureVector(r),
 				randRawFeatureVector(r),
 			)
+			req.ExtraData = randExtraOpaqueData(r)
 
 			v[0] = reflect.ValueOf(*req)
 		},
@@ -360,6 +375,8 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			req.ExtraData = randExtraOpaqueData(r)
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -410,6 +427,8 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			req.ExtraData = randExtraOpaqueData(r)
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -472,6 +491,83 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(*req)
 		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddInput{
+				SerialID: uint64(r.Int63()),
+				Amount:   btcutil.Amount(r.Int63()),
+				Sequence: uint32(r.Int31()),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.PrevOut.Hash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+			req.PrevOut.Index = uint32(r.Int31()) % math.MaxUint16
+
+			req.PkScript = make([]byte, 22)
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate pkscript: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				SerialID: uint64(r.Int63()),
+				Amount:   btcutil.Amount(r.Int63()),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			req.PkScript = make([]byte, 34)
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate pkscript: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			req := TxSignatures{}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.TxHash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+
+			numWitnesses := r.Intn(5)
+			if numWitnesses > 0 {
+				req.Witnesses = make([]InputWitness, numWitnesses)
+			}
+			for i := range req.Witnesses {
+				// Only nested p2sh inputs carry a sig script.
+				if r.Intn(2) == 0 {
+					req.Witnesses[i].SigScript = make([]byte, 23)
+					r.Read(req.Witnesses[i].SigScript)
+				}
+
+				witness := make([][]byte, r.Intn(3)+1)
+				for j := range witness {
+					witness[j] = make([]byte, r.Intn(72)+1)
+					r.Read(witness[j])
+				}
+				req.Witnesses[i].Witness = witness
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
 			req := ClosingSigned{
 				FeeSatoshis: btcutil.Amount(r.Int63()),
@@ -753,6 +849,8 @@ func TestLightningWireProtocol(t *testing.T) {
 					t.Fatalf("unable to generate key: %v", err)
 					return
 				}
+
+				req.ExtraData = randExtraOpaqueData(r)
 			}
 
 			v[0] = reflect.ValueOf(req)
@@ -779,6 +877,17 @@ func TestLightningWireProtocol(t *testing.T) {
 					NewShortChanIDFromInt(uint64(r.Int63())))
 			}
 
+			// With a 50/50 chance, we'll also attach query flags
+			// to each of the short channel ID's.
+			if r.Int31()%2 == 0 {
+				for i := int32(0); i < numChanIDs; i++ {
+					req.QueryFlags = append(req.QueryFlags,
+						QueryFlag(r.Int63n(
+							int64(QueryFlagAll)+1,
+						)))
+				}
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgReplyChannelRange: func(v []reflect.Value, r *rand.Rand) {
@@ -804,12 +913,57 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.EncodingType = EncodingSortedPlain
 			}
 
-			numChanIDs := rand.Int31n(5000)
+			// With a 50/50 chance each, we'll also attach the
+			// timestamps and checksums of the channel updates. As
+			// these triple the size of each entry, we'll attach
+			// fewer channels in that case to stay within the
+			// maximum message size.
+			withTimestamps := r.Int31()%2 == 0
+			withChecksums := r.Int31()%2 == 0
+
+			maxChanIDs := int32(5000)
+			if withTimestamps || withChecksums {
+				maxChanIDs = 2000
+			}
+
+			numChanIDs := rand.Int31n(maxChanIDs)
 			for i := int32(0); i < numChanIDs; i++ {
 				req.ShortChanIDs = append(req.ShortChanIDs,
 					NewShortChanIDFromInt(uint64(r.Int63())))
 			}
 
+			if withTimestamps {
+				for i := int32(0); i < numChanIDs; i++ {
+					req.Timestamps = append(req.Timestamps,
+						ChanUpdateTimestamps{
+							Timestamp1: r.Uint32(),
+							Timestamp2: r.Uint32(),
+						})
+				}
+			}
+			if withChecksums {
+				for i := int32(0); i < numChanIDs; i++ {
+					req.Checksums = append(req.Checksums,
+						ChanUpdateChecksums{
+							Checksum1: r.Uint32(),
+							Checksum2: r.Uint32(),
+						})
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		CustomTypeStart: func(v []reflect.Value, r *rand.Rand) {
+			numTypes := int32(math.MaxUint16 - CustomTypeStart + 1)
+			req := Custom{
+				Type: CustomTypeStart + MessageType(r.Int31n(numTypes)),
+				Data: make([]byte, r.Intn(1000)),
+			}
+			if _, err := r.Read(req.Data); err != nil {
+				t.Fatalf("unable to generate data: %v", err)
+				return
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 	}
@@ -877,6 +1031,30 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgShutdown,
 			scenario: func(m Shutdown) bool {
@@ -992,6 +1170,12 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: CustomTypeStart,
+			scenario: func(m Custom) bool {
+				return mainScenario(&m)
+			},
+		},
 	}
 	for _, test := range tests {
 		var config *quick.Config
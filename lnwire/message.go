@@ -54,6 +54,14 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+
+	// The messages of the dual funder workflow aren't compatible with the
+	// interactive transaction construction of the specification, so they
+	// use odd types within the custom range.
+	MsgTxAddInput   = 32835
+	MsgTxAddOutput  = 32837
+	MsgTxComplete   = 32839
+	MsgTxSignatures = 32841
 )
 
 // String return the string representation of message type.
@@ -71,6 +79,14 @@ func (t MessageType) String() string {
 		return "MsgFundingSigned"
 	case MsgFundingLocked:
 		return "FundingLocked"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
 	case MsgShutdown:
 		return "Shutdown"
 	case MsgClosingSigned:
@@ -116,6 +132,9 @@ func (t MessageType) String() string {
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
 	default:
+		if t >= CustomTypeStart {
+			return "Custom"
+		}
 		return "<unknown>"
 	}
 }
@@ -172,6 +191,14 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &FundingSigned{}
 	case MsgFundingLocked:
 		msg = &FundingLocked{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
 	case MsgShutdown:
 		msg = &Shutdown{}
 	case MsgClosingSigned:
@@ -217,7 +244,12 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
 	default:
-		return nil, &UnknownMessage{msgType}
+		// Messages of a type within the custom range are read as
+		// opaque custom messages.
+		if msgType < CustomTypeStart {
+			return nil, &UnknownMessage{msgType}
+		}
+		msg = &Custom{Type: msgType}
 	}
 
 	return msg, nil
@@ -3,6 +3,7 @@ package lnwire
 import (
 	"io"
 
+	"github.com/breez/lightninglib/tlv"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcutil"
@@ -19,6 +20,15 @@ const (
 	FFAnnounceChannel FundingFlag = 1 << iota
 )
 
+// DualFundRecordType is the type of the TLV record within the extra data of an
+// OpenChannel message by which the initiator requests the responder to
+// contribute funds of their own to the channel, using the dual funder
+// workflow to build the funding transaction. The record carries the fee rate
+// in sat/kw both parties pay for their inputs and outputs to the funding
+// transaction. The type is odd and within the experimental range, so peers
+// unaware of the workflow ignore the record.
+const DualFundRecordType tlv.Type = 65537
+
 // OpenChannel is the message Alice sends to Bob if we should like to create a
 // channel with Bob where she's the sole provider of funds to the channel.
 // Single funder channels simplify the initial funding workflow, are supported
@@ -122,6 +132,11 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -153,6 +168,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.HtlcPoint,
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
+		o.ExtraData,
 	)
 }
 
@@ -181,9 +197,33 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.HtlcPoint,
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
+		&o.ExtraData,
 	)
 }
 
+// SetDualFund adds the record requesting the responder to contribute funds to
+// the channel at the given funding fee rate to the extra data of the message.
+func (o *OpenChannel) SetDualFund(fundingFeePerKw uint32) error {
+	record := tlv.MakePrimitiveRecord(DualFundRecordType, &fundingFeePerKw)
+	return o.ExtraData.PackRecords(&record)
+}
+
+// DualFund returns whether the initiator requests the responder to contribute
+// funds to the channel, along with the funding fee rate in sat/kw both
+// parties pay for their inputs and outputs.
+func (o *OpenChannel) DualFund() (uint32, bool, error) {
+	var fundingFeePerKw uint32
+	record := tlv.MakePrimitiveRecord(DualFundRecordType, &fundingFeePerKw)
+
+	parsedTypes, err := o.ExtraData.ExtractRecords(&record)
+	if err != nil {
+		return 0, false, err
+	}
+
+	_, ok := parsedTypes[DualFundRecordType]
+	return fundingFeePerKw, ok, nil
+}
+
 // MsgType returns the MessageType code which uniquely identifies this message
 // as an OpenChannel on the wire.
 //
@@ -197,6 +237,5 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	return MaxMessagePayload
 }
@@ -3,6 +3,7 @@ package lnwire
 import (
 	"io"
 
+	"github.com/breez/lightninglib/tlv"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
@@ -24,6 +25,11 @@ type QueryChannelRange struct {
 	// NumBlocks is the number of blocks beyond the first block that short
 	// channel ID's should be sent for.
 	NumBlocks uint32
+
+	// QueryOptions signals the extended information about each channel
+	// that the querying node wants within the replies. This is only set
+	// if both nodes support the extended gossip queries.
+	QueryOptions QueryOption
 }
 
 // NewQueryChannelRange creates a new empty QueryChannelRange message.
@@ -40,6 +46,30 @@ var _ Message = (*QueryChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
+	if err := q.decodeRange(r); err != nil {
+		return err
+	}
+
+	// Any remaining bytes carry a TLV stream, which holds the query
+	// options if the querying node set any.
+	var queryOptions uint64
+	tlvStream, err := tlv.NewStream(
+		tlv.MakeBigSizeRecord(queryOptionsType, &queryOptions),
+	)
+	if err != nil {
+		return err
+	}
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+	q.QueryOptions = QueryOption(queryOptions)
+
+	return nil
+}
+
+// decodeRange deserializes the chain hash and the block range of the query,
+// which are shared with the ReplyChannelRange message.
+func (q *QueryChannelRange) decodeRange(r io.Reader) error {
 	return readElements(r,
 		q.ChainHash[:],
 		&q.FirstBlockHeight,
@@ -52,6 +82,30 @@ func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Encode(w io.Writer, pver uint32) error {
+	if err := q.encodeRange(w); err != nil {
+		return err
+	}
+
+	// We'll only append the query options if any are set, as nodes that
+	// don't support the extended gossip queries won't expect them.
+	if q.QueryOptions == 0 {
+		return nil
+	}
+
+	queryOptions := uint64(q.QueryOptions)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakeBigSizeRecord(queryOptionsType, &queryOptions),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// encodeRange serializes the chain hash and the block range of the query,
+// which are shared with the ReplyChannelRange message.
+func (q *QueryChannelRange) encodeRange(w io.Writer) error {
 	return writeElements(w,
 		q.ChainHash[:],
 		q.FirstBlockHeight,
@@ -72,6 +126,5 @@ func (q *QueryChannelRange) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) MaxPayloadLength(uint32) uint32 {
-	// 32 + 4 + 4
-	return 40
+	return MaxMessagePayload
 }
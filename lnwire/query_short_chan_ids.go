@@ -8,6 +8,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/breez/lightninglib/tlv"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 )
 
@@ -67,6 +68,12 @@ type QueryShortChanIDs struct {
 
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
+
+	// QueryFlags signals which of the announcements and updates of each
+	// of the short channel ID's the querying node wants, in the same
+	// order. If nil, all of them are requested. This is only set if both
+	// nodes support the extended gossip queries.
+	QueryFlags []QueryFlag
 }
 
 // NewQueryShortChanIDs creates a new QueryShortChanIDs message.
@@ -95,8 +102,38 @@ func (q *QueryShortChanIDs) Decode(r io.Reader, pver uint32) error {
 	}
 
 	q.EncodingType, q.ShortChanIDs, err = decodeShortChanIDs(r)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes carry a TLV stream, which holds the query flags
+	// if the querying node set any.
+	var encodedQueryFlags []byte
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(queryFlagsType, &encodedQueryFlags),
+	)
+	if err != nil {
+		return err
+	}
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := parsedTypes[queryFlagsType]; !ok {
+		return nil
+	}
+
+	q.QueryFlags, err = decodeQueryFlags(encodedQueryFlags)
+	if err != nil {
+		return err
+	}
+	if len(q.QueryFlags) != len(q.ShortChanIDs) {
+		return fmt.Errorf("got %v query flags for %v short chan ID's",
+			len(q.QueryFlags), len(q.ShortChanIDs))
+	}
 
-	return err
+	return nil
 }
 
 // decodeShortChanIDs decodes a set of short channel ID's that have been
@@ -251,9 +288,44 @@ func (q *QueryShortChanIDs) Encode(w io.Writer, pver uint32) error {
 		return err
 	}
 
+	// The query flags must follow the order of the short channel ID's,
+	// which are sorted while being encoded. So we'll sort them together
+	// beforehand.
+	if q.QueryFlags != nil && len(q.QueryFlags) != len(q.ShortChanIDs) {
+		return fmt.Errorf("got %v query flags for %v short chan ID's",
+			len(q.QueryFlags), len(q.ShortChanIDs))
+	}
+	sort.Sort(chanIDSorter{
+		shortChanIDs: q.ShortChanIDs,
+		queryFlags:   q.QueryFlags,
+	})
+
 	// Base on our encoding type, we'll write out the set of short channel
 	// ID's.
-	return encodeShortChanIDs(w, q.EncodingType, q.ShortChanIDs)
+	err = encodeShortChanIDs(w, q.EncodingType, q.ShortChanIDs)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll append the query flags, if any, as a TLV stream.
+	if q.QueryFlags == nil {
+		return nil
+	}
+
+	encodedQueryFlags, err := encodeQueryFlags(
+		q.EncodingType, q.QueryFlags,
+	)
+	if err != nil {
+		return err
+	}
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(queryFlagsType, &encodedQueryFlags),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // encodeShortChanIDs encodes the passed short channel ID's into the passed
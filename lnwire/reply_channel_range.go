@@ -1,13 +1,20 @@
 package lnwire
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/breez/lightninglib/tlv"
+)
 
 // ReplyChannelRange is the response to the QueryChannelRange message. It
 // includes the original query, and the next streaming chunk of encoded short
 // channel ID's as the response. We'll also include a byte that indicates if
 // this is the last query in the message.
 type ReplyChannelRange struct {
-	// QueryChannelRange is the corresponding query to this response.
+	// QueryChannelRange is the corresponding query to this response. Its
+	// query options aren't part of the reply.
 	QueryChannelRange
 
 	// Complete denotes if this is the conclusion of the set of streaming
@@ -21,6 +28,16 @@ type ReplyChannelRange struct {
 
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
+
+	// Timestamps holds the timestamps of the latest channel updates of
+	// each of the short channel ID's, in the same order. It is only set
+	// if the query requested it through QueryOptionTimestamps.
+	Timestamps []ChanUpdateTimestamps
+
+	// Checksums holds the checksums of the latest channel updates of each
+	// of the short channel ID's, in the same order. It is only set if the
+	// query requested it through QueryOptionChecksums.
+	Checksums []ChanUpdateChecksums
 }
 
 // NewReplyChannelRange creates a new empty ReplyChannelRange message.
@@ -37,7 +54,7 @@ var _ Message = (*ReplyChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
-	err := c.QueryChannelRange.Decode(r, pver)
+	err := c.QueryChannelRange.decodeRange(r)
 	if err != nil {
 		return err
 	}
@@ -47,8 +64,50 @@ func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
 	}
 
 	c.EncodingType, c.ShortChanIDs, err = decodeShortChanIDs(r)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes carry a TLV stream, which holds the timestamps
+	// and checksums of the channel updates if they were requested.
+	var encodedTimestamps, encodedChecksums []byte
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(timestampsType, &encodedTimestamps),
+		tlv.MakePrimitiveRecord(checksumsType, &encodedChecksums),
+	)
+	if err != nil {
+		return err
+	}
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := parsedTypes[timestampsType]; ok {
+		c.Timestamps, err = decodeTimestamps(encodedTimestamps)
+		if err != nil {
+			return err
+		}
+		if len(c.Timestamps) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %v timestamps for %v short "+
+				"chan ID's", len(c.Timestamps),
+				len(c.ShortChanIDs))
+		}
+	}
+
+	if _, ok := parsedTypes[checksumsType]; ok {
+		c.Checksums, err = decodeChecksums(encodedChecksums)
+		if err != nil {
+			return err
+		}
+		if len(c.Checksums) != len(c.ShortChanIDs) {
+			return fmt.Errorf("got %v checksums for %v short "+
+				"chan ID's", len(c.Checksums),
+				len(c.ShortChanIDs))
+		}
+	}
 
-	return err
+	return nil
 }
 
 // Encode serializes the target ReplyChannelRange into the passed io.Writer
@@ -56,7 +115,7 @@ func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *ReplyChannelRange) Encode(w io.Writer, pver uint32) error {
-	if err := c.QueryChannelRange.Encode(w, pver); err != nil {
+	if err := c.QueryChannelRange.encodeRange(w); err != nil {
 		return err
 	}
 
@@ -64,7 +123,61 @@ func (c *ReplyChannelRange) Encode(w io.Writer, pver uint32) error {
 		return err
 	}
 
-	return encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	// The timestamps and checksums must follow the order of the short
+	// channel ID's, which are sorted while being encoded. So we'll sort
+	// all of them together beforehand.
+	if c.Timestamps != nil && len(c.Timestamps) != len(c.ShortChanIDs) {
+		return fmt.Errorf("got %v timestamps for %v short chan ID's",
+			len(c.Timestamps), len(c.ShortChanIDs))
+	}
+	if c.Checksums != nil && len(c.Checksums) != len(c.ShortChanIDs) {
+		return fmt.Errorf("got %v checksums for %v short chan ID's",
+			len(c.Checksums), len(c.ShortChanIDs))
+	}
+	sort.Sort(chanIDSorter{
+		shortChanIDs: c.ShortChanIDs,
+		timestamps:   c.Timestamps,
+		checksums:    c.Checksums,
+	})
+
+	err := encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs)
+	if err != nil {
+		return err
+	}
+
+	// Finally, we'll append the timestamps and checksums, if any, as a
+	// TLV stream.
+	var records []tlv.Record
+	if c.Timestamps != nil {
+		encodedTimestamps, err := encodeTimestamps(
+			c.EncodingType, c.Timestamps,
+		)
+		if err != nil {
+			return err
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			timestampsType, &encodedTimestamps,
+		))
+	}
+	if c.Checksums != nil {
+		encodedChecksums, err := encodeChecksums(c.Checksums)
+		if err != nil {
+			return err
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			checksumsType, &encodedChecksums,
+		))
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
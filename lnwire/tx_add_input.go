@@ -0,0 +1,88 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// TxAddInput is sent by either side of a dual funder workflow in order to add
+// one of their inputs to the funding transaction under construction. Along
+// with the outpoint being spent, the sender includes the value and script of
+// the output it references, which allows the receiver to verify the sender's
+// signature for the input once the transaction is complete.
+type TxAddInput struct {
+	// ChannelID is the pending channel ID of the channel whose funding
+	// transaction the input is added to.
+	ChannelID ChannelID
+
+	// SerialID uniquely identifies this input amongst all the inputs and
+	// outputs added by the sender.
+	SerialID uint64
+
+	// PrevOut is the outpoint spent by the added input.
+	PrevOut wire.OutPoint
+
+	// Amount is the value of the output referenced by PrevOut.
+	Amount btcutil.Amount
+
+	// PkScript is the script of the output referenced by PrevOut.
+	PkScript PkScript
+
+	// Sequence is the sequence number of the added input.
+	Sequence uint32
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		t.ChannelID,
+		t.SerialID,
+		t.PrevOut,
+		t.Amount,
+		t.PkScript,
+		t.Sequence,
+	)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader into the target TxAddInput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&t.ChannelID,
+		&t.SerialID,
+		&t.PrevOut,
+		&t.Amount,
+		&t.PkScript,
+		&t.Sequence,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxAddInput message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 34 + 8 + 35 + 4
+	return 121
+}
@@ -0,0 +1,75 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TxAddOutput is sent by either side of a dual funder workflow in order to add
+// one of their outputs, typically a change output, to the funding transaction
+// under construction.
+type TxAddOutput struct {
+	// ChannelID is the pending channel ID of the channel whose funding
+	// transaction the output is added to.
+	ChannelID ChannelID
+
+	// SerialID uniquely identifies this output amongst all the inputs and
+	// outputs added by the sender.
+	SerialID uint64
+
+	// Amount is the value of the added output.
+	Amount btcutil.Amount
+
+	// PkScript is the script of the added output.
+	PkScript PkScript
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		t.ChannelID,
+		t.SerialID,
+		t.Amount,
+		t.PkScript,
+	)
+}
+
+// Decode deserializes the serialized TxAddOutput stored in the passed
+// io.Reader into the target TxAddOutput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&t.ChannelID,
+		&t.SerialID,
+		&t.Amount,
+		&t.PkScript,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxAddOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxAddOutput message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 8 + 35
+	return 83
+}
@@ -0,0 +1,62 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TxComplete is sent by either side of a dual funder workflow once they've
+// added all their inputs and outputs to the funding transaction under
+// construction. The message also carries the amount the sender contributes to
+// the channel, allowing the receiver to verify that the added inputs cover
+// it. A FundingAmount of zero signals that the sender doesn't contribute any
+// funds, in which case the regular single funder workflow carries on.
+type TxComplete struct {
+	// ChannelID is the pending channel ID of the channel whose funding
+	// transaction is being constructed.
+	ChannelID ChannelID
+
+	// FundingAmount is the amount the sender contributes to the capacity
+	// of the channel.
+	FundingAmount btcutil.Amount
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.Message
+// interface.
+var _ Message = (*TxComplete)(nil)
+
+// Encode serializes the target TxComplete into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, t.ChannelID, t.FundingAmount)
+}
+
+// Decode deserializes the serialized TxComplete stored in the passed
+// io.Reader into the target TxComplete using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &t.ChannelID, &t.FundingAmount)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxComplete on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MsgType() MessageType {
+	return MsgTxComplete
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxComplete message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8
+	return 40
+}
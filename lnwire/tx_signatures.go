@@ -0,0 +1,141 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// InputWitness carries the signature script and witness stack which spend a
+// single input of the funding transaction of a dual funder channel.
+type InputWitness struct {
+	// SigScript is the signature script of the input. It's only populated
+	// for nested p2sh inputs.
+	SigScript []byte
+
+	// Witness is the witness stack of the input.
+	Witness [][]byte
+}
+
+// TxSignatures is sent by either side of a dual funder workflow once they hold
+// a valid signature for their version of the commitment transaction. It
+// carries the signatures for all the inputs the sender added to the funding
+// transaction, in the order in which they appear within it.
+type TxSignatures struct {
+	// ChannelID is the permanent channel ID of the channel funded by the
+	// signed transaction.
+	ChannelID ChannelID
+
+	// TxHash is the txid of the signed funding transaction.
+	TxHash chainhash.Hash
+
+	// Witnesses are the input scripts for each of the sender's inputs.
+	Witnesses []InputWitness
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w io.Writer, pver uint32) error {
+	err := writeElements(w,
+		t.ChannelID,
+		t.TxHash[:],
+		uint16(len(t.Witnesses)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, witness := range t.Witnesses {
+		if err := wire.WriteVarBytes(w, 0, witness.SigScript); err != nil {
+			return err
+		}
+
+		numItems := uint16(len(witness.Witness))
+		if err := writeElement(w, numItems); err != nil {
+			return err
+		}
+		for _, item := range witness.Witness {
+			if err := wire.WriteVarBytes(w, 0, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader into the target TxSignatures using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	var numWitnesses uint16
+	err := readElements(r,
+		&t.ChannelID,
+		t.TxHash[:],
+		&numWitnesses,
+	)
+	if err != nil {
+		return err
+	}
+
+	if numWitnesses == 0 {
+		return nil
+	}
+
+	t.Witnesses = make([]InputWitness, numWitnesses)
+	for i := range t.Witnesses {
+		sigScript, err := wire.ReadVarBytes(
+			r, 0, MaxMessagePayload, "sigscript",
+		)
+		if err != nil {
+			return err
+		}
+		if len(sigScript) != 0 {
+			t.Witnesses[i].SigScript = sigScript
+		}
+
+		var numItems uint16
+		if err := readElement(r, &numItems); err != nil {
+			return err
+		}
+
+		witness := make([][]byte, numItems)
+		for j := range witness {
+			witness[j], err = wire.ReadVarBytes(
+				r, 0, MaxMessagePayload, "witness item",
+			)
+			if err != nil {
+				return err
+			}
+		}
+		t.Witnesses[i].Witness = witness
+	}
+
+	return nil
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxSignatures message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,339 @@
+package onion
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/tlv"
+)
+
+const (
+	// AmtType is the type of the record carrying the amount to forward to
+	// the next hop, encoded as a truncated uint64.
+	AmtType tlv.Type = 2
+
+	// LockTimeType is the type of the record carrying the time lock of the
+	// outgoing HTLC, encoded as a truncated uint32.
+	LockTimeType tlv.Type = 4
+
+	// NextHopType is the type of the record carrying the short channel ID
+	// of the channel to forward the HTLC over. It's omitted within the
+	// final hop's payload.
+	NextHopType tlv.Type = 6
+
+	// PaymentDataType is the type of the record carrying the payment
+	// address of the invoice being paid, followed by the total amount of
+	// the payment as a truncated uint64. It's only allowed within the
+	// final hop's payload.
+	PaymentDataType tlv.Type = 8
+
+	// KeysendType is the type of the record carrying the preimage of a
+	// keysend payment, chosen by its sender. It's only allowed within the
+	// final hop's payload.
+	KeysendType tlv.Type = 5482373484
+
+	// MinCustomRecordsTlvType is the lowest type of the records senders
+	// are free to pass to the final hop for use by applications. Records
+	// below this type are reserved for the protocol.
+	MinCustomRecordsTlvType = 65536
+)
+
+// ErrInvalidPayload is returned when a TLV hop payload either omits a record
+// that's required for the hop, or includes one that isn't allowed for it.
+type ErrInvalidPayload struct {
+	// Type is the type of the offending record.
+	Type tlv.Type
+
+	// Omitted is true if the record is missing, and false if it was
+	// included although it shouldn't have been.
+	Omitted bool
+
+	// FinalHop is true if the payload belongs to the final hop.
+	FinalHop bool
+}
+
+// Error returns a human-readable description of the invalid payload.
+func (e ErrInvalidPayload) Error() string {
+	hopType := "intermediate"
+	if e.FinalHop {
+		hopType = "final"
+	}
+
+	violation := "includes"
+	if e.Omitted {
+		violation = "omits"
+	}
+
+	return fmt.Sprintf("onion payload of %s hop %s record with type %d",
+		hopType, violation, e.Type)
+}
+
+// paymentData is the value of the payment data record, passing the payment
+// address of the invoice along with the total amount of the payment.
+type paymentData struct {
+	addr  [32]byte
+	total uint64
+}
+
+// ePaymentData is an Encoder for the payment data record.
+func ePaymentData(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*paymentData); ok {
+		if _, err := w.Write(v.addr[:]); err != nil {
+			return err
+		}
+		return tlv.ETUint64(w, &v.total, buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "paymentData")
+}
+
+// dPaymentData is a Decoder for the payment data record.
+func dPaymentData(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*paymentData); ok && l >= 32 && l <= 40 {
+		if _, err := io.ReadFull(r, v.addr[:]); err != nil {
+			return err
+		}
+		return tlv.DTUint64(r, &v.total, buf, l-32)
+	}
+	return tlv.NewTypeForDecodingErr(val, "paymentData", l, 40)
+}
+
+// newAmtRecord returns the record for the amount to forward.
+func newAmtRecord(amt *uint64) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		AmtType, amt, func() uint64 {
+			return tlv.SizeTUint64(*amt)
+		}, tlv.ETUint64, tlv.DTUint64,
+	)
+}
+
+// newLockTimeRecord returns the record for the outgoing time lock.
+func newLockTimeRecord(lockTime *uint32) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		LockTimeType, lockTime, func() uint64 {
+			return tlv.SizeTUint32(*lockTime)
+		}, tlv.ETUint32, tlv.DTUint32,
+	)
+}
+
+// newPaymentDataRecord returns the record for the payment data.
+func newPaymentDataRecord(data *paymentData) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		PaymentDataType, data, func() uint64 {
+			return 32 + tlv.SizeTUint64(data.total)
+		}, ePaymentData, dPaymentData,
+	)
+}
+
+// Payload holds the forwarding instructions carried within the TLV hop payload
+// of a single hop.
+type Payload struct {
+	// NextHop is the channel to forward the HTLC over, which is zero for
+	// the final hop.
+	NextHop lnwire.ShortChannelID
+
+	// AmountToForward is the amount to forward to the next hop, or to
+	// receive if this is the final hop.
+	AmountToForward lnwire.MilliSatoshi
+
+	// OutgoingCTLV is the time lock of the outgoing HTLC.
+	OutgoingCTLV uint32
+
+	// PaymentAddr is the payment address of the invoice being paid, which
+	// is only set for the final hop.
+	PaymentAddr *[32]byte
+
+	// TotalAmount is the total amount of the payment the HTLC is part of.
+	// It's passed along with the payment address.
+	TotalAmount lnwire.MilliSatoshi
+
+	// KeysendPreimage is the preimage of a keysend payment, which is only
+	// set for the final hop.
+	KeysendPreimage *[32]byte
+
+	// CustomRecords are the records of a type of at least
+	// MinCustomRecordsTlvType passed to the final hop.
+	CustomRecords map[uint64][]byte
+}
+
+// EncodePayload writes the TLV hop payload carrying the passed forwarding
+// instructions to w. The next hop is omitted if it's zero, which denotes the
+// final hop. The payment address, along with the total amount of the payment,
+// keysend preimage and custom records are only included if set.
+func EncodePayload(w io.Writer, p *Payload) error {
+	var (
+		amt      = uint64(p.AmountToForward)
+		lockTime = p.OutgoingCTLV
+		nextHop  = p.NextHop.ToUint64()
+	)
+
+	records := []tlv.Record{
+		newAmtRecord(&amt),
+		newLockTimeRecord(&lockTime),
+	}
+
+	if nextHop != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			NextHopType, &nextHop,
+		))
+	}
+
+	if p.PaymentAddr != nil {
+		data := &paymentData{
+			addr:  *p.PaymentAddr,
+			total: uint64(p.TotalAmount),
+		}
+		records = append(records, newPaymentDataRecord(data))
+	}
+
+	if p.KeysendPreimage != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			KeysendType, p.KeysendPreimage,
+		))
+	}
+
+	for typ := range p.CustomRecords {
+		if typ < MinCustomRecordsTlvType {
+			return fmt.Errorf("custom record type %d is below the "+
+				"minimum of %d", typ, MinCustomRecordsTlvType)
+		}
+	}
+	records = append(records, tlv.MapToRecords(p.CustomRecords)...)
+
+	// The keysend record shares its range with the custom records, so the
+	// records need to be sorted to form a canonical stream.
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// DecodePayload decodes the forwarding instructions from the TLV hop payload
+// of a hop. An ErrInvalidPayload is returned if the payload omits a record
+// that's required for the hop or includes one that isn't allowed for it.
+// Unknown odd records of a type of at least MinCustomRecordsTlvType are
+// returned as custom records, while unknown even records fail the decoding.
+func DecodePayload(payload []byte, finalHop bool) (*Payload, error) {
+	var (
+		amt      uint64
+		lockTime uint32
+		nextHop  uint64
+		data     paymentData
+		preimage [32]byte
+	)
+
+	stream, err := tlv.NewStream(
+		newAmtRecord(&amt),
+		newLockTimeRecord(&lockTime),
+		tlv.MakePrimitiveRecord(NextHopType, &nextHop),
+		newPaymentDataRecord(&data),
+		tlv.MakePrimitiveRecord(KeysendType, &preimage),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePayload(parsedTypes, finalHop); err != nil {
+		return nil, err
+	}
+
+	_, hasPaymentData := parsedTypes[PaymentDataType]
+	_, hasKeysend := parsedTypes[KeysendType]
+
+	p := &Payload{
+		NextHop:         lnwire.NewShortChanIDFromInt(nextHop),
+		AmountToForward: lnwire.MilliSatoshi(amt),
+		OutgoingCTLV:    lockTime,
+	}
+	if hasPaymentData {
+		p.PaymentAddr = &data.addr
+		p.TotalAmount = lnwire.MilliSatoshi(data.total)
+	}
+	if hasKeysend {
+		p.KeysendPreimage = &preimage
+	}
+
+	// Known records are marked with a nil value, so the remaining ones
+	// are unknown odd records, of which we'll only keep those within the
+	// range of custom records.
+	for typ, value := range parsedTypes {
+		if value == nil || typ < MinCustomRecordsTlvType {
+			continue
+		}
+
+		if p.CustomRecords == nil {
+			p.CustomRecords = make(map[uint64][]byte)
+		}
+		p.CustomRecords[uint64(typ)] = value
+	}
+
+	return p, nil
+}
+
+// validatePayload checks that the parsed records of a hop payload include all
+// of the records that are required for the hop, and none that aren't allowed
+// for it. The amount and time lock are required for every hop, and the next
+// hop for all but the final one. The payment data and keysend records are
+// only allowed within the final hop's payload.
+func validatePayload(parsedTypes tlv.TypeMap, finalHop bool) error {
+	_, hasAmt := parsedTypes[AmtType]
+	_, hasLockTime := parsedTypes[LockTimeType]
+	_, hasNextHop := parsedTypes[NextHopType]
+	_, hasPaymentData := parsedTypes[PaymentDataType]
+	_, hasKeysend := parsedTypes[KeysendType]
+
+	switch {
+	case !hasAmt:
+		return ErrInvalidPayload{
+			Type:     AmtType,
+			Omitted:  true,
+			FinalHop: finalHop,
+		}
+
+	case !hasLockTime:
+		return ErrInvalidPayload{
+			Type:     LockTimeType,
+			Omitted:  true,
+			FinalHop: finalHop,
+		}
+
+	case !finalHop && !hasNextHop:
+		return ErrInvalidPayload{
+			Type:     NextHopType,
+			Omitted:  true,
+			FinalHop: finalHop,
+		}
+
+	case finalHop && hasNextHop:
+		return ErrInvalidPayload{
+			Type:     NextHopType,
+			FinalHop: finalHop,
+		}
+
+	case !finalHop && hasPaymentData:
+		return ErrInvalidPayload{
+			Type:     PaymentDataType,
+			FinalHop: finalHop,
+		}
+
+	case !finalHop && hasKeysend:
+		return ErrInvalidPayload{
+			Type:     KeysendType,
+			FinalHop: finalHop,
+		}
+	}
+
+	return nil
+}
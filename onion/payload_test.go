@@ -0,0 +1,164 @@
+package onion
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/tlv"
+)
+
+// TestPayloadRoundTrip tests that the forwarding instructions of intermediate
+// and final hops survive being encoded within a TLV payload.
+func TestPayloadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	paymentAddr := [32]byte{1}
+	preimage := [32]byte{2}
+
+	testCases := []struct {
+		name     string
+		finalHop bool
+		payload  Payload
+	}{
+		{
+			name: "intermediate hop",
+			payload: Payload{
+				NextHop:         lnwire.NewShortChanIDFromInt(7),
+				AmountToForward: 1000,
+				OutgoingCTLV:    144,
+			},
+		},
+		{
+			name:     "final hop with payment addr",
+			finalHop: true,
+			payload: Payload{
+				AmountToForward: 1000,
+				OutgoingCTLV:    144,
+				PaymentAddr:     &paymentAddr,
+				TotalAmount:     5000,
+			},
+		},
+		{
+			name:     "final hop with keysend and custom records",
+			finalHop: true,
+			payload: Payload{
+				AmountToForward: 0,
+				OutgoingCTLV:    0,
+				KeysendPreimage: &preimage,
+				CustomRecords: map[uint64][]byte{
+					MinCustomRecordsTlvType + 1: {1},
+					MinCustomRecordsTlvType + 3: {},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var b bytes.Buffer
+		err := EncodePayload(&b, &testCase.payload)
+		if err != nil {
+			t.Fatalf("%v: unable to encode payload: %v",
+				testCase.name, err)
+		}
+
+		payload, err := DecodePayload(b.Bytes(), testCase.finalHop)
+		if err != nil {
+			t.Fatalf("%v: unable to parse payload: %v",
+				testCase.name, err)
+		}
+
+		if !reflect.DeepEqual(*payload, testCase.payload) {
+			t.Fatalf("%v: wrong payload: expected %v, got %v",
+				testCase.name, testCase.payload, *payload)
+		}
+	}
+}
+
+// TestPayloadValidation tests that payloads omitting records required for
+// the hop, or including records that aren't allowed for it, are rejected.
+func TestPayloadValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		payload     []byte
+		finalHop    bool
+		expectedErr error
+	}{
+		{
+			name:     "final hop without amount",
+			payload:  []byte{0x04, 0x01, 0x01},
+			finalHop: true,
+			expectedErr: ErrInvalidPayload{
+				Type:     AmtType,
+				Omitted:  true,
+				FinalHop: true,
+			},
+		},
+		{
+			name:     "final hop without time lock",
+			payload:  []byte{0x02, 0x01, 0x01},
+			finalHop: true,
+			expectedErr: ErrInvalidPayload{
+				Type:     LockTimeType,
+				Omitted:  true,
+				FinalHop: true,
+			},
+		},
+		{
+			name:    "intermediate hop without next hop",
+			payload: []byte{0x02, 0x01, 0x01, 0x04, 0x01, 0x01},
+			expectedErr: ErrInvalidPayload{
+				Type:    NextHopType,
+				Omitted: true,
+			},
+		},
+		{
+			name: "final hop with next hop",
+			payload: []byte{
+				0x02, 0x01, 0x01, 0x04, 0x01, 0x01, 0x06, 0x08,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+			},
+			finalHop: true,
+			expectedErr: ErrInvalidPayload{
+				Type:     NextHopType,
+				FinalHop: true,
+			},
+		},
+		{
+			name: "intermediate hop with payment data",
+			payload: append([]byte{
+				0x02, 0x01, 0x01, 0x04, 0x01, 0x01, 0x06, 0x08,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x08, 0x20,
+			}, make([]byte, 32)...),
+			expectedErr: ErrInvalidPayload{
+				Type: PaymentDataType,
+			},
+		},
+		{
+			name: "unknown even record",
+			payload: []byte{
+				0x02, 0x01, 0x01, 0x04, 0x01, 0x01, 0x0a, 0x00,
+			},
+			finalHop:    true,
+			expectedErr: tlv.ErrUnknownRequiredType(0x0a),
+		},
+		{
+			name:        "amount not minimally encoded",
+			payload:     []byte{0x02, 0x02, 0x00, 0x01},
+			finalHop:    true,
+			expectedErr: tlv.ErrTUintNotMinimal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := DecodePayload(testCase.payload, testCase.finalHop)
+		if err != testCase.expectedErr {
+			t.Fatalf("%v: expected error %v, got %v",
+				testCase.name, testCase.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+package rebalance
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/routing"
+	"github.com/breez/lightninglib/ticker"
+	"github.com/btcsuite/btcutil"
+)
+
+var (
+	// ErrSameChannel is returned when a rebalance is requested from a
+	// channel to itself.
+	ErrSameChannel = errors.New("outgoing and incoming channel must " +
+		"differ")
+
+	// ErrRebalancerShuttingDown is returned when a rebalance is requested
+	// while the rebalancer is shutting down.
+	ErrRebalancerShuttingDown = errors.New("rebalancer shutting down")
+)
+
+// ChannelBalance describes the balance of one of our active channels.
+type ChannelBalance struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID uint64
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is the part of the capacity that's ours.
+	LocalBalance btcutil.Amount
+}
+
+// ratio returns the share of the channel's capacity that's ours.
+func (c *ChannelBalance) ratio() float64 {
+	if c.Capacity == 0 {
+		return 0
+	}
+
+	return float64(c.LocalBalance) / float64(c.Capacity)
+}
+
+// Policy describes the balance ratios the rebalancer should keep our channels
+// within, and how much it may spend on fees to do so.
+type Policy struct {
+	// MinRatio is the lowest share of a channel's capacity that should be
+	// ours. Channels below it are topped up.
+	MinRatio float64
+
+	// MaxRatio is the highest share of a channel's capacity that should be
+	// ours. Channels above it are drained.
+	MaxRatio float64
+
+	// FeeBudget is the maximum amount of fees to spend on rebalancing
+	// within each BudgetInterval.
+	FeeBudget lnwire.MilliSatoshi
+
+	// BudgetInterval is the interval after which the fee budget is
+	// replenished.
+	BudgetInterval time.Duration
+}
+
+// Validate ensures the policy is sane.
+func (p *Policy) Validate() error {
+	switch {
+	case p.MinRatio < 0 || p.MaxRatio > 1:
+		return fmt.Errorf("balance ratios must be between 0 and 1")
+	case p.MinRatio >= p.MaxRatio:
+		return fmt.Errorf("min ratio %v must be below max ratio %v",
+			p.MinRatio, p.MaxRatio)
+	case p.FeeBudget == 0:
+		return fmt.Errorf("fee budget must be positive")
+	case p.BudgetInterval <= 0:
+		return fmt.Errorf("budget interval must be positive")
+	}
+
+	return nil
+}
+
+// targetRatio is the share of a channel's capacity we aim for when
+// rebalancing it.
+func (p *Policy) targetRatio() float64 {
+	return (p.MinRatio + p.MaxRatio) / 2
+}
+
+// Config contains the dependencies of the Rebalancer.
+type Config struct {
+	// FindRoute finds a route leaving our node over the outgoing channel
+	// and returning to it over the incoming channel, capable of carrying
+	// amt to ourselves within the fee limit.
+	FindRoute func(outgoingChan, incomingChan uint64,
+		amt, feeLimit lnwire.MilliSatoshi) (*routing.Route, error)
+
+	// AddInvoice adds an invoice over the given amount to our invoice
+	// registry, returning its payment hash.
+	AddInvoice func(amt btcutil.Amount, memo string) ([32]byte, error)
+
+	// SendToRoute pays the invoice identified by the payment hash over
+	// the given route, returning the payment preimage.
+	SendToRoute func(paymentHash [32]byte,
+		route *routing.Route) ([32]byte, error)
+
+	// CancelInvoice cancels the invoice identified by the payment hash,
+	// so that the self-invoice of a failed rebalance isn't left open.
+	CancelInvoice func(paymentHash [32]byte) error
+
+	// FetchChannels returns the balances of all our active channels.
+	FetchChannels func() ([]*ChannelBalance, error)
+
+	// Policy is an optional policy the rebalancer enforces each time the
+	// ticker fires.
+	Policy *Policy
+
+	// Ticker determines how often the policy is enforced. It's only
+	// required if a policy is set.
+	Ticker ticker.Ticker
+}
+
+// Request describes a single rebalance from one of our channels to another.
+type Request struct {
+	// OutgoingChanID is the channel the balance is shifted away from.
+	OutgoingChanID uint64
+
+	// IncomingChanID is the channel the balance is shifted to.
+	IncomingChanID uint64
+
+	// Amount is the amount to shift.
+	Amount btcutil.Amount
+
+	// FeeLimit is the maximum amount of fees to pay for the rebalance.
+	FeeLimit lnwire.MilliSatoshi
+}
+
+// Result describes a completed rebalance.
+type Result struct {
+	// Route is the circular route the payment to ourselves took.
+	Route *routing.Route
+
+	// Fee is the total fee paid for the rebalance.
+	Fee lnwire.MilliSatoshi
+
+	// PaymentHash is the payment hash of the self-invoice that was paid.
+	PaymentHash [32]byte
+
+	// Preimage is the preimage of the self-invoice that was paid.
+	Preimage [32]byte
+}
+
+// Rebalancer shifts balance between our channels by paying ourselves over
+// circular routes. Optionally, it periodically keeps our channels within the
+// balance ratios of its policy.
+type Rebalancer struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	// feeSpent is the amount of fees spent by the policy loop within the
+	// current budget interval, which started at budgetStart.
+	feeSpent    lnwire.MilliSatoshi
+	budgetStart time.Time
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New returns a new Rebalancer instance.
+func New(cfg *Config) *Rebalancer {
+	return &Rebalancer{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches the policy loop of the rebalancer, if it has a policy.
+func (r *Rebalancer) Start() error {
+	if !atomic.CompareAndSwapUint32(&r.started, 0, 1) {
+		return nil
+	}
+
+	if r.cfg.Policy == nil {
+		return nil
+	}
+	if err := r.cfg.Policy.Validate(); err != nil {
+		return err
+	}
+
+	log.Infof("Rebalancer keeping channel balance ratios within "+
+		"[%v, %v]", r.cfg.Policy.MinRatio, r.cfg.Policy.MaxRatio)
+
+	r.wg.Add(1)
+	go r.policyLoop()
+
+	return nil
+}
+
+// Stop signals the policy loop to exit and waits for it to do so.
+func (r *Rebalancer) Stop() error {
+	if !atomic.CompareAndSwapUint32(&r.stopped, 0, 1) {
+		return nil
+	}
+
+	close(r.quit)
+	r.wg.Wait()
+
+	return nil
+}
+
+// Rebalance shifts the requested amount from the outgoing to the incoming
+// channel by paying a self-invoice over a circular route.
+func (r *Rebalancer) Rebalance(req *Request) (*Result, error) {
+	if req.OutgoingChanID == req.IncomingChanID {
+		return nil, ErrSameChannel
+	}
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("rebalance amount must be positive")
+	}
+
+	select {
+	case <-r.quit:
+		return nil, ErrRebalancerShuttingDown
+	default:
+	}
+
+	// We'll first find a route before adding the invoice, to avoid
+	// cluttering our invoices in case there isn't any.
+	amt := lnwire.NewMSatFromSatoshis(req.Amount)
+	route, err := r.cfg.FindRoute(
+		req.OutgoingChanID, req.IncomingChanID, amt, req.FeeLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := fmt.Sprintf("rebalance from %v to %v", req.OutgoingChanID,
+		req.IncomingChanID)
+	paymentHash, err := r.cfg.AddInvoice(req.Amount, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Rebalancing %v from channel %v to channel %v, paying "+
+		"%v in fees", req.Amount, req.OutgoingChanID,
+		req.IncomingChanID, route.TotalFees)
+
+	preimage, err := r.cfg.SendToRoute(paymentHash, route)
+	if err != nil {
+		if cancelErr := r.cfg.CancelInvoice(paymentHash); cancelErr != nil {
+			log.Errorf("Unable to cancel rebalance invoice %x: %v",
+				paymentHash, cancelErr)
+		}
+		return nil, err
+	}
+
+	return &Result{
+		Route:       route,
+		Fee:         route.TotalFees,
+		PaymentHash: paymentHash,
+		Preimage:    preimage,
+	}, nil
+}
+
+// policyLoop enforces the policy of the rebalancer each time the ticker
+// fires.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Rebalancer) policyLoop() {
+	defer r.wg.Done()
+
+	r.cfg.Ticker.Resume()
+	defer r.cfg.Ticker.Stop()
+
+	r.budgetStart = time.Now()
+
+	for {
+		select {
+		case <-r.cfg.Ticker.Ticks():
+			if err := r.enforcePolicy(); err != nil {
+				log.Errorf("Unable to enforce rebalance "+
+					"policy: %v", err)
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// enforcePolicy performs a single rebalance from the channel holding the
+// largest share of its capacity above the policy's maximum ratio, to the
+// channel holding the lowest share below its minimum ratio, as long as the
+// fee budget allows for it.
+func (r *Rebalancer) enforcePolicy() error {
+	policy := r.cfg.Policy
+
+	if time.Since(r.budgetStart) >= policy.BudgetInterval {
+		r.feeSpent = 0
+		r.budgetStart = time.Now()
+	}
+	if r.feeSpent >= policy.FeeBudget {
+		log.Debugf("Rebalance fee budget of %v exhausted",
+			policy.FeeBudget)
+		return nil
+	}
+
+	channels, err := r.cfg.FetchChannels()
+	if err != nil {
+		return err
+	}
+
+	req := planRebalance(channels, policy)
+	if req == nil {
+		return nil
+	}
+	req.FeeLimit = policy.FeeBudget - r.feeSpent
+
+	result, err := r.Rebalance(req)
+	if err != nil {
+		return err
+	}
+	r.feeSpent += result.Fee
+
+	return nil
+}
+
+// planRebalance returns the rebalance bringing the most unbalanced channels
+// closest to the target ratio of the policy, or nil if no rebalance is
+// needed.
+func planRebalance(channels []*ChannelBalance, policy *Policy) *Request {
+	var source, sink *ChannelBalance
+	for _, channel := range channels {
+		ratio := channel.ratio()
+		switch {
+		case ratio > policy.MaxRatio:
+			if source == nil || ratio > source.ratio() {
+				source = channel
+			}
+
+		case ratio < policy.MinRatio:
+			if sink == nil || ratio < sink.ratio() {
+				sink = channel
+			}
+		}
+	}
+	if source == nil || sink == nil {
+		return nil
+	}
+
+	// We'll shift as much as brings either channel to the target ratio,
+	// without pushing the other one past it.
+	target := policy.targetRatio()
+	excess := source.LocalBalance -
+		btcutil.Amount(target*float64(source.Capacity))
+	deficit := btcutil.Amount(target*float64(sink.Capacity)) -
+		sink.LocalBalance
+
+	amt := excess
+	if deficit < amt {
+		amt = deficit
+	}
+	if amt <= 0 {
+		return nil
+	}
+
+	return &Request{
+		OutgoingChanID: source.ChanID,
+		IncomingChanID: sink.ChanID,
+		Amount:         amt,
+	}
+}
@@ -0,0 +1,236 @@
+package rebalance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/routing"
+	"github.com/btcsuite/btcutil"
+)
+
+// TestPolicyValidate asserts that policies the rebalancer can't enforce are
+// rejected.
+func TestPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := Policy{
+		MinRatio:       0.2,
+		MaxRatio:       0.8,
+		FeeBudget:      1000,
+		BudgetInterval: time.Hour,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid policy rejected: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Policy)
+	}{
+		{
+			name:   "ratio out of range",
+			modify: func(p *Policy) { p.MaxRatio = 1.5 },
+		},
+		{
+			name:   "min ratio above max ratio",
+			modify: func(p *Policy) { p.MinRatio = 0.9 },
+		},
+		{
+			name:   "zero fee budget",
+			modify: func(p *Policy) { p.FeeBudget = 0 },
+		},
+		{
+			name:   "zero budget interval",
+			modify: func(p *Policy) { p.BudgetInterval = 0 },
+		},
+	}
+
+	for _, test := range tests {
+		policy := valid
+		test.modify(&policy)
+		if err := policy.Validate(); err == nil {
+			t.Fatalf("%v: invalid policy accepted", test.name)
+		}
+	}
+}
+
+// TestPlanRebalance asserts that the most unbalanced channels are paired up,
+// and that the planned amount brings neither of them past the target ratio.
+func TestPlanRebalance(t *testing.T) {
+	t.Parallel()
+
+	policy := &Policy{
+		MinRatio:       0.2,
+		MaxRatio:       0.8,
+		BudgetInterval: time.Hour,
+	}
+
+	tests := []struct {
+		name     string
+		channels []*ChannelBalance
+		expected *Request
+	}{
+		{
+			name: "balanced",
+			channels: []*ChannelBalance{
+				{ChanID: 1, Capacity: 1000, LocalBalance: 500},
+				{ChanID: 2, Capacity: 1000, LocalBalance: 300},
+			},
+		},
+		{
+			name: "no sink",
+			channels: []*ChannelBalance{
+				{ChanID: 1, Capacity: 1000, LocalBalance: 900},
+				{ChanID: 2, Capacity: 1000, LocalBalance: 500},
+			},
+		},
+		{
+			name: "limited by sink",
+			channels: []*ChannelBalance{
+				{ChanID: 1, Capacity: 1000, LocalBalance: 850},
+				{ChanID: 2, Capacity: 10000, LocalBalance: 9500},
+				{ChanID: 3, Capacity: 1000, LocalBalance: 100},
+				{ChanID: 4, Capacity: 1000, LocalBalance: 150},
+			},
+			expected: &Request{
+				OutgoingChanID: 2,
+				IncomingChanID: 3,
+				Amount:         400,
+			},
+		},
+		{
+			name: "limited by source",
+			channels: []*ChannelBalance{
+				{ChanID: 1, Capacity: 1000, LocalBalance: 900},
+				{ChanID: 2, Capacity: 10000, LocalBalance: 0},
+			},
+			expected: &Request{
+				OutgoingChanID: 1,
+				IncomingChanID: 2,
+				Amount:         400,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		req := planRebalance(test.channels, policy)
+		switch {
+		case req == nil && test.expected == nil:
+		case req == nil || test.expected == nil:
+			t.Fatalf("%v: expected %v, got %v", test.name,
+				test.expected, req)
+		case *req != *test.expected:
+			t.Fatalf("%v: expected %v, got %v", test.name,
+				*test.expected, *req)
+		}
+	}
+}
+
+// TestRebalance asserts that a rebalance pays a self-invoice over the route
+// found between the requested channels, and reports its fee.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	const (
+		outgoingChan = 1
+		incomingChan = 2
+		amt          = btcutil.Amount(1000)
+		fee          = lnwire.MilliSatoshi(5000)
+	)
+	paymentHash := [32]byte{1}
+	preimage := [32]byte{2}
+	route := &routing.Route{
+		TotalAmount: lnwire.NewMSatFromSatoshis(amt) + fee,
+		TotalFees:   fee,
+	}
+
+	var (
+		invoiceAmt      btcutil.Amount
+		canceledInvoice *[32]byte
+		sendErr         error
+	)
+	rebalancer := New(&Config{
+		FindRoute: func(out, in uint64,
+			routeAmt, _ lnwire.MilliSatoshi) (*routing.Route, error) {
+
+			if out != outgoingChan || in != incomingChan {
+				return nil, errors.New("wrong channels")
+			}
+			if routeAmt != lnwire.NewMSatFromSatoshis(amt) {
+				return nil, errors.New("wrong amount")
+			}
+			return route, nil
+		},
+		AddInvoice: func(invAmt btcutil.Amount,
+			_ string) ([32]byte, error) {
+
+			invoiceAmt = invAmt
+			return paymentHash, nil
+		},
+		SendToRoute: func(hash [32]byte,
+			r *routing.Route) ([32]byte, error) {
+
+			if hash != paymentHash || r != route {
+				return [32]byte{}, errors.New("wrong payment")
+			}
+			if sendErr != nil {
+				return [32]byte{}, sendErr
+			}
+			return preimage, nil
+		},
+		CancelInvoice: func(hash [32]byte) error {
+			canceledInvoice = &hash
+			return nil
+		},
+	})
+
+	result, err := rebalancer.Rebalance(&Request{
+		OutgoingChanID: outgoingChan,
+		IncomingChanID: incomingChan,
+		Amount:         amt,
+		FeeLimit:       fee,
+	})
+	if err != nil {
+		t.Fatalf("unable to rebalance: %v", err)
+	}
+	if invoiceAmt != amt {
+		t.Fatalf("expected invoice over %v, got %v", amt, invoiceAmt)
+	}
+	if result.Fee != fee {
+		t.Fatalf("expected fee %v, got %v", fee, result.Fee)
+	}
+	if result.Preimage != preimage {
+		t.Fatalf("expected preimage %x, got %x", preimage,
+			result.Preimage)
+	}
+	if canceledInvoice != nil {
+		t.Fatalf("invoice of successful rebalance canceled")
+	}
+
+	// The self-invoice of a failed rebalance is canceled.
+	sendErr = errors.New("payment failed")
+	_, err = rebalancer.Rebalance(&Request{
+		OutgoingChanID: outgoingChan,
+		IncomingChanID: incomingChan,
+		Amount:         amt,
+		FeeLimit:       fee,
+	})
+	if err != sendErr {
+		t.Fatalf("expected %v, got %v", sendErr, err)
+	}
+	if canceledInvoice == nil || *canceledInvoice != paymentHash {
+		t.Fatalf("expected invoice %x to be canceled", paymentHash)
+	}
+
+	// Rebalancing a channel to itself isn't possible.
+	_, err = rebalancer.Rebalance(&Request{
+		OutgoingChanID: outgoingChan,
+		IncomingChanID: outgoingChan,
+		Amount:         amt,
+	})
+	if err != ErrSameChannel {
+		t.Fatalf("expected ErrSameChannel, got %v", err)
+	}
+}
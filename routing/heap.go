@@ -12,6 +12,14 @@ type nodeWithDist struct {
 	// current context.
 	dist int64
 
+	// weight is the cumulative weight of the edges on the path from this
+	// node to the target, without accounting for its probability.
+	weight int64
+
+	// probability is the probability that the payment succeeds from this
+	// node to the target.
+	probability float64
+
 	// node is the vertex itself. This pointer can be used to explore all
 	// the outgoing edges (channels) emanating from a node.
 	node *channeldb.LightningNode
@@ -2,6 +2,7 @@ package routing
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -12,48 +13,74 @@ import (
 )
 
 const (
-	// vertexDecay is the decay period of colored vertexes added to
-	// missionControl. Once vertexDecay passes after an entry has been
-	// added to the prune view, it is garbage collected. This value is
-	// larger than edgeDecay as an edge failure typical indicates an
-	// unbalanced channel, while a vertex failure indicates a node is not
-	// online and active.
-	vertexDecay = time.Duration(time.Minute * 5)
-
-	// edgeDecay is the decay period of colored edges added to
-	// missionControl. Once edgeDecay passed after an entry has been added,
-	// it is garbage collected. This value is smaller than vertexDecay as
-	// an edge related failure during payment sending typically indicates
-	// that a channel was unbalanced, a condition which may quickly change.
-	//
-	// TODO(roasbeef): instead use random delay on each?
-	edgeDecay = time.Duration(time.Second * 5)
+	// aprioriHopProbability is the assumed success probability of a hop
+	// in a route when no other information is available.
+	aprioriHopProbability = 0.6
+
+	// prevSuccessProbability is the assumed success probability of a hop
+	// that recently forwarded an amount at least as large as the one
+	// that is to be sent.
+	prevSuccessProbability = 0.95
+
+	// penaltyHalfLife is the time after which the penalty applied to the
+	// success probability of a node or node pair that failed is halved.
+	// This value is larger than the time a channel typically takes to be
+	// rebalanced, as well as the time a failing node typically takes to
+	// come back online.
+	penaltyHalfLife = time.Hour
+
+	// paymentAttemptPenalty is the virtual cost in path finding weight
+	// units of executing a payment attempt that fails. It is used to trade
+	// off potentially better routes against their probability of
+	// succeeding.
+	paymentAttemptPenalty = lnwire.MilliSatoshi(100000)
+
+	// minRouteProbability is the minimum success probability a route must
+	// have for it to be attempted.
+	minRouteProbability = 0.01
+
+	// maxMcHistory is the maximum number of payment results that are
+	// persisted by missionControl. Once exceeded, the oldest results are
+	// removed.
+	maxMcHistory = 1000
 )
 
+// nodePair is a pair of nodes, where the first node forwards payments to the
+// second one.
+type nodePair struct {
+	from Vertex
+	to   Vertex
+}
+
+// pairResult is the last observed outcome of forwarding a payment between a
+// pair of nodes.
+type pairResult struct {
+	// timestamp is the time at which the outcome was observed.
+	timestamp time.Time
+
+	// amt is the amount that was forwarded, or attempted to be.
+	amt lnwire.MilliSatoshi
+
+	// success indicates whether the payment was forwarded successfully.
+	success bool
+}
+
 // missionControl contains state which summarizes the past attempts of HTLC
 // routing by external callers when sending payments throughout the network.
 // missionControl remembers the outcome of these past routing attempts (success
 // and failure), and is able to provide hints/guidance to future HTLC routing
-// attempts.  missionControl maintains a decaying network view of the
-// edges/vertexes that should be marked as "pruned" during path finding. This
-// graph view acts as a shared memory during HTLC payment routing attempts.
-// With each execution, if an error is encountered, based on the type of error
-// and the location of the error within the route, an edge or vertex is added
-// to the view. Later sending attempts will then query the view for all the
-// vertexes/edges that should be ignored. Items in the view decay after a set
-// period of time, allowing the view to be dynamic w.r.t network changes.
+// attempts. The outcomes are persisted, and are used to estimate the
+// probability that a node forwards a payment of a certain amount to another
+// node. The penalty applied to failed nodes and node pairs decays over time,
+// allowing the view to be dynamic w.r.t network changes.
 type missionControl struct {
-	// failedEdges maps a short channel ID to be pruned, to the time that
-	// it was added to the prune view. Edges are added to this map if a
-	// caller reports to missionControl a failure localized to that edge
-	// when sending a payment.
-	failedEdges map[uint64]time.Time
-
-	// failedVertexes maps a node's public key that should be pruned, to
-	// the time that it was added to the prune view. Vertexes are added to
-	// this map if a caller reports to missionControl a failure localized
-	// to that particular vertex.
-	failedVertexes map[Vertex]time.Time
+	// lastPairResult maps a pair of nodes to the last observed outcome of
+	// forwarding a payment between them.
+	lastPairResult map[nodePair]pairResult
+
+	// lastNodeFailure maps a node's public key to the last time a failure
+	// localized to that node as a whole was observed.
+	lastNodeFailure map[Vertex]time.Time
 
 	graph *channeldb.ChannelGraph
 
@@ -61,102 +88,197 @@ type missionControl struct {
 
 	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	// now is the function used to obtain the current time. It can be
+	// overridden in tests.
+	now func() time.Time
+
 	sync.Mutex
+}
+
+// newMissionControl returns a new instance of missionControl, restoring the
+// payment results persisted in the database of the graph.
+func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningNode,
+	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) (
+	*missionControl, error) {
+
+	m := &missionControl{
+		lastPairResult:  make(map[nodePair]pairResult),
+		lastNodeFailure: make(map[Vertex]time.Time),
+		selfNode:        selfNode,
+		queryBandwidth:  qb,
+		graph:           g,
+		now:             time.Now,
+	}
+
+	results, err := g.Database().FetchMissionControlResults()
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO(roasbeef): further counters, if vertex continually unavailable,
-	// add to another generation
+	// The results are returned from oldest to newest, so replaying them
+	// leaves us with the latest result of every node and node pair.
+	for _, result := range results {
+		m.applyResult(result)
+	}
 
-	// TODO(roasbeef): also add favorable metrics for nodes
+	log.Debugf("Mission Control restored %v payment results",
+		len(results))
+
+	return m, nil
 }
 
-// newMissionControl returns a new instance of missionControl.
+// applyResult applies a payment result to the in-memory state of mission
+// control. A result without a destination node is a failure of its source
+// node as a whole.
 //
-// TODO(roasbeef): persist memory
-func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningNode,
-	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) *missionControl {
+// NOTE: This method must be called with the mutex held, or before mission
+// control is in use.
+func (m *missionControl) applyResult(result *channeldb.MissionControlResult) {
+	from := Vertex(result.FromNode)
+	if result.ToNode == [33]byte{} {
+		m.lastNodeFailure[from] = result.Timestamp
+		return
+	}
+
+	m.lastPairResult[nodePair{from: from, to: Vertex(result.ToNode)}] =
+		pairResult{
+			timestamp: result.Timestamp,
+			amt:       result.Amount,
+			success:   result.Success,
+		}
+}
+
+// addResult applies a payment result to the state of mission control and
+// persists it, such that it survives restarts.
+func (m *missionControl) addResult(result *channeldb.MissionControlResult) {
+	m.Lock()
+	m.applyResult(result)
+	m.Unlock()
 
-	return &missionControl{
-		failedEdges:    make(map[uint64]time.Time),
-		failedVertexes: make(map[Vertex]time.Time),
-		selfNode:       selfNode,
-		queryBandwidth: qb,
-		graph:          g,
+	err := m.graph.Database().AddMissionControlResult(result, maxMcHistory)
+	if err != nil {
+		log.Errorf("Unable to persist mission control result: %v", err)
 	}
 }
 
-// graphPruneView is a filter of sorts that path finding routines should
-// consult during the execution. Any edges or vertexes within the view should
-// be ignored during path finding. The contents of the view reflect the current
-// state of the wider network from the PoV of mission control compiled via HTLC
-// routing attempts in the past.
-type graphPruneView struct {
-	edges map[uint64]struct{}
+// reportVertexFailure records a failure localized to the vertex as a whole.
+func (m *missionControl) reportVertexFailure(v Vertex) {
+	m.addResult(&channeldb.MissionControlResult{
+		Timestamp: m.now(),
+		FromNode:  v,
+	})
+}
 
-	vertexes map[Vertex]struct{}
+// reportPairResult records the outcome of forwarding the amount from one
+// node to another.
+func (m *missionControl) reportPairResult(from, to Vertex,
+	amt lnwire.MilliSatoshi, success bool) {
+
+	m.addResult(&channeldb.MissionControlResult{
+		Timestamp: m.now(),
+		FromNode:  from,
+		ToNode:    to,
+		Amount:    amt,
+		Success:   success,
+	})
 }
 
-// GraphPruneView returns a new graphPruneView instance which is to be
-// consulted during path finding. If a vertex/edge is found within the returned
-// prune view, it is to be ignored as a goroutine has had issues routing
-// through it successfully. Within this method the main view of the
-// missionControl is garbage collected as entries are detected to be "stale".
-func (m *missionControl) GraphPruneView() graphPruneView {
-	// First, we'll grab the current time, this value will be used to
-	// determine if an entry is stale or not.
-	now := time.Now()
+// getEdgeProbability is expected to return the success probability of a
+// payment of the given amount being forwarded from one node to another. Path
+// finding uses it to favor routes that are likely to succeed.
+func (m *missionControl) getEdgeProbability(from, to Vertex,
+	amt lnwire.MilliSatoshi) float64 {
 
 	m.Lock()
+	defer m.Unlock()
 
-	// For each of the vertexes that have been added to the prune view, if
-	// it is now "stale", then we'll ignore it and avoid adding it to the
-	// view we'll return.
-	vertexes := make(map[Vertex]struct{})
-	for vertex, pruneTime := range m.failedVertexes {
-		if now.Sub(pruneTime) >= vertexDecay {
-			log.Tracef("Pruning decayed failure report for vertex %v "+
-				"from Mission Control", vertex)
+	return m.getEdgeProbabilityLocked(from, to, amt, m.now())
+}
 
-			delete(m.failedVertexes, vertex)
-			continue
-		}
+// getEdgeProbabilityLocked returns the success probability of forwarding the
+// amount from one node to another at the given time.
+//
+// NOTE: This method must be called with the mutex held.
+func (m *missionControl) getEdgeProbabilityLocked(from, to Vertex,
+	amt lnwire.MilliSatoshi, now time.Time) float64 {
+
+	// Our own channels are covered by the bandwidth hints, so there's no
+	// uncertainty about them.
+	if from == Vertex(m.selfNode.PubKeyBytes) {
+		return 1
+	}
+
+	probability := aprioriHopProbability
 
-		vertexes[vertex] = struct{}{}
+	// If the node failed as a whole, the probability is lowered for all of
+	// its pairs, regardless of the amount.
+	nodeFailTime, nodeFailed := m.lastNodeFailure[from]
+	if nodeFailed {
+		probability = penalizedProbability(nodeFailTime, now)
 	}
 
-	// We'll also do the same for edges, but use the edgeDecay this time
-	// rather than the decay for vertexes.
-	edges := make(map[uint64]struct{})
-	for edge, pruneTime := range m.failedEdges {
-		if now.Sub(pruneTime) >= edgeDecay {
-			log.Tracef("Pruning decayed failure report for edge %v "+
-				"from Mission Control", edge)
+	result, ok := m.lastPairResult[nodePair{from: from, to: to}]
+	switch {
+	case !ok:
 
-			delete(m.failedEdges, edge)
-			continue
-		}
+	// A failure only tells us something about amounts at least as large
+	// as the one that failed.
+	case !result.success && amt >= result.amt:
+		probability = math.Min(
+			probability, penalizedProbability(result.timestamp, now),
+		)
+
+	// A success is only relevant for amounts up to the one that
+	// succeeded, and as long as the node didn't fail since.
+	case result.success && amt <= result.amt &&
+		(!nodeFailed || result.timestamp.After(nodeFailTime)):
 
-		edges[edge] = struct{}{}
+		probability = prevSuccessProbability
 	}
 
-	m.Unlock()
+	return probability
+}
+
+// penalizedProbability returns the success probability of a node or node pair
+// that failed at the given time. The probability is zero right after the
+// failure, and recovers towards the a-priori probability as time passes.
+func penalizedProbability(failTime, now time.Time) float64 {
+	elapsed := now.Sub(failTime)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	recovery := 1 - math.Pow(2, -float64(elapsed)/float64(penaltyHalfLife))
+
+	return aprioriHopProbability * recovery
+}
+
+// graphPruneView is a filter of sorts that path finding routines should
+// consult during the execution. Any edges or vertexes within the view should
+// be ignored during path finding. It is used by payment sessions to make
+// sure that edges and vertexes that failed during the session aren't retried.
+type graphPruneView struct {
+	edges map[uint64]struct{}
 
-	log.Debugf("Mission Control returning prune view of %v edges, %v "+
-		"vertexes", len(edges), len(vertexes))
+	vertexes map[Vertex]struct{}
+}
 
+// newGraphPruneView returns a new, empty graphPruneView.
+func newGraphPruneView() graphPruneView {
 	return graphPruneView{
-		edges:    edges,
-		vertexes: vertexes,
+		edges:    make(map[uint64]struct{}),
+		vertexes: make(map[Vertex]struct{}),
 	}
 }
 
 // paymentSession is used during an HTLC routings session to prune the local
 // chain view in response to failures, and also report those failures back to
-// missionControl. The snapshot copied for this session will only ever grow,
-// and will now be pruned after a decay like the main view within mission
-// control. We do this as we want to avoid the case where we continually try a
-// bad edge or route multiple times in a session. This can lead to an infinite
-// loop if payment attempts take long enough. An additional set of edges can
-// also be provided to assist in reaching the payment's destination.
+// missionControl. The prune view of this session will only ever grow, and
+// won't decay like the probabilities within mission control. We do this as we
+// want to avoid the case where we continually try a bad edge or route
+// multiple times in a session. This can lead to an infinite loop if payment
+// attempts take long enough. An additional set of edges can also be provided
+// to assist in reaching the payment's destination.
 type paymentSession struct {
 	pruneViewSnapshot graphPruneView
 
@@ -164,6 +286,10 @@ type paymentSession struct {
 
 	bandwidthHints map[uint64]lnwire.MilliSatoshi
 
+	// reservedBandwidth is the bandwidth of our channels that is used by
+	// shards of a multi-path payment that are in flight.
+	reservedBandwidth map[uint64]lnwire.MilliSatoshi
+
 	// errFailedFeeChans is a map of the short channel ID's that were the
 	// source of policy related routing failures during this payment attempt.
 	// We'll use this map to prune out channels when the first error may not
@@ -176,14 +302,14 @@ type paymentSession struct {
 	preBuiltRoutes []*Route
 }
 
-// NewPaymentSession creates a new payment session backed by the latest prune
-// view from Mission Control. An optional set of routing hints can be provided
-// in order to populate additional edges to explore when finding a path to the
-// payment's destination.
+// NewPaymentSession creates a new payment session backed by Mission Control.
+// An optional set of routing hints can be provided in order to populate
+// additional edges to explore when finding a path to the payment's
+// destination.
 func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 	target *btcec.PublicKey) (*paymentSession, error) {
 
-	viewSnapshot := m.GraphPruneView()
+	viewSnapshot := newGraphPruneView()
 
 	edges := make(map[Vertex][]*channeldb.ChannelEdgePolicy)
 
@@ -245,6 +371,7 @@ func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 		pruneViewSnapshot:    viewSnapshot,
 		additionalEdges:      edges,
 		bandwidthHints:       bandwidthHints,
+		reservedBandwidth:    make(map[uint64]lnwire.MilliSatoshi),
 		errFailedPolicyChans: make(map[uint64]struct{}),
 		mc:                   m,
 	}, nil
@@ -256,9 +383,10 @@ func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 // used for things like channel rebalancing, and swaps.
 func (m *missionControl) NewPaymentSessionFromRoutes(routes []*Route) *paymentSession {
 	return &paymentSession{
-		pruneViewSnapshot:    m.GraphPruneView(),
+		pruneViewSnapshot:    newGraphPruneView(),
 		haveRoutes:           true,
 		preBuiltRoutes:       routes,
+		reservedBandwidth:    make(map[uint64]lnwire.MilliSatoshi),
 		errFailedPolicyChans: make(map[uint64]struct{}),
 		mc:                   m,
 	}
@@ -300,43 +428,78 @@ func generateBandwidthHints(sourceNode *channeldb.LightningNode,
 }
 
 // ReportVertexFailure adds a vertex to the graph prune view after a client
-// reports a routing failure localized to the vertex. The time the vertex was
-// added is noted, as it'll be pruned from the shared view after a period of
-// vertexDecay. However, the vertex will remain pruned for the *local* session.
-// This ensures we don't retry this vertex during the payment attempt.
+// reports a routing failure localized to the vertex. The failure is reported
+// to mission control, which lowers the success probability of the vertex for
+// future payment sessions, until the penalty decays. However, the vertex will
+// remain pruned for the *local* session. This ensures we don't retry this
+// vertex during the payment attempt.
 func (p *paymentSession) ReportVertexFailure(v Vertex) {
 	log.Debugf("Reporting vertex %v failure to Mission Control", v)
 
 	// First, we'll add the failed vertex to our local prune view snapshot.
 	p.pruneViewSnapshot.vertexes[v] = struct{}{}
 
-	// With the vertex added, we'll now report back to the global prune
-	// view, with this new piece of information so it can be utilized for
-	// new payment sessions.
-	p.mc.Lock()
-	p.mc.failedVertexes[v] = time.Now()
-	p.mc.Unlock()
+	// With the vertex added, we'll now report back to mission control,
+	// with this new piece of information so it can be utilized for new
+	// payment sessions.
+	p.mc.reportVertexFailure(v)
 }
 
-// ReportChannelFailure adds a channel to the graph prune view. The time the
-// channel was added is noted, as it'll be pruned from the global view after a
-// period of edgeDecay. However, the edge will remain pruned for the duration
-// of the *local* session. This ensures that we don't flap by continually
-// retrying an edge after its pruning has expired.
-//
-// TODO(roasbeef): also add value attempted to send and capacity of channel
-func (p *paymentSession) ReportChannelFailure(e uint64) {
+// ReportChannelFailure adds a channel of the route to the graph prune view.
+// The failure to forward the amount of the route over the channel is
+// reported to mission control, which lowers the success probability of the
+// node pair for future payment sessions, until the penalty decays. However,
+// the edge will remain pruned for the duration of the *local* session. This
+// ensures that we don't flap by continually retrying an edge.
+func (p *paymentSession) ReportChannelFailure(route *Route, e uint64) {
 	log.Debugf("Reporting edge %v failure to Mission Control", e)
 
 	// First, we'll add the failed edge to our local prune view snapshot.
 	p.pruneViewSnapshot.edges[e] = struct{}{}
 
-	// With the edge added, we'll now report back to the global prune view,
-	// with this new piece of information so it can be utilized for new
-	// payment sessions.
-	p.mc.Lock()
-	p.mc.failedEdges[e] = time.Now()
-	p.mc.Unlock()
+	// With the edge added, we'll now report back to mission control, with
+	// this new piece of information so it can be utilized for new payment
+	// sessions.
+	for i, hop := range route.Hops {
+		if hop.ChannelID != e {
+			continue
+		}
+
+		from, to, amt := p.hopPair(route, i)
+		p.mc.reportPairResult(from, to, amt, false)
+		return
+	}
+}
+
+// ReportSuccess reports to mission control that the payment sent over the
+// route was forwarded successfully by every node of the route, raising the
+// success probability of their node pairs for future payment sessions.
+func (p *paymentSession) ReportSuccess(route *Route) {
+	for i := range route.Hops {
+		from, to, amt := p.hopPair(route, i)
+
+		// There's no point in recording the outcome of our own
+		// channels, as their bandwidth is known.
+		if from == Vertex(p.mc.selfNode.PubKeyBytes) {
+			continue
+		}
+
+		p.mc.reportPairResult(from, to, amt, true)
+	}
+}
+
+// hopPair returns the pair of nodes connected by the channel of the hop of the
+// route with the given index, along with the amount forwarded over it.
+func (p *paymentSession) hopPair(route *Route, i int) (Vertex, Vertex,
+	lnwire.MilliSatoshi) {
+
+	if i == 0 {
+		return Vertex(p.mc.selfNode.PubKeyBytes),
+			route.Hops[0].PubKeyBytes, route.TotalAmount
+	}
+
+	return route.Hops[i-1].PubKeyBytes, route.Hops[i].PubKeyBytes,
+		route.Hops[i-1].AmtToForward
 }
 
 // ReportChannelPolicyFailure handles a failure message that relates to a
@@ -364,6 +527,47 @@ func (p *paymentSession) ReportChannelPolicyFailure(
 	p.errFailedPolicyChans[failedChanID] = struct{}{}
 }
 
+// reserveBandwidth marks the amount sent over the route as used on the channel
+// of its first hop, such that concurrent shards of a multi-path payment aren't
+// routed over a channel that can't carry them all.
+func (p *paymentSession) reserveBandwidth(route *Route) {
+	p.reservedBandwidth[route.Hops[0].ChannelID] += route.TotalAmount
+}
+
+// releaseBandwidth makes the amount sent over the route available again on the
+// channel of its first hop, after the shard sent over it failed.
+func (p *paymentSession) releaseBandwidth(route *Route) {
+	chanID := route.Hops[0].ChannelID
+	p.reservedBandwidth[chanID] -= route.TotalAmount
+	if p.reservedBandwidth[chanID] == 0 {
+		delete(p.reservedBandwidth, chanID)
+	}
+}
+
+// availableBandwidth returns the bandwidth hints of the session, minus the
+// bandwidth reserved by shards in flight.
+func (p *paymentSession) availableBandwidth() map[uint64]lnwire.MilliSatoshi {
+	if len(p.reservedBandwidth) == 0 {
+		return p.bandwidthHints
+	}
+
+	hints := make(map[uint64]lnwire.MilliSatoshi, len(p.bandwidthHints))
+	for chanID, bandwidth := range p.bandwidthHints {
+		reserved := p.reservedBandwidth[chanID]
+
+		// Path finding treats a zero hint as unknown, so we'll leave
+		// a single milli-satoshi on exhausted channels to ensure
+		// they're skipped.
+		if reserved >= bandwidth {
+			hints[chanID] = 1
+			continue
+		}
+		hints[chanID] = bandwidth - reserved
+	}
+
+	return hints
+}
+
 // RequestRoute returns a route which is likely to be capable for successfully
 // routing the specified HTLC payment to the target node. Initially the first
 // set of paths returned from this method may encounter routing failure along
@@ -402,29 +606,47 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 
 	// TODO(roasbeef): sync logic amongst dist sys
 
+	sourceVertex := Vertex(p.mc.selfNode.PubKeyBytes)
+	restrictions := &restrictParams{
+		ignoredNodes:          pruneView.vertexes,
+		ignoredEdges:          pruneView.edges,
+		feeLimit:              payment.FeeLimit,
+		probabilitySource:     p.mc.getEdgeProbability,
+		paymentAttemptPenalty: paymentAttemptPenalty,
+		minProbability:        minRouteProbability,
+		outgoingChannelID:     payment.OutgoingChannelID,
+		lastHop:               payment.LastHop,
+	}
+
 	// Taking into account this prune view, we'll attempt to locate a path
 	// to our destination, respecting the recommendations from
-	// missionControl.
-	path, err := findPath(
-		&graphParams{
-			graph:           p.mc.graph,
-			additionalEdges: p.additionalEdges,
-			bandwidthHints:  p.bandwidthHints,
-		},
-		&restrictParams{
-			ignoredNodes: pruneView.vertexes,
-			ignoredEdges: pruneView.edges,
-			feeLimit:     payment.FeeLimit,
-		},
-		p.mc.selfNode, payment.Target, payment.Amount,
+	// missionControl. Payments to ourselves, such as rebalances, need a
+	// circular path instead.
+	var (
+		path []*channeldb.ChannelEdgePolicy
+		err  error
 	)
+	if NewVertex(payment.Target) == sourceVertex {
+		path, err = p.findCircularPath(
+			restrictions, payment.Target, payment.Amount,
+		)
+	} else {
+		path, err = findPath(
+			&graphParams{
+				graph:           p.mc.graph,
+				additionalEdges: p.additionalEdges,
+				bandwidthHints:  p.availableBandwidth(),
+			},
+			restrictions, p.mc.selfNode, payment.Target,
+			payment.Amount,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// With the next candidate path found, we'll attempt to turn this into
 	// a route by applying the time-lock and fee requirements.
-	sourceVertex := Vertex(p.mc.selfNode.PubKeyBytes)
 	route, err := newRoute(
 		payment.Amount, payment.FeeLimit, sourceVertex, path, height,
 		finalCltvDelta,
@@ -438,11 +660,205 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	return route, err
 }
 
+// findCircularPath finds a path leaving our node over the outgoing channel of
+// the restrictions, and returning to our node as the target. As a path can't
+// visit our node twice, it's found from the peer of the outgoing channel
+// onwards, without passing through our node or the outgoing channel again.
+func (p *paymentSession) findCircularPath(r *restrictParams,
+	target *btcec.PublicKey,
+	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
+
+	if r.outgoingChannelID == nil {
+		return nil, fmt.Errorf("payments to ourselves require an " +
+			"outgoing channel")
+	}
+	chanID := *r.outgoingChannelID
+
+	sourceVertex := Vertex(p.mc.selfNode.PubKeyBytes)
+	_, outgoingPolicy, err := fetchLocalPolicy(
+		p.mc.graph, sourceVertex, chanID, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if bandwidth, ok := p.availableBandwidth()[chanID]; ok &&
+		bandwidth < amt {
+
+		return nil, newErrf(ErrNoPathFound, "outgoing channel %v "+
+			"has insufficient bandwidth: %v", chanID, bandwidth)
+	}
+
+	ignoredNodes := make(map[Vertex]struct{}, len(r.ignoredNodes)+1)
+	for node := range r.ignoredNodes {
+		ignoredNodes[node] = struct{}{}
+	}
+	ignoredNodes[sourceVertex] = struct{}{}
+
+	ignoredEdges := make(map[uint64]struct{}, len(r.ignoredEdges)+1)
+	for edge := range r.ignoredEdges {
+		ignoredEdges[edge] = struct{}{}
+	}
+	ignoredEdges[chanID] = struct{}{}
+
+	peerRestrictions := *r
+	peerRestrictions.ignoredNodes = ignoredNodes
+	peerRestrictions.ignoredEdges = ignoredEdges
+	peerRestrictions.outgoingChannelID = nil
+
+	peer := &channeldb.LightningNode{
+		PubKeyBytes: outgoingPolicy.Node.PubKeyBytes,
+	}
+	path, err := findPath(
+		&graphParams{
+			graph:           p.mc.graph,
+			additionalEdges: p.additionalEdges,
+		},
+		&peerRestrictions, peer, target, amt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*channeldb.ChannelEdgePolicy{outgoingPolicy},
+		path...), nil
+}
+
 // ResetHistory resets the history of missionControl returning it to a state as
 // if no payment attempts have been made.
-func (m *missionControl) ResetHistory() {
+func (m *missionControl) ResetHistory() error {
+	if err := m.graph.Database().ResetMissionControlResults(); err != nil {
+		return err
+	}
+
 	m.Lock()
-	m.failedEdges = make(map[uint64]time.Time)
-	m.failedVertexes = make(map[Vertex]time.Time)
+	m.lastPairResult = make(map[nodePair]pairResult)
+	m.lastNodeFailure = make(map[Vertex]time.Time)
 	m.Unlock()
+
+	log.Debugf("Mission Control history cleared")
+
+	return nil
+}
+
+// MissionControlNodeSnapshot contains a snapshot of the last failure of a
+// node as a whole, as observed by mission control.
+type MissionControlNodeSnapshot struct {
+	// Node is the public key of the node.
+	Node Vertex
+
+	// LastFail is the time of the last failure localized to the node.
+	LastFail time.Time
+}
+
+// MissionControlPairSnapshot contains a snapshot of the last result of
+// forwarding a payment between a pair of nodes, as observed by mission
+// control.
+type MissionControlPairSnapshot struct {
+	// FromNode is the node that forwarded, or was asked to forward, the
+	// payment.
+	FromNode Vertex
+
+	// ToNode is the node the payment was forwarded to.
+	ToNode Vertex
+
+	// Timestamp is the time of the last result.
+	Timestamp time.Time
+
+	// Amount is the amount of the last result.
+	Amount lnwire.MilliSatoshi
+
+	// Success indicates whether the last result was a success.
+	Success bool
+
+	// SuccessProb is the current success probability of forwarding the
+	// amount of the last result between the pair of nodes.
+	SuccessProb float64
+}
+
+// MissionControlSnapshot contains a snapshot of the current state of mission
+// control.
+type MissionControlSnapshot struct {
+	// Nodes contains the last failures of nodes as a whole.
+	Nodes []MissionControlNodeSnapshot
+
+	// Pairs contains the last results of node pairs.
+	Pairs []MissionControlPairSnapshot
+}
+
+// GetHistorySnapshot takes a snapshot of the current state of mission
+// control.
+func (m *missionControl) GetHistorySnapshot() *MissionControlSnapshot {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.now()
+
+	snapshot := &MissionControlSnapshot{
+		Nodes: make(
+			[]MissionControlNodeSnapshot, 0,
+			len(m.lastNodeFailure),
+		),
+		Pairs: make(
+			[]MissionControlPairSnapshot, 0, len(m.lastPairResult),
+		),
+	}
+
+	for v, lastFail := range m.lastNodeFailure {
+		snapshot.Nodes = append(snapshot.Nodes,
+			MissionControlNodeSnapshot{
+				Node:     v,
+				LastFail: lastFail,
+			},
+		)
+	}
+
+	for pair, result := range m.lastPairResult {
+		snapshot.Pairs = append(snapshot.Pairs,
+			m.pairSnapshot(pair, result, now),
+		)
+	}
+
+	return snapshot
+}
+
+// GetPairHistorySnapshot returns the success probability of forwarding the
+// amount from one node to another, along with the last result of the pair, if
+// any.
+func (m *missionControl) GetPairHistorySnapshot(from, to Vertex,
+	amt lnwire.MilliSatoshi) (float64, *MissionControlPairSnapshot) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.now()
+	probability := m.getEdgeProbabilityLocked(from, to, amt, now)
+
+	pair := nodePair{from: from, to: to}
+	result, ok := m.lastPairResult[pair]
+	if !ok {
+		return probability, nil
+	}
+
+	snapshot := m.pairSnapshot(pair, result, now)
+	return probability, &snapshot
+}
+
+// pairSnapshot returns a snapshot of the last result of the node pair, along
+// with the current success probability of forwarding its amount between them.
+//
+// NOTE: This method must be called with the mutex held.
+func (m *missionControl) pairSnapshot(pair nodePair, result pairResult,
+	now time.Time) MissionControlPairSnapshot {
+
+	return MissionControlPairSnapshot{
+		FromNode:  pair.from,
+		ToNode:    pair.to,
+		Timestamp: result.timestamp,
+		Amount:    result.amt,
+		Success:   result.success,
+		SuccessProb: m.getEdgeProbabilityLocked(
+			pair.from, pair.to, result.amt, now,
+		),
+	}
 }
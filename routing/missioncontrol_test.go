@@ -0,0 +1,113 @@
+package routing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/channeldb"
+	"github.com/breez/lightninglib/lnwire"
+)
+
+// assertProbability asserts that the probability is equal to the expected one,
+// within a small margin.
+func assertProbability(t *testing.T, expected, probability float64) {
+	t.Helper()
+
+	if math.Abs(expected-probability) > 0.0001 {
+		t.Fatalf("expected probability %v, got %v", expected,
+			probability)
+	}
+}
+
+// TestMissionControl tests that mission control derives success probabilities
+// from the reported payment results, that penalties decay over time and that
+// the results are restored after a restart.
+func TestMissionControl(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+
+	selfNode := &channeldb.LightningNode{PubKeyBytes: Vertex{1}}
+	nodeA := Vertex{2}
+	nodeB := Vertex{3}
+	nodeC := Vertex{4}
+
+	now := time.Unix(1500000000, 0)
+	newMC := func() *missionControl {
+		mc, err := newMissionControl(graph, selfNode, nil)
+		if err != nil {
+			t.Fatalf("unable to create mission control: %v", err)
+		}
+		mc.now = func() time.Time { return now }
+		return mc
+	}
+	mc := newMC()
+
+	getProb := func(from, to Vertex, amt lnwire.MilliSatoshi) float64 {
+		return mc.getEdgeProbability(from, to, amt)
+	}
+
+	// Without any results, the a-priori probability applies, except for
+	// our own channels.
+	assertProbability(t, aprioriHopProbability, getProb(nodeA, nodeB, 1000))
+	assertProbability(t, 1, getProb(selfNode.PubKeyBytes, nodeA, 1000))
+
+	// Right after a pair failed, its probability is zero for amounts at
+	// least as large as the one that failed. Smaller amounts aren't
+	// affected.
+	mc.reportPairResult(nodeA, nodeB, 1000, false)
+	assertProbability(t, 0, getProb(nodeA, nodeB, 1000))
+	assertProbability(t, aprioriHopProbability, getProb(nodeA, nodeB, 500))
+
+	// After one half life, half of the penalty is gone.
+	now = now.Add(penaltyHalfLife)
+	assertProbability(t, aprioriHopProbability/2, getProb(nodeA, nodeB, 1000))
+
+	// A success raises the probability for amounts up to the one that
+	// succeeded.
+	mc.reportPairResult(nodeA, nodeB, 2000, true)
+	assertProbability(t, prevSuccessProbability, getProb(nodeA, nodeB, 1000))
+	assertProbability(t, aprioriHopProbability, getProb(nodeA, nodeB, 3000))
+
+	// A failure of the node as a whole affects all of its pairs, including
+	// those that succeeded before.
+	now = now.Add(time.Second)
+	mc.reportVertexFailure(nodeA)
+	assertProbability(t, 0, getProb(nodeA, nodeB, 1000))
+	assertProbability(t, 0, getProb(nodeA, nodeC, 1000))
+
+	// After a restart, the results should have been restored.
+	now = now.Add(penaltyHalfLife)
+	mc = newMC()
+	assertProbability(t, aprioriHopProbability/2, getProb(nodeA, nodeC, 1000))
+
+	snapshot := mc.GetHistorySnapshot()
+	if len(snapshot.Nodes) != 1 || snapshot.Nodes[0].Node != nodeA {
+		t.Fatalf("expected a failure of node A, got %v", snapshot.Nodes)
+	}
+	if len(snapshot.Pairs) != 1 || !snapshot.Pairs[0].Success ||
+		snapshot.Pairs[0].Amount != 2000 {
+
+		t.Fatalf("expected a success of pair A->B, got %v",
+			snapshot.Pairs)
+	}
+
+	probability, pair := mc.GetPairHistorySnapshot(nodeA, nodeB, 3000)
+	assertProbability(t, aprioriHopProbability/2, probability)
+	if pair == nil || pair.FromNode != nodeA || pair.ToNode != nodeB {
+		t.Fatalf("expected history of pair A->B, got %v", pair)
+	}
+
+	// Finally, resetting the history should also remove the persisted
+	// results.
+	if err := mc.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset history: %v", err)
+	}
+	mc = newMC()
+	assertProbability(t, aprioriHopProbability, getProb(nodeA, nodeB, 1000))
+}
@@ -26,7 +26,7 @@ var (
 		Port: 9000}
 	testAddrs = []net.Addr{testAddr}
 
-	testFeatures = lnwire.NewFeatureVector(nil, lnwire.GlobalFeatures)
+	testFeatures = lnwire.NewFeatureVector(nil, lnwire.Features)
 
 	testHash = [32]byte{
 		0xb7, 0x94, 0x38, 0x5f, 0x2d, 0x1e, 0xf7, 0xab,
@@ -35,7 +35,10 @@ var (
 		0x6a, 0x49, 0x18, 0x83, 0x31, 0x98, 0x47, 0x53,
 	}
 
-	testTime = time.Date(2018, time.January, 9, 14, 00, 00, 0, time.UTC)
+	// testTime is the last update of the edges of the test graphs. It
+	// must lie after the cutoff of pruneZombieChans, otherwise the router
+	// prunes the edges on startup.
+	testTime = time.Date(2019, time.March, 9, 14, 00, 00, 0, time.UTC)
 
 	priv1, _    = btcec.NewPrivateKey(btcec.S256())
 	bitcoinKey1 = priv1.PubKey()
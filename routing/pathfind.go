@@ -10,6 +10,7 @@ import (
 
 	"github.com/breez/lightninglib/channeldb"
 	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/onion"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/coreos/bbolt"
 	sphinx "github.com/lightningnetwork/lightning-onion"
@@ -84,6 +85,26 @@ type Hop struct {
 	// hop. This value is less than the value that the incoming HTLC
 	// carries as a fee will be subtracted by the hop.
 	AmtToForward lnwire.MilliSatoshi
+
+	// TLVPayload is true if the hop is passed a TLV payload rather than
+	// the legacy fixed-size one. Only nodes that signal support for TLV
+	// onion payloads can be passed one.
+	TLVPayload bool
+
+	// KeysendPreimage is the preimage of a keysend payment, which is
+	// passed to the final hop within its TLV payload. It's only set for
+	// the final hop of such a payment.
+	KeysendPreimage *[32]byte
+
+	// PaymentAddr is the payment address of the invoice being paid, which
+	// is passed to the final hop within its TLV payload. It's only set for
+	// the final hop of a payment to an invoice with a payment address.
+	PaymentAddr *[32]byte
+
+	// TotalAmount is the total amount of the payment, which is passed to
+	// the final hop along with the payment address. It differs from the
+	// amount to forward if the payment is split across several routes.
+	TotalAmount lnwire.MilliSatoshi
 }
 
 // edgePolicyWithSource is a helper struct to keep track of the source node
@@ -221,21 +242,24 @@ func (r *Route) containsChannel(chanID uint64) bool {
 	return ok
 }
 
-// ToHopPayloads converts a complete route into the series of per-hop payloads
-// that is to be encoded within each HTLC using an opaque Sphinx packet.
-func (r *Route) ToHopPayloads() []sphinx.HopData {
-	hopPayloads := make([]sphinx.HopData, len(r.Hops))
+// ToSphinxPath converts a complete route into the sphinx payment path that is
+// to be encoded within each HTLC using an opaque Sphinx packet. Each hop is
+// passed either a legacy or a TLV payload, depending on whether it supports
+// the latter.
+func (r *Route) ToSphinxPath() (*sphinx.PaymentPath, error) {
+	var path sphinx.PaymentPath
+	if len(r.Hops) > len(path) {
+		return nil, fmt.Errorf("route of %d hops exceeds the maximum "+
+			"of %d", len(r.Hops), len(path))
+	}
 
 	// For each hop encoded within the route, we'll convert the hop struct
-	// to the matching per-hop payload struct as used by the sphinx
-	// package.
+	// to an OnionHop with matching per-hop payload within the path as used
+	// by the sphinx package.
 	for i, hop := range r.Hops {
-		hopPayloads[i] = sphinx.HopData{
-			// TODO(roasbeef): properly set realm, make sphinx type
-			// an enum actually?
-			Realm:         0,
-			ForwardAmount: uint64(hop.AmtToForward),
-			OutgoingCltv:  hop.OutgoingTimeLock,
+		pub, err := btcec.ParsePubKey(hop.PubKeyBytes[:], btcec.S256())
+		if err != nil {
+			return nil, err
 		}
 
 		// As a base case, the next hop is set to all zeroes in order
@@ -248,11 +272,56 @@ func (r *Route) ToHopPayloads() []sphinx.HopData {
 			nextHop = r.Hops[i+1].ChannelID
 		}
 
-		binary.BigEndian.PutUint64(hopPayloads[i].NextAddress[:],
-			nextHop)
+		var payload sphinx.HopPayload
+		if hop.TLVPayload {
+			hopPayload := &onion.Payload{
+				NextHop:         lnwire.NewShortChanIDFromInt(nextHop),
+				AmountToForward: hop.AmtToForward,
+				OutgoingCTLV:    hop.OutgoingTimeLock,
+				KeysendPreimage: hop.KeysendPreimage,
+				PaymentAddr:     hop.PaymentAddr,
+				TotalAmount:     hop.TotalAmount,
+			}
+
+			var b bytes.Buffer
+			err := onion.EncodePayload(&b, hopPayload)
+			if err != nil {
+				return nil, err
+			}
+
+			payload, err = sphinx.NewHopPayload(nil, b.Bytes())
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// The legacy payload is unable to carry anything but
+			// the forwarding instructions.
+			if hop.KeysendPreimage != nil || hop.PaymentAddr != nil {
+				return nil, fmt.Errorf("hop %x requires a TLV "+
+					"payload", hop.PubKeyBytes[:])
+			}
+
+			hopData := sphinx.HopData{
+				ForwardAmount: uint64(hop.AmtToForward),
+				OutgoingCltv:  hop.OutgoingTimeLock,
+			}
+			binary.BigEndian.PutUint64(
+				hopData.NextAddress[:], nextHop,
+			)
+
+			payload, err = sphinx.NewHopPayload(&hopData, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		path[i] = sphinx.OnionHop{
+			NodePub:    *pub,
+			HopPayload: payload,
+		}
 	}
 
-	return hopPayloads
+	return &path, nil
 }
 
 // newRoute returns a fully valid route between the source and target that's
@@ -340,11 +409,18 @@ func newRoute(amtToSend, feeLimit lnwire.MilliSatoshi, sourceVertex Vertex,
 		// Since we're traversing the path backwards atm, we prepend
 		// each new hop such that, the final slice of hops will be in
 		// the forwards order.
+		// We'll pass the hop a TLV payload if its node announcement
+		// signals support for them.
+		features := edge.Node.Features
+		tlvPayload := features != nil &&
+			features.HasFeature(lnwire.TLVOnionPayloadOptional)
+
 		currentHop := &Hop{
 			PubKeyBytes:      Vertex(edge.Node.PubKeyBytes),
 			ChannelID:        edge.ChannelID,
 			AmtToForward:     amtToForward,
 			OutgoingTimeLock: outgoingTimeLock,
+			TLVPayload:       tlvPayload,
 		}
 		hops = append([]*Hop{currentHop}, hops...)
 
@@ -453,6 +529,28 @@ func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 	return int64(fee) + timeLockPenalty
 }
 
+// probabilityBasedDist converts the weight of a path and its success
+// probability into a distance used by path finding. The attempt penalty is
+// scaled by the inverse of the probability, such that the less likely a path
+// is to succeed, the further away it is. Without a penalty, the distance is
+// the weight itself.
+func probabilityBasedDist(weight int64, probability float64,
+	penalty lnwire.MilliSatoshi) int64 {
+
+	if penalty == 0 {
+		return weight
+	}
+
+	// Clamp the distance to infinity, to prevent overflowing it for very
+	// low probabilities.
+	dist := float64(weight) + float64(penalty)/probability
+	if dist >= float64(infinity) {
+		return infinity
+	}
+
+	return int64(dist)
+}
+
 // graphParams wraps the set of graph parameters passed to findPath.
 type graphParams struct {
 	// tx can be set to an existing db transaction. If not set, a new
@@ -491,6 +589,33 @@ type restrictParams struct {
 	// feeLimit is a maximum fee amount allowed to be used on the path from
 	// the source to the target.
 	feeLimit lnwire.MilliSatoshi
+
+	// probabilitySource is an optional callback that returns the
+	// probability that the payment of the given amount can be forwarded
+	// from one node to another. If it isn't set, all edges are assumed to
+	// always succeed.
+	probabilitySource func(fromNode, toNode Vertex,
+		amt lnwire.MilliSatoshi) float64
+
+	// paymentAttemptPenalty is the virtual cost in path finding weight
+	// units of executing a payment attempt that fails. It is used to trade
+	// off potentially better routes against their probability of
+	// succeeding.
+	paymentAttemptPenalty lnwire.MilliSatoshi
+
+	// minProbability is the minimum required route success probability to
+	// attempt the payment.
+	minProbability float64
+
+	// outgoingChannelID is an optional channel that the path must leave
+	// the source node through. If set, all other channels of the source
+	// node are ignored.
+	outgoingChannelID *uint64
+
+	// lastHop is an optional node that the path must reach the target
+	// through. If set, the channels of all other nodes with the target are
+	// ignored.
+	lastHop *Vertex
 }
 
 // findPath attempts to find a path from the source node within the
@@ -578,9 +703,11 @@ func findPath(g *graphParams, r *restrictParams,
 	targetNode := &channeldb.LightningNode{PubKeyBytes: targetVertex}
 	distance[targetVertex] = nodeWithDist{
 		dist:            0,
+		weight:          0,
 		node:            targetNode,
 		amountToReceive: amt,
 		fee:             0,
+		probability:     1,
 	}
 
 	// We'll use this map as a series of "next" hop pointers. So to get
@@ -617,13 +744,32 @@ func findPath(g *graphParams, r *restrictParams,
 			return
 		}
 
+		// If the path must leave the source through a specific
+		// channel, then we'll skip all other channels of the source.
+		if isSourceChan && r.outgoingChannelID != nil &&
+			edge.ChannelID != *r.outgoingChannelID {
+
+			return
+		}
+
+		// Similarly, if the path must reach the target through a
+		// specific node, then we'll skip the channels of all other
+		// nodes with the target.
+		if toNode == targetVertex && r.lastHop != nil &&
+			fromVertex != *r.lastHop {
+
+			return
+		}
+
 		toNodeDist := distance[toNode]
 
 		amountToSend := toNodeDist.amountToReceive
 
 		// If the estimated band width of the channel edge is not able
-		// to carry the amount that needs to be send, return.
-		if bandwidth > 0 && bandwidth < amountToSend {
+		// to carry the amount that needs to be send, return. Local
+		// channels whose link is offline report a zero bandwidth, so
+		// they are skipped as well.
+		if bandwidth < amountToSend {
 			return
 		}
 
@@ -664,16 +810,37 @@ func findPath(g *graphParams, r *restrictParams,
 			return
 		}
 
+		// Request the success probability for this edge from the
+		// probability source, if any. The probability of the path from
+		// fromNode to the target is the product of the probabilities
+		// of its edges.
+		edgeProbability := 1.0
+		if r.probabilitySource != nil {
+			edgeProbability = r.probabilitySource(
+				fromVertex, toNode, amountToSend,
+			)
+		}
+		probability := toNodeDist.probability * edgeProbability
+
+		// If the probability is zero or below the minimum, there is no
+		// point in trying this edge.
+		if probability == 0 || probability < r.minProbability {
+			return
+		}
+
 		// By adding fromNode in the route, there will be an extra
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
 		// the HTLC that is handed out to fromNode.
 		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
 
-		// Compute the tentative distance to this new channel/edge
-		// which is the distance from our toNode to the target node
-		// plus the weight of this edge.
-		tempDist := toNodeDist.dist + weight
+		// Compute the tentative weight of the path from fromNode to
+		// the target, and from it the tentative distance, which also
+		// accounts for the probability of the path failing.
+		tempWeight := toNodeDist.weight + weight
+		tempDist := probabilityBasedDist(
+			tempWeight, probability, r.paymentAttemptPenalty,
+		)
 
 		// If this new tentative distance is not better than the current
 		// best known distance to this node, return.
@@ -697,9 +864,11 @@ func findPath(g *graphParams, r *restrictParams,
 		// map is populated with this edge.
 		distance[fromVertex] = nodeWithDist{
 			dist:            tempDist,
+			weight:          tempWeight,
 			node:            fromNode,
 			amountToReceive: amountToReceive,
 			fee:             fee,
+			probability:     probability,
 		}
 
 		next[fromVertex] = edge
@@ -839,7 +1008,17 @@ func findPath(g *graphParams, r *restrictParams,
 func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 	source *channeldb.LightningNode, target *btcec.PublicKey,
 	amt lnwire.MilliSatoshi, feeLimit lnwire.MilliSatoshi, numPaths uint32,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([][]*channeldb.ChannelEdgePolicy, error) {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi,
+	restrictions *RouteRestrictions) ([][]*channeldb.ChannelEdgePolicy, error) {
+
+	var (
+		outgoingChannelID *uint64
+		lastHop           *Vertex
+	)
+	if restrictions != nil {
+		outgoingChannelID = restrictions.OutgoingChannelID
+		lastHop = restrictions.LastHop
+	}
 
 	ignoredEdges := make(map[uint64]struct{})
 	ignoredVertexes := make(map[Vertex]struct{})
@@ -861,9 +1040,11 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 			bandwidthHints: bandwidthHints,
 		},
 		&restrictParams{
-			ignoredNodes: ignoredVertexes,
-			ignoredEdges: ignoredEdges,
-			feeLimit:     feeLimit,
+			ignoredNodes:      ignoredVertexes,
+			ignoredEdges:      ignoredEdges,
+			feeLimit:          feeLimit,
+			outgoingChannelID: outgoingChannelID,
+			lastHop:           lastHop,
 		},
 		source, target, amt,
 	)
@@ -931,6 +1112,13 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 				ignoredVertexes[Vertex(node)] = struct{}{}
 			}
 
+			// The outgoing channel restriction only applies to
+			// spur paths leaving our own node.
+			var spurOutgoingChannelID *uint64
+			if spurNode.PubKeyBytes == source.PubKeyBytes {
+				spurOutgoingChannelID = outgoingChannelID
+			}
+
 			// With the edges that are part of our root path, and
 			// the Vertexes (other than the spur path) within the
 			// root path removed, we'll attempt to find another
@@ -942,9 +1130,11 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 					bandwidthHints: bandwidthHints,
 				},
 				&restrictParams{
-					ignoredNodes: ignoredVertexes,
-					ignoredEdges: ignoredEdges,
-					feeLimit:     feeLimit,
+					ignoredNodes:      ignoredVertexes,
+					ignoredEdges:      ignoredEdges,
+					feeLimit:          feeLimit,
+					outgoingChannelID: spurOutgoingChannelID,
+					lastHop:           lastHop,
 				}, spurNode, target, amt,
 			)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	sphinx "github.com/lightningnetwork/lightning-onion"
 )
 
 const (
@@ -775,10 +776,23 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 	// Next, we'll assert that the "next hop" field in each route payload
 	// properly points to the channel ID that the HTLC should be forwarded
 	// along.
-	hopPayloads := route.ToHopPayloads()
-	if len(hopPayloads) != expectedHopCount {
+	sphinxPath, err := route.ToSphinxPath()
+	if err != nil {
+		t.Fatalf("unable to create sphinx path: %v", err)
+	}
+	if sphinxPath.TrueRouteLength() != expectedHopCount {
 		t.Fatalf("incorrect number of hop payloads: expected %v, got %v",
-			expectedHopCount, len(hopPayloads))
+			expectedHopCount, sphinxPath.TrueRouteLength())
+	}
+
+	// The nodes of the test graph don't signal support for TLV payloads,
+	// so each hop is passed a legacy payload.
+	hopPayloads := make([]*sphinx.HopData, expectedHopCount)
+	for i := range hopPayloads {
+		hopPayloads[i], err = sphinxPath[i].HopPayload.HopData()
+		if err != nil || hopPayloads[i] == nil {
+			t.Fatalf("hop %v has no legacy payload: %v", i, err)
+		}
 	}
 
 	// Hops should point to the next hop
@@ -966,7 +980,7 @@ func TestKShortestPathFinding(t *testing.T) {
 	target := graph.aliasMap["luoji"]
 	paths, err := findPaths(
 		nil, graph.graph, sourceNode, target, paymentAmt, noFeeLimit, 100,
-		nil,
+		nil, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to find paths between roasbeef and "+
@@ -1680,6 +1694,149 @@ func TestPathSourceEdgesBandwidth(t *testing.T) {
 	assertExpectedPath(t, path, "songoku", "sophon")
 }
 
+// TestProbabilityRouting tests that path finding favors routes that are likely
+// to succeed, and skips routes whose success probability is too low.
+func TestProbabilityRouting(t *testing.T) {
+	t.Parallel()
+
+	graph, err := parseTestGraph(basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer graph.cleanUp()
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	target := graph.aliasMap["sophon"]
+	payAmt := lnwire.NewMSatFromSatoshis(50000)
+	songoku := NewVertex(graph.aliasMap["songoku"])
+
+	// The edge from songoku to sophon, which is on the cheapest path, is
+	// unlikely to succeed.
+	songokuProbability := 0.05
+	probabilitySource := func(fromNode, toNode Vertex,
+		amt lnwire.MilliSatoshi) float64 {
+
+		if fromNode == songoku {
+			return songokuProbability
+		}
+		return 1
+	}
+
+	findPathWithPenalty := func(penalty lnwire.MilliSatoshi) (
+		[]*channeldb.ChannelEdgePolicy, error) {
+
+		return findPath(
+			&graphParams{
+				graph: graph.graph,
+			},
+			&restrictParams{
+				feeLimit:              noFeeLimit,
+				probabilitySource:     probabilitySource,
+				paymentAttemptPenalty: penalty,
+				minProbability:        0.01,
+			},
+			sourceNode, target, payAmt,
+		)
+	}
+
+	// Without an attempt penalty, only the weight matters, so the
+	// cheapest path via songoku is still chosen.
+	path, err := findPathWithPenalty(0)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "songoku", "sophon")
+
+	// With a high attempt penalty, the path via phamnuwen, which is more
+	// likely to succeed, is favored.
+	path, err = findPathWithPenalty(lnwire.NewMSatFromSatoshis(100000))
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "phamnuwen", "sophon")
+
+	// Finally, once the probability of the edge from songoku is below the
+	// minimum, it is skipped regardless of the penalty.
+	songokuProbability = 0.001
+	path, err = findPathWithPenalty(0)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "phamnuwen", "sophon")
+}
+
+// TestRestrictedPathFinding tests that paths are only found over the outgoing
+// channel and from the last hop they're restricted to.
+func TestRestrictedPathFinding(t *testing.T) {
+	t.Parallel()
+
+	graph, err := parseTestGraph(basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer graph.cleanUp()
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	target := graph.aliasMap["sophon"]
+	payAmt := lnwire.NewMSatFromSatoshis(50000)
+
+	findRestrictedPath := func(outgoingChannelID *uint64,
+		lastHop *Vertex) ([]*channeldb.ChannelEdgePolicy, error) {
+
+		return findPath(
+			&graphParams{
+				graph: graph.graph,
+			},
+			&restrictParams{
+				feeLimit:          noFeeLimit,
+				outgoingChannelID: outgoingChannelID,
+				lastHop:           lastHop,
+			},
+			sourceNode, target, payAmt,
+		)
+	}
+
+	// Without any restrictions, the cheapest path via songoku is chosen.
+	path, err := findRestrictedPath(nil, nil)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "songoku", "sophon")
+
+	// Restricting the outgoing channel to the one with phamnuwen forces
+	// the more expensive path.
+	phamnuwenChan := uint64(999991)
+	path, err = findRestrictedPath(&phamnuwenChan, nil)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "phamnuwen", "sophon")
+
+	// The same goes for restricting the last hop to phamnuwen.
+	phamnuwen := NewVertex(graph.aliasMap["phamnuwen"])
+	path, err = findRestrictedPath(nil, &phamnuwen)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, path, "phamnuwen", "sophon")
+
+	// Finally, there's no path leaving through the channel with songoku
+	// that reaches sophon from phamnuwen.
+	songokuChan := uint64(12345)
+	_, err = findRestrictedPath(&songokuChan, &phamnuwen)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path to be found, instead got: %v", err)
+	}
+}
+
 func TestPathInsufficientCapacityWithFee(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package routing
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
 	"runtime"
@@ -34,6 +35,11 @@ const (
 	// if we should give up on a payment attempt. This will be used if a
 	// value isn't specified in the LightningNode struct.
 	defaultPayAttemptTimeout = time.Duration(time.Second * 60)
+
+	// minShardAmount is the smallest amount a shard of a multi-path
+	// payment is split into. Below it, the fees paid by the shard would
+	// likely outweigh its contribution to the payment.
+	minShardAmount = lnwire.MilliSatoshi(10000)
 )
 
 var (
@@ -169,6 +175,20 @@ type Config struct {
 		htlcAdd *lnwire.UpdateAddHTLC,
 		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
 
+	// SendShardToSwitch is the multi-path counterpart of SendToSwitch,
+	// used to forward one of the shards of a payment. Unlike SendToSwitch,
+	// it must permit other shards with the same payment hash to be in
+	// flight at the same time.
+	SendShardToSwitch func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
+
+	// Control is the control tower of the switch the payments are sent
+	// through. The router records every attempt to send a payment with
+	// it, along with the reason a payment failed, such that the outcome
+	// of the payment can be reported even after a restart.
+	Control htlcswitch.ControlTower
+
 	// ChannelPruneExpiry is the duration used to determine if a channel
 	// should be pruned or not. If the delta between now and when the
 	// channel was last updated is greater than ChannelPruneExpiry, then
@@ -289,12 +309,13 @@ type ChannelRouter struct {
 	ntfnClientUpdates chan *topologyClientUpdate
 
 	// missionControl is a shared memory of sorts that executions of
-	// payment path finding use in order to remember which vertexes/edges
-	// were pruned from prior attempts. During SendPayment execution,
-	// errors sent by nodes are mapped into a vertex or edge to be pruned.
-	// Each run will then take into account this set of pruned
-	// vertexes/edges to reduce route failure and pass on graph information
-	// gained to the next execution.
+	// payment path finding use in order to remember the outcome of prior
+	// attempts. During SendPayment execution, errors sent by nodes are
+	// mapped into a failure of a vertex or node pair, and settled routes
+	// into successes of their node pairs. Each run will then take into
+	// account the success probabilities derived from these outcomes to
+	// reduce route failure and pass on graph information gained to the
+	// next execution, even across restarts.
 	missionControl *missionControl
 
 	// channelEdgeMtx is a mutex we use to make sure we process only one
@@ -339,9 +360,12 @@ func New(cfg Config) (*ChannelRouter, error) {
 		quit:              make(chan struct{}),
 	}
 
-	r.missionControl = newMissionControl(
+	r.missionControl, err = newMissionControl(
 		cfg.Graph, selfNode, cfg.QueryBandwidth,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	return r, nil
 }
@@ -1400,6 +1424,29 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	amt, feeLimit lnwire.MilliSatoshi, numPaths uint32,
 	finalExpiry ...uint16) ([]*Route, error) {
 
+	return r.FindRestrictedRoutes(
+		target, amt, feeLimit, nil, numPaths, finalExpiry...,
+	)
+}
+
+// RouteRestrictions restricts the routes that may be used to reach a
+// destination.
+type RouteRestrictions struct {
+	// OutgoingChannelID is an optional channel of ours that the routes
+	// must leave our node through.
+	OutgoingChannelID *uint64
+
+	// LastHop is an optional node that the routes must reach the
+	// destination through.
+	LastHop *Vertex
+}
+
+// FindRestrictedRoutes is like FindRoutes, but only returns routes that
+// satisfy the given restrictions, if any.
+func (r *ChannelRouter) FindRestrictedRoutes(target *btcec.PublicKey,
+	amt, feeLimit lnwire.MilliSatoshi, restrictions *RouteRestrictions,
+	numPaths uint32, finalExpiry ...uint16) ([]*Route, error) {
+
 	var finalCLTVDelta uint16
 	if len(finalExpiry) == 0 {
 		finalCLTVDelta = DefaultFinalCLTVDelta
@@ -1412,17 +1459,19 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 
 	// Before attempting to perform a series of graph traversals to find
 	// the k-shortest paths to the destination, we'll first consult our
-	// path cache
+	// path cache, which only holds unrestricted routes.
 	rt := newRouteTuple(amt, dest)
-	r.routeCacheMtx.RLock()
-	routes, ok := r.routeCache[rt]
-	r.routeCacheMtx.RUnlock()
-
-	// If we already have a cached route, and it contains at least the
-	// number of paths requested, then we'll return it directly as there's
-	// no need to repeat the computation.
-	if ok && uint32(len(routes)) >= numPaths {
-		return routes, nil
+	if restrictions == nil {
+		r.routeCacheMtx.RLock()
+		routes, ok := r.routeCache[rt]
+		r.routeCacheMtx.RUnlock()
+
+		// If we already have a cached route, and it contains at least
+		// the number of paths requested, then we'll return it directly
+		// as there's no need to repeat the computation.
+		if ok && uint32(len(routes)) >= numPaths {
+			return routes, nil
+		}
 	}
 
 	// If we don't have a set of routes cached, we'll query the graph for a
@@ -1468,7 +1517,7 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// our source to the destination.
 	shortestPaths, err := findPaths(
 		tx, r.cfg.Graph, r.selfNode, target, amt, feeLimit, numPaths,
-		bandwidthHints,
+		bandwidthHints, restrictions,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -1499,13 +1548,155 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 
 	// Populate the cache with this set of fresh routes so we can reuse
 	// them in the future.
-	r.routeCacheMtx.Lock()
-	r.routeCache[rt] = validRoutes
-	r.routeCacheMtx.Unlock()
+	if restrictions == nil {
+		r.routeCacheMtx.Lock()
+		r.routeCache[rt] = validRoutes
+		r.routeCacheMtx.Unlock()
+	}
 
 	return validRoutes, nil
 }
 
+// FindCircularRoute attempts to find a route leaving our node over the
+// outgoing channel, and returning to it over the incoming channel, that's
+// capable of carrying a payment of amt to ourselves within the fee limit.
+// Paying ourselves over such a route shifts our balance from the outgoing to
+// the incoming channel.
+func (r *ChannelRouter) FindCircularRoute(outgoingChan, incomingChan uint64,
+	amt, feeLimit lnwire.MilliSatoshi,
+	finalCLTVDelta uint16) (*Route, error) {
+
+	if outgoingChan == incomingChan {
+		return nil, fmt.Errorf("outgoing and incoming channel must " +
+			"differ")
+	}
+
+	log.Debugf("Searching for circular route from channel %v to "+
+		"channel %v, sending %v", outgoingChan, incomingChan, amt)
+
+	selfVertex := Vertex(r.selfNode.PubKeyBytes)
+	outgoingInfo, outgoingPolicy, err := fetchLocalPolicy(
+		r.cfg.Graph, selfVertex, outgoingChan, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	incomingInfo, incomingPolicy, err := fetchLocalPolicy(
+		r.cfg.Graph, selfVertex, incomingChan, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The first hop of the route is the peer of the outgoing channel. The
+	// last hop before reaching us again is the peer of the incoming
+	// channel, whose policy leads towards us.
+	firstHop := Vertex(outgoingPolicy.Node.PubKeyBytes)
+	lastHop := Vertex(incomingInfo.NodeKey1Bytes)
+	if lastHop == selfVertex {
+		lastHop = Vertex(incomingInfo.NodeKey2Bytes)
+	}
+
+	pathEdges := []*channeldb.ChannelEdgePolicy{outgoingPolicy}
+
+	// Unless both channels are with the same peer, we'll need to find a
+	// path between both peers that doesn't pass through our node. The
+	// last hop has to receive enough to pay for its fee to forward the
+	// payment back to us.
+	if firstHop != lastHop {
+		lastHopPub, err := btcec.ParsePubKey(lastHop[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		lastHopAmt := amt + computeFee(amt, incomingPolicy)
+
+		path, err := findPath(
+			&graphParams{
+				graph: r.cfg.Graph,
+			},
+			&restrictParams{
+				ignoredNodes: map[Vertex]struct{}{
+					selfVertex: {},
+				},
+				feeLimit: feeLimit,
+			},
+			&channeldb.LightningNode{PubKeyBytes: firstHop},
+			lastHopPub, lastHopAmt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pathEdges = append(pathEdges, path...)
+	}
+
+	pathEdges = append(pathEdges, incomingPolicy)
+
+	route, err := newRoute(
+		amt, feeLimit, selfVertex, pathEdges, uint32(currentHeight),
+		finalCLTVDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outgoing channel must be able to carry the amount we'll receive
+	// back, along with the fees paid to the hops along the route.
+	bandwidth := r.cfg.QueryBandwidth(outgoingInfo)
+	if bandwidth < route.TotalAmount {
+		return nil, newErrf(ErrNoPathFound, "outgoing channel %v "+
+			"has insufficient bandwidth to send %v: %v",
+			outgoingChan, route.TotalAmount, bandwidth)
+	}
+
+	go log.Tracef("Obtained circular route sending %v from channel %v "+
+		"to channel %v: %v", amt, outgoingChan, incomingChan,
+		newLogClosure(func() string {
+			return spew.Sdump(route)
+		}),
+	)
+
+	return route, nil
+}
+
+// fetchLocalPolicy returns the edge info of one of our own channels, along
+// with its policy for the direction leading away from us, or towards us if
+// incoming is set.
+func fetchLocalPolicy(graph *channeldb.ChannelGraph, selfVertex Vertex,
+	chanID uint64, incoming bool) (*channeldb.ChannelEdgeInfo,
+	*channeldb.ChannelEdgePolicy, error) {
+
+	info, policy1, policy2, err := graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if Vertex(info.NodeKey1Bytes) != selfVertex &&
+		Vertex(info.NodeKey2Bytes) != selfVertex {
+
+		return nil, nil, fmt.Errorf("channel %v is not one of our "+
+			"channels", chanID)
+	}
+
+	for _, policy := range []*channeldb.ChannelEdgePolicy{policy1, policy2} {
+		if policy == nil {
+			continue
+		}
+
+		towardsUs := Vertex(policy.Node.PubKeyBytes) == selfVertex
+		if towardsUs == incoming {
+			return info, policy, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("routing policy of channel %v is "+
+		"unknown", chanID)
+}
+
 // generateSphinxPacket generates then encodes a sphinx packet which encodes
 // the onion route specified by the passed layer 3 route. The blob returned
 // from this function can immediately be included within an HTLC add packet to
@@ -1520,27 +1711,23 @@ func generateSphinxPacket(route *Route, paymentHash []byte) ([]byte,
 		return nil, nil, ErrNoRouteHopsProvided
 	}
 
-	// First obtain all the public keys along the route which are contained
-	// in each hop.
-	nodes := make([]*btcec.PublicKey, len(route.Hops))
-	for i, hop := range route.Hops {
-		pub, err := btcec.ParsePubKey(hop.PubKeyBytes[:],
-			btcec.S256())
-		if err != nil {
-			return nil, nil, err
-		}
-
-		nodes[i] = pub
-	}
-
-	// Next we generate the per-hop payload which gives each node within
+	// Next we generate the payment path, which gives each node within
 	// the route the necessary information (fees, CLTV value, etc) to
-	// properly forward the payment.
-	hopPayloads := route.ToHopPayloads()
+	// properly forward the payment within its per-hop payload.
+	sphinxPath, err := route.ToSphinxPath()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	log.Tracef("Constructed per-hop payloads for payment_hash=%x: %v",
 		paymentHash[:], newLogClosure(func() string {
-			return spew.Sdump(hopPayloads)
+			path := make([]sphinx.OnionHop, sphinxPath.TrueRouteLength())
+			for i := range path {
+				hopCopy := sphinxPath[i]
+				hopCopy.NodePub.Curve = nil
+				path[i] = hopCopy
+			}
+			return spew.Sdump(path)
 		}),
 	)
 
@@ -1552,7 +1739,7 @@ func generateSphinxPacket(route *Route, paymentHash []byte) ([]byte,
 	// Next generate the onion routing packet which allows us to perform
 	// privacy preserving source routing across the network.
 	sphinxPacket, err := sphinx.NewOnionPacket(
-		nodes, sessionKey, hopPayloads, paymentHash,
+		sphinxPath, sessionKey, paymentHash,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1576,7 +1763,7 @@ func generateSphinxPacket(route *Route, paymentHash []byte) ([]byte,
 
 	return onionBlob.Bytes(), &sphinx.Circuit{
 		SessionKey:  sessionKey,
-		PaymentPath: nodes,
+		PaymentPath: sphinxPath.NodeKeys(),
 	}, nil
 }
 
@@ -1622,6 +1809,34 @@ type LightningPayment struct {
 	// destination successfully.
 	RouteHints [][]HopHint
 
+	// MaxShards is the maximum number of HTLCs the payment may be split
+	// into at once, when sent using SendMultiPathPayment. A value of zero
+	// or one sends the payment as a single HTLC.
+	MaxShards uint32
+
+	// DestFeatures is the set of features signaled by the invoice being
+	// paid, if any. The payment is only split into multiple HTLCs if the
+	// invoice signals support for multi-path payments.
+	DestFeatures *lnwire.FeatureVector
+
+	// KeysendPreimage is the preimage of a keysend payment, which pays to
+	// the target without an invoice. If set, it's passed to the target
+	// within the final hop's payload, and PaymentHash must be its hash.
+	KeysendPreimage *[32]byte
+
+	// PaymentAddr is the payment address of the invoice being paid, if it
+	// has one. It's passed to the target within the final hop's payload
+	// of the payment, and each of its shards.
+	PaymentAddr *[32]byte
+
+	// OutgoingChannelID is an optional channel of ours that the payment,
+	// and each of its shards, must leave our node through.
+	OutgoingChannelID *uint64
+
+	// LastHop is an optional node that the payment must reach its target
+	// through.
+	LastHop *Vertex
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -1643,7 +1858,12 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte, *Route
 		return [32]byte{}, nil, nil, err
 	}
 
-	return r.sendPayment(payment, paySession)
+	preimage, route, failedRoutes, err := r.sendPayment(payment, paySession)
+	if err != nil {
+		r.failPayment(payment.PaymentHash, err)
+	}
+
+	return preimage, route, failedRoutes, err
 }
 
 // SendToRoute attempts to send a payment as described within the passed
@@ -1660,7 +1880,125 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 		routes,
 	)
 
-	return r.sendPayment(payment, paySession)
+	preimage, route, failedRoutes, err := r.sendPayment(payment, paySession)
+	if err != nil {
+		r.failPayment(payment.PaymentHash, err)
+	}
+
+	return preimage, route, failedRoutes, err
+}
+
+// ProbePayment probes the candidate routes returned by FindRoutes for sending
+// the amount to the target, in order of increasing fees. Each probe is an HTLC
+// with a random payment hash, which the target can't know the preimage of.
+// As a result, a probe that reaches the target is failed by it with an
+// unknown payment hash error, which proves that the route is able to carry
+// the payment, without the payment being made. The outcome of each probe is
+// reported to mission control. The cheapest route that reached the target is
+// returned, along with the probes that failed before it.
+func (r *ChannelRouter) ProbePayment(target *btcec.PublicKey,
+	amt, feeLimit lnwire.MilliSatoshi, numRoutes uint32,
+	finalExpiry ...uint16) (*Route, []*FailedPaymentAttempt, error) {
+
+	routes, err := r.FindRoutes(
+		target, amt, feeLimit, numRoutes, finalExpiry...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Cached routes may outnumber the requested ones.
+	if uint32(len(routes)) > numRoutes {
+		routes = routes[:numRoutes]
+	}
+
+	// The probes are reported through a payment session, such that their
+	// failures are interpreted like those of actual payments.
+	paySession := r.missionControl.NewPaymentSessionFromRoutes(routes)
+
+	var failedRoutes []*FailedPaymentAttempt
+	for _, route := range routes {
+		var paymentHash [32]byte
+		if _, err := rand.Read(paymentHash[:]); err != nil {
+			return nil, failedRoutes, err
+		}
+
+		payment := &LightningPayment{
+			Target:      target,
+			Amount:      amt,
+			FeeLimit:    feeLimit,
+			PaymentHash: paymentHash,
+		}
+		htlcAdd, circuit, err := newHtlcAdd(route, payment)
+		if err != nil {
+			return nil, failedRoutes, err
+		}
+
+		log.Tracef("Probing route to %x with payment hash %x: %v",
+			target.SerializeCompressed(), paymentHash,
+			newLogClosure(func() string {
+				return spew.Sdump(route)
+			}),
+		)
+
+		firstHop := lnwire.NewShortChanIDFromInt(
+			route.Hops[0].ChannelID,
+		)
+		_, sendError := r.cfg.SendToSwitch(firstHop, htlcAdd, circuit)
+
+		// As no one knows the preimage of the random payment hash, the
+		// probe can't be settled.
+		if sendError == nil {
+			return nil, failedRoutes, fmt.Errorf("probe with "+
+				"payment hash %x was unexpectedly settled",
+				paymentHash)
+		}
+
+		if isProbeSuccess(sendError, target) {
+			paySession.ReportSuccess(route)
+			return route, failedRoutes, nil
+		}
+
+		log.Debugf("Probe with payment hash %x failed: %v",
+			paymentHash, sendError)
+
+		// The failure is reported to mission control, but unlike when
+		// sending a payment, no failure terminates the probing, as
+		// the next route may still succeed.
+		attempt, _ := r.processSendError(paySession, route, sendError)
+		if attempt == nil {
+			attempt = &FailedPaymentAttempt{
+				PaymentRoute: route,
+				PaymentError: sendError,
+			}
+		}
+		failedRoutes = append(failedRoutes, attempt)
+	}
+
+	return nil, failedRoutes, newErrf(ErrNoRouteFound, "none of the %v "+
+		"probed routes reached the destination", len(routes))
+}
+
+// isProbeSuccess returns whether the error a probe failed with was sent by the
+// target, meaning that the probe reached it.
+func isProbeSuccess(sendError error, target *btcec.PublicKey) bool {
+	fErr, ok := sendError.(*htlcswitch.ForwardingError)
+	if !ok || !fErr.ErrorSource.IsEqual(target) {
+		return false
+	}
+
+	switch fErr.FailureMessage.(type) {
+	case *lnwire.FailUnknownPaymentHash:
+		return true
+
+	// This failure may only be sent by the final node as well, so it also
+	// proves that the probe reached the target.
+	case *lnwire.FailIncorrectPaymentAmount:
+		return true
+
+	default:
+		return false
+	}
 }
 
 // sendPayment attempts to send a payment as described within the passed
@@ -1750,9 +2088,11 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 			// If we're unable to successfully make a payment using
 			// any of the routes we've found, then return an error.
 			if sendError != nil {
-				return [32]byte{}, nil, failedRoutes, fmt.Errorf("unable to "+
-					"route payment to destination: %v",
-					sendError)
+				return [32]byte{}, nil, failedRoutes, newErrf(
+					ErrNoRouteFound, "unable to route "+
+						"payment to destination: %v",
+					sendError,
+				)
 			}
 
 			return preImage, nil, nil, err
@@ -1764,25 +2104,13 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 			}),
 		)
 
-		// Generate the raw encoded sphinx packet to be included along
-		// with the htlcAdd message that we send directly to the
-		// switch.
-		onionBlob, circuit, err := generateSphinxPacket(
-			route, payment.PaymentHash[:],
-		)
-		if err != nil {
-			return preImage, nil, failedRoutes, err
-		}
-
 		// Craft an HTLC packet to send to the layer 2 switch. The
 		// metadata within this packet will be used to route the
 		// payment through the network, starting with the first-hop.
-		htlcAdd := &lnwire.UpdateAddHTLC{
-			Amount:      route.TotalAmount,
-			Expiry:      route.TotalTimeLock,
-			PaymentHash: payment.PaymentHash,
+		htlcAdd, circuit, err := newHtlcAdd(route, payment)
+		if err != nil {
+			return preImage, nil, failedRoutes, err
 		}
-		copy(htlcAdd.OnionBlob[:], onionBlob)
 
 		// Attempt to send this payment through the network to complete
 		// the payment. If this attempt fails, then we'll continue on
@@ -1790,9 +2118,11 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		firstHop := lnwire.NewShortChanIDFromInt(
 			route.Hops[0].ChannelID,
 		)
+		attemptID := r.registerAttempt(payment.PaymentHash, route)
 		preImage, sendError = r.cfg.SendToSwitch(
 			firstHop, htlcAdd, circuit,
 		)
+		r.resolveAttempt(payment.PaymentHash, attemptID, route, sendError)
 		if sendError != nil {
 			// An error occurred when attempting to send the
 			// payment, depending on the error type, we'll either
@@ -1801,227 +2131,687 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 			log.Errorf("Attempt to send payment %x failed: %v",
 				payment.PaymentHash, sendError)
 
-			fErr, ok := sendError.(*htlcswitch.ForwardingError)
-			if !ok {
-				return preImage, nil, failedRoutes, sendError
+			attempt, err := r.processSendError(
+				paySession, route, sendError,
+			)
+			if attempt != nil {
+				failedRoutes = append(failedRoutes, attempt)
+			}
+			if err != nil {
+				return preImage, nil, failedRoutes, err
 			}
 
-			errSource := fErr.ErrorSource
-			errVertex := NewVertex(errSource)
+			continue
+		}
 
-			log.Tracef("node=%x reported failure when sending "+
-				"htlc=%x", errSource.SerializeCompressed(),
-				payment.PaymentHash[:])
+		paySession.ReportSuccess(route)
 
-			// Always determine chan id ourselves, because a channel
-			// update with id may not be available.
-			failedChanID, err := getFailedChannelID(route, errSource)
-			if err != nil {
-				return preImage, nil, failedRoutes, err
+		return preImage, route, failedRoutes, nil
+	}
+}
+
+// newHtlcAdd crafts the HTLC to send to the first hop of the route, carrying
+// the sphinx packet encoding the route, and returns it along with the circuit
+// needed to decrypt any errors.
+func newHtlcAdd(route *Route, payment *LightningPayment) (
+	*lnwire.UpdateAddHTLC, *sphinx.Circuit, error) {
+
+	paymentHash := payment.PaymentHash
+
+	// The route may be shared with other attempts, or be the one passed
+	// in by the caller, so we'll only modify the final hop of a copy of
+	// it.
+	if len(route.Hops) > 0 &&
+		(payment.KeysendPreimage != nil || payment.PaymentAddr != nil) {
+
+		routeCopy := *route
+		routeCopy.Hops = make([]*Hop, len(route.Hops))
+		copy(routeCopy.Hops, route.Hops)
+
+		finalHop := *route.Hops[len(route.Hops)-1]
+		routeCopy.Hops[len(routeCopy.Hops)-1] = &finalHop
+
+		// If this is a keysend payment, the final hop is passed the
+		// preimage. Only TLV payloads are able to carry it, and the
+		// payee is expected to understand them if it accepts keysend
+		// payments.
+		if payment.KeysendPreimage != nil {
+			finalHop.KeysendPreimage = payment.KeysendPreimage
+			finalHop.TLVPayload = true
+		}
+
+		// Likewise, the final hop is passed the payment address of the
+		// invoice along with the total amount of the payment, if the
+		// invoice has one.
+		if payment.PaymentAddr != nil {
+			finalHop.PaymentAddr = payment.PaymentAddr
+			finalHop.TotalAmount = payment.Amount
+			finalHop.TLVPayload = true
+		}
+
+		route = &routeCopy
+	}
+
+	// Generate the raw encoded sphinx packet to be included along with
+	// the htlcAdd message that we send directly to the switch.
+	onionBlob, circuit, err := generateSphinxPacket(route, paymentHash[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	htlcAdd := &lnwire.UpdateAddHTLC{
+		Amount:      route.TotalAmount,
+		Expiry:      route.TotalTimeLock,
+		PaymentHash: paymentHash,
+	}
+	copy(htlcAdd.OnionBlob[:], onionBlob)
+
+	return htlcAdd, circuit, nil
+}
+
+// shardResult is the outcome of sending a single shard of a multi-path
+// payment.
+type shardResult struct {
+	route     *Route
+	attemptID uint64
+	preimage  [32]byte
+	err       error
+}
+
+// SendMultiPathPayment attempts to send a payment as described within the
+// passed LightningPayment, splitting it into up to MaxShards HTLCs sent
+// along different routes if no single route can carry it. Failed shards are
+// retried along new routes. This function is blocking and will return either:
+// when all shards of the payment have settled, or the payment failed or timed
+// out and no shards are left in flight. If the payment succeeds, the routes of
+// all settled shards are returned, along with the payment preimage.
+func (r *ChannelRouter) SendMultiPathPayment(payment *LightningPayment) ([32]byte,
+	[]*Route, []*FailedPaymentAttempt, error) {
+
+	// A payment that may not be split is simply sent along a single
+	// route. Besides our own limit, this is the case if the invoice being
+	// paid doesn't signal support for multi-path payments, or lacks the
+	// payment address that ties the shards together.
+	splittable := payment.MaxShards > 1 && payment.PaymentAddr != nil &&
+		payment.DestFeatures != nil &&
+		payment.DestFeatures.HasFeature(lnwire.MPPOptional)
+	if !splittable {
+		preimage, route, failedRoutes, err := r.SendPayment(payment)
+		if err != nil {
+			return preimage, nil, failedRoutes, err
+		}
+
+		return preimage, []*Route{route}, failedRoutes, nil
+	}
+
+	paySession, err := r.missionControl.NewPaymentSession(
+		payment.RouteHints, payment.Target,
+	)
+	if err != nil {
+		return [32]byte{}, nil, nil, err
+	}
+
+	preimage, routes, failedRoutes, err := r.sendMultiPathPayment(
+		payment, paySession,
+	)
+	if err != nil {
+		r.failPayment(payment.PaymentHash, err)
+	}
+
+	return preimage, routes, failedRoutes, err
+}
+
+// sendMultiPathPayment sends the payment in shards over the routes returned by
+// the payment session, until the total amount settled or the payment failed.
+func (r *ChannelRouter) sendMultiPathPayment(payment *LightningPayment,
+	paySession *paymentSession) ([32]byte, []*Route, []*FailedPaymentAttempt,
+	error) {
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return [32]byte{}, nil, nil, err
+	}
+
+	finalCLTVDelta := uint16(DefaultFinalCLTVDelta)
+	if payment.FinalCLTVDelta != nil {
+		finalCLTVDelta = *payment.FinalCLTVDelta
+	}
+
+	payAttemptTimeout := payment.PayAttemptTimeout
+	if payAttemptTimeout == 0 {
+		payAttemptTimeout = defaultPayAttemptTimeout
+	}
+	timeoutChan := time.After(payAttemptTimeout)
+
+	var (
+		// remaining is the part of the payment amount that isn't
+		// carried by any shard in flight or settled yet, and
+		// feeBudget the part of the fee limit that isn't spent by
+		// them.
+		remaining = payment.Amount
+		feeBudget = payment.FeeLimit
+
+		// shardAmt is the amount we'll attempt to send with the next
+		// shard. It's halved each time no route can carry it.
+		shardAmt = payment.Amount
+
+		inFlight     uint32
+		results      = make(chan *shardResult)
+		preimage     [32]byte
+		settled      []*Route
+		failedRoutes []*FailedPaymentAttempt
+
+		// paymentErr is the error that terminates the payment. Once
+		// it's set, no more shards are sent.
+		paymentErr error
+		sendError  error
+	)
+
+	for {
+		// As long as the payment hasn't failed or settled, we'll send
+		// as many shards as we're allowed to, until they carry the
+		// full payment amount.
+		for paymentErr == nil && len(settled) == 0 && remaining > 0 &&
+			inFlight < payment.MaxShards {
+
+			if shardAmt > remaining {
+				shardAmt = remaining
 			}
-			failedRoutes = append(failedRoutes, &FailedPaymentAttempt{
-				PaymentRoute: route,
-				PaymentError: fmt.Errorf(
-					"send htlc failed, channel id=%v, error=%v",
-					failedChanID,
-					sendError)})
-
-			// processChannelUpdateAndRetry is a closure that
-			// handles a failure message containing a channel
-			// update. This function always tries to apply the
-			// channel update and passes on the result to the
-			// payment session to adjust its view on the reliability
-			// of the network.
-			//
-			// As channel id, the locally determined channel id is
-			// used. It does not rely on the channel id that is part
-			// of the channel update message, because the remote
-			// node may lie to us or the update may be corrupt.
-			processChannelUpdateAndRetry := func(
-				update *lnwire.ChannelUpdate,
-				pubKey *btcec.PublicKey) {
-
-				// Try to apply the channel update.
-				updateOk := r.applyChannelUpdate(update, pubKey)
-
-				// If the update could not be applied, prune the
-				// edge. There is no reason to continue trying
-				// this channel.
-				//
-				// TODO: Could even prune the node completely?
-				// Or is there a valid reason for the channel
-				// update to fail?
-				if !updateOk {
-					paySession.ReportChannelFailure(
-						failedChanID,
-					)
+
+			// Each shard may spend its share of the fee budget
+			// that's left.
+			shardFeeLimit := lnwire.MilliSatoshi(
+				float64(feeBudget) * float64(shardAmt) /
+					float64(remaining),
+			)
+			shard := *payment
+			shard.Amount = shardAmt
+			shard.FeeLimit = shardFeeLimit
+
+			route, err := paySession.RequestRoute(
+				&shard, uint32(currentHeight), finalCLTVDelta,
+			)
+			if err != nil {
+				// If no route can carry the shard, we'll try
+				// again with a smaller one.
+				if shardAmt/2 >= minShardAmount {
+					shardAmt /= 2
+					continue
 				}
 
-				paySession.ReportChannelPolicyFailure(
-					NewVertex(errSource), failedChanID,
-				)
+				// Otherwise, the payment can't be completed
+				// unless shards in flight fail and free up
+				// their bandwidth.
+				if inFlight == 0 {
+					paymentErr = err
+					if sendError != nil {
+						paymentErr = newErrf(
+							ErrNoRouteFound,
+							"unable to route "+
+								"payment to "+
+								"destination: %v",
+							sendError,
+						)
+					}
+				}
+				break
 			}
 
-			switch onionErr := fErr.FailureMessage.(type) {
-			// If the end destination didn't know the payment
-			// hash or we sent the wrong payment amount to the
-			// destination, then we'll terminate immediately.
-			case *lnwire.FailUnknownPaymentHash:
-				return preImage, nil, failedRoutes, sendError
-
-			// If we sent the wrong amount to the destination, then
-			// we'll exit early.
-			case *lnwire.FailIncorrectPaymentAmount:
-				return preImage, nil, failedRoutes, sendError
-
-			// If the time-lock that was extended to the final node
-			// was incorrect, then we can't proceed.
-			case *lnwire.FailFinalIncorrectCltvExpiry:
-				return preImage, nil, failedRoutes, sendError
-
-			// If we crafted an invalid onion payload for the final
-			// node, then we'll exit early.
-			case *lnwire.FailFinalIncorrectHtlcAmount:
-				return preImage, nil, failedRoutes, sendError
-
-			// Similarly, if the HTLC expiry that we extended to
-			// the final hop expires too soon, then will fail the
-			// payment.
-			//
-			// TODO(roasbeef): can happen to to race condition, try
-			// again with recent block height
-			case *lnwire.FailFinalExpiryTooSoon:
-				return preImage, nil, failedRoutes, sendError
-
-			// If we erroneously attempted to cross a chain border,
-			// then we'll cancel the payment.
-			case *lnwire.FailInvalidRealm:
-				return preImage, nil, failedRoutes, sendError
-
-			// If we get a notice that the expiry was too soon for
-			// an intermediate node, then we'll prune out the node
-			// that sent us this error, as it doesn't now what the
-			// correct block height is.
-			case *lnwire.FailExpiryTooSoon:
-				r.applyChannelUpdate(&onionErr.Update, errSource)
-				paySession.ReportVertexFailure(errVertex)
-				continue
+			htlcAdd, circuit, err := newHtlcAdd(route, payment)
+			if err != nil {
+				paymentErr = err
+				break
+			}
 
-			// If we hit an instance of onion payload corruption or
-			// an invalid version, then we'll exit early as this
-			// shouldn't happen in the typical case.
-			case *lnwire.FailInvalidOnionVersion:
-				return preImage, nil, failedRoutes, sendError
-			case *lnwire.FailInvalidOnionHmac:
-				return preImage, nil, failedRoutes, sendError
-			case *lnwire.FailInvalidOnionKey:
-				return preImage, nil, failedRoutes, sendError
-
-			// If we get a failure due to violating the minimum
-			// amount, we'll apply the new minimum amount and retry
-			// routing.
-			case *lnwire.FailAmountBelowMinimum:
-				processChannelUpdateAndRetry(
-					&onionErr.Update, errSource,
-				)
-				continue
+			log.Debugf("Sending shard of %v of payment %x, using "+
+				"route: %v", shardAmt, payment.PaymentHash,
+				newLogClosure(func() string {
+					return spew.Sdump(route)
+				}),
+			)
 
-			// If we get a failure due to a fee, we'll apply the
-			// new fee update, and retry our attempt using the
-			// newly updated fees.
-			case *lnwire.FailFeeInsufficient:
-				processChannelUpdateAndRetry(
-					&onionErr.Update, errSource,
-				)
-				continue
+			// Until the shard is resolved, its amount and fees
+			// are no longer available to other shards, nor is the
+			// bandwidth it uses on our channel.
+			remaining -= shardAmt
+			feeBudget -= route.TotalFees
+			paySession.reserveBandwidth(route)
+			inFlight++
 
-			// If we get the failure for an intermediate node that
-			// disagrees with our time lock values, then we'll
-			// apply the new delta value and try it once more.
-			case *lnwire.FailIncorrectCltvExpiry:
-				processChannelUpdateAndRetry(
-					&onionErr.Update, errSource,
+			firstHop := lnwire.NewShortChanIDFromInt(
+				route.Hops[0].ChannelID,
+			)
+			attemptID := r.registerAttempt(
+				payment.PaymentHash, route,
+			)
+			go func() {
+				preimage, err := r.cfg.SendShardToSwitch(
+					firstHop, htlcAdd, circuit,
 				)
-				continue
 
-			// The outgoing channel that this node was meant to
-			// forward one is currently disabled, so we'll apply
-			// the update and continue.
-			case *lnwire.FailChannelDisabled:
-				r.applyChannelUpdate(&onionErr.Update, errSource)
-				paySession.ReportChannelFailure(failedChanID)
-				continue
+				select {
+				case results <- &shardResult{
+					route:     route,
+					attemptID: attemptID,
+					preimage:  preimage,
+					err:       err,
+				}:
+				case <-r.quit:
+				}
+			}()
+		}
 
-			// It's likely that the outgoing channel didn't have
-			// sufficient capacity, so we'll prune this edge for
-			// now, and continue onwards with our path finding.
-			case *lnwire.FailTemporaryChannelFailure:
-				r.applyChannelUpdate(onionErr.Update, errSource)
-				paySession.ReportChannelFailure(failedChanID)
-				continue
+		// Once no shards are left in flight, the payment is
+		// finished.
+		if inFlight == 0 {
+			switch {
+			case len(settled) > 0:
+				return preimage, settled, failedRoutes, nil
 
-			// If the send fail due to a node not having the
-			// required features, then we'll note this error and
-			// continue.
-			case *lnwire.FailRequiredNodeFeatureMissing:
-				paySession.ReportVertexFailure(errVertex)
-				continue
+			case paymentErr != nil:
+				return [32]byte{}, nil, failedRoutes, paymentErr
+			}
+		}
 
-			// If the send fail due to a node not having the
-			// required features, then we'll note this error and
-			// continue.
-			case *lnwire.FailRequiredChannelFeatureMissing:
-				paySession.ReportVertexFailure(errVertex)
-				continue
+		select {
+		case result := <-results:
+			inFlight--
 
-			// If the next hop in the route wasn't known or
-			// offline, we'll only the channel which we attempted
-			// to route over. This is conservative, and it can
-			// handle faulty channels between nodes properly.
-			// Additionally, this guards against routing nodes
-			// returning errors in order to attempt to black list
-			// another node.
-			case *lnwire.FailUnknownNextPeer:
-				paySession.ReportChannelFailure(failedChanID)
-				continue
+			r.resolveAttempt(
+				payment.PaymentHash, result.attemptID,
+				result.route, result.err,
+			)
 
-			// If the node wasn't able to forward for which ever
-			// reason, then we'll note this and continue with the
-			// routes.
-			case *lnwire.FailTemporaryNodeFailure:
-				paySession.ReportVertexFailure(errVertex)
-				continue
+			if result.err == nil {
+				paySession.ReportSuccess(result.route)
 
-			case *lnwire.FailPermanentNodeFailure:
-				paySession.ReportVertexFailure(errVertex)
+				preimage = result.preimage
+				settled = append(settled, result.route)
 				continue
+			}
 
-			// If we crafted a route that contains a too long time
-			// lock for an intermediate node, we'll prune the node.
-			// As there currently is no way of knowing that node's
-			// maximum acceptable cltv, we cannot take this
-			// constraint into account during routing.
-			//
-			// TODO(joostjager): Record the rejected cltv and use
-			// that as a hint during future path finding through
-			// that node.
-			case *lnwire.FailExpiryTooFar:
-				paySession.ReportVertexFailure(errVertex)
-				continue
+			log.Errorf("Attempt to send shard of payment %x "+
+				"failed: %v", payment.PaymentHash, result.err)
 
-			// If we get a permanent channel or node failure, then
-			// we'll note this (exclude the vertex/edge), and
-			// continue with the rest of the routes.
-			case *lnwire.FailPermanentChannelFailure:
-				paySession.ReportChannelFailure(failedChanID)
-				continue
+			// The shard failed, so its amount, fees and bandwidth
+			// are available to the next ones.
+			route := result.route
+			remaining += route.TotalAmount - route.TotalFees
+			feeBudget += route.TotalFees
+			paySession.releaseBandwidth(route)
+			sendError = result.err
 
-			default:
-				return preImage, nil, failedRoutes, sendError
+			attempt, err := r.processSendError(
+				paySession, route, result.err,
+			)
+			if attempt != nil {
+				failedRoutes = append(failedRoutes, attempt)
+			}
+			if err != nil && paymentErr == nil {
+				paymentErr = err
 			}
+
+		case <-timeoutChan:
+			// We can't abort shards that are already in flight,
+			// so we'll stop sending new ones and wait for them to
+			// be resolved.
+			if paymentErr == nil {
+				errStr := fmt.Sprintf("payment attempt not "+
+					"completed before timeout of %v",
+					payAttemptTimeout)
+				paymentErr = newErr(
+					ErrPaymentAttemptTimeout, errStr,
+				)
+			}
+			timeoutChan = nil
+
+		case <-r.quit:
+			return [32]byte{}, nil, failedRoutes,
+				fmt.Errorf("router shutting down")
+		}
+	}
+}
+
+// registerAttempt persists a new attempt to send the payment along the given
+// route, and returns its attempt ID. Failing to record it doesn't prevent the
+// attempt from being made, in which case zero is returned.
+func (r *ChannelRouter) registerAttempt(paymentHash [32]byte,
+	route *Route) uint64 {
+
+	attempt := &channeldb.PaymentAttempt{
+		Hops:          make([]channeldb.AttemptHop, len(route.Hops)),
+		TotalAmount:   route.TotalAmount,
+		TotalFees:     route.TotalFees,
+		TotalTimeLock: route.TotalTimeLock,
+		AttemptTime:   time.Now(),
+	}
+	for i, hop := range route.Hops {
+		attempt.Hops[i] = channeldb.AttemptHop{
+			PubKey:           hop.PubKeyBytes,
+			ChannelID:        hop.ChannelID,
+			AmtToForward:     hop.AmtToForward,
+			OutgoingTimeLock: hop.OutgoingTimeLock,
 		}
+	}
 
-		return preImage, route, failedRoutes, nil
+	err := r.cfg.Control.RegisterAttempt(paymentHash, attempt)
+	if err != nil {
+		log.Errorf("Unable to register attempt to send payment %x: %v",
+			paymentHash, err)
+		return 0
+	}
+
+	return attempt.AttemptID
+}
+
+// resolveAttempt records the outcome of the attempt to send the payment along
+// the given route. If the attempt failed with a forwarding error, the index
+// of the node that reported it within the route and the code of its failure
+// message are recorded as well.
+func (r *ChannelRouter) resolveAttempt(paymentHash [32]byte, attemptID uint64,
+	route *Route, sendError error) {
+
+	// The attempt couldn't be registered, so there's nothing to resolve.
+	if attemptID == 0 {
+		return
+	}
+
+	var err error
+	if sendError == nil {
+		err = r.cfg.Control.SettleAttempt(paymentHash, attemptID)
+	} else {
+		var (
+			sourceIndex uint32
+			failureCode lnwire.FailCode
+		)
+		if fErr, ok := sendError.(*htlcswitch.ForwardingError); ok {
+			source := NewVertex(fErr.ErrorSource)
+			for i, hop := range route.Hops {
+				if hop.PubKeyBytes == source {
+					sourceIndex = uint32(i + 1)
+					break
+				}
+			}
+			failureCode = fErr.FailureMessage.Code()
+		}
+
+		err = r.cfg.Control.FailAttempt(
+			paymentHash, attemptID, sourceIndex, failureCode,
+		)
+	}
+	if err != nil {
+		log.Errorf("Unable to resolve attempt %v to send payment %x: "+
+			"%v", attemptID, paymentHash, err)
+	}
+}
+
+// failPayment records that the payment failed because of the given error, if
+// none of its HTLCs are left in flight.
+func (r *ChannelRouter) failPayment(paymentHash [32]byte, paymentErr error) {
+	reason := channeldb.FailureReasonError
+	switch {
+	case IsError(paymentErr, ErrPaymentAttemptTimeout):
+		reason = channeldb.FailureReasonTimeout
+
+	case IsError(paymentErr, ErrNoPathFound, ErrNoRouteFound,
+		ErrInsufficientCapacity, ErrMaxHopsExceeded,
+		ErrTargetNotInNetwork, ErrFeeLimitExceeded):
+
+		reason = channeldb.FailureReasonNoRoute
+	}
+
+	if fErr, ok := paymentErr.(*htlcswitch.ForwardingError); ok {
+		switch fErr.FailureMessage.(type) {
+		case *lnwire.FailUnknownPaymentHash,
+			*lnwire.FailIncorrectPaymentAmount,
+			*lnwire.FailFinalIncorrectCltvExpiry,
+			*lnwire.FailFinalIncorrectHtlcAmount,
+			*lnwire.FailFinalExpiryTooSoon:
+
+			reason = channeldb.FailureReasonIncorrectPaymentDetails
+		}
+	}
+
+	// If an HTLC of the payment is still in flight, such as when the
+	// router is shutting down, or the payment was already paid, then it
+	// didn't fail.
+	err := r.cfg.Control.FailPayment(paymentHash, reason)
+	switch err {
+	case nil, htlcswitch.ErrPaymentInFlight, htlcswitch.ErrAlreadyPaid:
+	default:
+		log.Errorf("Unable to mark payment %x as failed: %v",
+			paymentHash, err)
+	}
+}
+
+// processSendError analyzes an error that occurred when sending an HTLC over
+// the given route, and reports the failure to the payment session, such that
+// subsequent attempts route around it. It returns the failed attempt to
+// record, if any, and a non-nil error if the payment can't be retried.
+func (r *ChannelRouter) processSendError(paySession *paymentSession,
+	route *Route, sendError error) (*FailedPaymentAttempt, error) {
+
+	fErr, ok := sendError.(*htlcswitch.ForwardingError)
+	if !ok {
+		return nil, sendError
+	}
+
+	errSource := fErr.ErrorSource
+	errVertex := NewVertex(errSource)
+
+	log.Tracef("node=%x reported failure when sending htlc over "+
+		"route: %v", errSource.SerializeCompressed(),
+		newLogClosure(func() string {
+			return spew.Sdump(route)
+		}),
+	)
+
+	// Always determine chan id ourselves, because a channel
+	// update with id may not be available.
+	failedChanID, err := getFailedChannelID(route, errSource)
+	if err != nil {
+		return nil, err
+	}
+	attempt := &FailedPaymentAttempt{
+		PaymentRoute: route,
+		PaymentError: fmt.Errorf(
+			"send htlc failed, channel id=%v, error=%v",
+			failedChanID, sendError,
+		),
+	}
+
+	// processChannelUpdateAndRetry is a closure that
+	// handles a failure message containing a channel
+	// update. This function always tries to apply the
+	// channel update and passes on the result to the
+	// payment session to adjust its view on the reliability
+	// of the network.
+	//
+	// As channel id, the locally determined channel id is
+	// used. It does not rely on the channel id that is part
+	// of the channel update message, because the remote
+	// node may lie to us or the update may be corrupt.
+	processChannelUpdateAndRetry := func(
+		update *lnwire.ChannelUpdate,
+		pubKey *btcec.PublicKey) {
+
+		// Try to apply the channel update.
+		updateOk := r.applyChannelUpdate(update, pubKey)
+
+		// If the update could not be applied, prune the
+		// edge. There is no reason to continue trying
+		// this channel.
+		//
+		// TODO: Could even prune the node completely?
+		// Or is there a valid reason for the channel
+		// update to fail?
+		if !updateOk {
+			paySession.ReportChannelFailure(
+				route, failedChanID,
+			)
+		}
+
+		paySession.ReportChannelPolicyFailure(
+			NewVertex(errSource), failedChanID,
+		)
+	}
+
+	switch onionErr := fErr.FailureMessage.(type) {
+	// If the end destination didn't know the payment
+	// hash or we sent the wrong payment amount to the
+	// destination, then we'll terminate immediately.
+	case *lnwire.FailUnknownPaymentHash:
+		return attempt, sendError
+
+	// If we sent the wrong amount to the destination, then
+	// we'll exit early.
+	case *lnwire.FailIncorrectPaymentAmount:
+		return attempt, sendError
+
+	// If the time-lock that was extended to the final node
+	// was incorrect, then we can't proceed.
+	case *lnwire.FailFinalIncorrectCltvExpiry:
+		return attempt, sendError
+
+	// If we crafted an invalid onion payload for the final
+	// node, then we'll exit early.
+	case *lnwire.FailFinalIncorrectHtlcAmount:
+		return attempt, sendError
+
+	// Similarly, if the HTLC expiry that we extended to
+	// the final hop expires too soon, then will fail the
+	// payment.
+	//
+	// TODO(roasbeef): can happen to to race condition, try
+	// again with recent block height
+	case *lnwire.FailFinalExpiryTooSoon:
+		return attempt, sendError
+
+	// If we erroneously attempted to cross a chain border,
+	// then we'll cancel the payment.
+	case *lnwire.FailInvalidRealm:
+		return attempt, sendError
+
+	// If we get a notice that the expiry was too soon for
+	// an intermediate node, then we'll prune out the node
+	// that sent us this error, as it doesn't now what the
+	// correct block height is.
+	case *lnwire.FailExpiryTooSoon:
+		r.applyChannelUpdate(&onionErr.Update, errSource)
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	// If we hit an instance of onion payload corruption or
+	// an invalid version, then we'll exit early as this
+	// shouldn't happen in the typical case.
+	case *lnwire.FailInvalidOnionVersion:
+		return attempt, sendError
+	case *lnwire.FailInvalidOnionHmac:
+		return attempt, sendError
+	case *lnwire.FailInvalidOnionKey:
+		return attempt, sendError
+
+	// If we get a failure due to violating the minimum
+	// amount, we'll apply the new minimum amount and retry
+	// routing.
+	case *lnwire.FailAmountBelowMinimum:
+		processChannelUpdateAndRetry(
+			&onionErr.Update, errSource,
+		)
+		return attempt, nil
+
+	// If we get a failure due to a fee, we'll apply the
+	// new fee update, and retry our attempt using the
+	// newly updated fees.
+	case *lnwire.FailFeeInsufficient:
+		processChannelUpdateAndRetry(
+			&onionErr.Update, errSource,
+		)
+		return attempt, nil
+
+	// If we get the failure for an intermediate node that
+	// disagrees with our time lock values, then we'll
+	// apply the new delta value and try it once more.
+	case *lnwire.FailIncorrectCltvExpiry:
+		processChannelUpdateAndRetry(
+			&onionErr.Update, errSource,
+		)
+		return attempt, nil
+
+	// The outgoing channel that this node was meant to
+	// forward one is currently disabled, so we'll apply
+	// the update and continue.
+	case *lnwire.FailChannelDisabled:
+		r.applyChannelUpdate(&onionErr.Update, errSource)
+		paySession.ReportChannelFailure(route, failedChanID)
+		return attempt, nil
+
+	// It's likely that the outgoing channel didn't have
+	// sufficient capacity, so we'll prune this edge for
+	// now, and continue onwards with our path finding.
+	case *lnwire.FailTemporaryChannelFailure:
+		r.applyChannelUpdate(onionErr.Update, errSource)
+		paySession.ReportChannelFailure(route, failedChanID)
+		return attempt, nil
+
+	// If the send fail due to a node not having the
+	// required features, then we'll note this error and
+	// continue.
+	case *lnwire.FailRequiredNodeFeatureMissing:
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	// If the send fail due to a node not having the
+	// required features, then we'll note this error and
+	// continue.
+	case *lnwire.FailRequiredChannelFeatureMissing:
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	// If the next hop in the route wasn't known or
+	// offline, we'll only the channel which we attempted
+	// to route over. This is conservative, and it can
+	// handle faulty channels between nodes properly.
+	// Additionally, this guards against routing nodes
+	// returning errors in order to attempt to black list
+	// another node.
+	case *lnwire.FailUnknownNextPeer:
+		paySession.ReportChannelFailure(route, failedChanID)
+		return attempt, nil
+
+	// If the node wasn't able to forward for which ever
+	// reason, then we'll note this and continue with the
+	// routes.
+	case *lnwire.FailTemporaryNodeFailure:
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	case *lnwire.FailPermanentNodeFailure:
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	// If we crafted a route that contains a too long time
+	// lock for an intermediate node, we'll prune the node.
+	// As there currently is no way of knowing that node's
+	// maximum acceptable cltv, we cannot take this
+	// constraint into account during routing.
+	//
+	// TODO(joostjager): Record the rejected cltv and use
+	// that as a hint during future path finding through
+	// that node.
+	case *lnwire.FailExpiryTooFar:
+		paySession.ReportVertexFailure(errVertex)
+		return attempt, nil
+
+	// If we get a permanent channel or node failure, then
+	// we'll note this (exclude the vertex/edge), and
+	// continue with the rest of the routes.
+	case *lnwire.FailPermanentChannelFailure:
+		paySession.ReportChannelFailure(route, failedChanID)
+		return attempt, nil
+
+	default:
+		return attempt, sendError
 	}
 }
 
@@ -2316,3 +3106,19 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 
 	return false
 }
+
+// QueryMissionControl returns a snapshot of the payment results observed by
+// mission control, which it uses to estimate success probabilities during
+// path finding.
+func (r *ChannelRouter) QueryMissionControl() *MissionControlSnapshot {
+	return r.missionControl.GetHistorySnapshot()
+}
+
+// QueryProbability returns the estimated success probability of forwarding
+// the amount from one node to another, along with the last payment result
+// observed for the pair, if any.
+func (r *ChannelRouter) QueryProbability(fromNode, toNode Vertex,
+	amt lnwire.MilliSatoshi) (float64, *MissionControlPairSnapshot) {
+
+	return r.missionControl.GetPairHistorySnapshot(fromNode, toNode, amt)
+}
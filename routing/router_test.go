@@ -2,10 +2,12 @@ package routing
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"image/color"
 	"math/rand"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -93,6 +95,9 @@ func createTestCtxFromGraphInstance(startingHeight uint32, graphInstance *testGr
 
 			return [32]byte{}, nil
 		},
+		Control: htlcswitch.NewPaymentControl(
+			false, graphInstance.graph.Database(),
+		),
 		ChannelPruneExpiry: time.Hour * 24,
 		GraphPruneInterval: time.Hour * 2,
 		QueryBandwidth: func(e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
@@ -271,6 +276,94 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	}
 }
 
+// TestFindCircularRoute asserts that a circular route found by the router
+// leaves our node over the outgoing channel, and returns to it over the
+// incoming channel.
+func TestFindCircularRoute(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll shift balance from our channel with songoku to our channel
+	// with phamnuwen. The only path between them that doesn't pass
+	// through our node goes through sophon:
+	//	roasbeef -> songoku -> sophon -> phamnuwen -> roasbeef
+	const (
+		outgoingChan = 12345
+		incomingChan = 999991
+	)
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	route, err := ctx.router.FindCircularRoute(
+		outgoingChan, incomingChan, paymentAmt, noFeeLimit,
+		DefaultFinalCLTVDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to find circular route: %v", err)
+	}
+
+	expectedHops := []string{"songoku", "sophon", "phamnuwen", "roasbeef"}
+	if len(route.Hops) != len(expectedHops) {
+		t.Fatalf("expected %v hops, got %v: %v", len(expectedHops),
+			len(route.Hops), spew.Sdump(route))
+	}
+	for i, alias := range expectedHops {
+		hop := route.Hops[i]
+		if !bytes.Equal(hop.PubKeyBytes[:],
+			ctx.aliases[alias].SerializeCompressed()) {
+
+			t.Fatalf("expected hop %v to be %v, got %v", i, alias,
+				getAliasFromPubKey(hop.PubKeyBytes[:],
+					ctx.aliases))
+		}
+	}
+	if route.Hops[0].ChannelID != outgoingChan {
+		t.Fatalf("expected first hop over channel %v, got %v",
+			outgoingChan, route.Hops[0].ChannelID)
+	}
+	if route.Hops[3].ChannelID != incomingChan {
+		t.Fatalf("expected last hop over channel %v, got %v",
+			incomingChan, route.Hops[3].ChannelID)
+	}
+	if route.Hops[3].AmtToForward != paymentAmt {
+		t.Fatalf("expected to receive %v, got %v", paymentAmt,
+			route.Hops[3].AmtToForward)
+	}
+	if route.TotalFees == 0 {
+		t.Fatalf("expected circular route to pay fees")
+	}
+
+	// The outgoing channel must be able to carry the fees paid along the
+	// route, on top of the amount we receive back.
+	ctx.router.cfg.QueryBandwidth = func(
+		e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
+
+		return route.TotalAmount - 1
+	}
+	_, err = ctx.router.FindCircularRoute(
+		outgoingChan, incomingChan, paymentAmt, noFeeLimit,
+		DefaultFinalCLTVDelta,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected ErrNoPathFound, got %v", err)
+	}
+
+	// A circular route over a single channel isn't possible.
+	_, err = ctx.router.FindCircularRoute(
+		outgoingChan, outgoingChan, paymentAmt, noFeeLimit,
+		DefaultFinalCLTVDelta,
+	)
+	if err == nil {
+		t.Fatalf("circular route over a single channel found")
+	}
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -326,7 +419,7 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 
 	// Send off the payment request to the router, route through satoshi
 	// should've been selected as a fall back and succeeded correctly.
-	paymentPreImage, route, err := ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err := ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable to send payment: %v", err)
 	}
@@ -354,6 +447,261 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 	}
 }
 
+// TestNewHtlcAddRouteUnchanged tests that passing the payment address and
+// keysend preimage to the final hop of a route doesn't modify the route the
+// HTLC is created from, as it may be shared with other payment attempts.
+func TestNewHtlcAddRouteUnchanged(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(1000)
+	routes, err := ctx.router.FindRoutes(
+		ctx.aliases["sophon"], paymentAmt, noFeeLimit,
+		defaultNumRoutes, DefaultFinalCLTVDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to find routes: %v", err)
+	}
+	route := routes[0]
+
+	var paymentAddr, preimage [32]byte
+	paymentAddr[0] = 1
+	preimage[0] = 2
+	payment := &LightningPayment{
+		Target:          ctx.aliases["sophon"],
+		Amount:          paymentAmt,
+		PaymentHash:     sha256.Sum256(preimage[:]),
+		PaymentAddr:     &paymentAddr,
+		KeysendPreimage: &preimage,
+	}
+
+	if _, _, err := newHtlcAdd(route, payment); err != nil {
+		t.Fatalf("unable to create htlc: %v", err)
+	}
+
+	finalHop := route.Hops[len(route.Hops)-1]
+	if finalHop.PaymentAddr != nil || finalHop.KeysendPreimage != nil ||
+		finalHop.TotalAmount != 0 || finalHop.TLVPayload {
+
+		t.Fatalf("final hop of route was modified: %v",
+			spew.Sdump(finalHop))
+	}
+}
+
+// TestProbePayment tests that probing falls back to the next cheapest route
+// when a probe fails before reaching the destination, and that a probe failed
+// by the destination with an unknown payment hash is a success.
+func TestProbePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	sourcePub, err := ctx.router.selfNode.PubKey()
+	if err != nil {
+		t.Fatalf("unable to fetch source pubkey: %v", err)
+	}
+	target := ctx.aliases["luoji"]
+
+	// The direct channel to luo ji, which is the cheapest route, isn't
+	// able to carry the payment. Any other probe reaches luo ji, which
+	// doesn't know the payment hash.
+	roasbeefLuoji := lnwire.NewShortChanIDFromInt(689530843)
+	var probeHashes [][32]byte
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error) {
+
+		probeHashes = append(probeHashes, htlcAdd.PaymentHash)
+
+		if firstHop == roasbeefLuoji {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    sourcePub,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource: target,
+			FailureMessage: lnwire.NewFailUnknownPaymentHash(
+				htlcAdd.Amount,
+			),
+		}
+	}
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(1000)
+	route, failedRoutes, err := ctx.router.ProbePayment(
+		target, paymentAmt, noFeeLimit, defaultNumRoutes,
+	)
+	if err != nil {
+		t.Fatalf("unable to probe payment: %v", err)
+	}
+
+	// The probe over the direct channel should have failed, after which
+	// the route through satoshi should have been probed successfully.
+	if len(failedRoutes) != 1 {
+		t.Fatalf("expected 1 failed probe, got %v", len(failedRoutes))
+	}
+	if len(route.Hops) != 2 {
+		t.Fatalf("incorrect route length: expected %v got %v", 2,
+			len(route.Hops))
+	}
+	if !bytes.Equal(route.Hops[0].PubKeyBytes[:],
+		ctx.aliases["satoshi"].SerializeCompressed()) {
+
+		t.Fatalf("route should go through satoshi as first hop, "+
+			"instead passes through: %v",
+			getAliasFromPubKey(route.Hops[0].PubKeyBytes[:],
+				ctx.aliases))
+	}
+
+	// Each probe should have used its own random payment hash.
+	if len(probeHashes) != 2 || probeHashes[0] == probeHashes[1] {
+		t.Fatalf("expected two distinct probe hashes, got %x",
+			probeHashes)
+	}
+
+	// Finally, the successful probe should have been reported to mission
+	// control.
+	satoshi := NewVertex(ctx.aliases["satoshi"])
+	_, pair := ctx.router.QueryProbability(
+		satoshi, NewVertex(target), paymentAmt,
+	)
+	if pair == nil || !pair.Success {
+		t.Fatalf("expected successful probe to be reported, got %v",
+			pair)
+	}
+}
+
+// TestSendMultiPathPayment tests that a payment too large for any of our
+// channels is split into shards sent over different channels, and that the
+// shards together deliver the full payment amount. Payments to invoices that
+// don't signal support for multi-path payments must not be split.
+func TestSendMultiPathPayment(t *testing.T) {
+	t.Parallel()
+
+	// Setup a network in which we can reach the destination through two
+	// channels, neither of which can carry the full payment.
+	policy := &testChannelPolicy{
+		Expiry:  144,
+		MinHTLC: 1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "b", 100000, policy, 1),
+		symmetricTestChannel("roasbeef", "c", 100000, policy, 2),
+		symmetricTestChannel("b", "d", 100000, policy, 3),
+		symmetricTestChannel("c", "d", 100000, policy, 4),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	const startingBlockHeight = 101
+
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var preImage [32]byte
+	copy(preImage[:], bytes.Repeat([]byte{9}, 32))
+
+	// We'll record the amount sent over each of our channels.
+	var (
+		mtx  sync.Mutex
+		sent = make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	)
+	ctx.router.cfg.SendShardToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error) {
+
+		mtx.Lock()
+		sent[firstHop] += htlcAdd.Amount
+		mtx.Unlock()
+
+		return preImage, nil
+	}
+
+	// Without the multi-path payment feature, the payment is sent along a
+	// single route, which can't carry it.
+	var paymentAddr [32]byte
+	paymentAmt := lnwire.NewMSatFromSatoshis(150000)
+	payment := LightningPayment{
+		Target:      ctx.aliases["d"],
+		Amount:      paymentAmt,
+		FeeLimit:    noFeeLimit,
+		PaymentHash: [32]byte{1},
+		MaxShards:   2,
+		PaymentAddr: &paymentAddr,
+		DestFeatures: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+			), lnwire.Features,
+		),
+	}
+
+	_, _, _, err = ctx.router.SendMultiPathPayment(&payment)
+	if err == nil {
+		t.Fatalf("payment without multi-path support was split")
+	}
+	if len(sent) != 0 {
+		t.Fatalf("expected no shards to be sent, got %v", len(sent))
+	}
+
+	payment.PaymentHash = [32]byte{2}
+	payment.DestFeatures.Set(lnwire.MPPOptional)
+
+	paymentPreImage, routes, _, err := ctx.router.SendMultiPathPayment(
+		&payment,
+	)
+	if err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+
+	if !bytes.Equal(paymentPreImage[:], preImage[:]) {
+		t.Fatalf("incorrect preimage used: expected %x got %x",
+			preImage[:], paymentPreImage[:])
+	}
+
+	// The payment should have been split over both of our channels.
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 shards, got %v", len(routes))
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected shards over 2 channels, got %v", len(sent))
+	}
+
+	var total lnwire.MilliSatoshi
+	for _, route := range routes {
+		total += route.TotalAmount - route.TotalFees
+	}
+	if total != paymentAmt {
+		t.Fatalf("expected shards to deliver %v, got %v", paymentAmt,
+			total)
+	}
+}
+
 // TestChannelUpdateValidation tests that a failed payment with an associated
 // channel update will only be applied to the graph when the update contains a
 // valid signature.
@@ -460,7 +808,7 @@ func TestChannelUpdateValidation(t *testing.T) {
 	// Send off the payment request to the router. The specified route
 	// should be attempted and the channel update should be received by
 	// router and ignored because it is missing a valid signature.
-	_, _, err = ctx.router.SendToRoute([]*Route{route}, payment)
+	_, _, _, err = ctx.router.SendToRoute([]*Route{route}, payment)
 	if err == nil {
 		t.Fatalf("expected route to fail with channel update")
 	}
@@ -493,7 +841,7 @@ func TestChannelUpdateValidation(t *testing.T) {
 	}
 
 	// Retry the payment using the same route as before.
-	_, _, err = ctx.router.SendToRoute([]*Route{route}, payment)
+	_, _, _, err = ctx.router.SendToRoute([]*Route{route}, payment)
 	if err == nil {
 		t.Fatalf("expected route to fail with channel update")
 	}
@@ -586,7 +934,7 @@ func TestSendPaymentErrorRepeatedFeeInsufficient(t *testing.T) {
 
 	// Send off the payment request to the router, route through satoshi
 	// should've been selected as a fall back and succeeded correctly.
-	paymentPreImage, route, err := ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err := ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable to send payment: %v", err)
 	}
@@ -717,7 +1065,7 @@ func TestSendPaymentErrorNonFinalTimeLockErrors(t *testing.T) {
 	// Send off the payment request to the router, this payment should
 	// succeed as we should actually go through Pham Nuwen in order to get
 	// to Sophon, even though he has higher fees.
-	paymentPreImage, route, err := ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err := ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable to send payment: %v", err)
 	}
@@ -744,7 +1092,7 @@ func TestSendPaymentErrorNonFinalTimeLockErrors(t *testing.T) {
 
 	// Once again, Roasbeef should route around Goku since they disagree
 	// w.r.t to the block height, and instead go through Pham Nuwen.
-	paymentPreImage, route, err = ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err = ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable to send payment: %v", err)
 	}
@@ -824,11 +1172,13 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 		return preImage, nil
 	}
 
-	ctx.router.missionControl.ResetHistory()
+	if err := ctx.router.missionControl.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset mission control: %v", err)
+	}
 
 	// When we try to dispatch that payment, we should receive an error as
 	// both attempts should fail and cause both routes to be pruned.
-	_, _, err = ctx.router.SendPayment(&payment)
+	_, _, _, err = ctx.router.SendPayment(&payment)
 	if err == nil {
 		t.Fatalf("payment didn't return error")
 	}
@@ -839,7 +1189,9 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 		t.Fatalf("expected UnknownNextPeer instead got: %v", err)
 	}
 
-	ctx.router.missionControl.ResetHistory()
+	if err := ctx.router.missionControl.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset mission control: %v", err)
+	}
 
 	// Next, we'll modify the SendToSwitch method to indicate that luo ji
 	// wasn't originally online. This should also halt the send all
@@ -860,7 +1212,7 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	// This shouldn't return an error, as we'll make a payment attempt via
 	// the satoshi channel based on the assumption that there might be an
 	// intermittent issue with the roasbeef <-> lioji channel.
-	paymentPreImage, route, err := ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err := ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable send payment: %v", err)
 	}
@@ -883,7 +1235,9 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 				ctx.aliases))
 	}
 
-	ctx.router.missionControl.ResetHistory()
+	if err := ctx.router.missionControl.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset mission control: %v", err)
+	}
 
 	// Finally, we'll modify the SendToSwitch function to indicate that the
 	// roasbeef -> luoji channel has insufficient capacity. This should
@@ -903,7 +1257,7 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 		return preImage, nil
 	}
 
-	paymentPreImage, route, err = ctx.router.SendPayment(&payment)
+	paymentPreImage, route, _, err = ctx.router.SendPayment(&payment)
 	if err != nil {
 		t.Fatalf("unable to send payment: %v", err)
 	}
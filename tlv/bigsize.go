@@ -0,0 +1,171 @@
+package tlv
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrVarIntNotCanonical signals that the decoded varint was not minimally
+// encoded.
+var ErrVarIntNotCanonical = errors.New("decoded varint is not canonical")
+
+// ReadVarInt reads a variable length integer from r and returns it as a
+// uint64. The integer is encoded in the BigSize format: values below 0xfd are
+// encoded as a single byte, while larger values are prefixed by a
+// discriminant byte of 0xfd, 0xfe or 0xff, followed by the value as a big
+// endian uint16, uint32 or uint64 respectively. Values that aren't minimally
+// encoded are rejected.
+func ReadVarInt(r io.Reader, buf *[8]byte) (uint64, error) {
+	_, err := io.ReadFull(r, buf[:1])
+	if err != nil {
+		return 0, err
+	}
+	discriminant := buf[0]
+
+	var rv uint64
+	switch {
+	case discriminant < 0xfd:
+		rv = uint64(discriminant)
+
+	case discriminant == 0xfd:
+		_, err := io.ReadFull(r, buf[:2])
+		switch {
+		case err == io.EOF:
+			return 0, io.ErrUnexpectedEOF
+		case err != nil:
+			return 0, err
+		}
+		rv = uint64(binary.BigEndian.Uint16(buf[:2]))
+
+		// The encoding is not canonical if the value could have been
+		// encoded using fewer bytes.
+		if rv < 0xfd {
+			return 0, ErrVarIntNotCanonical
+		}
+
+	case discriminant == 0xfe:
+		_, err := io.ReadFull(r, buf[:4])
+		switch {
+		case err == io.EOF:
+			return 0, io.ErrUnexpectedEOF
+		case err != nil:
+			return 0, err
+		}
+		rv = uint64(binary.BigEndian.Uint32(buf[:4]))
+
+		// The encoding is not canonical if the value could have been
+		// encoded using fewer bytes.
+		if rv <= 0xffff {
+			return 0, ErrVarIntNotCanonical
+		}
+
+	default:
+		_, err := io.ReadFull(r, buf[:])
+		switch {
+		case err == io.EOF:
+			return 0, io.ErrUnexpectedEOF
+		case err != nil:
+			return 0, err
+		}
+		rv = binary.BigEndian.Uint64(buf[:])
+
+		// The encoding is not canonical if the value could have been
+		// encoded using fewer bytes.
+		if rv <= 0xffffffff {
+			return 0, ErrVarIntNotCanonical
+		}
+	}
+
+	return rv, nil
+}
+
+// WriteVarInt serializes val to w using the BigSize format, which uses the
+// fewest bytes possible to encode the value.
+func WriteVarInt(w io.Writer, val uint64, buf *[8]byte) error {
+	var length int
+	switch {
+	case val < 0xfd:
+		buf[0] = uint8(val)
+		length = 1
+
+	case val <= 0xffff:
+		buf[0] = 0xfd
+		binary.BigEndian.PutUint16(buf[1:3], uint16(val))
+		length = 3
+
+	case val <= 0xffffffff:
+		buf[0] = 0xfe
+		binary.BigEndian.PutUint32(buf[1:5], uint32(val))
+		length = 5
+
+	default:
+		// A uint64 doesn't fit into the buffer next to its
+		// discriminant, so we'll write the discriminant on its own.
+		if _, err := w.Write([]byte{0xff}); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint64(buf[:], val)
+		length = 8
+	}
+
+	_, err := w.Write(buf[:length])
+	return err
+}
+
+// VarIntSize returns the number of bytes needed to encode val in the BigSize
+// format.
+func VarIntSize(val uint64) uint64 {
+	switch {
+	case val < 0xfd:
+		return 1
+	case val <= 0xffff:
+		return 3
+	case val <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// EBigSize is an Encoder for uint64 values encoded in the BigSize format. An
+// error is returned if val is not a *uint64.
+func EBigSize(w io.Writer, val interface{}, buf *[8]byte) error {
+	if i, ok := val.(*uint64); ok {
+		return WriteVarInt(w, *i, buf)
+	}
+	return NewTypeForEncodingErr(val, "uint64")
+}
+
+// DBigSize is a Decoder for uint64 values encoded in the BigSize format. An
+// error is returned if val is not a *uint64, or the length doesn't match the
+// size of the decoded value.
+func DBigSize(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if i, ok := val.(*uint64); ok && l <= 9 {
+		v, err := ReadVarInt(io.LimitReader(r, int64(l)), buf)
+		if err != nil {
+			return err
+		}
+		if VarIntSize(v) != l {
+			return NewTypeForDecodingErr(val, "BigSize", l,
+				VarIntSize(v))
+		}
+		*i = v
+		return nil
+	}
+	return NewTypeForDecodingErr(val, "BigSize", l, 9)
+}
+
+// SizeBigSize returns a SizeFunc that computes the number of bytes needed to
+// encode the uint64 behind val in the BigSize format.
+func SizeBigSize(val *uint64) SizeFunc {
+	return func() uint64 {
+		return VarIntSize(*val)
+	}
+}
+
+// MakeBigSizeRecord creates a record for a uint64 value encoded in the
+// BigSize format.
+func MakeBigSizeRecord(typ Type, val *uint64) Record {
+	return MakeDynamicRecord(typ, val, SizeBigSize(val), EBigSize, DBigSize)
+}
@@ -0,0 +1,177 @@
+package tlv
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+type varIntTest struct {
+	Name   string
+	Value  uint64
+	Bytes  []byte
+	ExpErr error
+}
+
+var varIntTests = []varIntTest{
+	{
+		Name:  "zero",
+		Value: 0,
+		Bytes: []byte{0x00},
+	},
+	{
+		Name:  "one byte high",
+		Value: 252,
+		Bytes: []byte{0xfc},
+	},
+	{
+		Name:  "two byte low",
+		Value: 253,
+		Bytes: []byte{0xfd, 0x00, 0xfd},
+	},
+	{
+		Name:  "two byte high",
+		Value: 65535,
+		Bytes: []byte{0xfd, 0xff, 0xff},
+	},
+	{
+		Name:  "four byte low",
+		Value: 65536,
+		Bytes: []byte{0xfe, 0x00, 0x01, 0x00, 0x00},
+	},
+	{
+		Name:  "four byte high",
+		Value: 4294967295,
+		Bytes: []byte{0xfe, 0xff, 0xff, 0xff, 0xff},
+	},
+	{
+		Name:  "eight byte low",
+		Value: 4294967296,
+		Bytes: []byte{0xff, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00},
+	},
+	{
+		Name:  "eight byte high",
+		Value: 18446744073709551615,
+		Bytes: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	},
+	{
+		Name:   "two byte not canonical",
+		Bytes:  []byte{0xfd, 0x00, 0xfc},
+		ExpErr: ErrVarIntNotCanonical,
+	},
+	{
+		Name:   "four byte not canonical",
+		Bytes:  []byte{0xfe, 0x00, 0x00, 0xff, 0xff},
+		ExpErr: ErrVarIntNotCanonical,
+	},
+	{
+		Name:   "eight byte not canonical",
+		Bytes:  []byte{0xff, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff},
+		ExpErr: ErrVarIntNotCanonical,
+	},
+	{
+		Name:   "two byte short read",
+		Bytes:  []byte{0xfd, 0x00},
+		ExpErr: io.ErrUnexpectedEOF,
+	},
+	{
+		Name:   "four byte short read",
+		Bytes:  []byte{0xfe, 0xff, 0xff},
+		ExpErr: io.ErrUnexpectedEOF,
+	},
+	{
+		Name:   "eight byte short read",
+		Bytes:  []byte{0xff, 0xff, 0xff, 0xff, 0xff},
+		ExpErr: io.ErrUnexpectedEOF,
+	},
+	{
+		Name:   "one byte no read",
+		Bytes:  []byte{},
+		ExpErr: io.EOF,
+	},
+}
+
+// TestVarInt asserts that the BigSize varint encoding round trips canonical
+// values and rejects non-minimal or truncated encodings.
+func TestVarInt(t *testing.T) {
+	for _, test := range varIntTests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			var buf [8]byte
+			r := bytes.NewReader(test.Bytes)
+			val, err := ReadVarInt(r, &buf)
+			if err != test.ExpErr {
+				t.Fatalf("expected decoding error: %v, got: %v",
+					test.ExpErr, err)
+			}
+
+			// If we expected a decoding error, there's no point
+			// checking the value or the encoding.
+			if test.ExpErr != nil {
+				return
+			}
+
+			if val != test.Value {
+				t.Fatalf("expected value: %d, got %d",
+					test.Value, val)
+			}
+
+			var w bytes.Buffer
+			if err := WriteVarInt(&w, test.Value, &buf); err != nil {
+				t.Fatalf("unable to encode varint: %v", err)
+			}
+
+			if !bytes.Equal(w.Bytes(), test.Bytes) {
+				t.Fatalf("expected bytes: %x, got %x",
+					test.Bytes, w.Bytes())
+			}
+
+			size := VarIntSize(test.Value)
+			if size != uint64(len(test.Bytes)) {
+				t.Fatalf("expected size: %d, got %d",
+					len(test.Bytes), size)
+			}
+		})
+	}
+}
+
+// TestBigSizeRecord asserts that BigSize records survive being encoded within
+// a TLV stream, and that the length of the record must match the size of its
+// value.
+func TestBigSizeRecord(t *testing.T) {
+	for _, test := range varIntTests {
+		if test.ExpErr != nil {
+			continue
+		}
+
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			val := test.Value
+			stream := MustNewStream(MakeBigSizeRecord(1, &val))
+
+			var b bytes.Buffer
+			if err := stream.Encode(&b); err != nil {
+				t.Fatalf("unable to encode stream: %v", err)
+			}
+
+			var decoded uint64
+			stream = MustNewStream(MakeBigSizeRecord(1, &decoded))
+			if err := stream.Decode(&b); err != nil {
+				t.Fatalf("unable to decode stream: %v", err)
+			}
+
+			if decoded != test.Value {
+				t.Fatalf("expected value: %d, got %d",
+					test.Value, decoded)
+			}
+		})
+	}
+
+	// A value padded beyond its minimal size must be rejected.
+	var decoded uint64
+	stream := MustNewStream(MakeBigSizeRecord(1, &decoded))
+	err := stream.Decode(bytes.NewReader([]byte{0x01, 0x02, 0x05, 0x00}))
+	if _, ok := err.(ErrTypeForDecoding); !ok {
+		t.Fatalf("expected ErrTypeForDecoding, got: %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+package tlv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// Type is a 64-bit identifier for a TLV Record.
+type Type uint64
+
+// TypeMap is a map of parsed Types. The map values are byte slices. If the byte
+// slice is nil, the type was successfully parsed. Otherwise the value is a byte
+// slice containing the encoded data.
+type TypeMap map[Type][]byte
+
+// Encoder is a signature for methods that can encode TLV values. An error
+// should be returned if the Encoder cannot support the underlying type of val.
+// The provided scratch buffer must be non-nil.
+type Encoder func(w io.Writer, val interface{}, buf *[8]byte) error
+
+// Decoder is a signature for methods that can decode TLV values. An error
+// should be returned if the Decoder cannot support the underlying type of val.
+// The provided scratch buffer must be non-nil.
+type Decoder func(r io.Reader, val interface{}, buf *[8]byte, l uint64) error
+
+// SizeFunc is a function that can compute the length of a given field. Since
+// the size of the underlying field can change, this allows the size of the
+// field to be evaluated at the time of encoding.
+type SizeFunc func() uint64
+
+// RecordProducer is an interface for objects that can produce a Record object
+// capable of encoding and/or decoding the RecordProducer as a Record.
+type RecordProducer interface {
+	// Record returns a Record that can be used to encode or decode the
+	// backing object.
+	Record() Record
+}
+
+// Record holds the required information to encode or decode a TLV record.
+type Record struct {
+	value      interface{}
+	typ        Type
+	staticSize uint64
+	sizeFunc   SizeFunc
+	encoder    Encoder
+	decoder    Decoder
+}
+
+// Record returns the Record itself, allowing a Record to be used wherever a
+// RecordProducer is expected.
+func (f *Record) Record() Record {
+	return *f
+}
+
+// Size returns the size of the Record's value. If no static size is known, the
+// dynamic size will be evaluated.
+func (f *Record) Size() uint64 {
+	if f.sizeFunc == nil {
+		return f.staticSize
+	}
+
+	return f.sizeFunc()
+}
+
+// Type returns the type of the underlying TLV record.
+func (f *Record) Type() Type {
+	return f.typ
+}
+
+// Encode writes out the TLV record to the passed writer. This is useful when a
+// caller wants to obtain the raw encoding of a *single* TLV record, outside the
+// context of the Stream struct.
+func (f *Record) Encode(w io.Writer) error {
+	var b [8]byte
+	return f.encoder(w, f.value, &b)
+}
+
+// Decode reads in the TLV record from the passed reader. This is useful when a
+// caller wants to decode a *single* TLV record, outside the context of the
+// Stream struct.
+func (f *Record) Decode(r io.Reader, l uint64) error {
+	var b [8]byte
+	return f.decoder(r, f.value, &b, l)
+}
+
+// MakePrimitiveRecord creates a record for common types.
+func MakePrimitiveRecord(typ Type, val interface{}) Record {
+	var (
+		staticSize uint64
+		sizeFunc   SizeFunc
+		encoder    Encoder
+		decoder    Decoder
+	)
+	switch e := val.(type) {
+	case *uint8:
+		staticSize = 1
+		encoder = EUint8
+		decoder = DUint8
+
+	case *uint16:
+		staticSize = 2
+		encoder = EUint16
+		decoder = DUint16
+
+	case *uint32:
+		staticSize = 4
+		encoder = EUint32
+		decoder = DUint32
+
+	case *uint64:
+		staticSize = 8
+		encoder = EUint64
+		decoder = DUint64
+
+	case *[32]byte:
+		staticSize = 32
+		encoder = EBytes32
+		decoder = DBytes32
+
+	case *[33]byte:
+		staticSize = 33
+		encoder = EBytes33
+		decoder = DBytes33
+
+	case **btcec.PublicKey:
+		staticSize = 33
+		encoder = EPubKey
+		decoder = DPubKey
+
+	case *[]byte:
+		sizeFunc = SizeVarBytes(e)
+		encoder = EVarBytes
+		decoder = DVarBytes
+
+	default:
+		panic(fmt.Sprintf("unknown primitive type: %T", val))
+	}
+
+	return Record{
+		value:      val,
+		typ:        typ,
+		staticSize: staticSize,
+		sizeFunc:   sizeFunc,
+		encoder:    encoder,
+		decoder:    decoder,
+	}
+}
+
+// MakeStaticRecord creates a fixed-length record from the given type, value,
+// size, encoder and decoder.
+func MakeStaticRecord(typ Type, val interface{}, size uint64, encoder Encoder,
+	decoder Decoder) Record {
+
+	return Record{
+		value:      val,
+		typ:        typ,
+		staticSize: size,
+		encoder:    encoder,
+		decoder:    decoder,
+	}
+}
+
+// MakeDynamicRecord creates a variable-length record whose size is computed by
+// sizeFunc at the time of encoding.
+func MakeDynamicRecord(typ Type, val interface{}, sizeFunc SizeFunc,
+	encoder Encoder, decoder Decoder) Record {
+
+	return Record{
+		value:    val,
+		typ:      typ,
+		sizeFunc: sizeFunc,
+		encoder:  encoder,
+		decoder:  decoder,
+	}
+}
+
+// SizeVarBytes returns a SizeFunc that computes the length of a byte slice.
+func SizeVarBytes(e *[]byte) SizeFunc {
+	return func() uint64 {
+		return uint64(len(*e))
+	}
+}
+
+// RecordsToProducers converts a slice of Record structs into a slice of
+// RecordProducer interfaces.
+func RecordsToProducers(records []Record) []RecordProducer {
+	producers := make([]RecordProducer, len(records))
+	for i := range records {
+		producers[i] = &records[i]
+	}
+
+	return producers
+}
+
+// SortRecords sorts the given slice of records by their type, as required for
+// a canonical stream encoding.
+func SortRecords(records []Record) {
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Type() < records[j].Type()
+	})
+}
+
+// StubEncoder is a factory function that returns an Encoder which writes the
+// provided bytes regardless of the value it's given. This is used to re-encode
+// records that were parsed without a known type.
+func StubEncoder(v []byte) Encoder {
+	return func(w io.Writer, val interface{}, buf *[8]byte) error {
+		_, err := w.Write(v)
+		return err
+	}
+}
+
+// MapToRecords encodes the passed TLV map as a series of regular Records. The
+// resulting records are sorted by type and can be passed to NewStream.
+func MapToRecords(tlvMap map[uint64][]byte) []Record {
+	records := make([]Record, 0, len(tlvMap))
+	for k, v := range tlvMap {
+		records = append(records, MakeStaticRecord(
+			Type(k), nil, uint64(len(v)), StubEncoder(v), nil,
+		))
+	}
+
+	SortRecords(records)
+
+	return records
+}
+
+// EncodeRecords is a helper that encodes the given records into a raw TLV
+// stream.
+func EncodeRecords(records []Record) ([]byte, error) {
+	stream, err := NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,234 @@
+package tlv
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+type streamValues struct {
+	amt      uint64
+	cltv     uint32
+	preimage [32]byte
+	blob     []byte
+}
+
+func (v *streamValues) records() []Record {
+	return []Record{
+		MakePrimitiveRecord(2, &v.amt),
+		MakePrimitiveRecord(4, &v.cltv),
+		MakePrimitiveRecord(6, &v.preimage),
+		MakePrimitiveRecord(9, &v.blob),
+	}
+}
+
+// TestStreamEncodeDecode asserts that a stream of known records round trips,
+// and that the parsed types of the decoded stream are reported.
+func TestStreamEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	values := &streamValues{
+		amt:  1000,
+		cltv: 144,
+		blob: []byte{0x01, 0x02, 0x03},
+	}
+	values.preimage[0] = 0xaa
+
+	stream, err := NewStream(values.records()...)
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+
+	decoded := &streamValues{}
+	stream, err = NewStream(decoded.records()...)
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(&b)
+	if err != nil {
+		t.Fatalf("unable to decode stream: %v", err)
+	}
+
+	if !reflect.DeepEqual(values, decoded) {
+		t.Fatalf("decoded values don't match: expected %v, got %v",
+			values, decoded)
+	}
+
+	expTypes := TypeMap{2: nil, 4: nil, 6: nil, 9: nil}
+	if !reflect.DeepEqual(parsedTypes, expTypes) {
+		t.Fatalf("expected parsed types %v, got %v", expTypes,
+			parsedTypes)
+	}
+}
+
+// TestStreamDecodeUnknownTypes asserts that unknown odd types are skipped while
+// unknown even types cause decoding to fail.
+func TestStreamDecodeUnknownTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		bytes       []byte
+		expErr      error
+		expTypes    TypeMap
+		expCltv     uint32
+		expCltvRead bool
+	}{
+		{
+			name:     "empty stream",
+			bytes:    []byte{},
+			expTypes: TypeMap{},
+		},
+		{
+			name:     "unknown odd type",
+			bytes:    []byte{0x01, 0x02, 0xab, 0xcd},
+			expTypes: TypeMap{1: []byte{0xab, 0xcd}},
+		},
+		{
+			name: "unknown odd type before known type",
+			bytes: []byte{
+				0x03, 0x00,
+				0x04, 0x04, 0x00, 0x00, 0x00, 0x90,
+			},
+			expTypes:    TypeMap{3: []byte{}, 4: nil},
+			expCltv:     144,
+			expCltvRead: true,
+		},
+		{
+			name:   "unknown even type",
+			bytes:  []byte{0x08, 0x01, 0x00},
+			expErr: ErrUnknownRequiredType(8),
+		},
+		{
+			name: "duplicate type",
+			bytes: []byte{
+				0x04, 0x04, 0x00, 0x00, 0x00, 0x90,
+				0x04, 0x04, 0x00, 0x00, 0x00, 0x90,
+			},
+			expErr: ErrStreamNotCanonical,
+		},
+		{
+			name: "unsorted types",
+			bytes: []byte{
+				0x05, 0x00,
+				0x03, 0x00,
+			},
+			expErr: ErrStreamNotCanonical,
+		},
+		{
+			name:   "record too large",
+			bytes:  []byte{0x03, 0xfe, 0x00, 0x01, 0x00, 0x00},
+			expErr: ErrRecordTooLarge,
+		},
+		{
+			name:   "truncated value",
+			bytes:  []byte{0x04, 0x04, 0x00, 0x00},
+			expErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:   "missing length",
+			bytes:  []byte{0x03},
+			expErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:   "wrong length for known type",
+			bytes:  []byte{0x04, 0x02, 0x00, 0x90},
+			expErr: NewTypeForDecodingErr(new(uint32), "uint32", 2, 4),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var cltv uint32
+			stream := MustNewStream(MakePrimitiveRecord(4, &cltv))
+
+			r := bytes.NewReader(test.bytes)
+			parsedTypes, err := stream.DecodeWithParsedTypes(r)
+			if !reflect.DeepEqual(err, test.expErr) {
+				t.Fatalf("expected error: %v, got: %v",
+					test.expErr, err)
+			}
+			if test.expErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(parsedTypes, test.expTypes) {
+				t.Fatalf("expected parsed types %v, got %v",
+					test.expTypes, parsedTypes)
+			}
+			if test.expCltvRead && cltv != test.expCltv {
+				t.Fatalf("expected cltv %v, got %v",
+					test.expCltv, cltv)
+			}
+		})
+	}
+}
+
+// TestNewStreamNotCanonical asserts that a stream can't be created from records
+// that aren't sorted by strictly increasing type.
+func TestNewStreamNotCanonical(t *testing.T) {
+	t.Parallel()
+
+	var a, b uint8
+	_, err := NewStream(
+		MakePrimitiveRecord(2, &a), MakePrimitiveRecord(2, &b),
+	)
+	if err != ErrStreamNotCanonical {
+		t.Fatalf("expected ErrStreamNotCanonical, got: %v", err)
+	}
+
+	_, err = NewStream(
+		MakePrimitiveRecord(3, &a), MakePrimitiveRecord(1, &b),
+	)
+	if err != ErrStreamNotCanonical {
+		t.Fatalf("expected ErrStreamNotCanonical, got: %v", err)
+	}
+}
+
+// TestMapToRecords asserts that raw records produced from a map are encoded in
+// sorted order and can be decoded back.
+func TestMapToRecords(t *testing.T) {
+	t.Parallel()
+
+	tlvMap := map[uint64][]byte{
+		7: {0x07},
+		1: {0x01, 0x01},
+		5: {},
+	}
+
+	encoded, err := EncodeRecords(MapToRecords(tlvMap))
+	if err != nil {
+		t.Fatalf("unable to encode records: %v", err)
+	}
+
+	expEncoded := []byte{
+		0x01, 0x02, 0x01, 0x01,
+		0x05, 0x00,
+		0x07, 0x01, 0x07,
+	}
+	if !bytes.Equal(encoded, expEncoded) {
+		t.Fatalf("expected encoding %x, got %x", expEncoded, encoded)
+	}
+
+	parsedTypes, err := MustNewStream().DecodeWithParsedTypes(
+		bytes.NewReader(encoded),
+	)
+	if err != nil {
+		t.Fatalf("unable to decode records: %v", err)
+	}
+
+	for typ, value := range tlvMap {
+		if !bytes.Equal(parsedTypes[Type(typ)], value) {
+			t.Fatalf("expected value %x for type %d, got %x",
+				value, typ, parsedTypes[Type(typ)])
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package tlv
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+)
+
+// ErrTUintNotMinimal signals that a truncated unsigned integer was not encoded
+// using the minimal number of bytes.
+var ErrTUintNotMinimal = errors.New("truncated uint not minimally encoded")
+
+// SizeTUint32 returns the number of bytes needed to encode val as a truncated
+// uint32, which omits any leading zero bytes.
+func SizeTUint32(val uint32) uint64 {
+	return uint64((bits.Len32(val) + 7) / 8)
+}
+
+// SizeTUint64 returns the number of bytes needed to encode val as a truncated
+// uint64, which omits any leading zero bytes.
+func SizeTUint64(val uint64) uint64 {
+	return uint64((bits.Len64(val) + 7) / 8)
+}
+
+// ETUint32 is an Encoder for truncated uint32 values. An error is returned if
+// val is not a *uint32.
+func ETUint32(w io.Writer, val interface{}, buf *[8]byte) error {
+	if i, ok := val.(*uint32); ok {
+		binary.BigEndian.PutUint32(buf[:4], *i)
+		numZeros := 4 - SizeTUint32(*i)
+		_, err := w.Write(buf[numZeros:4])
+		return err
+	}
+	return NewTypeForEncodingErr(val, "uint32")
+}
+
+// DTUint32 is a Decoder for truncated uint32 values. An error is returned if
+// val is not a *uint32, the length exceeds 4 bytes, or the value isn't
+// minimally encoded.
+func DTUint32(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if i, ok := val.(*uint32); ok && l <= 4 {
+		*buf = [8]byte{}
+		if _, err := io.ReadFull(r, buf[4-l:4]); err != nil {
+			return err
+		}
+		*i = binary.BigEndian.Uint32(buf[:4])
+
+		if SizeTUint32(*i) != l {
+			return ErrTUintNotMinimal
+		}
+		return nil
+	}
+	return NewTypeForDecodingErr(val, "uint32", l, 4)
+}
+
+// ETUint64 is an Encoder for truncated uint64 values. An error is returned if
+// val is not a *uint64.
+func ETUint64(w io.Writer, val interface{}, buf *[8]byte) error {
+	if i, ok := val.(*uint64); ok {
+		binary.BigEndian.PutUint64(buf[:], *i)
+		numZeros := 8 - SizeTUint64(*i)
+		_, err := w.Write(buf[numZeros:])
+		return err
+	}
+	return NewTypeForEncodingErr(val, "uint64")
+}
+
+// DTUint64 is a Decoder for truncated uint64 values. An error is returned if
+// val is not a *uint64, the length exceeds 8 bytes, or the value isn't
+// minimally encoded.
+func DTUint64(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if i, ok := val.(*uint64); ok && l <= 8 {
+		*buf = [8]byte{}
+		if _, err := io.ReadFull(r, buf[8-l:]); err != nil {
+			return err
+		}
+		*i = binary.BigEndian.Uint64(buf[:])
+
+		if SizeTUint64(*i) != l {
+			return ErrTUintNotMinimal
+		}
+		return nil
+	}
+	return NewTypeForDecodingErr(val, "uint64", l, 8)
+}
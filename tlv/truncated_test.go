@@ -0,0 +1,112 @@
+package tlv
+
+import (
+	"bytes"
+	"testing"
+)
+
+var tuint64Tests = []struct {
+	Value  uint64
+	Bytes  []byte
+	ExpErr error
+}{
+	{
+		Value: 0,
+		Bytes: []byte{},
+	},
+	{
+		Value: 0x01,
+		Bytes: []byte{0x01},
+	},
+	{
+		Value: 0x0100,
+		Bytes: []byte{0x01, 0x00},
+	},
+	{
+		Value: 0x01000000,
+		Bytes: []byte{0x01, 0x00, 0x00, 0x00},
+	},
+	{
+		Value: 0x0100000000000000,
+		Bytes: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	},
+	{
+		Value: 0xffffffffffffffff,
+		Bytes: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	},
+	{
+		Bytes:  []byte{0x00},
+		ExpErr: ErrTUintNotMinimal,
+	},
+	{
+		Bytes:  []byte{0x00, 0x01},
+		ExpErr: ErrTUintNotMinimal,
+	},
+}
+
+// TestTUint64 asserts that truncated uint64s are encoded using the minimal
+// number of bytes, and that non-minimal encodings are rejected.
+func TestTUint64(t *testing.T) {
+	for _, test := range tuint64Tests {
+		var buf [8]byte
+
+		if test.ExpErr == nil {
+			if size := SizeTUint64(test.Value); size !=
+				uint64(len(test.Bytes)) {
+
+				t.Fatalf("size mismatch for %x: want %d, got %d",
+					test.Value, len(test.Bytes), size)
+			}
+
+			var b bytes.Buffer
+			err := ETUint64(&b, &test.Value, &buf)
+			if err != nil {
+				t.Fatalf("unable to encode %x: %v", test.Value, err)
+			}
+			if !bytes.Equal(b.Bytes(), test.Bytes) {
+				t.Fatalf("encoding mismatch for %x: want %x, "+
+					"got %x", test.Value, test.Bytes, b.Bytes())
+			}
+		}
+
+		var value uint64
+		r := bytes.NewReader(test.Bytes)
+		err := DTUint64(r, &value, &buf, uint64(len(test.Bytes)))
+		if err != test.ExpErr {
+			t.Fatalf("decoding %x: want error %v, got %v",
+				test.Bytes, test.ExpErr, err)
+		}
+		if err == nil && value != test.Value {
+			t.Fatalf("decoding %x: want %x, got %x", test.Bytes,
+				test.Value, value)
+		}
+	}
+}
+
+// TestTUint32 asserts that truncated uint32s survive an encoding round trip.
+func TestTUint32(t *testing.T) {
+	values := []uint32{0, 0x01, 0xff, 0x0100, 0x010000, 0xffffffff}
+	for _, value := range values {
+		var (
+			buf [8]byte
+			b   bytes.Buffer
+		)
+		if err := ETUint32(&b, &value, &buf); err != nil {
+			t.Fatalf("unable to encode %x: %v", value, err)
+		}
+		if uint64(b.Len()) != SizeTUint32(value) {
+			t.Fatalf("size mismatch for %x: want %d, got %d",
+				value, SizeTUint32(value), b.Len())
+		}
+
+		var decoded uint32
+		err := DTUint32(&b, &decoded, &buf, SizeTUint32(value))
+		if err != nil {
+			t.Fatalf("unable to decode %x: %v", value, err)
+		}
+		if decoded != value {
+			t.Fatalf("round trip mismatch: want %x, got %x",
+				value, decoded)
+		}
+	}
+}
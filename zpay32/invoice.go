@@ -68,6 +68,15 @@ const (
 
 	// fieldTypeC contains an optional requested final CLTV delta.
 	fieldTypeC = 24
+
+	// fieldTypeS contains a 32-byte payment address, which is a nonce
+	// included in the final hop's payload to prevent intermediaries from
+	// probing the recipient.
+	fieldTypeS = 16
+
+	// fieldType9 contains one or more bytes for signaling features
+	// supported or required by the receiver.
+	fieldType9 = 5
 )
 
 // MessageSigner is passed to the Encode method to provide a signature
@@ -147,6 +156,19 @@ type Invoice struct {
 	//
 	// NOTE: This is optional.
 	RouteHints [][]routing.HopHint
+
+	// PaymentAddr is the payment address to be used by payments to this
+	// invoice, which the sender passes to the receiver within the final
+	// hop's payload.
+	//
+	// NOTE: This is optional.
+	PaymentAddr *[32]byte
+
+	// Features represents an optional field used to signal optional or
+	// required features of the receiver.
+	//
+	// NOTE: This is optional.
+	Features *lnwire.FeatureVector
 }
 
 // Amount is a functional option that allows callers of NewInvoice to set the
@@ -220,6 +242,23 @@ func RouteHint(routeHint []routing.HopHint) func(*Invoice) {
 	}
 }
 
+// PaymentAddr is a functional option that allows callers of NewInvoice to set
+// the desired payment address that is advertised on the invoice.
+func PaymentAddr(addr [32]byte) func(*Invoice) {
+	return func(i *Invoice) {
+		i.PaymentAddr = &addr
+	}
+}
+
+// Features is a functional option that allows callers of NewInvoice to set the
+// desired feature bits that are advertised on the invoice. The feature vector
+// is typically the invoice feature set of the feature manager.
+func Features(features *lnwire.FeatureVector) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Features = features
+	}
+}
+
 // NewInvoice creates a new Invoice object. The last parameter is a set of
 // variadic arguments for setting optional fields of the invoice.
 //
@@ -537,6 +576,13 @@ func validateInvoice(invoice *Invoice) error {
 			len(invoice.Destination.SerializeCompressed()))
 	}
 
+	// If the payment address is required by the invoice, it must be set.
+	if invoice.Features != nil && invoice.PaymentAddr == nil &&
+		invoice.Features.IsSet(lnwire.PaymentAddrRequired) {
+
+		return fmt.Errorf("payment address required but not set")
+	}
+
 	return nil
 }
 
@@ -606,6 +652,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.PaymentHash, err = parsePaymentHash(base32Data)
+		case fieldTypeS:
+			if invoice.PaymentAddr != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.PaymentAddr, err = parsePaymentHash(base32Data)
 		case fieldTypeD:
 			if invoice.Description != nil {
 				// We skip the field if we have already seen a
@@ -664,6 +718,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.RouteHints = append(invoice.RouteHints, routeHint)
+		case fieldType9:
+			if invoice.Features != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.Features, err = parseFeatures(base32Data)
 		default:
 			// Ignore unknown type.
 		}
@@ -875,6 +937,14 @@ func parseRouteHint(data []byte) ([]routing.HopHint, error) {
 	return routeHint, nil
 }
 
+// parseFeatures converts the data (encoded in base32) into a feature vector.
+func parseFeatures(data []byte) (*lnwire.FeatureVector, error) {
+	rawFeatures := lnwire.NewRawFeatureVector()
+	rawFeatures.DecodeBase32(data)
+
+	return lnwire.NewFeatureVector(rawFeatures, lnwire.Features), nil
+}
+
 // writeTaggedFields writes the non-nil tagged fields of the Invoice to the
 // base32 buffer.
 func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
@@ -896,6 +966,24 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.PaymentAddr != nil {
+		// Convert 32 byte payment address to 52 5-bit groups.
+		base32, err := bech32.ConvertBits(invoice.PaymentAddr[:], 8, 5,
+			true)
+		if err != nil {
+			return err
+		}
+		if len(base32) != hashBase32Len {
+			return fmt.Errorf("invalid payment address length: %d",
+				len(invoice.PaymentAddr))
+		}
+
+		err = writeTaggedField(bufferBase32, fieldTypeS, base32)
+		if err != nil {
+			return err
+		}
+	}
+
 	if invoice.Description != nil {
 		base32, err := bech32.ConvertBits([]byte(*invoice.Description),
 			8, 5, true)
@@ -1007,6 +1095,16 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.Features != nil {
+		features := invoice.Features.EncodeBase32()
+		if len(features) > 0 {
+			err := writeTaggedField(bufferBase32, fieldType9, features)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if invoice.Destination != nil {
 		// Convert 33 byte pubkey to 53 5-bit groups.
 		pubKeyBase32, err := bech32.ConvertBits(
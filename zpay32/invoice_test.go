@@ -757,6 +757,61 @@ func TestNewInvoice(t *testing.T) {
 	}
 }
 
+// TestPaymentAddr tests that the payment address and feature bits of an
+// invoice survive encoding and decoding, and that an invoice requiring a
+// payment address must include one.
+func TestPaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	var paymentAddr [32]byte
+	copy(paymentAddr[:], bytes.Repeat([]byte{0x11}, 32))
+
+	invoice, err := NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash,
+		time.Unix(1496314658, 0), Amount(testMillisat2500uBTC),
+		Description(testCupOfCoffee), PaymentAddr(paymentAddr),
+		Features(lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(lnwire.PaymentAddrRequired),
+			lnwire.Features,
+		)),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+
+	// The destination is recovered from the signature when decoding.
+	invoice.Destination = testPubKey
+	if err := compareInvoices(invoice, decoded); err != nil {
+		t.Fatalf("decoded invoice doesn't match: %v", err)
+	}
+	if !decoded.Features.HasFeature(lnwire.PaymentAddrOptional) {
+		t.Fatalf("expected payment addr feature to be set")
+	}
+
+	_, err = NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash,
+		time.Unix(1496314658, 0), Description(testCupOfCoffee),
+		Features(lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(lnwire.PaymentAddrRequired),
+			lnwire.Features,
+		)),
+	)
+	if err == nil {
+		t.Fatalf("expected invoice requiring a payment addr without " +
+			"one to be invalid")
+	}
+}
+
 func compareInvoices(expected, actual *Invoice) error {
 	if !reflect.DeepEqual(expected.Net, actual.Net) {
 		return fmt.Errorf("expected net %v, got %v",
@@ -815,9 +870,26 @@ func compareInvoices(expected, actual *Invoice) error {
 		}
 	}
 
+	if !compareHashes(expected.PaymentAddr, actual.PaymentAddr) {
+		return fmt.Errorf("expected payment addr %x, got %x",
+			expected.PaymentAddr, actual.PaymentAddr)
+	}
+
+	if !compareFeatures(expected.Features, actual.Features) {
+		return fmt.Errorf("expected features %v, got %v",
+			expected.Features, actual.Features)
+	}
+
 	return nil
 }
 
+func compareFeatures(a, b *lnwire.FeatureVector) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.EncodeBase32(), b.EncodeBase32())
+}
+
 func comparePubkeys(a, b *btcec.PublicKey) bool {
 	if a == b {
 		return true